@@ -49,13 +49,21 @@ func Run(proc *query.Procedure, input string, sourceFile string, outfile string)
 		if err != nil {
 			return errors.New(fmt.Sprintf("failed to create file: %s", err.Error()))
 		}
+		cw, err := csvqfile.NewCompressWriter(fp, csvqfile.CompressionOf(outfile))
+		if err != nil {
+			fp.Close()
+			os.Remove(outfile)
+			return errors.New(fmt.Sprintf("failed to create file: %s", err.Error()))
+		}
+		w := &outFileWriter{WriteCloser: cw}
 		defer func() {
-			if info, err := fp.Stat(); err == nil && info.Size() < 1 {
+			w.Close()
+			fp.Close()
+			if !w.written {
 				os.Remove(outfile)
 			}
-			fp.Close()
 		}()
-		query.OutFile = fp
+		query.OutFile = w
 	}
 
 	flow, err := proc.Execute(statements)
@@ -69,6 +77,21 @@ func Run(proc *query.Procedure, input string, sourceFile string, outfile string)
 	return err
 }
 
+// outFileWriter compresses the query results written to the file, and records
+// whether anything is written because compressed data can be buffered until
+// the writer is closed.
+type outFileWriter struct {
+	io.WriteCloser
+	written bool
+}
+
+func (w *outFileWriter) Write(p []byte) (int, error) {
+	if 0 < len(p) {
+		w.written = true
+	}
+	return w.WriteCloser.Write(p)
+}
+
 func LaunchInteractiveShell(proc *query.Procedure) error {
 	if cmd.IsReadableFromPipeOrRedirection() {
 		return errors.New("input from pipe or redirection cannot be used in interactive shell")
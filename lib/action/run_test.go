@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/file"
 	"github.com/mithrandie/csvq/lib/query"
 )
 
@@ -30,6 +31,17 @@ var executeTests = []struct {
 			"| 1 |\n" +
 			"+---+\n",
 	},
+	{
+		Name:    "Select Query Output To Compressed File",
+		Input:   "select 1 from dual",
+		OutFile: GetTestFilePath("select_query_output_file.csv.gz"),
+		Content: "" +
+			"+---+\n" +
+			"| 1 |\n" +
+			"+---+\n" +
+			"| 1 |\n" +
+			"+---+\n",
+	},
 	{
 		Name:   "Print",
 		Input:  "var @a := 1; print @a;",
@@ -100,7 +112,10 @@ func TestRun(t *testing.T) {
 
 			if 0 < len(v.OutFile) {
 				fp, _ := os.Open(v.OutFile)
-				buf, _ := ioutil.ReadAll(fp)
+				r, _ := file.NewDecompressReader(fp, file.CompressionOf(v.OutFile))
+				buf, _ := ioutil.ReadAll(r)
+				_ = r.Close()
+				_ = fp.Close()
 				if string(buf) != v.Content {
 					t.Errorf("%s: content = %q, want %q", v.Name, string(buf), v.Content)
 				}
@@ -0,0 +1,80 @@
+package action
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"sync"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/pgserver"
+	"github.com/mithrandie/csvq/lib/query"
+)
+
+var runningServer = struct {
+	mtx    sync.Mutex
+	server *pgserver.Server
+}{}
+
+// Serve serves the repository to PostgreSQL clients.
+// Files that clients can access are confined to the repository unless
+// allowOutsideRepository is true, and the password is required to listen on
+// addresses other than loopback addresses.
+func Serve(addr string, password string, allowOutsideRepository bool) error {
+	flags := cmd.GetFlags()
+
+	server := pgserver.NewServer(flags)
+	server.Password = password
+	if !allowOutsideRepository {
+		root := flags.Repository
+		if len(root) < 1 {
+			wd, err := os.Getwd()
+			if err != nil {
+				return err
+			}
+			root = wd
+		}
+		server.RootDirectory = root
+	}
+
+	l, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+	if len(password) < 1 && !isLoopbackAddr(l.Addr()) {
+		_ = l.Close()
+		return errors.New(fmt.Sprintf("password is required to listen on %s", addr))
+	}
+
+	runningServer.mtx.Lock()
+	runningServer.server = server
+	runningServer.mtx.Unlock()
+
+	query.LogNotice(fmt.Sprintf("Listening for PostgreSQL clients on %s", l.Addr()), flags.Quiet)
+	err = server.Serve(l)
+	if err == pgserver.ErrServerClosed {
+		return nil
+	}
+	return err
+}
+
+func isLoopbackAddr(addr net.Addr) bool {
+	tcpAddr, ok := addr.(*net.TCPAddr)
+	return ok && tcpAddr.IP.IsLoopback()
+}
+
+// closeRunningServer closes the server started by Serve and rolls back the
+// uncommitted changes of its connections.
+func closeRunningServer() {
+	runningServer.mtx.Lock()
+	server := runningServer.server
+	runningServer.server = nil
+	runningServer.mtx.Unlock()
+
+	if server != nil {
+		if err := server.Close(); err != nil {
+			query.LogError(err.Error())
+		}
+	}
+}
@@ -0,0 +1,15 @@
+package action
+
+import (
+	"testing"
+)
+
+func TestServe_PasswordRequired(t *testing.T) {
+	err := Serve("0.0.0.0:0", "", false)
+	expect := "password is required to listen on 0.0.0.0:0"
+	if err == nil {
+		t.Errorf("no error, want error %q", expect)
+	} else if err.Error() != expect {
+		t.Errorf("error %q, want error %q", err.Error(), expect)
+	}
+}
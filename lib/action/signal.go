@@ -13,6 +13,7 @@ func SetSignalHandler() {
 
 	go func() {
 		<-ch
+		closeRunningServer()
 		if err := query.Rollback(nil, nil); err != nil {
 			query.LogError(err.Error())
 		}
@@ -7,6 +7,7 @@ import (
 	"os"
 	"path/filepath"
 	"runtime"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -25,6 +26,7 @@ const (
 	RuntimeInformationSign  = "@#"
 )
 const DelimiteAutomatically = "SPACES"
+const DetectAutomatically = "AUTO"
 
 const (
 	RepositoryFlag           = "REPOSITORY"
@@ -33,9 +35,17 @@ const (
 	WaitTimeoutFlag          = "WAIT_TIMEOUT"
 	DelimiterFlag            = "DELIMITER"
 	JsonQueryFlag            = "JSON_QUERY"
+	SheetFlag                = "SHEET"
 	EncodingFlag             = "ENCODING"
 	NoHeaderFlag             = "NO_HEADER"
 	WithoutNullFlag          = "WITHOUT_NULL"
+	QuoteCharFlag            = "QUOTE_CHAR"
+	EscapeStyleFlag          = "ESCAPE_STYLE"
+	LazyQuotesFlag           = "LAZY_QUOTES"
+	CommentPrefixFlag        = "COMMENT_PREFIX"
+	SkipLinesFlag            = "SKIP_LINES"
+	OnParseErrorFlag         = "ON_PARSE_ERROR"
+	RejectFileFlag           = "REJECT_FILE"
 	FormatFlag               = "FORMAT"
 	WriteEncodingFlag        = "WRITE_ENCODING"
 	WriteDelimiterFlag       = "WRITE_DELIMITER"
@@ -60,9 +70,17 @@ var FlagList = []string{
 	WaitTimeoutFlag,
 	DelimiterFlag,
 	JsonQueryFlag,
+	SheetFlag,
 	EncodingFlag,
 	NoHeaderFlag,
 	WithoutNullFlag,
+	QuoteCharFlag,
+	EscapeStyleFlag,
+	LazyQuotesFlag,
+	CommentPrefixFlag,
+	SkipLinesFlag,
+	OnParseErrorFlag,
+	RejectFileFlag,
 	FormatFlag,
 	WriteEncodingFlag,
 	WriteDelimiterFlag,
@@ -92,23 +110,93 @@ const (
 	GFM
 	ORG
 	TEXT
+	PARQUET
+	XLSX
+	SQLITE
+	JSONL
 )
 
 var FormatLiteral = map[Format]string{
-	CSV:   "CSV",
-	TSV:   "TSV",
-	FIXED: "FIXED",
-	JSON:  "JSON",
-	LTSV:  "LTSV",
-	GFM:   "GFM",
-	ORG:   "ORG",
-	TEXT:  "TEXT",
+	CSV:     "CSV",
+	TSV:     "TSV",
+	FIXED:   "FIXED",
+	JSON:    "JSON",
+	LTSV:    "LTSV",
+	GFM:     "GFM",
+	ORG:     "ORG",
+	TEXT:    "TEXT",
+	PARQUET: "PARQUET",
+	XLSX:    "XLSX",
+	SQLITE:  "SQLITE",
+	JSONL:   "JSONL",
 }
 
 func (f Format) String() string {
 	return FormatLiteral[f]
 }
 
+type EscapeStyle int
+
+const (
+	DoubleQuoteEscape EscapeStyle = iota
+	BackslashEscape
+)
+
+var EscapeStyleLiteral = map[EscapeStyle]string{
+	DoubleQuoteEscape: "DOUBLE",
+	BackslashEscape:   "BACKSLASH",
+}
+
+func (e EscapeStyle) String() string {
+	return EscapeStyleLiteral[e]
+}
+
+type ParseErrorPolicy int
+
+const (
+	FailOnParseError ParseErrorPolicy = iota
+	SkipOnParseError
+	PadOnParseError
+)
+
+var ParseErrorPolicyLiteral = map[ParseErrorPolicy]string{
+	FailOnParseError: "FAIL",
+	SkipOnParseError: "SKIP",
+	PadOnParseError:  "PAD",
+}
+
+func (p ParseErrorPolicy) String() string {
+	return ParseErrorPolicyLiteral[p]
+}
+
+// Character encodings that are converted by csvq in addition to text.UTF8 and text.SJIS.
+const (
+	UTF8BOM     text.Encoding = "UTF8BOM"
+	UTF16LE     text.Encoding = "UTF16LE"
+	UTF16BE     text.Encoding = "UTF16BE"
+	EUCJP       text.Encoding = "EUCJP"
+	ISO2022JP   text.Encoding = "ISO2022JP"
+	LATIN1      text.Encoding = "LATIN1"
+	WINDOWS1252 text.Encoding = "WINDOWS1252"
+)
+
+var EncodingList = []text.Encoding{
+	text.UTF8,
+	UTF8BOM,
+	UTF16LE,
+	UTF16BE,
+	text.SJIS,
+	EUCJP,
+	ISO2022JP,
+	LATIN1,
+	WINDOWS1252,
+}
+
+var encodingAliases = map[string]text.Encoding{
+	"ISO88591": LATIN1,
+	"CP1252":   WINDOWS1252,
+}
+
 var JsonEscapeTypeLiteral = map[txjson.EscapeType]string{
 	txjson.Backslash:        "BACKSLASH",
 	txjson.HexDigits:        "HEX",
@@ -124,9 +212,12 @@ const (
 	TsvExt      = ".tsv"
 	FixedExt    = ".txt"
 	JsonExt     = ".json"
+	JsonlExt    = ".jsonl"
 	LtsvExt     = ".ltsv"
 	GfmExt      = ".md"
 	OrgExt      = ".org"
+	ParquetExt  = ".parquet"
+	XlsxExt     = ".xlsx"
 	SqlExt      = ".sql"
 	CsvqProcExt = ".cql"
 )
@@ -141,6 +232,7 @@ type Flags struct {
 	// For Import
 	Delimiter   rune
 	JsonQuery   string
+	Sheet       string
 	Encoding    text.Encoding
 	NoHeader    bool
 	WithoutNull bool
@@ -169,6 +261,18 @@ type Flags struct {
 	Stats bool
 
 	// For CSV
+	DetectDelimiter bool
+	DetectEncoding  bool
+	DetectHeader    bool
+	DetectQuote     bool
+	QuoteChar       rune
+	EscapeStyle     EscapeStyle
+	LazyQuotes      bool
+	CommentPrefix   string
+	SkipLines       int
+	OnParseError    ParseErrorPolicy
+	RejectFile      string
+
 	// For Fixed-Length Format
 	DelimitAutomatically    bool
 	DelimiterPositions      []int
@@ -196,48 +300,82 @@ func GetDefaultNumberOfCPU() int {
 
 func GetFlags() *Flags {
 	getFlags.Do(func() {
-		env, _ := GetEnvironment()
-
-		datetimeFormat := make([]string, 0, len(env.DatetimeFormat))
-		for _, v := range env.DatetimeFormat {
-			datetimeFormat = AppendStrIfNotExist(datetimeFormat, v)
-		}
-
-		flags = &Flags{
-			Repository:              "",
-			Location:                "Local",
-			DatetimeFormat:          datetimeFormat,
-			WaitTimeout:             10,
-			Delimiter:               ',',
-			JsonQuery:               "",
-			Encoding:                text.UTF8,
-			NoHeader:                false,
-			WithoutNull:             false,
-			Format:                  TEXT,
-			WriteEncoding:           text.UTF8,
-			WriteDelimiter:          ',',
-			WithoutHeader:           false,
-			LineBreak:               text.LF,
-			EncloseAll:              false,
-			JsonEscape:              txjson.Backslash,
-			PrettyPrint:             false,
-			EastAsianEncoding:       false,
-			CountDiacriticalSign:    false,
-			CountFormatCode:         false,
-			Color:                   false,
-			Quiet:                   false,
-			CPU:                     GetDefaultNumberOfCPU(),
-			Stats:                   false,
-			DelimitAutomatically:    false,
-			DelimiterPositions:      nil,
-			WriteDelimiterPositions: nil,
-			RetryInterval:           10 * time.Millisecond,
-			Now:                     "",
-		}
+		flags = NewFlags()
 	})
 	return flags
 }
 
+func NewFlags() *Flags {
+	env, _ := GetEnvironment()
+
+	datetimeFormat := make([]string, 0, len(env.DatetimeFormat))
+	for _, v := range env.DatetimeFormat {
+		datetimeFormat = AppendStrIfNotExist(datetimeFormat, v)
+	}
+
+	return &Flags{
+		Repository:              "",
+		Location:                "Local",
+		DatetimeFormat:          datetimeFormat,
+		WaitTimeout:             10,
+		Delimiter:               ',',
+		JsonQuery:               "",
+		Sheet:                   "",
+		Encoding:                text.UTF8,
+		NoHeader:                false,
+		WithoutNull:             false,
+		Format:                  TEXT,
+		WriteEncoding:           text.UTF8,
+		WriteDelimiter:          ',',
+		WithoutHeader:           false,
+		LineBreak:               text.LF,
+		EncloseAll:              false,
+		JsonEscape:              txjson.Backslash,
+		PrettyPrint:             false,
+		EastAsianEncoding:       false,
+		CountDiacriticalSign:    false,
+		CountFormatCode:         false,
+		Color:                   false,
+		Quiet:                   false,
+		CPU:                     GetDefaultNumberOfCPU(),
+		Stats:                   false,
+		DetectDelimiter:         false,
+		DetectEncoding:          false,
+		DetectHeader:            false,
+		DetectQuote:             false,
+		QuoteChar:               '"',
+		EscapeStyle:             DoubleQuoteEscape,
+		LazyQuotes:              false,
+		CommentPrefix:           "",
+		SkipLines:               0,
+		OnParseError:            FailOnParseError,
+		RejectFile:              "",
+		DelimitAutomatically:    false,
+		DelimiterPositions:      nil,
+		WriteDelimiterPositions: nil,
+		RetryInterval:           10 * time.Millisecond,
+		Now:                     "",
+	}
+}
+
+// Copy returns a copy of the flags that does not share slices with the original.
+func (f *Flags) Copy() *Flags {
+	c := *f
+	if f.DatetimeFormat != nil {
+		c.DatetimeFormat = make([]string, len(f.DatetimeFormat))
+		copy(c.DatetimeFormat, f.DatetimeFormat)
+	}
+	if f.DelimiterPositions != nil {
+		c.DelimiterPositions = make([]int, len(f.DelimiterPositions))
+		copy(c.DelimiterPositions, f.DelimiterPositions)
+	}
+	if f.WriteDelimiterPositions != nil {
+		c.WriteDelimiterPositions = make([]int, len(f.WriteDelimiterPositions))
+		copy(c.WriteDelimiterPositions, f.WriteDelimiterPositions)
+	}
+	return &c
+}
+
 func (f *Flags) SelectImportFormat() Format {
 	if 0 < len(f.JsonQuery) {
 		return JSON
@@ -245,6 +383,9 @@ func (f *Flags) SelectImportFormat() Format {
 	if f.DelimitAutomatically || f.DelimiterPositions != nil {
 		return FIXED
 	}
+	if f.DetectDelimiter {
+		return CSV
+	}
 	if f.Delimiter == '\t' {
 		return TSV
 	}
@@ -311,8 +452,8 @@ func (f *Flags) SetWaitTimeout(t float64) {
 		t = 0
 	}
 
-	flags.WaitTimeout = t
-	file.UpdateWaitTimeout(flags.WaitTimeout, flags.RetryInterval)
+	f.WaitTimeout = t
+	file.UpdateWaitTimeout(f.WaitTimeout, f.RetryInterval)
 	return
 }
 
@@ -321,6 +462,13 @@ func (f *Flags) SetDelimiter(s string) error {
 		return nil
 	}
 
+	if strings.EqualFold(s, DetectAutomatically) {
+		f.DetectDelimiter = true
+		f.DelimiterPositions = nil
+		f.DelimitAutomatically = false
+		return nil
+	}
+
 	delimiter, delimiterPositions, delimitAutomatically, err := ParseDelimiter(s, f.Delimiter, f.DelimiterPositions, f.DelimitAutomatically)
 	if err != nil {
 		return err
@@ -329,6 +477,7 @@ func (f *Flags) SetDelimiter(s string) error {
 	f.Delimiter = delimiter
 	f.DelimiterPositions = delimiterPositions
 	f.DelimitAutomatically = delimitAutomatically
+	f.DetectDelimiter = false
 	return nil
 }
 
@@ -336,28 +485,149 @@ func (f *Flags) SetJsonQuery(s string) {
 	f.JsonQuery = strings.TrimSpace(s)
 }
 
+func (f *Flags) SetSheet(s string) {
+	f.Sheet = strings.TrimSpace(s)
+}
+
 func (f *Flags) SetEncoding(s string) error {
 	if len(s) < 1 {
 		return nil
 	}
 
+	if strings.EqualFold(s, DetectAutomatically) {
+		f.DetectEncoding = true
+		return nil
+	}
+
 	encoding, err := ParseEncoding(s)
 	if err != nil {
 		return err
 	}
 
 	f.Encoding = encoding
+	f.DetectEncoding = false
 	return nil
 }
 
 func (f *Flags) SetNoHeader(b bool) {
 	f.NoHeader = b
+	f.DetectHeader = false
+}
+
+func (f *Flags) SetDetectHeader(b bool) {
+	f.DetectHeader = b
+}
+
+// SetNoHeaderValue sets the no-header flag from one of TRUE, FALSE and AUTO.
+// AUTO enables the detection of the header line.
+func (f *Flags) SetNoHeaderValue(s string) error {
+	b, detect, err := parseNoHeader(s)
+	if err != nil {
+		return err
+	}
+
+	if detect {
+		f.SetDetectHeader(true)
+	} else {
+		f.SetNoHeader(b)
+	}
+	return nil
+}
+
+func parseNoHeader(s string) (bool, bool, error) {
+	if strings.EqualFold(s, DetectAutomatically) {
+		return false, true, nil
+	}
+
+	b, err := strconv.ParseBool(s)
+	if err != nil {
+		return false, false, errors.New("no-header must be one of TRUE|FALSE|AUTO")
+	}
+	return b, false, nil
+}
+
+// NoHeaderValue is the value of the no-header option that accepts TRUE, FALSE
+// and AUTO. It implements flag.Value and can be passed without a value like
+// a boolean option.
+type NoHeaderValue struct {
+	value string
+}
+
+func (v *NoHeaderValue) Set(s string) error {
+	if _, _, err := parseNoHeader(s); err != nil {
+		return err
+	}
+	v.value = s
+	return nil
+}
+
+func (v *NoHeaderValue) String() string {
+	return v.value
+}
+
+func (v *NoHeaderValue) IsBoolFlag() bool {
+	return true
 }
 
 func (f *Flags) SetWithoutNull(b bool) {
 	f.WithoutNull = b
 }
 
+func (f *Flags) SetQuoteChar(s string) error {
+	if strings.EqualFold(s, DetectAutomatically) {
+		f.DetectQuote = true
+		return nil
+	}
+
+	c, err := ParseQuoteChar(s)
+	if err != nil {
+		return err
+	}
+
+	f.QuoteChar = c
+	f.DetectQuote = false
+	return nil
+}
+
+func (f *Flags) SetEscapeStyle(s string) error {
+	style, err := ParseEscapeStyle(s)
+	if err != nil {
+		return err
+	}
+
+	f.EscapeStyle = style
+	return nil
+}
+
+func (f *Flags) SetLazyQuotes(b bool) {
+	f.LazyQuotes = b
+}
+
+func (f *Flags) SetCommentPrefix(s string) {
+	f.CommentPrefix = UnescapeString(s)
+}
+
+func (f *Flags) SetSkipLines(i int) {
+	if i < 0 {
+		i = 0
+	}
+	f.SkipLines = i
+}
+
+func (f *Flags) SetOnParseError(s string) error {
+	policy, err := ParseParseErrorPolicy(s)
+	if err != nil {
+		return err
+	}
+
+	f.OnParseError = policy
+	return nil
+}
+
+func (f *Flags) SetRejectFile(s string) {
+	f.RejectFile = s
+}
+
 func (f *Flags) SetFormat(s string, outfile string) error {
 	var fm Format
 	var escape txjson.EscapeType
@@ -374,12 +644,18 @@ func (f *Flags) SetFormat(s string, outfile string) error {
 			fm = FIXED
 		case JsonExt:
 			fm = JSON
+		case JsonlExt:
+			fm = JSONL
 		case LtsvExt:
 			fm = LTSV
 		case GfmExt:
 			fm = GFM
 		case OrgExt:
 			fm = ORG
+		case ParquetExt:
+			fm = PARQUET
+		case XlsxExt:
+			fm = XLSX
 		default:
 			return nil
 		}
@@ -1,6 +1,8 @@
 package cmd
 
 import (
+	"flag"
+	"io"
 	"path/filepath"
 	"reflect"
 	"runtime"
@@ -12,6 +14,37 @@ import (
 	"github.com/mithrandie/csvq/lib/file"
 )
 
+func TestNewFlags(t *testing.T) {
+	flags := NewFlags()
+	if flags == GetFlags() {
+		t.Error("NewFlags() returns the shared flags, want a new instance")
+	}
+
+	flags.SetNoHeader(true)
+	if GetFlags().NoHeader {
+		t.Error("no-header of the shared flags is changed, want to be unchanged")
+	}
+}
+
+func TestFlags_Copy(t *testing.T) {
+	flags := NewFlags()
+	flags.DatetimeFormat = []string{"%Y%m%d"}
+	flags.DelimiterPositions = []int{2, 5}
+	flags.WriteDelimiterPositions = []int{3, 6}
+
+	c := flags.Copy()
+	if !reflect.DeepEqual(c, flags) {
+		t.Errorf("copy = %v, want %v", c, flags)
+	}
+
+	c.DatetimeFormat[0] = "%H%i"
+	c.DelimiterPositions[0] = 1
+	c.WriteDelimiterPositions[0] = 1
+	if flags.DatetimeFormat[0] != "%Y%m%d" || flags.DelimiterPositions[0] != 2 || flags.WriteDelimiterPositions[0] != 3 {
+		t.Error("slices of the copy are shared with the original")
+	}
+}
+
 func TestFlags_SelectImportFormat(t *testing.T) {
 	flags := GetFlags()
 
@@ -37,6 +70,13 @@ func TestFlags_SelectImportFormat(t *testing.T) {
 		t.Errorf("import-format = %q, want %q", format.String(), expect.String())
 	}
 
+	flags.SetDelimiter("auto")
+	format = flags.SelectImportFormat()
+	expect = CSV
+	if format != expect {
+		t.Errorf("import-format = %q, want %q", format.String(), expect.String())
+	}
+
 	flags.SetDelimiter(",")
 	format = flags.SelectImportFormat()
 	expect = CSV
@@ -189,6 +229,19 @@ func TestFlags_SetDelimiter(t *testing.T) {
 		t.Errorf("delimitPositions = %v, expect to set %v for %q", flags.DelimiterPositions, nil, "spaces")
 	}
 
+	flags.SetDelimiter("auto")
+	if flags.DetectDelimiter != true {
+		t.Errorf("detectDelimiter = %t, expect to set %t for %q", flags.DetectDelimiter, true, "auto")
+	}
+	if flags.DelimitAutomatically != false {
+		t.Errorf("delimitAutomatically = %t, expect to set %t for %q", flags.DelimitAutomatically, false, "auto")
+	}
+
+	flags.SetDelimiter(";")
+	if flags.DetectDelimiter != false {
+		t.Errorf("detectDelimiter = %t, expect to set %t for %q", flags.DetectDelimiter, false, ";")
+	}
+
 	expectErr := "delimiter must be one character, \"SPACES\" or JSON array of integers"
 	err := flags.SetDelimiter("[a]")
 	if err == nil {
@@ -215,6 +268,15 @@ func TestFlags_SetJsonQuery(t *testing.T) {
 	}
 }
 
+func TestFlags_SetSheet(t *testing.T) {
+	flags := GetFlags()
+
+	flags.SetSheet(" Sheet2 ")
+	if flags.Sheet != "Sheet2" {
+		t.Errorf("sheet = %q, expect to set %q", flags.Sheet, "Sheet2")
+	}
+}
+
 func TestFlags_SetEncoding(t *testing.T) {
 	flags := GetFlags()
 
@@ -223,7 +285,17 @@ func TestFlags_SetEncoding(t *testing.T) {
 		t.Errorf("encoding = %s, expect to set %s for %s", flags.Encoding, text.SJIS, "sjis")
 	}
 
-	expectErr := "encoding must be one of UTF8|SJIS"
+	flags.SetEncoding("auto")
+	if !flags.DetectEncoding {
+		t.Errorf("detect-encoding = %t, expect to set %t for %s", flags.DetectEncoding, true, "auto")
+	}
+
+	flags.SetEncoding("sjis")
+	if flags.DetectEncoding {
+		t.Errorf("detect-encoding = %t, expect to set %t for %s", flags.DetectEncoding, false, "sjis")
+	}
+
+	expectErr := "encoding must be one of UTF8|UTF8BOM|UTF16LE|UTF16BE|SJIS|EUCJP|ISO2022JP|LATIN1|WINDOWS1252"
 	err := flags.SetEncoding("error")
 	if err == nil {
 		t.Errorf("no error, want error %q for %s", expectErr, "error")
@@ -235,10 +307,93 @@ func TestFlags_SetEncoding(t *testing.T) {
 func TestFlags_SetNoHeader(t *testing.T) {
 	flags := GetFlags()
 
+	flags.SetDetectHeader(true)
 	flags.SetNoHeader(true)
 	if !flags.NoHeader {
 		t.Errorf("no-header = %t, expect to set %t", flags.NoHeader, true)
 	}
+	if flags.DetectHeader {
+		t.Errorf("detect-header = %t, expect to set %t", flags.DetectHeader, false)
+	}
+}
+
+func TestFlags_SetDetectHeader(t *testing.T) {
+	flags := GetFlags()
+
+	flags.SetDetectHeader(true)
+	if !flags.DetectHeader {
+		t.Errorf("detect-header = %t, expect to set %t", flags.DetectHeader, true)
+	}
+	flags.SetDetectHeader(false)
+}
+
+func TestFlags_SetNoHeaderValue(t *testing.T) {
+	flags := NewFlags()
+
+	if err := flags.SetNoHeaderValue("auto"); err != nil {
+		t.Errorf("unexpected error: %q", err.Error())
+	}
+	if !flags.DetectHeader {
+		t.Errorf("detect-header = %t, expect to set %t for %s", flags.DetectHeader, true, "auto")
+	}
+
+	if err := flags.SetNoHeaderValue("true"); err != nil {
+		t.Errorf("unexpected error: %q", err.Error())
+	}
+	if !flags.NoHeader {
+		t.Errorf("no-header = %t, expect to set %t for %s", flags.NoHeader, true, "true")
+	}
+	if flags.DetectHeader {
+		t.Errorf("detect-header = %t, expect to set %t for %s", flags.DetectHeader, false, "true")
+	}
+
+	if err := flags.SetNoHeaderValue("FALSE"); err != nil {
+		t.Errorf("unexpected error: %q", err.Error())
+	}
+	if flags.NoHeader {
+		t.Errorf("no-header = %t, expect to set %t for %s", flags.NoHeader, false, "FALSE")
+	}
+
+	expectErr := "no-header must be one of TRUE|FALSE|AUTO"
+	err := flags.SetNoHeaderValue("error")
+	if err == nil {
+		t.Errorf("no error, want error %q for %s", expectErr, "error")
+	} else if err.Error() != expectErr {
+		t.Errorf("error = %q, want error %q for %s", err.Error(), expectErr, "error")
+	}
+}
+
+func TestNoHeaderValue(t *testing.T) {
+	for _, v := range []struct {
+		Args   []string
+		Expect string
+		Error  bool
+	}{
+		{Args: []string{"-n"}, Expect: "true"},
+		{Args: []string{"-n=AUTO"}, Expect: "AUTO"},
+		{Args: []string{"--n=false"}, Expect: "false"},
+		{Args: []string{"-n=error"}, Error: true},
+	} {
+		value := &NoHeaderValue{}
+		set := flag.NewFlagSet("test", flag.ContinueOnError)
+		set.SetOutput(io.Discard)
+		set.Var(value, "n", "")
+
+		err := set.Parse(v.Args)
+		if err != nil {
+			if !v.Error {
+				t.Errorf("unexpected error %q for %v", err, v.Args)
+			}
+			continue
+		}
+		if v.Error {
+			t.Errorf("no error, want error for %v", v.Args)
+			continue
+		}
+		if value.String() != v.Expect {
+			t.Errorf("value = %q, want %q for %v", value.String(), v.Expect, v.Args)
+		}
+	}
 }
 
 func TestFlags_SetWithoutNull(t *testing.T) {
@@ -250,6 +405,129 @@ func TestFlags_SetWithoutNull(t *testing.T) {
 	}
 }
 
+func TestFlags_SetQuoteChar(t *testing.T) {
+	flags := GetFlags()
+
+	if err := flags.SetQuoteChar("'"); err != nil {
+		t.Errorf("unexpected error: %q", err.Error())
+	}
+	if flags.QuoteChar != '\'' {
+		t.Errorf("quote-char = %q, expect to set %q", flags.QuoteChar, '\'')
+	}
+
+	_ = flags.SetQuoteChar("auto")
+	if !flags.DetectQuote {
+		t.Errorf("detect-quote = %t, expect to set %t for %s", flags.DetectQuote, true, "auto")
+	}
+	if flags.QuoteChar != '\'' {
+		t.Errorf("quote-char = %q, expect to keep %q for %s", flags.QuoteChar, '\'', "auto")
+	}
+
+	_ = flags.SetQuoteChar("'")
+	if flags.DetectQuote {
+		t.Errorf("detect-quote = %t, expect to set %t for %s", flags.DetectQuote, false, "'")
+	}
+
+	expectErr := "quote-char must be one character except line breaks"
+	for _, s := range []string{"", "ab", "\\n"} {
+		err := flags.SetQuoteChar(s)
+		if err == nil {
+			t.Errorf("no error, want error %q for %q", expectErr, s)
+		} else if err.Error() != expectErr {
+			t.Errorf("error = %q, want error %q for %q", err.Error(), expectErr, s)
+		}
+	}
+
+	_ = flags.SetQuoteChar("\"")
+}
+
+func TestFlags_SetEscapeStyle(t *testing.T) {
+	flags := GetFlags()
+
+	if err := flags.SetEscapeStyle("backslash"); err != nil {
+		t.Errorf("unexpected error: %q", err.Error())
+	}
+	if flags.EscapeStyle != BackslashEscape {
+		t.Errorf("escape-style = %s, expect to set %s", flags.EscapeStyle, BackslashEscape)
+	}
+
+	expectErr := "escape-style must be one of DOUBLE|BACKSLASH"
+	err := flags.SetEscapeStyle("error")
+	if err == nil {
+		t.Errorf("no error, want error %q", expectErr)
+	} else if err.Error() != expectErr {
+		t.Errorf("error = %q, want error %q", err.Error(), expectErr)
+	}
+
+	_ = flags.SetEscapeStyle("double")
+}
+
+func TestFlags_SetLazyQuotes(t *testing.T) {
+	flags := GetFlags()
+
+	flags.SetLazyQuotes(true)
+	if !flags.LazyQuotes {
+		t.Errorf("lazy-quotes = %t, expect to set %t", flags.LazyQuotes, true)
+	}
+	flags.SetLazyQuotes(false)
+}
+
+func TestFlags_SetCommentPrefix(t *testing.T) {
+	flags := GetFlags()
+
+	flags.SetCommentPrefix("//")
+	if flags.CommentPrefix != "//" {
+		t.Errorf("comment-prefix = %q, expect to set %q", flags.CommentPrefix, "//")
+	}
+	flags.SetCommentPrefix("")
+}
+
+func TestFlags_SetSkipLines(t *testing.T) {
+	flags := GetFlags()
+
+	flags.SetSkipLines(3)
+	if flags.SkipLines != 3 {
+		t.Errorf("skip-lines = %d, expect to set %d", flags.SkipLines, 3)
+	}
+
+	flags.SetSkipLines(-1)
+	if flags.SkipLines != 0 {
+		t.Errorf("skip-lines = %d, expect to set %d", flags.SkipLines, 0)
+	}
+}
+
+func TestFlags_SetOnParseError(t *testing.T) {
+	flags := GetFlags()
+
+	if err := flags.SetOnParseError("pad"); err != nil {
+		t.Errorf("unexpected error: %q", err.Error())
+	}
+	if flags.OnParseError != PadOnParseError {
+		t.Errorf("on-parse-error = %s, expect to set %s", flags.OnParseError, PadOnParseError)
+	}
+
+	expectErr := "on-parse-error must be one of FAIL|SKIP|PAD"
+	err := flags.SetOnParseError("error")
+	if err == nil {
+		t.Errorf("no error, want error %q", expectErr)
+	} else if err.Error() != expectErr {
+		t.Errorf("error = %q, want error %q", err.Error(), expectErr)
+	}
+
+	_ = flags.SetOnParseError("fail")
+}
+
+func TestFlags_SetRejectFile(t *testing.T) {
+	flags := GetFlags()
+
+	flags.SetRejectFile("rejected.csv")
+	if flags.RejectFile != "rejected.csv" {
+		t.Errorf("reject-file = %q, expect to set %q", flags.RejectFile, "rejected.csv")
+	}
+
+	flags.SetRejectFile("")
+}
+
 func TestFlags_SetFormat(t *testing.T) {
 	flags := GetFlags()
 
@@ -293,6 +571,21 @@ func TestFlags_SetFormat(t *testing.T) {
 		t.Errorf("format = %s, expect to set %s for empty string with file %q", flags.Format, ORG, "foo.org")
 	}
 
+	flags.SetFormat("", "foo.parquet")
+	if flags.Format != PARQUET {
+		t.Errorf("format = %s, expect to set %s for empty string with file %q", flags.Format, PARQUET, "foo.parquet")
+	}
+
+	flags.SetFormat("", "foo.xlsx")
+	if flags.Format != XLSX {
+		t.Errorf("format = %s, expect to set %s for empty string with file %q", flags.Format, XLSX, "foo.xlsx")
+	}
+
+	flags.SetFormat("", "foo.jsonl")
+	if flags.Format != JSONL {
+		t.Errorf("format = %s, expect to set %s for empty string with file %q", flags.Format, JSONL, "foo.jsonl")
+	}
+
 	flags.SetFormat("csv", "")
 	if flags.Format != CSV {
 		t.Errorf("format = %s, expect to set %s for %s", flags.Format, CSV, "csv")
@@ -349,7 +642,22 @@ func TestFlags_SetFormat(t *testing.T) {
 		t.Errorf("format = %s, expect to set %s for %s", flags.Format, TEXT, "text")
 	}
 
-	expectErr := "format must be one of CSV|TSV|FIXED|JSON|LTSV|GFM|ORG|TEXT"
+	flags.SetFormat("parquet", "")
+	if flags.Format != PARQUET {
+		t.Errorf("format = %s, expect to set %s for %s", flags.Format, PARQUET, "parquet")
+	}
+
+	flags.SetFormat("xlsx", "")
+	if flags.Format != XLSX {
+		t.Errorf("format = %s, expect to set %s for %s", flags.Format, XLSX, "xlsx")
+	}
+
+	flags.SetFormat("jsonl", "")
+	if flags.Format != JSONL {
+		t.Errorf("format = %s, expect to set %s for %s", flags.Format, JSONL, "jsonl")
+	}
+
+	expectErr := "format must be one of CSV|TSV|FIXED|JSON|JSONL|LTSV|GFM|ORG|TEXT|PARQUET|XLSX"
 	err := flags.SetFormat("error", "")
 	if err == nil {
 		t.Errorf("no error, want error %q for %s", expectErr, "error")
@@ -366,7 +674,12 @@ func TestFlags_SetWriteEncoding(t *testing.T) {
 		t.Errorf("encoding = %s, expect to set %s for %s", flags.WriteEncoding, text.SJIS, "sjis")
 	}
 
-	expectErr := "encoding must be one of UTF8|SJIS"
+	flags.SetWriteEncoding("windows-1252")
+	if flags.WriteEncoding != WINDOWS1252 {
+		t.Errorf("encoding = %s, expect to set %s for %s", flags.WriteEncoding, WINDOWS1252, "windows-1252")
+	}
+
+	expectErr := "encoding must be one of UTF8|UTF8BOM|UTF16LE|UTF16BE|SJIS|EUCJP|ISO2022JP|LATIN1|WINDOWS1252"
 	err := flags.SetWriteEncoding("error")
 	if err == nil {
 		t.Errorf("no error, want error %q for %s", expectErr, "error")
@@ -275,17 +275,24 @@ func IsReadableFromPipeOrRedirection() bool {
 	return false
 }
 
+// ParseEncoding returns the encoding of the name.
+// Hyphens and underscores in the name are ignored, so that "UTF-16LE" and "EUC_JP" are also accepted.
 func ParseEncoding(s string) (text.Encoding, error) {
-	var encoding text.Encoding
-	switch strings.ToUpper(s) {
-	case "UTF8":
-		encoding = text.UTF8
-	case "SJIS":
-		encoding = text.SJIS
-	default:
-		return text.UTF8, errors.New("encoding must be one of UTF8|SJIS")
+	name := strings.NewReplacer("-", "", "_", "").Replace(strings.ToUpper(s))
+	for _, enc := range EncodingList {
+		if name == string(enc) {
+			return enc, nil
+		}
+	}
+	if enc, ok := encodingAliases[name]; ok {
+		return enc, nil
+	}
+
+	list := make([]string, len(EncodingList))
+	for i, enc := range EncodingList {
+		list[i] = string(enc)
 	}
-	return encoding, nil
+	return text.UTF8, errors.New("encoding must be one of " + strings.Join(list, "|"))
 }
 
 func ParseLineBreak(s string) (text.LineBreak, error) {
@@ -343,6 +350,8 @@ func ParseFormat(s string, et txjson.EscapeType) (Format, txjson.EscapeType, err
 		fm = FIXED
 	case "JSON":
 		fm = JSON
+	case "JSONL":
+		fm = JSONL
 	case "LTSV":
 		fm = LTSV
 	case "GFM":
@@ -351,6 +360,10 @@ func ParseFormat(s string, et txjson.EscapeType) (Format, txjson.EscapeType, err
 		fm = ORG
 	case "TEXT":
 		fm = TEXT
+	case "PARQUET":
+		fm = PARQUET
+	case "XLSX":
+		fm = XLSX
 	case "JSONH":
 		fm = JSON
 		et = txjson.HexDigits
@@ -358,7 +371,7 @@ func ParseFormat(s string, et txjson.EscapeType) (Format, txjson.EscapeType, err
 		fm = JSON
 		et = txjson.AllWithHexDigits
 	default:
-		return fm, et, errors.New("format must be one of CSV|TSV|FIXED|JSON|LTSV|GFM|ORG|TEXT")
+		return fm, et, errors.New("format must be one of CSV|TSV|FIXED|JSON|JSONL|LTSV|GFM|ORG|TEXT|PARQUET|XLSX")
 	}
 	return fm, et, nil
 }
@@ -378,6 +391,42 @@ func ParseJsonEscapeType(s string) (txjson.EscapeType, error) {
 	return escape, nil
 }
 
+func ParseQuoteChar(s string) (rune, error) {
+	c := []rune(UnescapeString(s))
+	if len(c) != 1 || c[0] == '\r' || c[0] == '\n' {
+		return 0, errors.New("quote-char must be one character except line breaks")
+	}
+	return c[0], nil
+}
+
+func ParseEscapeStyle(s string) (EscapeStyle, error) {
+	var style EscapeStyle
+	switch strings.ToUpper(s) {
+	case "DOUBLE":
+		style = DoubleQuoteEscape
+	case "BACKSLASH":
+		style = BackslashEscape
+	default:
+		return style, errors.New("escape-style must be one of DOUBLE|BACKSLASH")
+	}
+	return style, nil
+}
+
+func ParseParseErrorPolicy(s string) (ParseErrorPolicy, error) {
+	var policy ParseErrorPolicy
+	switch strings.ToUpper(s) {
+	case "FAIL":
+		policy = FailOnParseError
+	case "SKIP":
+		policy = SkipOnParseError
+	case "PAD":
+		policy = PadOnParseError
+	default:
+		return policy, errors.New("on-parse-error must be one of FAIL|SKIP|PAD")
+	}
+	return policy, nil
+}
+
 func AppendStrIfNotExist(list []string, elem string) []string {
 	if len(elem) < 1 {
 		return list
@@ -247,7 +247,31 @@ func TestParseEncoding(t *testing.T) {
 		t.Errorf("encoding = %s, expect to set %s for %s", e, text.SJIS, "sjis")
 	}
 
-	expectErr := "encoding must be one of UTF8|SJIS"
+	e, err = ParseEncoding("UTF-16le")
+	if err != nil {
+		t.Errorf("unexpected error: %q", err.Error())
+	}
+	if e != UTF16LE {
+		t.Errorf("encoding = %s, expect to set %s for %s", e, UTF16LE, "UTF-16le")
+	}
+
+	e, err = ParseEncoding("euc_jp")
+	if err != nil {
+		t.Errorf("unexpected error: %q", err.Error())
+	}
+	if e != EUCJP {
+		t.Errorf("encoding = %s, expect to set %s for %s", e, EUCJP, "euc_jp")
+	}
+
+	e, err = ParseEncoding("ISO-8859-1")
+	if err != nil {
+		t.Errorf("unexpected error: %q", err.Error())
+	}
+	if e != LATIN1 {
+		t.Errorf("encoding = %s, expect to set %s for %s", e, LATIN1, "ISO-8859-1")
+	}
+
+	expectErr := "encoding must be one of UTF8|UTF8BOM|UTF16LE|UTF16BE|SJIS|EUCJP|ISO2022JP|LATIN1|WINDOWS1252"
 	_, err = ParseEncoding("error")
 	if err == nil {
 		t.Errorf("no error, want error %q for %s", expectErr, "error")
@@ -0,0 +1,127 @@
+package driver
+
+import (
+	"context"
+	sqldriver "database/sql/driver"
+	"errors"
+
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/query"
+)
+
+var (
+	ErrConnClosed          = errors.New("connection is closed")
+	ErrTxInProgress        = errors.New("transaction is already in progress")
+	ErrTxDone              = errors.New("transaction has already been committed or rolled back")
+	ErrIsolationLevel      = errors.New("isolation level is not supported")
+	ErrLastInsertIdUnknown = errors.New("last insert id is not supported")
+)
+
+type Conn struct {
+	session *query.Session
+	tx      *Tx
+	closed  bool
+}
+
+func (c *Conn) Session() *query.Session {
+	return c.session
+}
+
+func (c *Conn) Prepare(sql string) (sqldriver.Stmt, error) {
+	return c.PrepareContext(context.Background(), sql)
+}
+
+func (c *Conn) PrepareContext(ctx context.Context, sql string) (sqldriver.Stmt, error) {
+	if c.closed {
+		return nil, ErrConnClosed
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	statements, err := parser.Parse(sql, "")
+	if err != nil {
+		return nil, query.NewSyntaxError(err.(*parser.SyntaxError))
+	}
+	return &Stmt{
+		conn:       c,
+		statements: statements,
+	}, nil
+}
+
+func (c *Conn) Close() error {
+	if c.closed {
+		return nil
+	}
+	c.closed = true
+	c.tx = nil
+	return c.session.Close()
+}
+
+func (c *Conn) Begin() (sqldriver.Tx, error) {
+	return c.BeginTx(context.Background(), sqldriver.TxOptions{})
+}
+
+func (c *Conn) BeginTx(ctx context.Context, opts sqldriver.TxOptions) (sqldriver.Tx, error) {
+	if c.closed {
+		return nil, ErrConnClosed
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if c.tx != nil {
+		return nil, ErrTxInProgress
+	}
+	if opts.Isolation != sqldriver.IsolationLevel(0) {
+		return nil, ErrIsolationLevel
+	}
+
+	c.tx = &Tx{conn: c}
+	return c.tx, nil
+}
+
+func (c *Conn) autoCommit(ctx context.Context) error {
+	if c.tx != nil {
+		return nil
+	}
+	return c.session.Commit(ctx)
+}
+
+func (c *Conn) autoRollback(ctx context.Context) {
+	if c.tx != nil {
+		return
+	}
+	c.session.Rollback(ctx)
+}
+
+type Tx struct {
+	conn *Conn
+}
+
+func (tx *Tx) Commit() error {
+	if tx.conn.tx != tx {
+		return ErrTxDone
+	}
+	tx.conn.tx = nil
+	return tx.conn.session.Commit(context.Background())
+}
+
+func (tx *Tx) Rollback() error {
+	if tx.conn.tx != tx {
+		return ErrTxDone
+	}
+	tx.conn.tx = nil
+	return tx.conn.session.Rollback(context.Background())
+}
+
+type Result struct {
+	affected int64
+}
+
+func (r Result) LastInsertId() (int64, error) {
+	return 0, ErrLastInsertIdUnknown
+}
+
+func (r Result) RowsAffected() (int64, error) {
+	return r.affected, nil
+}
@@ -0,0 +1,79 @@
+// Package driver provides a database/sql driver for csvq.
+//
+// The data source name is the path of the repository directory.
+//
+//	import _ "github.com/mithrandie/csvq/lib/driver"
+//
+//	db, err := sql.Open("csvq", "/path/to/repository")
+//	rows, err := db.Query("SELECT * FROM users WHERE id = ?", 1)
+//
+// Positional arguments are bound to the placeholders "?" in order. Named
+// arguments are bound to the variables that have the same names, so
+// sql.Named("id", 1) can be referred as @id in statements.
+//
+// Outside of transactions, changes are committed after each statement.
+package driver
+
+import (
+	"context"
+	"database/sql"
+	sqldriver "database/sql/driver"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/query"
+)
+
+const DriverName = "csvq"
+
+func init() {
+	sql.Register(DriverName, &Driver{})
+}
+
+type Driver struct{}
+
+func (d *Driver) Open(dsn string) (sqldriver.Conn, error) {
+	connector, err := d.OpenConnector(dsn)
+	if err != nil {
+		return nil, err
+	}
+	return connector.Connect(context.Background())
+}
+
+func (d *Driver) OpenConnector(dsn string) (sqldriver.Connector, error) {
+	flags := cmd.NewFlags()
+	if err := flags.SetRepository(dsn); err != nil {
+		return nil, err
+	}
+	return NewConnector(flags), nil
+}
+
+// Connector creates connections with copies of the flags.
+// It can be passed to sql.OpenDB to use flags other than the repository.
+type Connector struct {
+	flags *cmd.Flags
+}
+
+func NewConnector(flags *cmd.Flags) *Connector {
+	if flags == nil {
+		flags = cmd.NewFlags()
+	}
+	return &Connector{
+		flags: flags,
+	}
+}
+
+func (c *Connector) Connect(ctx context.Context) (sqldriver.Conn, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	flags := *c.flags
+	flags.Quiet = true
+	return &Conn{
+		session: query.NewSession(&flags),
+	}, nil
+}
+
+func (c *Connector) Driver() sqldriver.Driver {
+	return &Driver{}
+}
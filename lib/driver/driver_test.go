@@ -0,0 +1,216 @@
+package driver
+
+import (
+	"database/sql"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+var driverQueryTests = []struct {
+	Name   string
+	Query  string
+	Args   []interface{}
+	Result [][]interface{}
+	Error  string
+}{
+	{
+		Name:  "Query",
+		Query: "SELECT column1, column2 FROM table1",
+		Result: [][]interface{}{
+			{"1", "str1"},
+			{"2", "str2"},
+			{"3", "str3"},
+		},
+	},
+	{
+		Name:  "Query with Placeholders",
+		Query: "SELECT column2 FROM table1 WHERE column1 = ? OR column2 = ?",
+		Args:  []interface{}{1, "str3"},
+		Result: [][]interface{}{
+			{"str1"},
+			{"str3"},
+		},
+	},
+	{
+		Name:  "Query with Named Arguments",
+		Query: "SELECT column2 FROM table1 WHERE column1 = @id",
+		Args:  []interface{}{sql.Named("id", 2)},
+		Result: [][]interface{}{
+			{"str2"},
+		},
+	},
+	{
+		Name:  "Query Value Types",
+		Query: "SELECT 1, 1.5, TRUE, UNKNOWN, NULL",
+		Result: [][]interface{}{
+			{int64(1), float64(1.5), true, nil, nil},
+		},
+	},
+	{
+		Name:  "Query Unbound Placeholder Error",
+		Query: "SELECT ?, ?",
+		Args:  []interface{}{1},
+		Error: "[L:1 C:11] value for placeholder ?2 is not bound",
+	},
+	{
+		Name:  "Query Syntax Error",
+		Query: "SELECT FROM",
+		Error: "[L:1 C:8] syntax error: unexpected token \"FROM\"",
+	},
+}
+
+func TestDriver_Query(t *testing.T) {
+	db, err := sql.Open(DriverName, TestDir)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	defer db.Close()
+
+	for _, v := range driverQueryTests {
+		result, err := queryAll(db, v.Query, v.Args...)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if !reflect.DeepEqual(result, v.Result) {
+			t.Errorf("%s: result = %v, want %v", v.Name, result, v.Result)
+		}
+	}
+}
+
+func TestDriver_Exec(t *testing.T) {
+	db, err := sql.Open(DriverName, TestDir)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	defer db.Close()
+
+	stmt, err := db.Prepare("INSERT INTO insert_query VALUES (?, ?)")
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	defer stmt.Close()
+
+	for _, args := range [][]interface{}{{4, "str4"}, {5, "str5"}} {
+		res, err := stmt.Exec(args...)
+		if err != nil {
+			t.Fatalf("unexpected error %q", err)
+		}
+		if n, _ := res.RowsAffected(); n != 1 {
+			t.Errorf("rows affected = %d, want %d", n, 1)
+		}
+	}
+
+	expect := "column1,column2\n" +
+		"1,str1\n" +
+		"2,str2\n" +
+		"3,str3\n" +
+		"4,str4\n" +
+		"5,str5"
+	if s := readfile(t, "insert_query.csv"); s != expect {
+		t.Errorf("file = %q, want %q", s, expect)
+	}
+}
+
+func TestDriver_Tx(t *testing.T) {
+	db, err := sql.Open(DriverName, TestDir)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	defer db.Close()
+
+	original := readfile(t, "update_query.csv")
+
+	tx, err := db.Begin()
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	res, err := tx.Exec("UPDATE update_query SET column2 = ? WHERE column1 > ?", "updated", 1)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if n, _ := res.RowsAffected(); n != 2 {
+		t.Errorf("rows affected = %d, want %d", n, 2)
+	}
+	result, err := queryAll(tx, "SELECT column2 FROM update_query WHERE column1 = 3")
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if !reflect.DeepEqual(result, [][]interface{}{{"updated"}}) {
+		t.Errorf("result in transaction = %v, want %v", result, [][]interface{}{{"updated"}})
+	}
+	if err = tx.Rollback(); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if s := readfile(t, "update_query.csv"); s != original {
+		t.Errorf("file after rollback = %q, want %q", s, original)
+	}
+
+	tx, err = db.Begin()
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if _, err = tx.Exec("UPDATE update_query SET column2 = @v WHERE column1 = 1", sql.Named("v", "committed")); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if err = tx.Commit(); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+
+	expect := "column1,column2\n" +
+		"1,committed\n" +
+		"2,str2\n" +
+		"3,str3"
+	if s := readfile(t, "update_query.csv"); s != expect {
+		t.Errorf("file after commit = %q, want %q", s, expect)
+	}
+}
+
+type queryer interface {
+	Query(query string, args ...interface{}) (*sql.Rows, error)
+}
+
+func queryAll(db queryer, query string, args ...interface{}) ([][]interface{}, error) {
+	rows, err := db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+
+	var result [][]interface{}
+	for rows.Next() {
+		values := make([]interface{}, len(columns))
+		ptrs := make([]interface{}, len(columns))
+		for i := range values {
+			ptrs[i] = &values[i]
+		}
+		if err := rows.Scan(ptrs...); err != nil {
+			return nil, err
+		}
+		result = append(result, values)
+	}
+	return result, rows.Err()
+}
+
+func readfile(t *testing.T, name string) string {
+	b, err := ioutil.ReadFile(filepath.Join(TestDir, name))
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	return string(b)
+}
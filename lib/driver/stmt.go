@@ -0,0 +1,145 @@
+package driver
+
+import (
+	"context"
+	sqldriver "database/sql/driver"
+	"io"
+	"strings"
+
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/query"
+)
+
+type Stmt struct {
+	conn       *Conn
+	statements []parser.Statement
+}
+
+func (s *Stmt) Close() error {
+	s.statements = nil
+	return nil
+}
+
+// NumInput returns -1 because placeholders may appear in any of the statements
+// and named arguments refer to variables.
+func (s *Stmt) NumInput() int {
+	return -1
+}
+
+func (s *Stmt) Exec(args []sqldriver.Value) (sqldriver.Result, error) {
+	return s.ExecContext(context.Background(), namedValues(args))
+}
+
+func (s *Stmt) ExecContext(ctx context.Context, args []sqldriver.NamedValue) (sqldriver.Result, error) {
+	if s.conn.closed {
+		return nil, ErrConnClosed
+	}
+
+	params, err := parameters(args)
+	if err != nil {
+		return nil, err
+	}
+
+	affected, err := s.conn.session.ExecStatements(ctx, s.statements, params)
+	if err != nil {
+		s.conn.autoRollback(ctx)
+		return nil, err
+	}
+	if err = s.conn.autoCommit(ctx); err != nil {
+		return nil, err
+	}
+	return Result{affected: int64(affected)}, nil
+}
+
+func (s *Stmt) Query(args []sqldriver.Value) (sqldriver.Rows, error) {
+	return s.QueryContext(context.Background(), namedValues(args))
+}
+
+func (s *Stmt) QueryContext(ctx context.Context, args []sqldriver.NamedValue) (sqldriver.Rows, error) {
+	if s.conn.closed {
+		return nil, ErrConnClosed
+	}
+
+	params, err := parameters(args)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.conn.session.QueryStatements(ctx, s.statements, params)
+	if err != nil {
+		s.conn.autoRollback(ctx)
+		return nil, err
+	}
+	return &Rows{
+		conn: s.conn,
+		ctx:  ctx,
+		rows: rows,
+	}, nil
+}
+
+type Rows struct {
+	conn *Conn
+	ctx  context.Context
+	rows *query.Rows
+}
+
+func (r *Rows) Columns() []string {
+	columns := r.rows.Columns()
+	if columns == nil {
+		return []string{}
+	}
+	return columns
+}
+
+func (r *Rows) Next(dest []sqldriver.Value) error {
+	if !r.rows.Next() {
+		if err := r.rows.Err(); err != nil {
+			return err
+		}
+		return io.EOF
+	}
+
+	for i, v := range r.rows.Values() {
+		dest[i] = DriverValue(v)
+	}
+	return nil
+}
+
+func (r *Rows) Close() error {
+	if err := r.rows.Close(); err != nil {
+		r.conn.autoRollback(r.ctx)
+		return err
+	}
+	return r.conn.autoCommit(r.ctx)
+}
+
+func namedValues(args []sqldriver.Value) []sqldriver.NamedValue {
+	list := make([]sqldriver.NamedValue, len(args))
+	for i, v := range args {
+		list[i] = sqldriver.NamedValue{
+			Ordinal: i + 1,
+			Value:   v,
+		}
+	}
+	return list
+}
+
+func parameters(args []sqldriver.NamedValue) ([]query.Parameter, error) {
+	if len(args) < 1 {
+		return nil, nil
+	}
+
+	params := make([]query.Parameter, len(args))
+	for i, arg := range args {
+		p, err := PrimaryValue(arg.Value)
+		if err != nil {
+			return nil, err
+		}
+		params[i] = query.Parameter{
+			Name:    strings.TrimPrefix(arg.Name, string(parser.VariableSign)),
+			Ordinal: arg.Ordinal,
+			Value:   p,
+		}
+	}
+	return params, nil
+}
@@ -0,0 +1,55 @@
+package driver
+
+import (
+	sqldriver "database/sql/driver"
+	"fmt"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/ternary"
+)
+
+// PrimaryValue converts a driver value to a value used in queries.
+func PrimaryValue(v sqldriver.Value) (value.Primary, error) {
+	switch v := v.(type) {
+	case nil:
+		return value.NewNull(), nil
+	case int64:
+		return value.NewInteger(v), nil
+	case float64:
+		return value.NewFloat(v), nil
+	case bool:
+		return value.NewBoolean(v), nil
+	case []byte:
+		return value.NewString(string(v)), nil
+	case string:
+		return value.NewString(v), nil
+	case time.Time:
+		return value.NewDatetime(v), nil
+	}
+	return nil, fmt.Errorf("unsupported type %T", v)
+}
+
+// DriverValue converts a value of a query result to a driver value.
+// Unknown of ternary values is converted to nil.
+func DriverValue(p value.Primary) sqldriver.Value {
+	switch p := p.(type) {
+	case value.String:
+		return p.Raw()
+	case value.Integer:
+		return p.Raw()
+	case value.Float:
+		return p.Raw()
+	case value.Boolean:
+		return p.Raw()
+	case value.Ternary:
+		if p.Ternary() == ternary.UNKNOWN {
+			return nil
+		}
+		return p.Ternary().ParseBool()
+	case value.Datetime:
+		return p.Raw()
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+package file
+
+import (
+	"compress/gzip"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/dsnet/compress/bzip2"
+	"github.com/klauspost/compress/zstd"
+)
+
+type Compression int
+
+const (
+	NoCompression Compression = iota
+	Gzip
+	Bzip2
+	Zstd
+)
+
+const (
+	GzipExt  = ".gz"
+	Bzip2Ext = ".bz2"
+	ZstdExt  = ".zst"
+)
+
+var CompressionExtList = []string{GzipExt, Bzip2Ext, ZstdExt}
+
+var compressionLiterals = map[Compression]string{
+	NoCompression: "NONE",
+	Gzip:          "GZIP",
+	Bzip2:         "BZIP2",
+	Zstd:          "ZSTD",
+}
+
+func (c Compression) String() string {
+	return compressionLiterals[c]
+}
+
+func CompressionOf(path string) Compression {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case GzipExt:
+		return Gzip
+	case Bzip2Ext:
+		return Bzip2
+	case ZstdExt:
+		return Zstd
+	}
+	return NoCompression
+}
+
+// TrimCompressionExt returns the path without the compression extension
+// so that the file format can be determined by the remaining extension.
+func TrimCompressionExt(path string) string {
+	if CompressionOf(path) == NoCompression {
+		return path
+	}
+	return strings.TrimSuffix(path, filepath.Ext(path))
+}
+
+func NewDecompressReader(r io.Reader, c Compression) (io.ReadCloser, error) {
+	switch c {
+	case Gzip:
+		return gzip.NewReader(r)
+	case Bzip2:
+		return bzip2.NewReader(r, nil)
+	case Zstd:
+		d, err := zstd.NewReader(r)
+		if err != nil {
+			return nil, err
+		}
+		return d.IOReadCloser(), nil
+	}
+	return io.NopCloser(r), nil
+}
+
+func NewCompressWriter(w io.Writer, c Compression) (io.WriteCloser, error) {
+	switch c {
+	case Gzip:
+		return gzip.NewWriter(w), nil
+	case Bzip2:
+		return bzip2.NewWriter(w, nil)
+	case Zstd:
+		return zstd.NewWriter(w)
+	}
+	return nopWriteCloser{w}, nil
+}
+
+type nopWriteCloser struct {
+	io.Writer
+}
+
+func (nopWriteCloser) Close() error {
+	return nil
+}
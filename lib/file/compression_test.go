@@ -0,0 +1,83 @@
+package file
+
+import (
+	"bytes"
+	"io"
+	"testing"
+)
+
+var compressionOfTests = []struct {
+	Path    string
+	Result  Compression
+	Trimmed string
+}{
+	{
+		Path:    "/path/to/table.csv",
+		Result:  NoCompression,
+		Trimmed: "/path/to/table.csv",
+	},
+	{
+		Path:    "/path/to/table.csv.gz",
+		Result:  Gzip,
+		Trimmed: "/path/to/table.csv",
+	},
+	{
+		Path:    "/path/to/table.JSON.BZ2",
+		Result:  Bzip2,
+		Trimmed: "/path/to/table.JSON",
+	},
+	{
+		Path:    "/path/to/table.tsv.zst",
+		Result:  Zstd,
+		Trimmed: "/path/to/table.tsv",
+	},
+}
+
+func TestCompressionOf(t *testing.T) {
+	for _, v := range compressionOfTests {
+		result := CompressionOf(v.Path)
+		if result != v.Result {
+			t.Errorf("result = %s, want %s for %q", result, v.Result, v.Path)
+		}
+		trimmed := TrimCompressionExt(v.Path)
+		if trimmed != v.Trimmed {
+			t.Errorf("trimmed = %q, want %q for %q", trimmed, v.Trimmed, v.Path)
+		}
+	}
+}
+
+func TestCompressWriter(t *testing.T) {
+	data := []byte("column1,column2\n1,str1\n2,str2\n")
+
+	for _, c := range []Compression{NoCompression, Gzip, Bzip2, Zstd} {
+		buf := new(bytes.Buffer)
+		w, err := NewCompressWriter(buf, c)
+		if err != nil {
+			t.Errorf("unexpected error %q for %s", err, c)
+			continue
+		}
+		if _, err = w.Write(data); err != nil {
+			t.Errorf("unexpected error %q for %s", err, c)
+			continue
+		}
+		if err = w.Close(); err != nil {
+			t.Errorf("unexpected error %q for %s", err, c)
+			continue
+		}
+
+		r, err := NewDecompressReader(buf, c)
+		if err != nil {
+			t.Errorf("unexpected error %q for %s", err, c)
+			continue
+		}
+		result, err := io.ReadAll(r)
+		_ = r.Close()
+		if err != nil {
+			t.Errorf("unexpected error %q for %s", err, c)
+			continue
+		}
+		if !bytes.Equal(result, data) {
+			t.Errorf("result = %q, want %q for %s", result, data, c)
+		}
+	}
+}
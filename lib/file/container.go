@@ -4,11 +4,16 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"sync"
 )
 
 var container = make(map[string]*Handler)
+var containerMtx = &sync.Mutex{}
 
 func addToContainer(path string, handler *Handler) error {
+	containerMtx.Lock()
+	defer containerMtx.Unlock()
+
 	key := strings.ToUpper(path)
 	if _, ok := container[key]; ok {
 		return errors.New(fmt.Sprintf("file %s already opened", path))
@@ -18,29 +23,43 @@ func addToContainer(path string, handler *Handler) error {
 }
 
 func removeFromContainer(path string) {
+	containerMtx.Lock()
+	defer containerMtx.Unlock()
+
 	key := strings.ToUpper(path)
 	if _, ok := container[key]; ok {
 		delete(container, key)
 	}
 }
 
+func containedHandlers() map[string]*Handler {
+	containerMtx.Lock()
+	defer containerMtx.Unlock()
+
+	handlers := make(map[string]*Handler, len(container))
+	for k, h := range container {
+		handlers[k] = h
+	}
+	return handlers
+}
+
 func UnlockAll() error {
-	for k := range container {
-		if err := container[k].Close(); err != nil {
+	for k, h := range containedHandlers() {
+		if err := h.Close(); err != nil {
 			return err
 		}
-		delete(container, k)
+		removeFromContainer(k)
 	}
 	return nil
 }
 
 func UnlockAllWithErrors() error {
 	var errs []error
-	for k := range container {
-		if err := container[k].CloseWithErrors(); err != nil {
+	for k, h := range containedHandlers() {
+		if err := h.CloseWithErrors(); err != nil {
 			errs = append(errs, err.(*ForcedUnlockError).Errors...)
 		}
-		delete(container, k)
+		removeFromContainer(k)
 	}
 
 	if errs != nil {
@@ -1,21 +1,36 @@
 package json
 
-import "strings"
+import (
+	"strings"
+	"sync"
+)
 
 var Path = PathMap{}
 var Query = QueryMap{}
 
+var (
+	pathMtx  = &sync.RWMutex{}
+	queryMtx = &sync.RWMutex{}
+)
+
 type PathMap map[string]PathExpression
 
 func (m PathMap) Parse(s string) (PathExpression, error) {
-	if e, ok := m[s]; ok {
+	pathMtx.RLock()
+	e, ok := m[s]
+	pathMtx.RUnlock()
+	if ok {
 		return e, nil
 	}
+
 	e, err := ParsePath(s)
 	if err != nil {
 		return nil, err
 	}
+
+	pathMtx.Lock()
 	m[s] = e
+	pathMtx.Unlock()
 	return e, nil
 }
 
@@ -24,13 +39,20 @@ type QueryMap map[string]QueryExpression
 func (m QueryMap) Parse(s string) (QueryExpression, error) {
 	s = strings.TrimSpace(s)
 
-	if e, ok := m[s]; ok {
+	queryMtx.RLock()
+	e, ok := m[s]
+	queryMtx.RUnlock()
+	if ok {
 		return e, nil
 	}
+
 	e, err := ParseQuery(s)
 	if err != nil {
 		return nil, err
 	}
+
+	queryMtx.Lock()
 	m[s] = e
+	queryMtx.Unlock()
 	return e, nil
 }
@@ -0,0 +1,110 @@
+package json
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/go-text/json"
+)
+
+// LinesReader reads objects from JSON Lines text one line at a time.
+// Keys are added to Header in the order in which they first appear, so rows
+// read before a key is found can be shorter than the final header.
+type LinesReader struct {
+	Header     []string
+	EscapeType json.EscapeType
+
+	reader      *bufio.Reader
+	headerIndex map[string]int
+	line        int
+}
+
+func NewLinesReader(r io.Reader) *LinesReader {
+	return &LinesReader{
+		Header:      make([]string, 0, 10),
+		EscapeType:  json.Backslash,
+		reader:      bufio.NewReader(r),
+		headerIndex: make(map[string]int, 10),
+	}
+}
+
+func (r *LinesReader) Read() ([]value.Primary, error) {
+	for {
+		s, err := r.reader.ReadString('\n')
+		if err != nil && (err != io.EOF || len(s) < 1) {
+			return nil, err
+		}
+
+		r.line++
+		if r.line == 1 {
+			s = strings.TrimPrefix(s, "\ufeff")
+		}
+		s = strings.TrimSpace(s)
+		if len(s) < 1 {
+			continue
+		}
+
+		structure, et, e := json.ParseJson(s)
+		if e != nil {
+			if se, ok := e.(*json.SyntaxError); ok {
+				return nil, errors.New(fmt.Sprintf("line %d, column %d: %s", r.line, se.Column, se.Error()))
+			}
+			return nil, errors.New(fmt.Sprintf("line %d: %s", r.line, e.Error()))
+		}
+		if r.EscapeType < et {
+			r.EscapeType = et
+		}
+
+		obj, ok := structure.(json.Object)
+		if !ok {
+			return nil, errors.New(fmt.Sprintf("line %d: rows loaded from json lines must be objects", r.line))
+		}
+
+		for _, m := range obj.Members {
+			if _, ok := r.headerIndex[m.Key]; !ok {
+				r.headerIndex[m.Key] = len(r.Header)
+				r.Header = append(r.Header, m.Key)
+			}
+		}
+
+		row := make([]value.Primary, len(r.Header))
+		for _, m := range obj.Members {
+			row[r.headerIndex[m.Key]] = ConvertToValue(m.Value)
+		}
+		for i := range row {
+			if row[i] == nil {
+				row[i] = value.NewNull()
+			}
+		}
+		return row, nil
+	}
+}
+
+func LoadLines(r io.Reader) ([]string, [][]value.Primary, json.EscapeType, error) {
+	reader := NewLinesReader(r)
+
+	rows := make([][]value.Primary, 0, 1000)
+	for {
+		row, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, nil, reader.EscapeType, err
+		}
+		rows = append(rows, row)
+	}
+
+	for i := range rows {
+		for j := len(rows[i]); j < len(reader.Header); j++ {
+			rows[i] = append(rows[i], value.NewNull())
+		}
+	}
+
+	return reader.Header, rows, reader.EscapeType, nil
+}
@@ -0,0 +1,85 @@
+package json
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/go-text/json"
+)
+
+var loadLinesTests = []struct {
+	Json         string
+	ExpectHeader []string
+	ExpectValues [][]value.Primary
+	EscapeType   json.EscapeType
+	Error        string
+}{
+	{
+		Json: "{\"key1\":1, \"key2\":\"a\"}\n" +
+			"\n" +
+			"{\"key2\":\"b\", \"key3\":true}\r\n" +
+			"{\"key1\":3, \"key3\":{\"k\":\"\\u0041\"}}",
+		ExpectHeader: []string{"key1", "key2", "key3"},
+		ExpectValues: [][]value.Primary{
+			{
+				value.NewInteger(1),
+				value.NewString("a"),
+				value.NewNull(),
+			},
+			{
+				value.NewNull(),
+				value.NewString("b"),
+				value.NewBoolean(true),
+			},
+			{
+				value.NewInteger(3),
+				value.NewNull(),
+				value.NewString("{\"k\":\"A\"}"),
+			},
+		},
+		EscapeType: json.AllWithHexDigits,
+	},
+	{
+		Json:         "",
+		ExpectHeader: []string{},
+		ExpectValues: [][]value.Primary{},
+	},
+	{
+		Json:  "{\"key1\":1}\n{\"key1\":2, key2:3}\n",
+		Error: "line 2, column 12: unexpected token \"key\"",
+	},
+	{
+		Json:  "{\"key1\":1}\n[1, 2]\n",
+		Error: "line 2: rows loaded from json lines must be objects",
+	},
+}
+
+func TestLoadLines(t *testing.T) {
+	for _, v := range loadLinesTests {
+		header, values, et, err := LoadLines(strings.NewReader(v.Json))
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("unexpected error %q for %q", err.Error(), v.Json)
+			} else if err.Error() != v.Error {
+				t.Errorf("error %q, want error %q for %q", err, v.Error, v.Json)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("no error, want error %q for %q", v.Error, v.Json)
+			continue
+		}
+		if !reflect.DeepEqual(header, v.ExpectHeader) {
+			t.Errorf("header = %#v, want %#v for %q", header, v.ExpectHeader, v.Json)
+		}
+		if !reflect.DeepEqual(values, v.ExpectValues) {
+			t.Errorf("values = %#v, want %#v for %q", values, v.ExpectValues, v.Json)
+		}
+		if et != v.EscapeType {
+			t.Errorf("escape type = %d, want %d for %q", et, v.EscapeType, v.Json)
+		}
+	}
+}
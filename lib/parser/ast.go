@@ -1,6 +1,7 @@
 package parser
 
 import (
+	"strconv"
 	"strings"
 	"time"
 
@@ -288,6 +289,7 @@ type SelectEntity struct {
 	WhereClause   QueryExpression
 	GroupByClause QueryExpression
 	HavingClause  QueryExpression
+	WindowClause  QueryExpression
 }
 
 func (e SelectEntity) String() string {
@@ -304,6 +306,9 @@ func (e SelectEntity) String() string {
 	if e.HavingClause != nil {
 		s = append(s, e.HavingClause.String())
 	}
+	if e.WindowClause != nil {
+		s = append(s, e.WindowClause.String())
+	}
 	return joinWithSpace(s)
 }
 
@@ -360,6 +365,48 @@ func (gb GroupByClause) String() string {
 	return joinWithSpace(s)
 }
 
+type GroupingSet struct {
+	*BaseExpr
+	Values []QueryExpression
+}
+
+func (gs GroupingSet) String() string {
+	return putParentheses(listQueryExpressions(gs.Values))
+}
+
+type Rollup struct {
+	*BaseExpr
+	Rollup string
+	Values []QueryExpression
+}
+
+func (r Rollup) String() string {
+	s := []string{r.Rollup, putParentheses(listQueryExpressions(r.Values))}
+	return joinWithSpace(s)
+}
+
+type Cube struct {
+	*BaseExpr
+	Cube   string
+	Values []QueryExpression
+}
+
+func (c Cube) String() string {
+	s := []string{c.Cube, putParentheses(listQueryExpressions(c.Values))}
+	return joinWithSpace(s)
+}
+
+type GroupingSets struct {
+	*BaseExpr
+	GroupingSets string
+	Sets         []QueryExpression
+}
+
+func (gs GroupingSets) String() string {
+	s := []string{gs.GroupingSets, putParentheses(listQueryExpressions(gs.Sets))}
+	return joinWithSpace(s)
+}
+
 type HavingClause struct {
 	*BaseExpr
 	Having string
@@ -493,7 +540,9 @@ func (e TableObject) String() string {
 	if e.FormatElement != nil {
 		allArgs = append(allArgs, e.FormatElement)
 	}
-	allArgs = append(allArgs, e.Path)
+	if 0 < len(e.Path.Literal) {
+		allArgs = append(allArgs, e.Path)
+	}
 	if e.Args != nil {
 		allArgs = append(allArgs, e.Args...)
 	}
@@ -635,6 +684,27 @@ func (l Like) String() string {
 	return joinWithSpace(s)
 }
 
+type RegExp struct {
+	*BaseExpr
+	RegExp   string
+	LHS      QueryExpression
+	Pattern  QueryExpression
+	Negation Token
+}
+
+func (e RegExp) IsNegated() bool {
+	return !e.Negation.IsEmpty()
+}
+
+func (e RegExp) String() string {
+	s := []string{e.LHS.String()}
+	if e.IsNegated() {
+		s = append(s, e.Negation.Literal)
+	}
+	s = append(s, e.RegExp, e.Pattern.String())
+	return joinWithSpace(s)
+}
+
 type Exists struct {
 	*BaseExpr
 	Exists string
@@ -804,6 +874,56 @@ func (j Join) String() string {
 	return joinWithSpace(s)
 }
 
+type PivotTable struct {
+	*BaseExpr
+	Table      QueryExpression
+	Pivot      string
+	Aggregates []QueryExpression
+	For        string
+	Key        QueryExpression
+	In         string
+	Values     []QueryExpression
+	Any        string
+}
+
+func (e PivotTable) String() string {
+	values := e.Any
+	if 0 < len(e.Values) {
+		values = listQueryExpressions(e.Values)
+	}
+	s := []string{listQueryExpressions(e.Aggregates), e.For, e.Key.String(), e.In, putParentheses(values)}
+	return joinWithSpace([]string{e.Table.String(), e.Pivot, putParentheses(joinWithSpace(s))})
+}
+
+func (e PivotTable) IsDynamic() bool {
+	return 0 < len(e.Any)
+}
+
+type UnpivotTable struct {
+	*BaseExpr
+	Table   QueryExpression
+	Unpivot string
+	Nulls   Token
+	Value   Identifier
+	For     string
+	Key     Identifier
+	In      string
+	Columns []QueryExpression
+}
+
+func (e UnpivotTable) String() string {
+	s := []string{e.Table.String(), e.Unpivot}
+	if !e.Nulls.IsEmpty() {
+		s = append(s, e.Nulls.Literal)
+	}
+	clause := []string{e.Value.String(), e.For, e.Key.String(), e.In, putParentheses(listQueryExpressions(e.Columns))}
+	return joinWithSpace(append(s, putParentheses(joinWithSpace(clause))))
+}
+
+func (e UnpivotTable) IncludeNulls() bool {
+	return e.Nulls.Token == INCLUDE
+}
+
 type JoinCondition struct {
 	*BaseExpr
 	Literal string
@@ -984,6 +1104,8 @@ type AnalyticFunction struct {
 	Args           []QueryExpression
 	IgnoreNulls    bool
 	IgnoreNullsLit string
+	WithinGroup    string
+	OrderBy        QueryExpression
 	Over           string
 	AnalyticClause AnalyticClause
 }
@@ -1000,11 +1122,16 @@ func (e AnalyticFunction) String() string {
 		option = append(option, e.IgnoreNullsLit)
 	}
 
-	s := []string{
-		e.Name + "(" + joinWithSpace(option) + ")",
-		e.Over,
-		"(" + e.AnalyticClause.String() + ")",
+	clause := "(" + e.AnalyticClause.String() + ")"
+	if e.AnalyticClause.IsWindowReference() {
+		clause = e.AnalyticClause.WindowName.String()
 	}
+
+	s := []string{e.Name + "(" + joinWithSpace(option) + ")"}
+	if 0 < len(e.WithinGroup) {
+		s = append(s, e.WithinGroup, "("+e.OrderBy.String()+")")
+	}
+	s = append(s, e.Over, clause)
 	return joinWithSpace(s)
 }
 
@@ -1014,6 +1141,7 @@ func (e AnalyticFunction) IsDistinct() bool {
 
 type AnalyticClause struct {
 	*BaseExpr
+	WindowName      QueryExpression
 	PartitionClause QueryExpression
 	OrderByClause   QueryExpression
 	WindowingClause QueryExpression
@@ -1021,6 +1149,9 @@ type AnalyticClause struct {
 
 func (e AnalyticClause) String() string {
 	s := make([]string, 0)
+	if e.WindowName != nil {
+		s = append(s, e.WindowName.String())
+	}
 	if e.PartitionClause != nil {
 		s = append(s, e.PartitionClause.String())
 	}
@@ -1033,6 +1164,10 @@ func (e AnalyticClause) String() string {
 	return joinWithSpace(s)
 }
 
+func (e AnalyticClause) IsWindowReference() bool {
+	return e.WindowName != nil && e.PartitionClause == nil && e.OrderByClause == nil && e.WindowingClause == nil
+}
+
 func (e AnalyticClause) PartitionValues() []QueryExpression {
 	if e.PartitionClause == nil {
 		return nil
@@ -1040,6 +1175,29 @@ func (e AnalyticClause) PartitionValues() []QueryExpression {
 	return e.PartitionClause.(PartitionClause).Values
 }
 
+type WindowClause struct {
+	*BaseExpr
+	Window      string
+	Definitions []QueryExpression
+}
+
+func (e WindowClause) String() string {
+	s := []string{e.Window, listQueryExpressions(e.Definitions)}
+	return joinWithSpace(s)
+}
+
+type WindowDefinition struct {
+	*BaseExpr
+	Name   Identifier
+	As     string
+	Clause AnalyticClause
+}
+
+func (e WindowDefinition) String() string {
+	s := []string{e.Name.String(), e.As, "(" + e.Clause.String() + ")"}
+	return joinWithSpace(s)
+}
+
 type PartitionClause struct {
 	*BaseExpr
 	PartitionBy string
@@ -1053,29 +1211,34 @@ func (e PartitionClause) String() string {
 
 type WindowingClause struct {
 	*BaseExpr
-	Rows      string
+	Unit      Token
 	FrameLow  QueryExpression
 	FrameHigh QueryExpression
 	Between   string
 	And       string
+	Exclude   Token
 }
 
 func (e WindowingClause) String() string {
-	s := []string{e.Rows}
+	s := []string{e.Unit.Literal}
 	if e.FrameHigh == nil {
 		s = append(s, e.FrameLow.String())
 	} else {
 		s = append(s, e.Between, e.FrameLow.String(), e.And, e.FrameHigh.String())
 	}
+	if !e.Exclude.IsEmpty() {
+		s = append(s, e.Exclude.Literal)
+	}
 	return joinWithSpace(s)
 }
 
 type WindowFramePosition struct {
 	*BaseExpr
-	Direction int
-	Unbounded bool
-	Offset    int
-	Literal   string
+	Direction    int
+	Unbounded    bool
+	Offset       value.Primary
+	IntervalUnit string
+	Literal      string
 }
 
 func (e WindowFramePosition) String() string {
@@ -1141,6 +1304,16 @@ func (e RuntimeInformation) String() string {
 	return string(VariableSign) + string(RuntimeInformationSign) + e.Name
 }
 
+type Placeholder struct {
+	*BaseExpr
+	Literal string
+	Ordinal int
+}
+
+func (e Placeholder) String() string {
+	return e.Literal + strconv.Itoa(e.Ordinal)
+}
+
 type SetEnvVar struct {
 	*BaseExpr
 	EnvVar EnvironmentVariable
@@ -1184,6 +1357,25 @@ type DeleteQuery struct {
 	WhereClause QueryExpression
 }
 
+type MergeQuery struct {
+	*BaseExpr
+	WithClause QueryExpression
+	Table      Table
+	Source     QueryExpression
+	On         QueryExpression
+	WhenList   []MergeWhen
+}
+
+type MergeWhen struct {
+	*BaseExpr
+	Matched   bool
+	Condition QueryExpression
+	Operation Token
+	SetList   []UpdateSet
+	Fields    []QueryExpression
+	Values    QueryExpression
+}
+
 type CreateTable struct {
 	*BaseExpr
 	Table  Identifier
@@ -1191,6 +1383,24 @@ type CreateTable struct {
 	Query  QueryExpression
 }
 
+type ColumnDefinition struct {
+	*BaseExpr
+	Column  Identifier
+	Type    Identifier
+	NotNull bool
+}
+
+func (e ColumnDefinition) String() string {
+	s := []string{e.Column.String()}
+	if 0 < len(e.Type.Literal) {
+		s = append(s, e.Type.String())
+	}
+	if e.NotNull {
+		s = append(s, "NOT NULL")
+	}
+	return strings.Join(s, " ")
+}
+
 type AddColumns struct {
 	*BaseExpr
 	Table    QueryExpression
@@ -1200,8 +1410,10 @@ type AddColumns struct {
 
 type ColumnDefault struct {
 	*BaseExpr
-	Column Identifier
-	Value  QueryExpression
+	Column  Identifier
+	Type    Identifier
+	NotNull bool
+	Value   QueryExpression
 }
 
 type ColumnPosition struct {
@@ -1230,6 +1442,19 @@ type SetTableAttribute struct {
 	Value     QueryExpression
 }
 
+type CreateIndex struct {
+	*BaseExpr
+	Name    Identifier
+	Table   Identifier
+	Columns []QueryExpression
+}
+
+type DropIndex struct {
+	*BaseExpr
+	Name  Identifier
+	Table Identifier
+}
+
 type FunctionDeclaration struct {
 	*BaseExpr
 	Name       Identifier
@@ -1335,6 +1560,12 @@ type ShowFields struct {
 	Table Identifier
 }
 
+type Explain struct {
+	*BaseExpr
+	Analyze bool
+	Query   SelectQuery
+}
+
 type If struct {
 	*BaseExpr
 	Condition  QueryExpression
@@ -1480,6 +1711,16 @@ type Trigger struct {
 	Code    value.Primary
 }
 
+type TryCatch struct {
+	*BaseExpr
+	TryStatements   []Statement
+	CatchStatements []Statement
+}
+
+type Raise struct {
+	*BaseExpr
+}
+
 type Exit struct {
 	*BaseExpr
 	Code value.Primary
@@ -150,6 +150,18 @@ func TestColumnNumber_String(t *testing.T) {
 	}
 }
 
+func TestColumnDefinition_String(t *testing.T) {
+	e := ColumnDefinition{
+		Column:  Identifier{Literal: "column1"},
+		Type:    Identifier{Literal: "INTEGER"},
+		NotNull: true,
+	}
+	expect := "column1 INTEGER NOT NULL"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+}
+
 func TestParentheses_String(t *testing.T) {
 	s := "abcde"
 	e := Parentheses{Expr: NewStringValue(s)}
@@ -319,9 +331,19 @@ func TestSelectEntity_String(t *testing.T) {
 				RHS:      NewIntegerValueFromString("1"),
 			},
 		},
+		WindowClause: WindowClause{
+			Window: "window",
+			Definitions: []QueryExpression{
+				WindowDefinition{
+					Name:   Identifier{Literal: "w"},
+					As:     "as",
+					Clause: AnalyticClause{},
+				},
+			},
+		},
 	}
 
-	expect := "select column from table where column > 1 group by column1 having column > 1"
+	expect := "select column from table where column > 1 group by column1 having column > 1 window w as ()"
 	if e.String() != expect {
 		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
 	}
@@ -403,6 +425,74 @@ func TestGroupByClause_String(t *testing.T) {
 	}
 }
 
+func TestGroupingSet_String(t *testing.T) {
+	e := GroupingSet{}
+	expect := "()"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+
+	e = GroupingSet{
+		Values: []QueryExpression{
+			Identifier{Literal: "column1"},
+			Identifier{Literal: "column2"},
+		},
+	}
+	expect = "(column1, column2)"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+}
+
+func TestRollup_String(t *testing.T) {
+	e := Rollup{
+		Rollup: "rollup",
+		Values: []QueryExpression{
+			Identifier{Literal: "column1"},
+			Identifier{Literal: "column2"},
+		},
+	}
+	expect := "rollup (column1, column2)"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+}
+
+func TestCube_String(t *testing.T) {
+	e := Cube{
+		Cube: "cube",
+		Values: []QueryExpression{
+			Identifier{Literal: "column1"},
+			Identifier{Literal: "column2"},
+		},
+	}
+	expect := "cube (column1, column2)"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+}
+
+func TestGroupingSets_String(t *testing.T) {
+	e := GroupingSets{
+		GroupingSets: "grouping sets",
+		Sets: []QueryExpression{
+			Identifier{Literal: "column1"},
+			Rollup{
+				Rollup: "rollup",
+				Values: []QueryExpression{
+					Identifier{Literal: "column2"},
+					Identifier{Literal: "column3"},
+				},
+			},
+			GroupingSet{},
+		},
+	}
+	expect := "grouping sets (column1, rollup (column2, column3), ())"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+}
+
 func TestHavingClause_String(t *testing.T) {
 	e := HavingClause{
 		Having: "having",
@@ -653,6 +743,25 @@ func TestTableObject_String(t *testing.T) {
 	if e.String() != expect {
 		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
 	}
+
+	e = TableObject{
+		Type:          Identifier{Literal: "SQLITE"},
+		FormatElement: NewStringValue("data.db"),
+		Args:          []QueryExpression{NewStringValue("users")},
+	}
+	expect = "SQLITE('data.db', 'users')"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+
+	e = TableObject{
+		Type: Identifier{Literal: "XLSX"},
+		Args: []QueryExpression{NewStringValue("book.xlsx"), NewStringValue("Sheet1")},
+	}
+	expect = "XLSX('book.xlsx', 'Sheet1')"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
 }
 
 func TestJsonQuery_String(t *testing.T) {
@@ -862,6 +971,19 @@ func TestLike_String(t *testing.T) {
 	}
 }
 
+func TestRegExp_String(t *testing.T) {
+	e := RegExp{
+		RegExp:   "regexp",
+		LHS:      Identifier{Literal: "column"},
+		Pattern:  NewStringValue("^a"),
+		Negation: Token{Token: NOT, Literal: "not"},
+	}
+	expect := "column not regexp '^a'"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+}
+
 func TestExists_String(t *testing.T) {
 	e := Exists{
 		Exists: "exists",
@@ -1062,6 +1184,63 @@ func TestTable_Name(t *testing.T) {
 	}
 }
 
+func TestPivotTable_String(t *testing.T) {
+	e := PivotTable{
+		Table: Table{Object: Identifier{Literal: "table1"}},
+		Pivot: "pivot",
+		Aggregates: []QueryExpression{
+			Field{Object: AggregateFunction{Name: "sum", Args: []QueryExpression{FieldReference{Column: Identifier{Literal: "column1"}}}}},
+		},
+		For: "for",
+		Key: FieldReference{Column: Identifier{Literal: "column2"}},
+		In:  "in",
+		Values: []QueryExpression{
+			Field{Object: NewStringValue("a")},
+			Field{Object: NewStringValue("b"), As: "as", Alias: Identifier{Literal: "b2"}},
+		},
+	}
+	expect := "table1 pivot (sum(column1) for column2 in ('a', 'b' as b2))"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+
+	e = PivotTable{
+		Table: Table{Object: Identifier{Literal: "table1"}},
+		Pivot: "pivot",
+		Aggregates: []QueryExpression{
+			Field{Object: AggregateFunction{Name: "count", Args: []QueryExpression{AllColumns{}}}},
+		},
+		For: "for",
+		Key: FieldReference{Column: Identifier{Literal: "column2"}},
+		In:  "in",
+		Any: "any",
+	}
+	expect = "table1 pivot (count(*) for column2 in (any))"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+}
+
+func TestUnpivotTable_String(t *testing.T) {
+	e := UnpivotTable{
+		Table:   Table{Object: Identifier{Literal: "table1"}},
+		Unpivot: "unpivot",
+		Nulls:   Token{Token: INCLUDE, Literal: "include nulls"},
+		Value:   Identifier{Literal: "val"},
+		For:     "for",
+		Key:     Identifier{Literal: "col"},
+		In:      "in",
+		Columns: []QueryExpression{
+			Field{Object: FieldReference{Column: Identifier{Literal: "column1"}}},
+			Field{Object: FieldReference{Column: Identifier{Literal: "column2"}}},
+		},
+	}
+	expect := "table1 unpivot include nulls (val for col in (column1, column2))"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+}
+
 func TestJoin_String(t *testing.T) {
 	e := Join{
 		Join:      "join",
@@ -1388,6 +1567,48 @@ func TestAnalyticFunction_String(t *testing.T) {
 	if e.String() != expect {
 		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
 	}
+
+	e = AnalyticFunction{
+		Name: "sum",
+		Args: []QueryExpression{
+			Identifier{Literal: "column4"},
+		},
+		Over: "over",
+		AnalyticClause: AnalyticClause{
+			WindowName: Identifier{Literal: "w"},
+		},
+	}
+	expect = "sum(column4) over w"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+
+	e = AnalyticFunction{
+		Name: "percentile_cont",
+		Args: []QueryExpression{
+			NewFloatValueFromString("0.5"),
+		},
+		WithinGroup: "within group",
+		OrderBy: OrderByClause{
+			OrderBy: "order by",
+			Items: []QueryExpression{
+				OrderItem{Value: Identifier{Literal: "column3"}},
+			},
+		},
+		Over: "over",
+		AnalyticClause: AnalyticClause{
+			PartitionClause: PartitionClause{
+				PartitionBy: "partition by",
+				Values: []QueryExpression{
+					Identifier{Literal: "column1"},
+				},
+			},
+		},
+	}
+	expect = "percentile_cont(0.5) within group (order by column3) over (partition by column1)"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
 }
 
 func TestAnalyticFunction_IsDistinct(t *testing.T) {
@@ -1418,7 +1639,7 @@ func TestAnalyticClause_String(t *testing.T) {
 			},
 		},
 		WindowingClause: WindowingClause{
-			Rows: "rows",
+			Unit: Token{Token: ROWS, Literal: "rows"},
 			FrameLow: WindowFramePosition{
 				Direction: CURRENT,
 				Literal:   "current row",
@@ -1429,6 +1650,42 @@ func TestAnalyticClause_String(t *testing.T) {
 	if e.String() != expect {
 		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
 	}
+
+	e = AnalyticClause{
+		WindowName: Identifier{Literal: "w"},
+		OrderByClause: OrderByClause{
+			OrderBy: "order by",
+			Items: []QueryExpression{
+				OrderItem{Value: Identifier{Literal: "column3"}},
+			},
+		},
+	}
+	expect = "w order by column3"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+}
+
+func TestAnalyticClause_IsWindowReference(t *testing.T) {
+	e := AnalyticClause{
+		WindowName: Identifier{Literal: "w"},
+	}
+	if e.IsWindowReference() == false {
+		t.Errorf("window reference = %t, want %t for %#v", e.IsWindowReference(), true, e)
+	}
+
+	e = AnalyticClause{
+		WindowName: Identifier{Literal: "w"},
+		OrderByClause: OrderByClause{
+			OrderBy: "order by",
+			Items: []QueryExpression{
+				OrderItem{Value: Identifier{Literal: "column3"}},
+			},
+		},
+	}
+	if e.IsWindowReference() == true {
+		t.Errorf("window reference = %t, want %t for %#v", e.IsWindowReference(), false, e)
+	}
 }
 
 func TestAnalyticClause_PartitionValues(t *testing.T) {
@@ -1472,7 +1729,7 @@ func TestPartition_String(t *testing.T) {
 
 func TestWindowingClause_String(t *testing.T) {
 	e := WindowingClause{
-		Rows: "rows",
+		Unit: Token{Token: ROWS, Literal: "rows"},
 		FrameLow: WindowFramePosition{
 			Direction: CURRENT,
 			Literal:   "current row",
@@ -1484,10 +1741,10 @@ func TestWindowingClause_String(t *testing.T) {
 	}
 
 	e = WindowingClause{
-		Rows: "rows",
+		Unit: Token{Token: ROWS, Literal: "rows"},
 		FrameLow: WindowFramePosition{
 			Direction: PRECEDING,
-			Offset:    1,
+			Offset:    value.NewInteger(1),
 			Literal:   "1 preceding",
 		},
 		FrameHigh: WindowFramePosition{
@@ -1502,6 +1759,58 @@ func TestWindowingClause_String(t *testing.T) {
 	if e.String() != expect {
 		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
 	}
+
+	e = WindowingClause{
+		Unit: Token{Token: RANGE, Literal: "range"},
+		FrameLow: WindowFramePosition{
+			Direction:    PRECEDING,
+			Offset:       value.NewInteger(7),
+			IntervalUnit: "day",
+			Literal:      "interval 7 day preceding",
+		},
+		Exclude: Token{Token: CURRENT, Literal: "exclude current row"},
+	}
+	expect = "range interval 7 day preceding exclude current row"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+}
+
+func TestWindowClause_String(t *testing.T) {
+	e := WindowClause{
+		Window: "window",
+		Definitions: []QueryExpression{
+			WindowDefinition{
+				Name: Identifier{Literal: "w1"},
+				As:   "as",
+				Clause: AnalyticClause{
+					PartitionClause: PartitionClause{
+						PartitionBy: "partition by",
+						Values: []QueryExpression{
+							Identifier{Literal: "column1"},
+						},
+					},
+				},
+			},
+			WindowDefinition{
+				Name: Identifier{Literal: "w2"},
+				As:   "as",
+				Clause: AnalyticClause{
+					WindowName: Identifier{Literal: "w1"},
+					OrderByClause: OrderByClause{
+						OrderBy: "order by",
+						Items: []QueryExpression{
+							OrderItem{Value: Identifier{Literal: "column2"}},
+						},
+					},
+				},
+			},
+		},
+	}
+	expect := "window w1 as (partition by column1), w2 as (w1 order by column2)"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
 }
 
 func TestVariable_String(t *testing.T) {
@@ -1556,6 +1865,17 @@ func TestRuntimeInformation_String(t *testing.T) {
 	}
 }
 
+func TestPlaceholder_String(t *testing.T) {
+	e := Placeholder{
+		Literal: "?",
+		Ordinal: 3,
+	}
+	expect := "?3"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+}
+
 func TestCursorStatus_String(t *testing.T) {
 	e := CursorStatus{
 		CursorLit: "cursor",
@@ -8,12 +8,10 @@ import __yyfmt__ "fmt"
 //line parser.y:2
 
 import (
-	"strconv"
-
 	"github.com/mithrandie/csvq/lib/value"
 )
 
-//line parser.y:11
+//line parser.y:9
 type yySymType struct {
 	yys         int
 	program     []Statement
@@ -31,6 +29,8 @@ type yySymType struct {
 	envvar      EnvironmentVariable
 	updateset   UpdateSet
 	updatesets  []UpdateSet
+	mergewhen   MergeWhen
+	mergewhens  []MergeWhen
 	columndef   ColumnDefault
 	columndefs  []ColumnDefault
 	elseif      []ElseIf
@@ -53,138 +53,164 @@ const FLAG = 57354
 const ENVIRONMENT_VARIABLE = 57355
 const RUNTIME_INFORMATION = 57356
 const EXTERNAL_COMMAND = 57357
-const SELECT = 57358
-const FROM = 57359
-const UPDATE = 57360
-const SET = 57361
-const UNSET = 57362
-const DELETE = 57363
-const WHERE = 57364
-const INSERT = 57365
-const INTO = 57366
-const VALUES = 57367
-const AS = 57368
-const DUAL = 57369
-const STDIN = 57370
-const RECURSIVE = 57371
-const CREATE = 57372
-const ADD = 57373
-const DROP = 57374
-const ALTER = 57375
-const TABLE = 57376
-const FIRST = 57377
-const LAST = 57378
-const AFTER = 57379
-const BEFORE = 57380
-const DEFAULT = 57381
-const RENAME = 57382
-const TO = 57383
-const VIEW = 57384
-const ORDER = 57385
-const GROUP = 57386
-const HAVING = 57387
-const BY = 57388
-const ASC = 57389
-const DESC = 57390
-const LIMIT = 57391
-const OFFSET = 57392
-const PERCENT = 57393
-const JOIN = 57394
-const INNER = 57395
-const OUTER = 57396
-const LEFT = 57397
-const RIGHT = 57398
-const FULL = 57399
-const CROSS = 57400
-const ON = 57401
-const USING = 57402
-const NATURAL = 57403
-const UNION = 57404
-const INTERSECT = 57405
-const EXCEPT = 57406
-const ALL = 57407
-const ANY = 57408
-const EXISTS = 57409
-const IN = 57410
-const AND = 57411
-const OR = 57412
-const NOT = 57413
-const BETWEEN = 57414
-const LIKE = 57415
-const IS = 57416
-const NULL = 57417
-const DISTINCT = 57418
-const WITH = 57419
-const RANGE = 57420
-const UNBOUNDED = 57421
-const PRECEDING = 57422
-const FOLLOWING = 57423
-const CURRENT = 57424
-const ROW = 57425
-const CASE = 57426
-const IF = 57427
-const ELSEIF = 57428
-const WHILE = 57429
-const WHEN = 57430
-const THEN = 57431
-const ELSE = 57432
-const DO = 57433
-const END = 57434
-const DECLARE = 57435
-const CURSOR = 57436
-const FOR = 57437
-const FETCH = 57438
-const OPEN = 57439
-const CLOSE = 57440
-const DISPOSE = 57441
-const NEXT = 57442
-const PRIOR = 57443
-const ABSOLUTE = 57444
-const RELATIVE = 57445
-const SEPARATOR = 57446
-const PARTITION = 57447
-const OVER = 57448
-const COMMIT = 57449
-const ROLLBACK = 57450
-const CONTINUE = 57451
-const BREAK = 57452
-const EXIT = 57453
-const ECHO = 57454
-const PRINT = 57455
-const PRINTF = 57456
-const SOURCE = 57457
-const EXECUTE = 57458
-const CHDIR = 57459
-const PWD = 57460
-const RELOAD = 57461
-const REMOVE = 57462
-const SYNTAX = 57463
-const TRIGGER = 57464
-const FUNCTION = 57465
-const AGGREGATE = 57466
-const BEGIN = 57467
-const RETURN = 57468
-const IGNORE = 57469
-const WITHIN = 57470
-const VAR = 57471
-const SHOW = 57472
-const TIES = 57473
-const NULLS = 57474
-const ROWS = 57475
-const JSON_ROW = 57476
-const JSON_TABLE = 57477
-const COUNT = 57478
-const JSON_OBJECT = 57479
-const AGGREGATE_FUNCTION = 57480
-const LIST_FUNCTION = 57481
-const ANALYTIC_FUNCTION = 57482
-const FUNCTION_NTH = 57483
-const FUNCTION_WITH_INS = 57484
-const COMPARISON_OP = 57485
-const STRING_OP = 57486
-const SUBSTITUTION_OP = 57487
-const UMINUS = 57488
-const UPLUS = 57489
+const PLACEHOLDER = 57358
+const SELECT = 57359
+const FROM = 57360
+const UPDATE = 57361
+const SET = 57362
+const UNSET = 57363
+const DELETE = 57364
+const WHERE = 57365
+const INSERT = 57366
+const INTO = 57367
+const VALUES = 57368
+const AS = 57369
+const DUAL = 57370
+const STDIN = 57371
+const MERGE = 57372
+const RECURSIVE = 57373
+const CREATE = 57374
+const ADD = 57375
+const DROP = 57376
+const ALTER = 57377
+const TABLE = 57378
+const FIRST = 57379
+const LAST = 57380
+const AFTER = 57381
+const BEFORE = 57382
+const DEFAULT = 57383
+const RENAME = 57384
+const TO = 57385
+const VIEW = 57386
+const INDEX = 57387
+const ORDER = 57388
+const GROUP = 57389
+const HAVING = 57390
+const BY = 57391
+const ASC = 57392
+const DESC = 57393
+const LIMIT = 57394
+const OFFSET = 57395
+const PERCENT = 57396
+const ROLLUP = 57397
+const CUBE = 57398
+const GROUPING = 57399
+const JOIN = 57400
+const INNER = 57401
+const OUTER = 57402
+const LEFT = 57403
+const RIGHT = 57404
+const FULL = 57405
+const CROSS = 57406
+const ON = 57407
+const USING = 57408
+const NATURAL = 57409
+const PIVOT = 57410
+const UNPIVOT = 57411
+const UNION = 57412
+const INTERSECT = 57413
+const EXCEPT = 57414
+const ALL = 57415
+const ANY = 57416
+const EXISTS = 57417
+const IN = 57418
+const AND = 57419
+const OR = 57420
+const NOT = 57421
+const BETWEEN = 57422
+const LIKE = 57423
+const REGEXP = 57424
+const IS = 57425
+const NULL = 57426
+const DISTINCT = 57427
+const WITH = 57428
+const RANGE = 57429
+const UNBOUNDED = 57430
+const PRECEDING = 57431
+const FOLLOWING = 57432
+const CURRENT = 57433
+const ROW = 57434
+const CASE = 57435
+const IF = 57436
+const ELSEIF = 57437
+const WHILE = 57438
+const WHEN = 57439
+const THEN = 57440
+const ELSE = 57441
+const DO = 57442
+const END = 57443
+const DECLARE = 57444
+const CURSOR = 57445
+const FOR = 57446
+const FETCH = 57447
+const OPEN = 57448
+const CLOSE = 57449
+const DISPOSE = 57450
+const NEXT = 57451
+const PRIOR = 57452
+const ABSOLUTE = 57453
+const RELATIVE = 57454
+const SEPARATOR = 57455
+const PARTITION = 57456
+const OVER = 57457
+const WINDOW = 57458
+const COMMIT = 57459
+const ROLLBACK = 57460
+const CONTINUE = 57461
+const BREAK = 57462
+const EXIT = 57463
+const TRY = 57464
+const CATCH = 57465
+const RAISE = 57466
+const ECHO = 57467
+const PRINT = 57468
+const PRINTF = 57469
+const SOURCE = 57470
+const EXECUTE = 57471
+const CHDIR = 57472
+const PWD = 57473
+const RELOAD = 57474
+const REMOVE = 57475
+const SYNTAX = 57476
+const TRIGGER = 57477
+const FUNCTION = 57478
+const AGGREGATE = 57479
+const BEGIN = 57480
+const RETURN = 57481
+const IGNORE = 57482
+const WITHIN = 57483
+const VAR = 57484
+const SHOW = 57485
+const EXPLAIN = 57486
+const ANALYZE = 57487
+const TIES = 57488
+const NULLS = 57489
+const ROWS = 57490
+const GROUPS = 57491
+const EXCLUDE = 57492
+const NO = 57493
+const OTHERS = 57494
+const INTERVAL = 57495
+const SETS = 57496
+const INCLUDE = 57497
+const MATCHED = 57498
+const JSON_ROW = 57499
+const JSON_TABLE = 57500
+const SQLITE = 57501
+const XLSX = 57502
+const FILES = 57503
+const COUNT = 57504
+const JSON_OBJECT = 57505
+const AGGREGATE_FUNCTION = 57506
+const LIST_FUNCTION = 57507
+const ANALYTIC_FUNCTION = 57508
+const FUNCTION_NTH = 57509
+const FUNCTION_WITH_INS = 57510
+const COMPARISON_OP = 57511
+const STRING_OP = 57512
+const SUBSTITUTION_OP = 57513
+const UMINUS = 57514
+const UPLUS = 57515
 
 var yyToknames = [...]string{
 	"$end",
@@ -202,6 +228,7 @@ var yyToknames = [...]string{
 	"ENVIRONMENT_VARIABLE",
 	"RUNTIME_INFORMATION",
 	"EXTERNAL_COMMAND",
+	"PLACEHOLDER",
 	"SELECT",
 	"FROM",
 	"UPDATE",
@@ -215,6 +242,7 @@ var yyToknames = [...]string{
 	"AS",
 	"DUAL",
 	"STDIN",
+	"MERGE",
 	"RECURSIVE",
 	"CREATE",
 	"ADD",
@@ -229,6 +257,7 @@ var yyToknames = [...]string{
 	"RENAME",
 	"TO",
 	"VIEW",
+	"INDEX",
 	"ORDER",
 	"GROUP",
 	"HAVING",
@@ -238,6 +267,9 @@ var yyToknames = [...]string{
 	"LIMIT",
 	"OFFSET",
 	"PERCENT",
+	"ROLLUP",
+	"CUBE",
+	"GROUPING",
 	"JOIN",
 	"INNER",
 	"OUTER",
@@ -248,6 +280,8 @@ var yyToknames = [...]string{
 	"ON",
 	"USING",
 	"NATURAL",
+	"PIVOT",
+	"UNPIVOT",
 	"UNION",
 	"INTERSECT",
 	"EXCEPT",
@@ -260,6 +294,7 @@ var yyToknames = [...]string{
 	"NOT",
 	"BETWEEN",
 	"LIKE",
+	"REGEXP",
 	"IS",
 	"NULL",
 	"DISTINCT",
@@ -293,11 +328,15 @@ var yyToknames = [...]string{
 	"SEPARATOR",
 	"PARTITION",
 	"OVER",
+	"WINDOW",
 	"COMMIT",
 	"ROLLBACK",
 	"CONTINUE",
 	"BREAK",
 	"EXIT",
+	"TRY",
+	"CATCH",
+	"RAISE",
 	"ECHO",
 	"PRINT",
 	"PRINTF",
@@ -317,11 +356,24 @@ var yyToknames = [...]string{
 	"WITHIN",
 	"VAR",
 	"SHOW",
+	"EXPLAIN",
+	"ANALYZE",
 	"TIES",
 	"NULLS",
 	"ROWS",
+	"GROUPS",
+	"EXCLUDE",
+	"NO",
+	"OTHERS",
+	"INTERVAL",
+	"SETS",
+	"INCLUDE",
+	"MATCHED",
 	"JSON_ROW",
 	"JSON_TABLE",
+	"SQLITE",
+	"XLSX",
+	"FILES",
 	"COUNT",
 	"JSON_OBJECT",
 	"AGGREGATE_FUNCTION",
@@ -353,7 +405,7 @@ const yyEofCode = 1
 const yyErrCode = 2
 const yyInitialStackSize = 16
 
-//line parser.y:2313
+//line parser.y:2784
 
 func SetDebugLevel(level int, verbose bool) {
 	yyDebug = level
@@ -371,1091 +423,1379 @@ func Parse(s string, sourceFile string) ([]Statement, error) {
 var yyExca = [...]int{
 	-1, 0,
 	1, 1,
-	-2, 186,
+	-2, 224,
 	-1, 1,
 	1, -1,
 	-2, 0,
-	-1, 29,
-	1, 73,
-	86, 73,
-	88, 73,
-	90, 73,
-	92, 73,
-	148, 73,
-	-2, 216,
-	-1, 99,
-	16, 186,
-	18, 186,
-	21, 186,
-	23, 186,
+	-1, 25,
+	123, 1,
+	-2, 224,
+	-1, 32,
+	1, 78,
+	95, 78,
+	97, 78,
+	99, 78,
+	101, 78,
+	123, 78,
+	174, 78,
+	-2, 254,
+	-1, 115,
+	17, 224,
+	19, 224,
+	22, 224,
+	24, 224,
+	30, 224,
 	-2, 1,
-	-1, 117,
-	155, 273,
-	-2, 186,
-	-1, 123,
-	62, 166,
-	63, 166,
-	64, 166,
-	-2, 177,
-	-1, 162,
-	1, 146,
-	86, 146,
-	88, 146,
-	90, 146,
-	92, 146,
-	148, 146,
-	-2, 200,
-	-1, 167,
+	-1, 134,
+	181, 314,
+	-2, 224,
+	-1, 141,
+	70, 191,
+	71, 191,
+	72, 191,
+	-2, 215,
+	-1, 186,
+	1, 170,
+	95, 170,
+	97, 170,
+	99, 170,
+	101, 170,
+	123, 170,
+	174, 170,
+	-2, 238,
+	-1, 191,
+	1, 178,
+	95, 178,
+	97, 178,
+	99, 178,
+	101, 178,
+	123, 178,
+	174, 178,
+	-2, 238,
+	-1, 232,
+	76, 0,
+	80, 0,
+	81, 0,
+	82, 0,
+	83, 0,
+	169, 0,
+	176, 0,
+	-2, 282,
+	-1, 233,
+	76, 0,
+	80, 0,
+	81, 0,
+	82, 0,
+	83, 0,
+	169, 0,
+	176, 0,
+	-2, 284,
+	-1, 243,
+	76, 0,
+	80, 0,
+	81, 0,
+	82, 0,
+	83, 0,
+	169, 0,
+	176, 0,
+	-2, 294,
+	-1, 244,
+	76, 0,
+	80, 0,
+	81, 0,
+	82, 0,
+	83, 0,
+	169, 0,
+	176, 0,
+	-2, 296,
+	-1, 254,
+	95, 1,
+	99, 1,
+	101, 1,
+	-2, 224,
+	-1, 262,
+	101, 1,
+	-2, 224,
+	-1, 318,
+	101, 4,
+	-2, 224,
+	-1, 366,
+	76, 0,
+	80, 0,
+	81, 0,
+	82, 0,
+	83, 0,
+	169, 0,
+	176, 0,
+	-2, 295,
+	-1, 367,
+	76, 0,
+	80, 0,
+	81, 0,
+	82, 0,
+	83, 0,
+	169, 0,
+	176, 0,
+	-2, 297,
+	-1, 374,
+	101, 1,
+	-2, 224,
+	-1, 392,
+	58, 522,
+	-2, 434,
+	-1, 429,
+	1, 81,
+	95, 81,
+	97, 81,
+	99, 81,
+	101, 81,
+	123, 81,
+	174, 81,
+	-2, 238,
+	-1, 431,
+	1, 83,
+	95, 83,
+	97, 83,
+	99, 83,
+	101, 83,
+	123, 83,
+	174, 83,
+	-2, 238,
+	-1, 432,
 	1, 154,
-	86, 154,
-	88, 154,
-	90, 154,
-	92, 154,
-	148, 154,
-	-2, 200,
-	-1, 208,
-	68, 0,
-	72, 0,
-	73, 0,
-	74, 0,
-	143, 0,
-	150, 0,
-	-2, 243,
-	-1, 209,
-	68, 0,
-	72, 0,
-	73, 0,
-	74, 0,
-	143, 0,
-	150, 0,
-	-2, 245,
-	-1, 218,
-	68, 0,
-	72, 0,
-	73, 0,
-	74, 0,
-	143, 0,
-	150, 0,
-	-2, 255,
-	-1, 228,
-	86, 1,
-	90, 1,
-	92, 1,
-	-2, 186,
-	-1, 283,
-	92, 4,
-	-2, 186,
-	-1, 330,
-	68, 0,
-	72, 0,
-	73, 0,
-	74, 0,
-	143, 0,
-	150, 0,
-	-2, 256,
-	-1, 337,
-	92, 1,
-	-2, 186,
-	-1, 349,
-	52, 425,
-	-2, 357,
-	-1, 382,
-	1, 76,
-	86, 76,
-	88, 76,
-	90, 76,
-	92, 76,
-	148, 76,
-	-2, 200,
-	-1, 384,
-	1, 78,
-	86, 78,
-	88, 78,
-	90, 78,
-	92, 78,
-	148, 78,
-	-2, 200,
-	-1, 385,
-	1, 134,
-	86, 134,
-	88, 134,
-	90, 134,
-	92, 134,
-	148, 134,
-	-2, 200,
-	-1, 387,
-	1, 136,
-	86, 136,
-	88, 136,
-	90, 136,
-	92, 136,
-	148, 136,
-	-2, 200,
-	-1, 447,
-	92, 1,
-	-2, 186,
-	-1, 454,
-	88, 1,
-	90, 1,
-	92, 1,
-	-2, 186,
-	-1, 519,
-	86, 4,
-	88, 4,
-	90, 4,
-	92, 4,
-	-2, 186,
-	-1, 522,
-	92, 4,
-	-2, 186,
-	-1, 523,
-	92, 4,
-	-2, 186,
+	95, 154,
+	97, 154,
+	99, 154,
+	101, 154,
+	123, 154,
+	174, 154,
+	-2, 238,
+	-1, 435,
+	1, 157,
+	95, 157,
+	97, 157,
+	99, 157,
+	101, 157,
+	123, 157,
+	174, 157,
+	-2, 238,
+	-1, 456,
+	123, 4,
+	-2, 224,
+	-1, 498,
+	101, 1,
+	-2, 224,
+	-1, 505,
+	97, 1,
+	99, 1,
+	101, 1,
+	-2, 224,
+	-1, 586,
+	95, 4,
+	97, 4,
+	99, 4,
+	101, 4,
+	123, 4,
+	-2, 224,
+	-1, 590,
+	101, 4,
+	-2, 224,
 	-1, 591,
-	16, 435,
-	77, 435,
-	154, 435,
-	-2, 82,
-	-1, 614,
-	86, 4,
-	90, 4,
-	92, 4,
-	-2, 186,
-	-1, 619,
-	92, 4,
-	-2, 186,
-	-1, 620,
-	92, 4,
-	-2, 186,
-	-1, 641,
-	86, 1,
-	90, 1,
-	92, 1,
-	-2, 186,
-	-1, 676,
-	1, 90,
-	86, 90,
-	88, 90,
-	90, 90,
-	92, 90,
-	148, 90,
-	-2, 200,
-	-1, 679,
-	92, 6,
-	-2, 186,
-	-1, 690,
-	92, 4,
-	-2, 186,
-	-1, 746,
-	92, 6,
-	-2, 186,
-	-1, 747,
-	92, 6,
-	-2, 186,
-	-1, 751,
-	92, 4,
-	-2, 186,
-	-1, 755,
-	88, 4,
-	90, 4,
-	92, 4,
-	-2, 186,
-	-1, 775,
-	88, 1,
-	90, 1,
-	92, 1,
-	-2, 186,
-	-1, 788,
-	86, 6,
-	88, 6,
-	90, 6,
-	92, 6,
-	-2, 186,
-	-1, 828,
-	86, 6,
-	90, 6,
-	92, 6,
-	-2, 186,
-	-1, 831,
-	92, 8,
-	-2, 186,
-	-1, 836,
-	92, 6,
-	-2, 186,
-	-1, 839,
-	86, 4,
-	90, 4,
-	92, 4,
-	-2, 186,
-	-1, 862,
-	92, 6,
-	-2, 186,
-	-1, 890,
-	92, 6,
-	-2, 186,
-	-1, 894,
-	88, 6,
-	90, 6,
-	92, 6,
-	-2, 186,
-	-1, 896,
-	86, 8,
-	88, 8,
-	90, 8,
-	92, 8,
-	-2, 186,
-	-1, 899,
-	92, 8,
-	-2, 186,
-	-1, 900,
-	92, 8,
-	-2, 186,
-	-1, 903,
-	88, 4,
-	90, 4,
-	92, 4,
-	-2, 186,
-	-1, 915,
-	86, 8,
-	90, 8,
-	92, 8,
-	-2, 186,
-	-1, 924,
-	86, 6,
-	90, 6,
-	92, 6,
-	-2, 186,
+	101, 4,
+	-2, 224,
+	-1, 670,
+	17, 532,
+	86, 532,
+	180, 532,
+	-2, 87,
+	-1, 699,
+	95, 4,
+	99, 4,
+	101, 4,
+	-2, 224,
+	-1, 702,
+	101, 4,
+	-2, 224,
+	-1, 705,
+	101, 4,
+	-2, 224,
+	-1, 706,
+	101, 4,
+	-2, 224,
+	-1, 727,
+	95, 1,
+	99, 1,
+	101, 1,
+	-2, 224,
+	-1, 782,
+	1, 95,
+	95, 95,
+	97, 95,
+	99, 95,
+	101, 95,
+	123, 95,
+	174, 95,
+	-2, 238,
+	-1, 787,
+	101, 6,
+	-2, 224,
+	-1, 798,
+	101, 4,
+	-2, 224,
+	-1, 871,
+	123, 6,
+	-2, 224,
+	-1, 874,
+	101, 6,
+	-2, 224,
+	-1, 875,
+	101, 6,
+	-2, 224,
+	-1, 879,
+	101, 4,
+	-2, 224,
+	-1, 883,
+	97, 4,
+	99, 4,
+	101, 4,
+	-2, 224,
+	-1, 909,
+	97, 1,
+	99, 1,
+	101, 1,
+	-2, 224,
 	-1, 929,
-	92, 8,
-	-2, 186,
-	-1, 943,
-	92, 8,
-	-2, 186,
-	-1, 947,
-	88, 8,
-	90, 8,
-	92, 8,
-	-2, 186,
-	-1, 959,
-	88, 6,
-	90, 6,
-	92, 6,
-	-2, 186,
-	-1, 973,
-	86, 8,
-	90, 8,
-	92, 8,
-	-2, 186,
-	-1, 984,
-	88, 8,
-	90, 8,
-	92, 8,
-	-2, 186,
+	76, 261,
+	79, 261,
+	80, 261,
+	169, 261,
+	176, 261,
+	-2, 202,
+	-1, 936,
+	95, 6,
+	97, 6,
+	99, 6,
+	101, 6,
+	123, 6,
+	-2, 224,
+	-1, 990,
+	95, 6,
+	99, 6,
+	101, 6,
+	-2, 224,
+	-1, 993,
+	101, 6,
+	-2, 224,
+	-1, 994,
+	101, 8,
+	-2, 224,
+	-1, 999,
+	101, 6,
+	-2, 224,
+	-1, 1002,
+	95, 4,
+	99, 4,
+	101, 4,
+	-2, 224,
+	-1, 1033,
+	101, 6,
+	-2, 224,
+	-1, 1045,
+	123, 8,
+	-2, 224,
+	-1, 1073,
+	101, 6,
+	-2, 224,
+	-1, 1077,
+	97, 6,
+	99, 6,
+	101, 6,
+	-2, 224,
+	-1, 1080,
+	95, 8,
+	97, 8,
+	99, 8,
+	101, 8,
+	123, 8,
+	-2, 224,
+	-1, 1084,
+	101, 8,
+	-2, 224,
+	-1, 1085,
+	101, 8,
+	-2, 224,
+	-1, 1088,
+	97, 4,
+	99, 4,
+	101, 4,
+	-2, 224,
+	-1, 1119,
+	95, 8,
+	99, 8,
+	101, 8,
+	-2, 224,
+	-1, 1122,
+	101, 8,
+	-2, 224,
+	-1, 1146,
+	95, 6,
+	99, 6,
+	101, 6,
+	-2, 224,
+	-1, 1151,
+	101, 8,
+	-2, 224,
+	-1, 1171,
+	101, 8,
+	-2, 224,
+	-1, 1175,
+	97, 8,
+	99, 8,
+	101, 8,
+	-2, 224,
+	-1, 1184,
+	97, 6,
+	99, 6,
+	101, 6,
+	-2, 224,
+	-1, 1195,
+	95, 8,
+	99, 8,
+	101, 8,
+	-2, 224,
+	-1, 1204,
+	97, 8,
+	99, 8,
+	101, 8,
+	-2, 224,
 }
 
 const yyPrivate = 57344
 
-const yyLast = 3689
+const yyLast = 5036
 
 var yyAct = [...]int{
 
-	18, 942, 952, 941, 916, 304, 743, 889, 888, 121,
-	750, 829, 615, 458, 809, 178, 543, 295, 808, 118,
-	29, 116, 122, 496, 405, 23, 912, 844, 404, 22,
-	24, 349, 230, 749, 446, 598, 721, 234, 803, 155,
-	156, 593, 159, 160, 161, 163, 164, 166, 168, 83,
-	568, 510, 513, 807, 576, 742, 368, 468, 558, 1,
-	512, 359, 560, 233, 165, 965, 172, 176, 302, 476,
-	475, 245, 299, 348, 445, 599, 197, 345, 190, 191,
-	183, 362, 350, 173, 187, 128, 201, 202, 76, 406,
-	188, 239, 493, 134, 434, 187, 175, 400, 3, 74,
-	832, 207, 208, 209, 785, 211, 413, 786, 218, 61,
-	221, 222, 223, 224, 225, 226, 227, 123, 172, 29,
-	423, 122, 137, 189, 23, 187, 188, 782, 22, 610,
-	232, 187, 611, 672, 651, 229, 634, 136, 136, 480,
-	139, 481, 482, 477, 474, 111, 51, 478, 175, 284,
-	608, 236, 112, 113, 268, 269, 215, 607, 206, 188,
-	662, 100, 175, 663, 187, 592, 111, 572, 110, 109,
-	563, 277, 279, 112, 113, 87, 177, 171, 285, 480,
-	210, 481, 482, 477, 474, 421, 347, 478, 289, 166,
-	285, 254, 906, 303, 905, 288, 285, 3, 885, 884,
-	129, 68, 125, 240, 240, 126, 324, 124, 463, 883,
-	882, 253, 881, 328, 859, 330, 858, 166, 244, 857,
-	111, 293, 110, 109, 91, 855, 171, 112, 113, 853,
-	852, 91, 166, 843, 173, 842, 340, 784, 748, 285,
-	703, 129, 98, 702, 479, 315, 316, 175, 29, 701,
-	700, 303, 699, 23, 696, 70, 375, 22, 98, 674,
-	68, 91, 216, 329, 381, 383, 386, 388, 123, 331,
-	332, 671, 650, 633, 166, 166, 166, 166, 216, 397,
-	631, 583, 630, 629, 353, 242, 623, 333, 622, 287,
-	606, 393, 394, 395, 396, 166, 604, 591, 548, 326,
-	541, 410, 540, 29, 539, 528, 250, 420, 418, 91,
-	325, 334, 281, 282, 166, 166, 416, 856, 266, 509,
-	361, 344, 398, 366, 166, 437, 3, 419, 443, 854,
-	364, 365, 353, 242, 68, 378, 449, 464, 131, 815,
-	453, 369, 814, 457, 461, 435, 430, 431, 374, 462,
-	813, 92, 93, 94, 812, 811, 441, 29, 92, 93,
-	94, 491, 23, 778, 136, 773, 22, 433, 770, 768,
-	415, 767, 761, 760, 500, 175, 545, 526, 487, 131,
-	486, 503, 429, 432, 428, 175, 485, 427, 92, 93,
-	94, 426, 356, 425, 424, 411, 451, 380, 440, 175,
-	379, 507, 231, 520, 122, 517, 205, 175, 204, 175,
-	473, 354, 131, 521, 438, 439, 194, 193, 192, 199,
-	264, 573, 303, 896, 166, 240, 788, 265, 166, 166,
-	166, 519, 488, 99, 255, 3, 92, 93, 94, 472,
-	356, 527, 171, 549, 417, 550, 499, 921, 492, 554,
-	494, 495, 321, 91, 771, 557, 531, 559, 91, 354,
-	536, 537, 538, 377, 769, 649, 175, 29, 647, 367,
-	91, 243, 23, 637, 29, 766, 22, 70, 836, 23,
-	567, 707, 242, 22, 747, 544, 91, 584, 586, 515,
-	746, 195, 484, 470, 529, 294, 679, 637, 196, 411,
-	313, 314, 708, 705, 821, 819, 553, 765, 764, 91,
-	242, 323, 763, 544, 322, 91, 762, 297, 502, 504,
-	704, 552, 698, 810, 706, 376, 972, 960, 91, 263,
-	292, 467, 578, 166, 166, 166, 166, 257, 945, 29,
-	571, 547, 29, 29, 91, 3, 635, 91, 580, 579,
-	587, 87, 3, 581, 601, 157, 642, 175, 613, 91,
-	932, 617, 618, 931, 461, 624, 625, 626, 628, 462,
-	546, 87, 923, 907, 654, 901, 895, 648, 892, 838,
-	92, 93, 94, 835, 834, 92, 93, 94, 798, 256,
-	665, 166, 632, 787, 759, 643, 758, 92, 93, 94,
-	753, 673, 141, 627, 677, 693, 655, 656, 666, 692,
-	685, 569, 640, 92, 93, 94, 668, 691, 258, 259,
-	551, 518, 644, 452, 646, 450, 900, 151, 152, 899,
-	653, 949, 620, 652, 29, 660, 92, 93, 94, 29,
-	29, 619, 92, 93, 94, 944, 714, 523, 667, 943,
-	569, 709, 522, 688, 140, 92, 93, 94, 694, 695,
-	681, 29, 729, 687, 166, 943, 23, 929, 682, 683,
-	22, 92, 93, 94, 92, 93, 94, 890, 862, 751,
-	643, 175, 975, 142, 544, 690, 92, 93, 94, 724,
-	725, 726, 149, 150, 153, 154, 730, 891, 175, 29,
-	713, 890, 720, 752, 447, 339, 736, 751, 734, 175,
-	29, 772, 448, 733, 5, 337, 447, 515, 684, 470,
-	926, 515, 917, 777, 841, 830, 645, 616, 335, 754,
-	532, 533, 534, 535, 235, 776, 948, 913, 805, 3,
-	804, 789, 122, 669, 670, 791, 794, 757, 774, 756,
-	612, 790, 944, 801, 891, 752, 557, 448, 979, 781,
-	793, 779, 971, 938, 922, 876, 29, 29, 544, 837,
-	712, 29, 639, 795, 796, 29, 953, 738, 964, 911,
-	174, 825, 817, 802, 799, 817, 816, 166, 556, 820,
-	800, 175, 108, 970, 957, 29, 968, 969, 569, 982,
-	23, 823, 824, 967, 22, 956, 955, 636, 29, 718,
-	68, 562, 251, 199, 966, 827, 840, 95, 936, 826,
-	213, 833, 818, 953, 212, 214, 542, 414, 286, 318,
-	817, 863, 174, 317, 851, 248, 320, 319, 871, 220,
-	219, 363, 878, 577, 738, 738, 174, 166, 29, 977,
-	727, 29, 954, 659, 792, 860, 29, 658, 657, 29,
-	342, 68, 575, 875, 880, 847, 848, 849, 850, 897,
-	122, 817, 574, 3, 456, 887, 198, 879, 877, 898,
-	461, 846, 29, 96, 934, 462, 738, 870, 902, 893,
-	590, 935, 910, 904, 937, 557, 951, 864, 908, 954,
-	247, 248, 249, 871, 565, 566, 871, 871, 886, 480,
-	29, 481, 482, 343, 29, 589, 29, 909, 930, 29,
-	29, 872, 871, 29, 925, 711, 738, 940, 490, 866,
-	237, 174, 845, 603, 738, 29, 871, 594, 595, 596,
-	597, 602, 609, 600, 29, 963, 961, 958, 557, 29,
-	871, 939, 870, 133, 871, 870, 870, 716, 717, 132,
-	738, 186, 914, 29, 797, 918, 919, 29, 62, 978,
-	974, 870, 697, 69, 686, 680, 981, 678, 369, 29,
-	871, 927, 983, 605, 422, 870, 872, 389, 738, 872,
-	872, 871, 738, 29, 866, 946, 238, 866, 866, 870,
-	143, 145, 138, 870, 29, 872, 360, 146, 147, 962,
-	246, 346, 358, 866, 158, 272, 144, 88, 162, 872,
-	88, 167, 738, 169, 170, 391, 390, 866, 87, 870,
-	182, 185, 63, 872, 135, 928, 861, 872, 689, 980,
-	870, 866, 336, 8, 480, 866, 481, 482, 477, 474,
-	780, 55, 478, 469, 373, 7, 6, 738, 338, 465,
-	58, 300, 301, 872, 203, 352, 370, 371, 351, 174,
-	976, 866, 950, 933, 872, 372, 130, 920, 82, 57,
-	56, 60, 866, 498, 53, 59, 54, 715, 564, 460,
-	459, 506, 52, 508, 184, 455, 341, 588, 489, 241,
-	241, 127, 17, 16, 64, 148, 252, 241, 14, 514,
-	511, 13, 12, 9, 260, 261, 262, 15, 11, 10,
-	867, 739, 267, 865, 737, 401, 106, 115, 114, 105,
-	104, 107, 103, 399, 4, 480, 200, 481, 482, 477,
-	474, 722, 723, 478, 179, 2, 0, 0, 0, 0,
-	174, 0, 0, 0, 0, 0, 0, 0, 217, 290,
-	0, 291, 0, 296, 0, 0, 306, 0, 0, 0,
-	0, 0, 274, 0, 0, 0, 0, 0, 0, 0,
-	106, 115, 114, 105, 104, 107, 103, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 101, 100, 0, 0, 0, 0, 111, 102, 110,
-	109, 0, 241, 280, 112, 113, 276, 357, 0, 0,
-	357, 0, 0, 0, 306, 106, 115, 130, 105, 104,
-	107, 103, 0, 0, 0, 0, 0, 382, 384, 385,
-	387, 621, 0, 0, 0, 0, 392, 217, 217, 0,
-	0, 0, 0, 0, 0, 101, 100, 0, 409, 0,
-	412, 111, 102, 110, 109, 217, 0, 0, 112, 113,
-	273, 217, 217, 0, 106, 115, 114, 105, 104, 107,
-	103, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 355, 0, 0, 355, 0,
-	101, 100, 0, 0, 0, 0, 111, 102, 110, 109,
-	0, 0, 0, 112, 113, 0, 0, 306, 0, 466,
-	471, 241, 0, 0, 0, 483, 0, 0, 357, 0,
-	0, 0, 357, 0, 0, 0, 0, 0, 0, 0,
-	0, 497, 0, 0, 501, 471, 471, 505, 0, 101,
-	100, 497, 0, 0, 516, 111, 102, 110, 109, 0,
-	0, 0, 112, 113, 710, 719, 0, 0, 0, 217,
-	436, 436, 436, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 732, 0, 0, 0, 0, 524, 525, 0,
-	0, 497, 0, 735, 0, 306, 530, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 355, 0, 0, 0,
-	355, 0, 0, 0, 130, 0, 130, 130, 106, 115,
-	114, 105, 104, 107, 103, 0, 0, 0, 106, 115,
-	114, 105, 104, 107, 103, 0, 0, 0, 471, 0,
-	0, 570, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 357, 0, 0, 0, 0, 582, 0,
-	0, 585, 561, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 501, 806, 0, 471, 0, 106,
-	115, 114, 105, 104, 107, 103, 0, 217, 562, 0,
-	0, 0, 0, 101, 100, 0, 0, 0, 0, 111,
-	102, 110, 109, 101, 100, 0, 112, 113, 664, 111,
-	102, 110, 109, 0, 0, 217, 112, 113, 661, 0,
-	91, 71, 72, 73, 0, 95, 75, 87, 0, 88,
-	89, 355, 0, 0, 0, 0, 0, 306, 0, 0,
-	0, 0, 0, 0, 70, 0, 471, 0, 357, 357,
-	0, 0, 0, 0, 101, 100, 0, 0, 0, 0,
-	111, 102, 110, 109, 0, 0, 497, 112, 113, 0,
-	471, 471, 0, 0, 0, 0, 675, 676, 0, 0,
-	0, 0, 0, 84, 0, 0, 0, 85, 0, 0,
-	0, 96, 0, 0, 217, 0, 0, 0, 0, 0,
-	120, 119, 0, 0, 0, 0, 0, 0, 0, 0,
-	90, 0, 0, 0, 106, 115, 114, 105, 104, 107,
-	103, 0, 0, 0, 0, 471, 355, 355, 0, 0,
-	0, 357, 357, 357, 0, 728, 0, 0, 731, 0,
-	0, 0, 0, 0, 0, 0, 501, 92, 93, 94,
-	98, 0, 308, 79, 307, 309, 310, 311, 312, 0,
-	0, 0, 0, 0, 0, 305, 0, 77, 78, 86,
-	65, 298, 0, 0, 0, 0, 0, 0, 106, 115,
-	114, 105, 104, 107, 103, 0, 217, 0, 0, 101,
-	100, 0, 0, 0, 0, 111, 102, 110, 109, 0,
-	0, 357, 112, 113, 442, 0, 0, 0, 0, 355,
-	355, 355, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 91, 71, 72, 73, 0, 95, 75, 87,
-	0, 88, 89, 19, 0, 0, 0, 31, 32, 0,
-	0, 0, 0, 0, 0, 0, 70, 0, 25, 38,
-	0, 26, 497, 101, 100, 0, 0, 0, 0, 111,
-	102, 110, 109, 0, 0, 0, 112, 113, 276, 0,
-	217, 0, 0, 0, 0, 0, 0, 0, 0, 355,
-	0, 0, 0, 0, 0, 84, 0, 0, 0, 85,
-	0, 0, 0, 96, 0, 68, 0, 0, 0, 0,
-	0, 0, 869, 868, 0, 744, 873, 874, 0, 0,
-	0, 28, 90, 0, 35, 33, 34, 30, 0, 0,
-	0, 0, 0, 0, 0, 36, 37, 407, 408, 0,
-	41, 42, 43, 44, 45, 47, 48, 49, 39, 46,
-	50, 0, 0, 0, 745, 0, 0, 27, 40, 92,
-	93, 94, 98, 306, 81, 79, 80, 97, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 77,
-	78, 86, 65, 91, 71, 72, 73, 0, 95, 75,
-	87, 0, 88, 89, 19, 0, 0, 0, 31, 32,
-	0, 0, 0, 0, 0, 0, 0, 70, 0, 25,
-	38, 0, 26, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 84, 0, 0, 0,
-	85, 0, 0, 0, 96, 0, 68, 0, 0, 0,
-	0, 0, 0, 403, 402, 0, 66, 0, 0, 0,
-	0, 0, 28, 90, 0, 35, 33, 34, 30, 0,
-	0, 0, 0, 0, 0, 0, 36, 37, 407, 408,
-	67, 41, 42, 43, 44, 45, 47, 48, 49, 39,
-	46, 50, 0, 0, 0, 0, 0, 0, 27, 40,
-	92, 93, 94, 98, 0, 81, 79, 80, 97, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	77, 78, 86, 65, 91, 71, 72, 73, 0, 95,
-	75, 87, 0, 88, 89, 19, 0, 0, 0, 31,
-	32, 0, 0, 0, 0, 0, 0, 0, 70, 0,
-	25, 38, 0, 26, 0, 0, 0, 0, 0, 0,
+	19, 1170, 1120, 870, 1072, 1091, 1169, 1011, 637, 522,
+	1071, 1095, 991, 878, 1026, 957, 1096, 760, 1007, 256,
+	845, 923, 133, 139, 952, 700, 899, 877, 339, 959,
+	90, 958, 510, 497, 675, 573, 831, 680, 392, 611,
+	577, 334, 176, 177, 201, 579, 183, 184, 185, 187,
+	188, 190, 192, 649, 580, 259, 337, 404, 260, 138,
+	629, 559, 534, 626, 1, 413, 485, 890, 277, 496,
+	454, 24, 196, 199, 681, 533, 147, 206, 383, 155,
+	384, 869, 453, 23, 213, 214, 330, 391, 221, 140,
+	407, 210, 82, 225, 226, 463, 24, 393, 995, 80,
+	211, 753, 56, 921, 754, 210, 922, 211, 23, 212,
+	473, 158, 210, 695, 66, 210, 696, 231, 232, 233,
+	266, 235, 926, 848, 243, 244, 775, 247, 248, 249,
+	250, 251, 252, 253, 737, 196, 720, 189, 139, 211,
+	918, 693, 455, 94, 210, 157, 157, 540, 160, 541,
+	542, 535, 532, 240, 692, 536, 537, 538, 197, 319,
+	263, 1190, 540, 689, 541, 542, 535, 532, 671, 642,
+	536, 537, 538, 128, 632, 302, 303, 116, 320, 230,
+	129, 130, 128, 471, 127, 126, 24, 200, 387, 129,
+	130, 114, 386, 195, 324, 312, 314, 258, 23, 288,
+	556, 282, 128, 320, 127, 126, 320, 1192, 234, 129,
+	130, 74, 190, 148, 241, 143, 338, 190, 144, 1162,
+	142, 255, 267, 267, 268, 268, 145, 141, 1141, 1140,
+	360, 328, 286, 515, 1164, 1139, 348, 364, 1105, 366,
+	367, 1104, 190, 1103, 1070, 1030, 1025, 323, 1022, 1021,
+	351, 352, 1018, 1006, 195, 1005, 986, 985, 190, 929,
+	920, 876, 377, 856, 148, 275, 853, 320, 365, 1080,
+	812, 811, 539, 810, 809, 808, 368, 369, 1115, 805,
+	780, 774, 114, 338, 661, 736, 719, 717, 716, 715,
+	422, 709, 216, 74, 708, 691, 688, 670, 428, 430,
+	433, 436, 646, 645, 616, 241, 488, 609, 608, 190,
+	190, 190, 190, 576, 446, 607, 596, 322, 370, 480,
+	470, 468, 466, 371, 411, 24, 378, 362, 197, 486,
+	190, 316, 425, 24, 361, 317, 414, 23, 1066, 1023,
+	1017, 984, 932, 447, 912, 23, 907, 889, 852, 469,
+	190, 190, 851, 749, 674, 654, 613, 594, 549, 60,
+	190, 548, 479, 382, 494, 460, 406, 478, 421, 481,
+	482, 409, 410, 500, 403, 477, 150, 504, 476, 492,
+	99, 509, 513, 141, 484, 475, 149, 516, 1165, 528,
+	190, 190, 474, 427, 426, 442, 443, 444, 445, 390,
+	389, 388, 465, 157, 397, 270, 554, 483, 257, 229,
+	514, 228, 150, 329, 218, 217, 216, 215, 300, 349,
+	350, 223, 489, 490, 298, 643, 936, 150, 586, 115,
+	359, 289, 195, 982, 1028, 461, 357, 547, 502, 657,
+	1157, 1092, 733, 1014, 656, 24, 491, 905, 1128, 531,
+	903, 224, 587, 139, 751, 750, 723, 23, 735, 999,
+	74, 875, 74, 1059, 1060, 267, 267, 268, 268, 874,
+	816, 787, 338, 1122, 190, 467, 529, 530, 190, 190,
+	190, 589, 242, 584, 555, 424, 557, 558, 524, 412,
+	550, 564, 1127, 599, 617, 817, 618, 604, 605, 606,
+	622, 1059, 1060, 595, 1013, 1015, 625, 526, 527, 628,
+	981, 219, 588, 597, 898, 358, 993, 612, 220, 181,
+	567, 569, 100, 101, 102, 103, 104, 105, 106, 107,
+	108, 109, 110, 1010, 400, 271, 272, 273, 449, 3,
+	897, 582, 149, 1059, 1060, 1159, 612, 1129, 1097, 662,
+	664, 299, 1130, 461, 291, 636, 398, 297, 702, 814,
+	262, 1191, 621, 1116, 3, 953, 758, 620, 627, 24,
+	1138, 972, 917, 971, 1014, 1055, 24, 685, 896, 242,
+	242, 23, 895, 1056, 815, 894, 1058, 893, 23, 651,
+	666, 641, 813, 807, 838, 423, 615, 242, 1194, 653,
+	1185, 190, 190, 190, 190, 242, 242, 1176, 665, 94,
+	1061, 698, 652, 290, 721, 703, 704, 1173, 1155, 638,
+	710, 711, 712, 714, 728, 1094, 614, 658, 1097, 1154,
+	1145, 1110, 1086, 513, 399, 1013, 1015, 1079, 399, 1078,
+	1075, 718, 162, 1001, 740, 741, 292, 293, 1061, 998,
+	997, 947, 739, 935, 3, 338, 888, 887, 884, 713,
+	881, 514, 802, 801, 726, 734, 619, 585, 506, 759,
+	762, 638, 135, 32, 75, 600, 601, 602, 603, 503,
+	501, 1172, 730, 776, 729, 1171, 742, 743, 783, 1085,
+	1061, 732, 1084, 706, 705, 793, 748, 591, 32, 738,
+	1074, 161, 799, 590, 1073, 880, 159, 747, 1171, 879,
+	771, 167, 168, 242, 487, 487, 487, 499, 179, 1151,
+	1073, 498, 1033, 186, 796, 786, 191, 800, 193, 194,
+	803, 804, 823, 768, 163, 879, 767, 795, 790, 791,
+	798, 498, 172, 173, 376, 524, 829, 789, 374, 1109,
+	1067, 612, 1197, 841, 1148, 190, 1121, 844, 399, 1004,
+	818, 992, 399, 925, 196, 731, 701, 149, 372, 149,
+	149, 261, 1178, 227, 842, 1177, 1117, 955, 859, 954,
+	886, 772, 773, 834, 835, 836, 885, 729, 32, 697,
+	1172, 822, 1074, 3, 880, 499, 1199, 1193, 24, 1156,
+	1166, 3, 1144, 1049, 1000, 821, 725, 582, 792, 857,
+	23, 582, 1189, 858, 170, 171, 174, 175, 269, 269,
+	906, 1114, 951, 882, 624, 1135, 284, 285, 269, 287,
+	1100, 1133, 1134, 911, 1180, 1132, 294, 295, 296, 1099,
+	638, 1098, 827, 904, 301, 125, 242, 722, 74, 762,
+	850, 631, 190, 190, 283, 237, 908, 860, 612, 236,
+	238, 239, 934, 777, 855, 779, 928, 910, 937, 139,
+	111, 913, 770, 940, 943, 242, 915, 769, 223, 1131,
+	1027, 950, 902, 325, 625, 326, 610, 331, 354, 1024,
+	341, 996, 353, 977, 464, 321, 356, 355, 408, 399,
+	280, 778, 74, 551, 949, 420, 948, 246, 245, 415,
+	650, 399, 837, 3, 746, 976, 745, 942, 279, 280,
+	281, 744, 648, 190, 647, 967, 974, 32, 938, 508,
+	969, 380, 968, 762, 988, 32, 222, 1053, 930, 931,
+	269, 916, 979, 1052, 1016, 112, 980, 983, 975, 401,
+	987, 269, 540, 401, 541, 542, 669, 341, 634, 635,
+	381, 962, 963, 964, 965, 668, 966, 820, 99, 553,
+	242, 264, 429, 431, 432, 435, 1008, 151, 1003, 1009,
+	24, 441, 154, 687, 933, 686, 152, 941, 694, 1009,
+	861, 32, 23, 1034, 459, 153, 462, 209, 1043, 1020,
+	1029, 946, 825, 826, 927, 1051, 806, 399, 399, 419,
+	540, 794, 541, 542, 535, 532, 914, 190, 536, 537,
+	538, 788, 416, 417, 338, 785, 414, 1050, 1068, 1057,
+	690, 418, 676, 677, 678, 679, 1063, 3, 472, 1019,
+	1198, 1081, 139, 1069, 3, 438, 276, 32, 265, 1043,
+	1143, 405, 1107, 513, 513, 1108, 341, 1142, 518, 520,
+	525, 269, 269, 385, 1087, 1064, 863, 338, 278, 543,
+	402, 545, 306, 401, 95, 1113, 1042, 401, 625, 440,
+	242, 514, 514, 1111, 1043, 1089, 1090, 561, 1043, 1043,
+	563, 566, 525, 525, 570, 571, 67, 1125, 1126, 574,
+	439, 1082, 583, 1062, 399, 399, 399, 94, 1106, 205,
+	100, 101, 102, 103, 104, 105, 106, 107, 108, 109,
+	110, 208, 1152, 1043, 165, 95, 1043, 1042, 1147, 32,
+	69, 164, 166, 1102, 1101, 68, 156, 1044, 592, 593,
+	1150, 1032, 574, 1160, 900, 797, 341, 598, 373, 1168,
+	939, 924, 1163, 944, 945, 1043, 1036, 9, 8, 523,
+	7, 6, 1042, 375, 1179, 1161, 1042, 1042, 63, 335,
+	336, 32, 1183, 1188, 1182, 1043, 625, 1186, 32, 1043,
+	655, 396, 395, 394, 1012, 1158, 1093, 242, 1044, 1054,
+	89, 525, 62, 1196, 639, 1181, 640, 399, 1201, 1043,
+	61, 1042, 1203, 65, 1042, 58, 64, 1083, 1043, 59,
+	824, 633, 512, 511, 401, 989, 26, 57, 207, 659,
+	507, 660, 379, 1044, 663, 846, 401, 1044, 1044, 1202,
+	757, 667, 761, 1042, 552, 146, 672, 18, 17, 70,
+	566, 683, 1118, 525, 169, 524, 1123, 1124, 15, 581,
+	578, 14, 13, 1042, 560, 5, 10, 1042, 16, 32,
+	12, 182, 1044, 32, 32, 1044, 3, 11, 638, 1031,
+	1039, 866, 1035, 1037, 864, 450, 448, 1042, 1048, 4,
+	202, 1149, 2, 0, 1153, 0, 1042, 540, 182, 541,
+	542, 535, 532, 756, 1044, 536, 537, 538, 0, 0,
+	180, 0, 0, 0, 0, 0, 0, 341, 0, 0,
+	0, 0, 1076, 1174, 1044, 0, 0, 525, 1044, 0,
+	0, 0, 401, 401, 0, 0, 865, 198, 0, 341,
+	0, 0, 0, 1187, 0, 0, 0, 0, 1044, 0,
+	0, 0, 0, 0, 0, 0, 561, 1044, 0, 574,
+	0, 182, 1112, 525, 525, 0, 0, 1200, 0, 0,
+	0, 781, 782, 0, 574, 0, 0, 182, 0, 0,
+	0, 0, 32, 0, 0, 32, 0, 0, 32, 32,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	198, 0, 0, 0, 0, 0, 0, 0, 182, 0,
+	32, 0, 0, 0, 0, 0, 198, 0, 0, 0,
+	865, 0, 525, 865, 865, 0, 0, 0, 0, 401,
+	401, 401, 0, 0, 839, 1167, 0, 840, 0, 0,
+	843, 0, 0, 847, 0, 0, 540, 307, 541, 542,
+	535, 532, 832, 833, 536, 537, 538, 0, 3, 0,
+	566, 0, 0, 0, 0, 0, 0, 0, 182, 0,
+	32, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 32, 0, 0, 0, 865, 0, 0, 0, 0,
+	0, 0, 892, 0, 0, 0, 0, 0, 901, 892,
+	0, 901, 0, 0, 0, 0, 0, 198, 0, 0,
+	0, 0, 0, 0, 122, 132, 131, 121, 120, 123,
+	124, 119, 401, 525, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 242, 0, 1204, 0, 0, 865,
+	0, 0, 865, 1038, 0, 0, 0, 0, 865, 0,
+	0, 0, 0, 0, 32, 0, 0, 32, 32, 0,
+	0, 0, 32, 0, 0, 0, 32, 0, 242, 0,
+	0, 0, 0, 0, 0, 0, 960, 0, 892, 892,
+	892, 892, 865, 901, 0, 970, 0, 0, 0, 0,
+	0, 0, 32, 0, 1038, 0, 0, 574, 0, 0,
+	0, 0, 978, 0, 0, 0, 0, 117, 116, 182,
+	0, 847, 0, 128, 118, 127, 126, 0, 0, 32,
+	129, 130, 865, 0, 0, 182, 865, 0, 0, 1038,
+	0, 0, 0, 1038, 1038, 0, 0, 0, 0, 0,
+	182, 0, 0, 0, 0, 0, 0, 0, 517, 0,
+	182, 0, 182, 0, 0, 0, 892, 901, 0, 0,
+	0, 0, 0, 0, 198, 0, 0, 0, 1038, 960,
+	0, 1038, 0, 32, 0, 0, 32, 32, 0, 562,
+	1046, 1047, 32, 0, 0, 32, 0, 0, 0, 572,
+	0, 575, 0, 0, 0, 865, 0, 0, 0, 0,
+	1038, 0, 0, 0, 0, 0, 0, 0, 341, 0,
+	0, 0, 0, 182, 0, 0, 32, 0, 0, 0,
+	1038, 0, 0, 0, 1038, 0, 0, 0, 32, 0,
+	0, 0, 0, 865, 0, 0, 0, 341, 341, 0,
+	0, 0, 0, 0, 1038, 0, 0, 0, 0, 0,
+	0, 341, 198, 1038, 0, 0, 32, 0, 0, 0,
+	32, 0, 0, 32, 0, 0, 0, 32, 32, 0,
+	0, 32, 0, 0, 0, 122, 132, 131, 121, 120,
+	123, 124, 119, 0, 0, 0, 1136, 1137, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1195, 0, 0,
+	0, 0, 32, 0, 0, 32, 0, 0, 99, 77,
+	78, 79, 0, 111, 81, 94, 0, 95, 96, 20,
+	97, 182, 0, 901, 34, 35, 0, 525, 99, 32,
+	0, 0, 0, 76, 32, 0, 27, 41, 29, 28,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 84, 0, 0,
-	0, 85, 0, 0, 0, 96, 0, 68, 0, 0,
-	0, 0, 0, 0, 741, 740, 0, 744, 0, 0,
-	0, 0, 0, 28, 90, 0, 35, 33, 34, 30,
-	0, 0, 0, 0, 0, 0, 0, 36, 37, 0,
-	0, 0, 41, 42, 43, 44, 45, 47, 48, 49,
-	39, 46, 50, 0, 0, 0, 745, 0, 0, 27,
-	40, 92, 93, 94, 98, 0, 81, 79, 80, 97,
+	525, 0, 0, 0, 32, 0, 0, 0, 32, 0,
+	707, 86, 0, 0, 0, 682, 0, 32, 117, 116,
+	0, 99, 0, 0, 128, 118, 127, 126, 32, 91,
+	0, 129, 130, 92, 0, 0, 0, 32, 112, 0,
+	74, 0, 0, 0, 0, 397, 270, 1041, 1040, 0,
+	872, 0, 0, 684, 0, 0, 31, 98, 0, 38,
+	36, 37, 33, 0, 0, 0, 0, 0, 0, 0,
+	0, 39, 40, 457, 458, 0, 1045, 0, 55, 45,
+	46, 47, 48, 49, 51, 52, 53, 42, 50, 54,
+	0, 0, 0, 873, 0, 0, 30, 43, 44, 0,
+	100, 101, 102, 103, 104, 105, 106, 107, 108, 109,
+	110, 114, 0, 182, 0, 0, 88, 85, 87, 113,
+	100, 101, 102, 103, 104, 105, 106, 107, 108, 109,
+	110, 83, 84, 93, 71, 0, 0, 0, 0, 0,
+	182, 0, 0, 122, 182, 0, 121, 120, 123, 124,
+	119, 0, 828, 0, 122, 132, 131, 121, 120, 123,
+	124, 119, 182, 100, 101, 102, 103, 104, 105, 106,
+	107, 108, 109, 110, 0, 400, 271, 272, 273, 198,
+	0, 0, 0, 854, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 99, 77, 78, 79, 398, 111, 81,
+	94, 862, 95, 96, 20, 97, 0, 0, 0, 34,
+	35, 0, 0, 99, 0, 0, 0, 0, 76, 0,
+	0, 27, 41, 29, 28, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 117, 116, 76, 0,
+	0, 0, 128, 118, 127, 126, 86, 117, 116, 129,
+	130, 0, 0, 128, 118, 127, 126, 0, 0, 315,
+	129, 130, 311, 0, 91, 0, 182, 99, 92, 0,
+	0, 0, 0, 112, 0, 74, 0, 0, 0, 0,
+	0, 0, 452, 451, 0, 72, 0, 0, 0, 0,
+	0, 31, 98, 0, 38, 36, 37, 33, 0, 0,
+	0, 0, 0, 0, 0, 956, 39, 40, 457, 458,
+	73, 456, 0, 55, 45, 46, 47, 48, 49, 51,
+	52, 53, 42, 50, 54, 0, 0, 0, 0, 0,
+	0, 30, 43, 44, 0, 100, 101, 102, 103, 104,
+	105, 106, 107, 108, 109, 110, 114, 0, 0, 0,
+	0, 88, 85, 87, 113, 100, 101, 102, 103, 104,
+	105, 106, 107, 108, 109, 110, 83, 84, 93, 71,
+	99, 77, 78, 79, 0, 111, 81, 94, 0, 95,
+	96, 20, 97, 0, 0, 0, 34, 35, 0, 568,
+	0, 0, 0, 0, 0, 76, 0, 0, 27, 41,
+	29, 28, 0, 0, 0, 0, 0, 0, 0, 100,
+	101, 102, 103, 104, 105, 106, 107, 108, 109, 110,
+	0, 0, 0, 86, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 122, 132, 131, 121, 120, 123, 124,
+	119, 91, 0, 891, 99, 92, 0, 0, 0, 0,
+	112, 0, 74, 0, 0, 0, 0, 0, 0, 868,
+	867, 0, 872, 0, 0, 0, 0, 0, 31, 98,
+	0, 38, 36, 37, 33, 0, 0, 0, 0, 0,
+	0, 0, 0, 39, 40, 0, 0, 0, 871, 0,
+	55, 45, 46, 47, 48, 49, 51, 52, 53, 42,
+	50, 54, 0, 0, 0, 873, 0, 0, 30, 43,
+	44, 0, 100, 101, 102, 103, 104, 105, 106, 107,
+	108, 109, 110, 114, 0, 0, 117, 116, 88, 85,
+	87, 113, 128, 118, 127, 126, 0, 0, 0, 129,
+	130, 819, 0, 83, 84, 93, 71, 99, 77, 78,
+	79, 0, 111, 81, 94, 0, 95, 96, 20, 97,
+	0, 0, 0, 34, 35, 0, 0, 0, 0, 0,
+	0, 0, 76, 0, 0, 27, 41, 29, 28, 0,
+	0, 0, 0, 0, 0, 0, 100, 101, 102, 103,
+	104, 105, 106, 107, 108, 109, 110, 0, 0, 0,
+	86, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	122, 132, 131, 121, 120, 123, 124, 119, 91, 0,
+	565, 0, 92, 0, 0, 0, 0, 112, 0, 74,
+	0, 0, 0, 0, 0, 0, 22, 21, 0, 72,
+	0, 0, 309, 0, 0, 31, 98, 0, 38, 36,
+	37, 33, 122, 132, 131, 121, 120, 123, 124, 119,
+	39, 40, 0, 0, 73, 25, 0, 55, 45, 46,
+	47, 48, 49, 51, 52, 53, 42, 50, 54, 0,
+	0, 0, 0, 0, 0, 30, 43, 44, 0, 100,
+	101, 102, 103, 104, 105, 106, 107, 108, 109, 110,
+	114, 0, 0, 117, 116, 88, 85, 87, 113, 128,
+	118, 127, 126, 0, 0, 0, 129, 130, 755, 0,
+	83, 84, 93, 71, 99, 77, 78, 79, 0, 111,
+	81, 94, 0, 95, 96, 0, 97, 0, 0, 0,
+	0, 0, 0, 0, 0, 117, 116, 0, 0, 76,
+	0, 128, 118, 127, 126, 0, 0, 0, 129, 130,
+	308, 0, 99, 77, 78, 79, 0, 111, 81, 94,
+	0, 95, 96, 0, 97, 0, 0, 86, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 76, 0, 0,
+	0, 0, 0, 0, 0, 91, 0, 0, 0, 92,
+	0, 0, 0, 0, 112, 0, 0, 0, 0, 0,
+	99, 0, 0, 137, 136, 86, 0, 0, 0, 0,
+	0, 0, 0, 98, 274, 0, 0, 0, 0, 0,
+	0, 0, 1065, 91, 0, 270, 0, 92, 0, 0,
+	0, 0, 112, 0, 0, 0, 0, 0, 0, 0,
+	0, 137, 136, 0, 0, 0, 0, 0, 0, 0,
+	0, 98, 0, 0, 0, 0, 100, 101, 102, 103,
+	104, 105, 106, 107, 108, 109, 110, 114, 0, 0,
+	0, 0, 343, 85, 342, 344, 345, 346, 347, 0,
+	0, 0, 0, 0, 0, 340, 0, 83, 84, 93,
+	71, 333, 0, 0, 100, 101, 102, 103, 104, 105,
+	106, 107, 108, 109, 110, 114, 0, 0, 0, 0,
+	343, 85, 342, 344, 345, 346, 347, 0, 0, 0,
+	0, 0, 0, 340, 0, 83, 84, 93, 71, 99,
+	77, 78, 79, 0, 111, 81, 94, 0, 95, 96,
+	0, 97, 100, 101, 102, 103, 104, 105, 106, 107,
+	108, 109, 110, 0, 76, 271, 272, 273, 0, 0,
+	0, 0, 0, 0, 99, 77, 78, 79, 0, 111,
+	81, 94, 0, 95, 96, 0, 97, 0, 0, 0,
+	0, 0, 86, 0, 0, 0, 0, 0, 0, 76,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 77, 78, 86, 65, 91, 71, 72, 73, 0,
-	95, 75, 87, 0, 88, 89, 19, 0, 0, 0,
-	31, 32, 0, 0, 0, 0, 0, 0, 0, 70,
-	0, 25, 38, 0, 26, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 84, 0,
-	0, 0, 85, 0, 0, 0, 96, 0, 68, 0,
-	0, 0, 0, 0, 0, 21, 20, 0, 66, 0,
-	0, 0, 0, 0, 28, 90, 0, 35, 33, 34,
-	30, 0, 0, 0, 0, 0, 0, 0, 36, 37,
-	0, 0, 67, 41, 42, 43, 44, 45, 47, 48,
-	49, 39, 46, 50, 0, 0, 0, 0, 0, 0,
-	27, 40, 92, 93, 94, 98, 0, 81, 79, 80,
-	97, 91, 71, 72, 73, 0, 95, 75, 87, 0,
-	88, 89, 77, 78, 86, 65, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 70, 91, 71, 72, 73,
-	0, 95, 75, 87, 0, 88, 89, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	70, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 84, 0, 0, 0, 85, 0,
-	0, 0, 96, 0, 0, 0, 0, 0, 0, 0,
-	0, 120, 119, 0, 0, 0, 0, 0, 0, 84,
-	0, 90, 0, 85, 0, 0, 0, 96, 0, 0,
-	0, 0, 0, 0, 0, 0, 120, 119, 0, 0,
-	0, 0, 0, 0, 0, 0, 90, 91, 71, 72,
-	73, 0, 95, 75, 87, 0, 88, 89, 92, 93,
-	94, 98, 0, 308, 79, 307, 309, 310, 311, 312,
-	0, 70, 0, 0, 0, 0, 305, 0, 77, 78,
-	86, 65, 0, 92, 93, 94, 98, 0, 308, 79,
-	307, 309, 310, 311, 312, 0, 0, 0, 0, 0,
-	0, 0, 0, 77, 78, 86, 65, 0, 0, 0,
-	84, 0, 0, 0, 85, 0, 0, 0, 96, 0,
-	0, 0, 0, 0, 0, 0, 0, 120, 119, 0,
-	0, 0, 0, 0, 0, 0, 181, 90, 91, 71,
-	72, 73, 0, 95, 75, 87, 0, 88, 89, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 70, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 180, 0, 92, 93, 94, 98, 0, 81,
-	79, 80, 97, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 77, 78, 86, 65, 0, 0,
-	0, 84, 0, 0, 0, 85, 0, 0, 0, 96,
-	0, 0, 0, 0, 0, 0, 0, 0, 120, 119,
-	0, 0, 0, 0, 0, 0, 0, 0, 90, 91,
-	71, 72, 73, 0, 95, 75, 87, 0, 88, 89,
-	91, 71, 72, 73, 0, 95, 75, 87, 0, 88,
-	89, 0, 0, 70, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 70, 92, 93, 94, 98, 0,
-	81, 79, 80, 97, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 305, 0, 77, 78, 86, 65, 0,
-	0, 0, 84, 0, 0, 0, 85, 0, 0, 0,
-	96, 251, 0, 84, 0, 0, 0, 85, 0, 120,
-	119, 96, 0, 68, 0, 0, 0, 0, 0, 90,
-	120, 119, 0, 0, 0, 0, 0, 0, 0, 0,
-	90, 91, 71, 72, 73, 0, 95, 75, 87, 0,
-	88, 89, 91, 71, 72, 73, 0, 95, 75, 87,
-	0, 88, 89, 0, 0, 70, 92, 93, 94, 98,
-	0, 81, 79, 80, 97, 0, 70, 92, 93, 94,
-	98, 0, 81, 79, 80, 97, 77, 78, 86, 65,
-	0, 0, 0, 0, 0, 0, 0, 77, 78, 86,
-	65, 0, 0, 0, 84, 0, 0, 0, 85, 0,
-	0, 0, 96, 0, 0, 84, 0, 0, 0, 85,
-	0, 120, 119, 96, 0, 0, 0, 0, 0, 0,
-	0, 90, 120, 119, 0, 0, 0, 0, 0, 0,
-	0, 0, 90, 91, 71, 278, 73, 0, 95, 75,
-	87, 0, 88, 89, 0, 0, 106, 115, 114, 105,
-	104, 107, 103, 0, 0, 0, 0, 70, 92, 93,
-	94, 98, 0, 81, 79, 80, 97, 984, 0, 92,
-	93, 94, 98, 0, 81, 79, 80, 97, 77, 78,
-	86, 65, 0, 0, 0, 0, 0, 0, 0, 77,
-	78, 86, 117, 0, 0, 0, 84, 0, 0, 0,
-	85, 0, 0, 0, 96, 0, 0, 0, 0, 0,
-	0, 0, 0, 120, 119, 0, 0, 0, 0, 0,
-	0, 101, 100, 90, 0, 0, 0, 111, 102, 110,
-	109, 0, 0, 0, 112, 113, 106, 115, 114, 105,
-	104, 107, 103, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 973, 0, 0,
-	92, 93, 94, 98, 0, 81, 79, 80, 97, 106,
-	115, 114, 105, 104, 107, 103, 0, 0, 0, 0,
-	77, 78, 86, 65, 0, 0, 0, 0, 0, 0,
-	959, 0, 106, 115, 114, 105, 104, 107, 103, 0,
-	0, 0, 106, 115, 114, 105, 104, 107, 103, 0,
-	0, 101, 100, 947, 0, 0, 0, 111, 102, 110,
-	109, 0, 0, 924, 112, 113, 106, 115, 114, 105,
-	104, 107, 103, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 101, 100, 0, 915, 0, 0,
-	111, 102, 110, 109, 0, 0, 0, 112, 113, 0,
-	0, 0, 0, 0, 0, 0, 0, 101, 100, 0,
-	0, 0, 0, 111, 102, 110, 109, 101, 100, 0,
-	112, 113, 0, 111, 102, 110, 109, 0, 0, 0,
-	112, 113, 106, 115, 114, 105, 104, 107, 103, 0,
-	0, 101, 100, 0, 0, 0, 0, 111, 102, 110,
-	109, 0, 0, 903, 112, 113, 106, 115, 114, 105,
-	104, 107, 103, 0, 0, 0, 106, 115, 114, 105,
-	104, 107, 103, 0, 0, 0, 0, 894, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 839, 106, 115,
-	114, 105, 104, 107, 103, 0, 0, 0, 106, 115,
-	114, 105, 104, 107, 103, 0, 0, 101, 100, 0,
-	0, 831, 0, 111, 102, 110, 109, 0, 0, 828,
-	112, 113, 106, 115, 114, 105, 104, 107, 103, 0,
-	0, 101, 100, 0, 0, 0, 0, 111, 102, 110,
-	109, 101, 100, 0, 112, 113, 0, 111, 102, 110,
-	109, 0, 0, 0, 112, 113, 106, 115, 114, 105,
-	104, 107, 103, 101, 100, 0, 0, 0, 0, 111,
-	102, 110, 109, 101, 100, 0, 112, 113, 0, 111,
-	102, 110, 109, 0, 0, 0, 112, 113, 106, 115,
-	114, 105, 104, 107, 103, 0, 0, 101, 100, 0,
-	0, 0, 0, 111, 102, 110, 109, 0, 335, 822,
-	112, 113, 106, 115, 114, 105, 104, 107, 103, 0,
-	0, 0, 106, 115, 114, 105, 104, 107, 103, 0,
-	0, 101, 100, 775, 0, 0, 0, 111, 102, 110,
-	109, 0, 0, 783, 112, 113, 106, 115, 114, 105,
-	104, 107, 103, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 101, 100, 0, 0, 755, 0, 111,
-	102, 110, 109, 0, 0, 0, 112, 113, 106, 115,
-	114, 105, 104, 107, 103, 0, 0, 101, 100, 0,
-	0, 0, 0, 111, 102, 110, 109, 101, 100, 641,
-	112, 113, 0, 111, 102, 110, 109, 0, 0, 638,
-	112, 113, 106, 115, 114, 105, 104, 107, 103, 0,
-	0, 101, 100, 0, 0, 0, 0, 111, 102, 110,
-	109, 0, 0, 614, 112, 113, 106, 115, 114, 105,
-	104, 107, 103, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 271, 101, 100, 0, 0, 555, 0, 111,
-	102, 110, 109, 0, 0, 0, 112, 113, 106, 115,
-	114, 105, 104, 107, 103, 0, 0, 0, 0, 106,
-	115, 114, 105, 104, 107, 103, 0, 101, 100, 454,
-	0, 0, 0, 111, 102, 110, 109, 0, 0, 0,
-	112, 113, 283, 106, 115, 114, 105, 104, 107, 103,
-	0, 101, 100, 0, 0, 0, 0, 111, 102, 110,
-	109, 275, 0, 0, 112, 113, 0, 0, 0, 106,
-	115, 114, 105, 104, 107, 103, 0, 0, 0, 0,
-	0, 0, 270, 101, 100, 0, 0, 0, 0, 111,
-	102, 110, 109, 0, 101, 100, 112, 113, 0, 0,
-	111, 102, 110, 109, 0, 0, 0, 112, 113, 106,
-	115, 114, 105, 104, 107, 103, 0, 0, 101, 100,
-	0, 0, 0, 0, 111, 102, 110, 109, 0, 0,
-	0, 112, 113, 106, 115, 114, 105, 104, 107, 103,
-	0, 0, 0, 106, 101, 100, 105, 104, 107, 103,
-	111, 102, 110, 109, 228, 0, 0, 112, 113, 106,
-	115, 114, 105, 104, 107, 103, 0, 0, 0, 106,
-	444, 114, 105, 104, 107, 103, 0, 0, 0, 0,
-	0, 0, 0, 0, 101, 100, 0, 0, 0, 0,
-	111, 102, 110, 109, 0, 0, 0, 112, 113, 106,
-	327, 114, 105, 104, 107, 103, 0, 0, 101, 100,
-	0, 0, 0, 0, 111, 102, 110, 109, 101, 100,
-	0, 112, 113, 0, 111, 102, 110, 109, 0, 0,
-	0, 112, 113, 0, 101, 100, 0, 0, 0, 0,
-	111, 102, 110, 109, 101, 100, 0, 112, 113, 0,
-	111, 102, 110, 109, 0, 0, 0, 112, 113, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 101, 100, 0, 0, 0, 0,
-	111, 102, 110, 109, 0, 0, 0, 112, 113,
+	91, 0, 0, 0, 92, 0, 0, 0, 0, 112,
+	0, 0, 0, 0, 0, 0, 0, 86, 137, 136,
+	0, 0, 0, 0, 0, 0, 0, 99, 98, 0,
+	0, 0, 0, 0, 0, 91, 0, 0, 0, 92,
+	0, 0, 0, 0, 112, 0, 0, 0, 0, 0,
+	0, 0, 76, 137, 136, 0, 0, 0, 0, 0,
+	0, 122, 132, 98, 121, 120, 123, 124, 119, 0,
+	0, 100, 101, 102, 103, 104, 105, 106, 107, 108,
+	109, 110, 114, 0, 0, 0, 0, 343, 85, 342,
+	344, 345, 346, 347, 0, 0, 0, 0, 0, 0,
+	340, 0, 83, 84, 93, 71, 100, 101, 102, 103,
+	104, 105, 106, 107, 108, 109, 110, 114, 0, 0,
+	0, 0, 343, 85, 342, 344, 345, 346, 347, 0,
+	0, 0, 0, 0, 0, 0, 0, 83, 84, 93,
+	71, 99, 77, 78, 79, 0, 111, 81, 94, 0,
+	95, 96, 0, 97, 117, 116, 0, 0, 0, 0,
+	128, 118, 127, 126, 0, 0, 76, 129, 130, 100,
+	101, 102, 103, 104, 105, 106, 107, 108, 109, 110,
+	0, 99, 77, 78, 79, 0, 111, 81, 94, 0,
+	95, 96, 0, 97, 86, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 76, 0, 0, 0,
+	0, 0, 91, 0, 0, 0, 92, 0, 0, 0,
+	0, 112, 0, 74, 0, 0, 0, 0, 0, 99,
+	137, 136, 764, 765, 766, 0, 0, 0, 0, 0,
+	98, 0, 0, 0, 122, 132, 131, 121, 120, 123,
+	124, 119, 91, 0, 270, 0, 92, 0, 0, 0,
+	0, 112, 0, 0, 0, 0, 0, 0, 0, 0,
+	137, 136, 0, 0, 0, 0, 0, 0, 0, 0,
+	98, 0, 0, 100, 101, 102, 103, 104, 105, 106,
+	107, 108, 109, 110, 114, 0, 0, 0, 0, 88,
+	85, 87, 113, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 83, 84, 93, 71, 849, 0,
+	0, 0, 0, 100, 101, 102, 103, 104, 105, 106,
+	107, 108, 109, 110, 114, 0, 0, 117, 116, 88,
+	85, 87, 113, 128, 118, 127, 126, 0, 0, 0,
+	129, 130, 752, 0, 83, 84, 93, 763, 99, 77,
+	78, 79, 0, 111, 81, 94, 0, 95, 96, 0,
+	97, 100, 101, 102, 103, 104, 105, 106, 107, 108,
+	109, 110, 0, 76, 271, 272, 273, 0, 0, 0,
+	0, 0, 0, 99, 77, 78, 79, 0, 111, 81,
+	94, 0, 95, 96, 0, 97, 0, 0, 0, 0,
+	0, 86, 0, 0, 0, 0, 0, 0, 76, 0,
+	0, 122, 132, 131, 121, 120, 123, 124, 119, 91,
+	0, 0, 0, 92, 0, 0, 0, 0, 112, 0,
+	0, 0, 99, 0, 0, 0, 86, 137, 136, 0,
+	0, 0, 0, 0, 0, 0, 204, 98, 0, 0,
+	0, 0, 0, 0, 91, 546, 0, 0, 92, 0,
+	0, 0, 0, 112, 0, 0, 0, 0, 0, 0,
+	0, 0, 137, 136, 0, 0, 0, 0, 0, 0,
+	0, 0, 98, 0, 0, 0, 203, 0, 0, 0,
+	100, 101, 102, 103, 104, 105, 106, 107, 108, 109,
+	110, 114, 0, 0, 117, 116, 88, 85, 87, 113,
+	128, 118, 127, 126, 0, 0, 0, 129, 130, 644,
+	0, 83, 84, 93, 71, 100, 101, 102, 103, 104,
+	105, 106, 107, 108, 109, 110, 114, 0, 0, 784,
+	0, 88, 85, 87, 113, 122, 132, 131, 121, 120,
+	123, 124, 119, 0, 0, 0, 83, 84, 93, 71,
+	99, 77, 78, 79, 0, 111, 81, 94, 0, 95,
+	96, 0, 97, 0, 100, 101, 102, 103, 104, 105,
+	106, 107, 108, 109, 110, 76, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 99, 77, 78, 79, 0,
+	111, 81, 94, 0, 95, 96, 0, 97, 0, 0,
+	0, 0, 0, 86, 0, 0, 0, 0, 0, 0,
+	76, 0, 0, 122, 132, 131, 121, 120, 123, 124,
+	119, 91, 0, 0, 0, 92, 0, 0, 117, 116,
+	112, 0, 0, 0, 128, 118, 127, 126, 86, 137,
+	136, 129, 130, 493, 0, 0, 0, 0, 0, 98,
+	0, 0, 0, 0, 0, 0, 91, 0, 0, 0,
+	92, 0, 0, 0, 0, 112, 283, 0, 0, 0,
+	0, 0, 0, 0, 137, 136, 0, 0, 0, 0,
+	0, 0, 0, 0, 98, 0, 0, 0, 0, 0,
+	0, 0, 100, 101, 102, 103, 104, 105, 106, 107,
+	108, 109, 110, 114, 0, 0, 117, 116, 88, 85,
+	87, 113, 128, 118, 127, 126, 0, 0, 973, 129,
+	130, 340, 0, 83, 84, 93, 71, 100, 101, 102,
+	103, 104, 105, 106, 107, 108, 109, 110, 114, 0,
+	0, 0, 0, 88, 85, 87, 113, 122, 132, 131,
+	121, 120, 123, 124, 119, 0, 0, 0, 83, 84,
+	93, 71, 99, 77, 78, 79, 0, 111, 81, 94,
+	0, 95, 96, 0, 97, 0, 0, 0, 0, 0,
+	0, 0, 99, 0, 0, 0, 0, 76, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 99, 77, 78,
+	79, 0, 111, 81, 94, 0, 95, 96, 0, 97,
+	0, 0, 0, 0, 0, 86, 0, 99, 0, 332,
+	0, 0, 76, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 91, 0, 0, 0, 92, 0, 0,
+	117, 116, 112, 0, 0, 99, 128, 118, 127, 126,
+	86, 137, 136, 129, 130, 311, 0, 0, 0, 0,
+	0, 98, 0, 0, 0, 0, 0, 0, 91, 0,
+	270, 0, 92, 0, 0, 0, 0, 112, 0, 0,
+	0, 0, 0, 0, 0, 0, 137, 136, 0, 0,
+	0, 0, 961, 0, 0, 0, 98, 0, 0, 0,
+	0, 0, 0, 0, 100, 101, 102, 103, 104, 105,
+	106, 107, 108, 109, 110, 114, 0, 0, 437, 0,
+	88, 85, 87, 113, 100, 101, 102, 103, 104, 105,
+	106, 107, 108, 109, 110, 83, 84, 93, 71, 100,
+	101, 102, 103, 104, 105, 106, 107, 108, 109, 110,
+	114, 0, 0, 434, 0, 88, 85, 87, 113, 100,
+	101, 102, 103, 104, 105, 106, 107, 108, 109, 110,
+	83, 84, 93, 71, 99, 77, 78, 79, 0, 111,
+	81, 94, 0, 95, 96, 0, 97, 100, 101, 102,
+	103, 104, 105, 106, 107, 108, 109, 110, 0, 76,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 99,
+	77, 78, 79, 0, 111, 81, 94, 0, 95, 96,
+	0, 97, 0, 0, 0, 0, 0, 86, 0, 99,
+	0, 0, 0, 0, 76, 0, 0, 122, 132, 131,
+	121, 120, 123, 124, 119, 91, 0, 0, 0, 92,
+	0, 0, 0, 0, 112, 0, 74, 0, 99, 1184,
+	0, 0, 86, 137, 136, 0, 0, 0, 0, 0,
+	0, 0, 0, 98, 0, 0, 0, 0, 0, 0,
+	91, 544, 0, 0, 92, 0, 0, 0, 0, 112,
+	0, 0, 0, 0, 0, 0, 0, 0, 137, 136,
+	0, 0, 0, 0, 673, 0, 0, 0, 98, 0,
+	0, 0, 0, 0, 0, 0, 100, 101, 102, 103,
+	104, 105, 106, 107, 108, 109, 110, 114, 0, 0,
+	117, 116, 88, 85, 87, 113, 128, 118, 127, 126,
+	0, 0, 0, 129, 130, 0, 0, 83, 84, 93,
+	71, 100, 101, 102, 103, 104, 105, 106, 107, 108,
+	109, 110, 114, 0, 0, 0, 0, 88, 85, 87,
+	113, 100, 101, 102, 103, 104, 105, 106, 107, 108,
+	109, 110, 83, 84, 93, 71, 99, 77, 78, 79,
+	0, 111, 81, 94, 0, 95, 96, 0, 97, 0,
+	100, 101, 102, 103, 104, 105, 106, 107, 108, 109,
+	110, 76, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 99, 77, 313, 79, 0, 111, 81, 94, 0,
+	95, 96, 0, 97, 0, 0, 0, 0, 0, 86,
+	0, 0, 0, 0, 0, 0, 76, 0, 0, 122,
+	132, 131, 121, 120, 123, 124, 119, 91, 0, 0,
+	0, 92, 0, 0, 0, 0, 112, 0, 0, 0,
+	0, 1175, 0, 0, 86, 137, 136, 0, 0, 0,
+	0, 0, 0, 0, 0, 98, 0, 0, 0, 0,
+	630, 0, 91, 0, 0, 0, 92, 0, 0, 0,
+	0, 112, 0, 0, 0, 0, 0, 0, 0, 0,
+	137, 136, 122, 132, 131, 121, 120, 123, 124, 119,
+	98, 0, 631, 0, 0, 0, 0, 0, 100, 101,
+	102, 103, 104, 105, 106, 107, 108, 109, 110, 114,
+	0, 0, 117, 116, 88, 85, 87, 113, 128, 118,
+	127, 126, 0, 0, 0, 129, 130, 0, 0, 83,
+	84, 93, 134, 100, 101, 102, 103, 104, 105, 106,
+	107, 108, 109, 110, 114, 0, 0, 0, 0, 88,
+	85, 87, 113, 0, 0, 122, 132, 131, 121, 120,
+	123, 124, 119, 0, 83, 84, 93, 71, 0, 0,
+	0, 0, 0, 0, 0, 117, 116, 1146, 0, 0,
+	0, 128, 118, 127, 126, 0, 0, 0, 129, 130,
+	122, 132, 131, 121, 120, 123, 124, 119, 0, 0,
+	122, 132, 131, 121, 120, 123, 124, 119, 0, 0,
+	0, 0, 1119, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1088, 122, 132, 131, 121, 120, 123, 124,
+	119, 0, 0, 0, 122, 132, 131, 121, 120, 123,
+	124, 119, 0, 0, 0, 1077, 0, 0, 117, 116,
+	0, 0, 0, 0, 128, 118, 127, 126, 994, 0,
+	0, 129, 130, 122, 132, 131, 121, 120, 123, 124,
+	119, 0, 0, 122, 132, 131, 121, 120, 123, 124,
+	119, 0, 0, 117, 116, 1002, 0, 0, 0, 128,
+	118, 127, 126, 117, 116, 990, 129, 130, 0, 128,
+	118, 127, 126, 0, 0, 0, 129, 130, 122, 132,
+	131, 121, 120, 123, 124, 119, 117, 116, 0, 0,
+	0, 99, 128, 118, 127, 126, 0, 117, 116, 129,
+	130, 0, 0, 128, 118, 127, 126, 0, 0, 0,
+	129, 130, 0, 0, 521, 0, 0, 122, 132, 131,
+	121, 120, 123, 124, 119, 0, 117, 116, 0, 0,
+	0, 0, 128, 118, 127, 126, 117, 116, 925, 129,
+	130, 0, 128, 118, 127, 126, 0, 0, 0, 129,
+	130, 122, 132, 131, 121, 120, 123, 124, 119, 0,
+	0, 122, 132, 131, 121, 120, 123, 124, 119, 0,
+	0, 117, 116, 909, 0, 0, 0, 128, 118, 127,
+	126, 0, 0, 919, 129, 130, 122, 132, 131, 121,
+	120, 123, 124, 119, 0, 0, 122, 132, 131, 121,
+	120, 123, 124, 119, 0, 0, 0, 0, 883, 0,
+	117, 116, 0, 0, 0, 0, 128, 118, 127, 126,
+	0, 0, 0, 129, 130, 122, 132, 131, 121, 120,
+	123, 124, 119, 100, 101, 102, 103, 104, 105, 106,
+	107, 108, 109, 110, 117, 116, 372, 0, 0, 0,
+	128, 118, 127, 126, 117, 116, 0, 129, 130, 0,
+	128, 118, 127, 126, 0, 0, 830, 129, 130, 122,
+	132, 131, 121, 120, 123, 124, 119, 0, 0, 117,
+	116, 0, 0, 0, 0, 128, 118, 127, 126, 117,
+	116, 727, 129, 130, 0, 128, 118, 127, 126, 0,
+	0, 724, 129, 130, 0, 0, 122, 132, 131, 121,
+	120, 123, 124, 119, 0, 0, 0, 0, 117, 116,
+	0, 0, 0, 0, 128, 118, 127, 126, 699, 305,
+	0, 129, 130, 122, 132, 131, 121, 120, 123, 124,
+	119, 0, 0, 122, 132, 131, 121, 120, 123, 124,
+	119, 0, 0, 0, 0, 623, 0, 0, 0, 0,
+	0, 0, 117, 116, 0, 505, 0, 0, 128, 118,
+	127, 126, 0, 0, 0, 129, 130, 122, 132, 131,
+	121, 120, 123, 124, 119, 0, 0, 122, 132, 131,
+	121, 120, 123, 124, 119, 0, 0, 0, 0, 117,
+	116, 318, 0, 0, 310, 128, 118, 127, 126, 0,
+	0, 0, 129, 130, 122, 132, 131, 121, 120, 123,
+	124, 119, 304, 0, 0, 0, 117, 116, 0, 99,
+	0, 0, 128, 118, 127, 126, 117, 116, 0, 129,
+	130, 0, 128, 118, 127, 126, 0, 0, 0, 129,
+	130, 0, 519, 0, 0, 122, 132, 131, 121, 120,
+	123, 124, 119, 0, 0, 0, 0, 0, 0, 0,
+	117, 116, 0, 0, 0, 0, 128, 118, 127, 126,
+	117, 116, 0, 129, 130, 0, 128, 118, 127, 126,
+	0, 0, 0, 129, 130, 122, 132, 131, 121, 120,
+	123, 124, 119, 99, 0, 327, 0, 117, 116, 0,
+	0, 0, 0, 128, 118, 127, 126, 254, 0, 0,
+	129, 130, 122, 132, 131, 121, 120, 123, 124, 119,
+	99, 0, 122, 495, 131, 121, 120, 123, 124, 119,
+	0, 0, 99, 0, 0, 0, 0, 0, 117, 116,
+	178, 0, 0, 99, 128, 118, 127, 126, 0, 0,
+	94, 129, 130, 122, 363, 131, 121, 120, 123, 124,
+	119, 100, 101, 102, 103, 104, 105, 106, 107, 108,
+	109, 110, 0, 0, 0, 0, 0, 0, 117, 116,
+	0, 0, 0, 0, 128, 118, 127, 126, 0, 0,
+	0, 129, 130, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 117, 116, 0, 0, 0,
+	0, 128, 118, 127, 126, 117, 116, 0, 129, 130,
+	0, 128, 118, 127, 126, 0, 0, 0, 129, 130,
+	0, 0, 0, 0, 0, 100, 101, 102, 103, 104,
+	105, 106, 107, 108, 109, 110, 117, 116, 0, 0,
+	0, 0, 128, 118, 127, 126, 0, 0, 0, 129,
+	130, 0, 100, 101, 102, 103, 104, 105, 106, 107,
+	108, 109, 110, 0, 100, 101, 102, 103, 104, 105,
+	106, 107, 108, 109, 110, 100, 101, 102, 103, 104,
+	105, 106, 107, 108, 109, 110,
 }
 var yyPact = [...]int{
 
-	2171, -1000, 285, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 3491, -1000,
-	2718, 2707, -1000, -1000, 184, 925, 919, 1017, 540, -1000,
-	560, 1004, 1007, 555, 555, 592, -1000, -1000, 2707, 2707,
-	543, 2707, 2707, 2707, 2707, 2707, 2707, 2707, -1000, 555,
-	555, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 297, -1000, -1000, -1000, 2616, 2423, 1024, 932, -64,
-	-36, -1000, -1000, -1000, -1000, -1000, -1000, 2707, 2707, 264,
-	263, 262, -1000, 348, 258, 2707, 2707, -1000, -1000, -1000,
-	555, -1000, -1000, -1000, -1000, -1000, -1000, 254, 252, 2171,
-	2707, 2707, 2707, 742, 2707, 752, 108, 2707, 774, 2707,
-	2707, 2707, 2707, 2707, 2707, 2707, 3465, 2616, -1000, 248,
-	2707, 646, 3491, 887, 972, 482, 454, 993, 838, 736,
-	-1000, 733, 555, 482, -1000, 33, 289, -1000, 495, -1000,
-	555, 555, 555, 379, 277, -1000, -1000, -1000, 555, -1000,
-	-1000, -1000, -1000, 2707, 2707, 3441, 3375, -1000, 998, 3491,
-	3491, 1112, -64, 3491, 3401, -1000, 1610, -64, 3491, -1000,
-	2809, 2707, 1058, 157, 158, 225, 3351, 81, 760, 1017,
-	-1000, -1000, -1000, -1000, 30, 555, -1000, 524, 2605, 511,
-	-1000, -1000, 1516, 736, 736, 108, 108, 761, 771, -1000,
-	-1000, 3475, -1000, 378, 736, 2707, -1000, 71, 17, 17,
-	808, 3531, 2707, 108, 2707, -1000, 2616, -1000, 17, 108,
-	108, -4, -4, -1000, -1000, -1000, 1157, 3475, 2171, 157,
-	156, 2707, 640, 625, 615, 2707, 811, 867, 482, 992,
-	28, -1000, -1000, 305, 995, 984, 305, 776, 776, 776,
-	2307, -1000, 315, 1035, 1017, 2707, 430, 309, 246, 243,
-	-1000, -1000, -1000, 2707, 2707, 2707, 2707, 963, 3491, 3491,
-	1014, 1013, 555, 2707, 2707, 2707, 2707, 3491, 2707, 3491,
-	-1000, -1000, -1000, 1869, 555, 1017, 555, 38, 759, 932,
-	290, -1000, -1000, 153, 2707, -1000, -1000, -1000, -1000, 152,
-	27, 958, -1000, 3491, -1000, -1000, -34, 240, 239, 237,
-	233, 230, 228, 2707, 2514, -1000, -1000, 108, 191, 191,
-	191, 742, -1000, 2707, 1546, -1000, -1000, 2707, 3501, -1000,
-	17, -1000, -1000, 626, -1000, 2707, 533, 2171, 531, 2707,
-	3340, 824, 2707, 2332, 183, 505, 449, 482, 984, 86,
-	-1000, 466, -1000, -1000, 257, -1000, 226, 224, 305, 884,
-	2707, -1000, 225, -1000, 225, 225, -1000, 555, 733, -1000,
-	220, 227, 449, 555, -1000, 3491, 733, 555, 733, 164,
-	555, 3491, -64, 3491, -64, -64, 3491, -64, 3491, 1017,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 3491, 529, 283,
-	-1000, -1000, 2718, 2707, -1000, -1000, -1000, -1000, -1000, 561,
-	-1000, 20, 556, 555, 555, -1000, 223, 555, -1000, 150,
-	-1000, 2307, 555, 2605, 736, 736, 736, 2707, 2707, 2707,
-	149, 147, 145, 757, -1000, 124, -1000, 222, -1000, -1000,
-	473, 143, 2707, 3475, 2707, 528, 614, 2171, 2707, 3308,
-	704, -1000, -1000, 3491, 2171, -1000, 2707, 1411, -1000, 12,
-	857, 3491, -1000, 108, 449, -1000, -1000, 555, 993, 9,
-	271, -75, -1000, -1000, 820, 810, 789, 789, 856, 305,
-	-1000, -1000, -1000, -1000, 555, 126, 2707, 2707, 984, 870,
-	844, 3491, 772, -1000, -1000, 772, 142, 7, -1000, 902,
-	555, 904, -1000, 449, 900, 892, -1000, 141, -1000, 957,
-	135, -1, -1000, -1000, -8, 903, -26, -1000, 663, 1869,
-	3284, 639, 1869, 1869, 550, 541, 733, 133, -1000, -1000,
-	-1000, 131, 2707, 2707, 2514, 2707, 128, 127, 125, -1000,
-	-1000, -1000, 108, 118, -22, 2707, -1000, 729, 345, 3194,
-	3475, 687, 520, -1000, 3250, 2707, -1000, 3160, 638, 3491,
-	-1000, 734, 337, 2332, 333, -1000, -1000, -1000, 117, -24,
-	-1000, 984, 449, 2707, 305, 305, 806, -1000, 805, 801,
-	789, -1000, -1000, -1000, 1360, 5, 1350, -1000, -1000, 2707,
-	2707, 952, 555, -1000, -1000, -1000, 449, 449, 116, -25,
-	2707, 104, 555, 2707, 951, 371, 949, 1017, 1017, 2707,
-	948, 1017, -1000, -1000, 1869, 595, 2707, 517, 513, 1869,
-	1869, 99, 946, 416, 97, 95, 94, 88, 85, 414,
-	397, 375, -1000, -1000, 108, 1206, -1000, 881, -1000, -1000,
-	685, 2171, 3160, -1000, -1000, 2707, -1000, -1000, -1000, 922,
-	784, 449, -1000, -1000, 3491, 856, 1082, 305, 305, 305,
-	798, 2707, -1000, 2707, 555, 3491, -1000, 733, -1000, -1000,
-	-1000, 902, 555, 3491, -1000, -1000, -64, 3491, 733, 2020,
-	365, -1000, -1000, -1000, 903, 3491, 359, 83, 617, 508,
-	1869, 3218, 662, 660, 504, 502, -1000, 219, 218, 410,
-	406, 402, 401, 369, 217, 215, 332, 214, 322, -1000,
-	2707, 211, -1000, 671, 3184, -1000, -1000, -1000, 108, -1000,
-	-1000, -1000, 2707, 209, 1082, 991, 856, 305, -28, 3128,
-	82, -51, -1000, -1000, -1000, -1000, 501, 278, -1000, -1000,
-	2718, 2707, -1000, -1000, 2707, 2707, 2020, 2020, 938, 496,
-	589, 1869, 2707, 699, -1000, 1869, -1000, -1000, 653, 651,
-	733, 418, 201, 200, 196, 188, 185, 418, 418, 399,
-	418, 398, 3094, 887, -1000, 2171, -1000, 3491, 555, -1000,
-	2707, 856, -1000, -1000, -1000, -1000, 2707, -1000, 2020, 3070,
-	637, 3060, 32, 753, 3491, 492, 491, 353, 684, 487,
-	-1000, 3038, -1000, 636, -1000, -1000, 80, 78, -1000, 889,
-	835, 418, 418, 418, 418, 418, 75, 887, 74, 175,
-	70, 163, -1000, 64, 61, 3491, 59, -1000, 2020, 588,
-	2707, 1718, 555, 555, -1000, -1000, 2020, -1000, 680, 1869,
-	-1000, 2707, -1000, -1000, -1000, 831, 2707, 57, 55, 54,
-	44, 43, -1000, -1000, 418, -1000, 418, -1000, -1000, -1000,
-	611, 486, 2020, 3028, 484, 275, -1000, -1000, 2718, 2707,
-	-1000, -1000, -1000, 538, 535, 483, -1000, 669, 3004, 2332,
-	-1000, -1000, -1000, -1000, -1000, -1000, 39, 37, 481, 587,
-	2020, 2707, 695, -1000, 2020, 650, 1718, 2938, 634, 1718,
-	1718, -1000, -1000, 1869, 314, -1000, -1000, 679, 480, -1000,
-	2914, -1000, 632, -1000, -1000, 1718, 577, 2707, 471, 468,
-	-1000, 812, -1000, 678, 2020, -1000, 2707, 559, 446, 1718,
-	2904, 649, 544, -1000, 817, 726, 725, 711, -1000, 668,
-	2881, 435, 575, 1718, 2707, 694, -1000, 1718, -1000, -1000,
-	745, 723, -1000, 716, 710, -1000, -1000, -1000, -1000, 2020,
-	677, 434, -1000, 2848, -1000, 594, 770, -1000, -1000, -1000,
-	-1000, -1000, 673, 1718, -1000, 2707, -1000, 718, -1000, -1000,
-	666, 2758, -1000, -1000, 1718,
+	2383, -1000, 255, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 4776,
+	-1000, 4052, 3875, -1000, -1000, 2383, 196, 941, 959, 937,
+	1096, 4879, -1000, 598, 1112, 1061, 4856, 4856, 705, -1000,
+	-1000, 3875, 3875, 4868, 374, 3875, 3875, 3875, 3875, 3875,
+	3875, 3875, -1000, 4856, 4856, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 261, -1000, -1000, -1000,
+	-1000, 3840, 3204, 1103, 966, -73, -76, -1000, -1000, -1000,
+	-1000, -1000, -1000, 3875, 3875, 237, 236, 235, 234, -1000,
+	342, 232, 3875, 3875, -1000, -1000, -1000, -1000, 4856, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 231, 229, 2383, 3875, 3875, 3875, 799,
+	3875, 779, 34, 3875, 3875, 834, 3875, 3875, 3875, 3875,
+	3875, 3875, 3875, 4749, 3840, -1000, 228, 3875, 674, 4776,
+	437, 925, 1023, 3075, 2646, 1021, 1050, 848, 769, -1000,
+	762, 4856, 4856, 3711, 4856, -1000, 15, 260, -1000, 510,
+	-1000, 4856, 4856, 4856, 381, 375, -1000, -1000, -1000, 4856,
+	-1000, -1000, -1000, -1000, 3875, 3875, 4709, 4641, -1000, 1054,
+	-1000, 762, 247, 4776, 4776, 2416, -73, 4776, 4668, -1000,
+	3541, -73, 4776, -1000, 4087, 3875, 1918, 150, 154, 4631,
+	83, 819, 1096, -1000, -1000, -1000, -1000, 10, 4856, -1000,
+	4829, 3451, 3683, -1000, -1000, 2560, 3875, 769, 769, 34,
+	34, 812, 823, -1000, -1000, 1907, -1000, 353, 769, 3875,
+	-1000, 27, 7, 7, 861, 4817, 3875, 34, 3875, 3875,
+	-1000, 3840, -1000, 7, 7, 34, 34, -2, -2, -1000,
+	-1000, -1000, 2835, 1907, 2383, 150, 142, 3875, 671, 649,
+	645, 3875, 2383, 879, 911, 3075, 1043, 8, 4, -1000,
+	-1000, 221, 220, 219, 1857, 1052, 3075, 1028, 1857, 825,
+	825, 825, 2775, -1000, 309, 844, 989, 840, 1096, 3875,
+	491, 305, 214, 213, -1000, -1000, -1000, 3875, 3875, 3663,
+	3628, 1020, 4776, 4776, 1088, 1067, 4856, -1000, 3875, 3875,
+	3875, 3875, 4776, 3875, 4776, -1000, -1000, -1000, 2029, 4856,
+	1096, 4856, 19, 818, 966, 295, -1000, -1000, 140, 3875,
+	-1000, -1000, -1000, -1000, 139, -1, 1011, -1000, 4776, -1000,
+	-1000, -70, 212, 205, 198, 195, 187, 182, 138, 3875,
+	3416, -1000, -1000, 34, 149, 149, 149, 799, -1000, 3875,
+	3329, -1000, -1000, 3875, 4786, -1000, 7, 7, -1000, -1000,
+	622, -1000, 3875, 579, 2383, 578, 3875, 4597, 567, 876,
+	3875, 2810, 207, 4755, 4417, 2873, 3075, 3075, 3875, 3875,
+	3875, 1028, 88, -1000, 3924, -1000, 3288, -1000, 376, -1000,
+	181, 178, 1857, 837, 922, 3875, -1000, 247, -1000, 247,
+	247, -1000, 4856, 762, -1000, 4856, 2280, 2049, 2873, 4856,
+	4856, -1000, 4776, 762, 4856, 762, 132, 4856, 4776, -73,
+	4776, -73, -73, 4776, -1000, -73, 4776, -1000, 1096, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 4776, 566, 254, -1000,
+	-1000, 4052, 3875, -1000, -1000, -1000, 2029, -1000, -1000, 603,
+	-1000, -6, 597, 4856, 4856, -1000, 177, 4856, -1000, 135,
+	-1000, 2775, 4856, 3451, 769, 769, 769, 3875, 3875, 3875,
+	-1000, 134, 127, 126, 809, -1000, 125, -1000, 176, -1000,
+	-1000, 520, 123, 3875, 1907, 3875, 565, 642, 2383, 3875,
+	4587, 731, -1000, -1000, 4776, 2383, 446, -1000, 3875, 4106,
+	-1000, -10, 908, 4776, -1000, 34, 2873, -1000, -1000, 4856,
+	-1000, 4856, 1050, -15, 249, -94, -1000, -1000, 3195, 122,
+	121, -1000, 866, 864, 850, 850, 893, 175, 289, 1857,
+	-1000, -1000, -1000, -1000, 4856, -1000, 4856, 103, 3875, 3875,
+	1028, 1857, 917, 907, 4776, 829, -1000, -1000, 829, 116,
+	-16, 3895, -1000, 174, 995, 4856, 1814, -1000, 2873, 942,
+	940, -1000, -1000, 115, -21, -1000, 1003, 114, -30, -1000,
+	-1000, -43, 947, -68, -1000, 693, 2029, 4560, 669, 435,
+	2029, 2029, 594, 593, 762, 113, -1000, -1000, -1000, 110,
+	3875, 3875, 3416, 3875, 108, 107, 106, -1000, -1000, -1000,
+	34, 105, -48, 3875, -1000, 760, 315, 4450, 1907, 712,
+	563, -1000, 4523, 3875, -1000, 4479, 668, -1000, 4776, -1000,
+	765, 296, 2810, 311, -1000, -1000, -1000, 104, -50, -1000,
+	-1000, 1028, 2873, 3875, 3875, -1000, -1000, 1857, 1857, 863,
+	-1000, 858, 856, 850, 2775, 173, 308, 307, -1000, -1000,
+	-1000, -1000, 3018, -80, 2374, -1000, 1228, 450, 3875, 3027,
+	999, 4856, 798, 788, 4856, -1000, -1000, -1000, 2873, 2873,
+	100, -58, 3875, 822, 781, 99, 4856, 3239, 998, 4856,
+	333, 994, 1096, 1096, 3875, 984, 1096, -1000, -1000, 2029,
+	641, 3875, 2029, 562, 561, 2029, 2029, 98, 979, 478,
+	94, 93, 92, 90, 89, 477, 444, 355, -1000, -1000,
+	34, 2197, -1000, 920, -1000, -1000, 711, 2383, 4479, -1000,
+	-1000, 3875, -1000, -1000, -1000, 965, 816, 2873, -1000, -1000,
+	4776, 4415, 893, 1377, 1857, 1857, 1857, 854, 490, 4856,
+	-1000, -1000, 3875, -1000, 3875, 4856, 3875, -1000, 4856, 4776,
+	-1000, -61, 4776, 2987, 172, 168, 112, 762, -1000, 780,
+	-1000, 82, -1000, -1000, 995, 4856, 4776, 3875, 773, 949,
+	-1000, -1000, -73, 4776, -1000, 762, -1000, 2206, 331, -1000,
+	-1000, -1000, 947, 4776, 323, 80, 610, 559, 2029, 4440,
+	557, 690, 684, 556, 555, -1000, 167, 2103, 472, 470,
+	467, 463, 399, 964, 2103, 303, 964, 300, -1000, 3875,
+	166, -1000, 700, 4405, -1000, -1000, -1000, 34, -1000, -1000,
+	-1000, -1000, 3875, 164, 1377, 951, 893, 1857, 2873, 468,
+	-41, 4332, 79, -78, 4371, -1000, -62, 977, 3027, -1000,
+	78, 3875, 3875, 162, -1000, -1000, -1000, -1000, -1000, 4776,
+	943, 3875, -1000, 552, 252, -1000, -1000, 4052, 3875, -1000,
+	-1000, 2206, 3875, 3875, 2206, 2206, 974, 550, 636, 2029,
+	3875, 729, -1000, 2029, 443, -1000, -1000, 683, 681, 762,
+	-1000, 3648, -1000, 2103, 2103, 2103, 2103, 919, 964, -1000,
+	3648, -1000, -1000, 458, -1000, 456, 3407, 925, -1000, 2383,
+	-1000, 4776, 4856, -1000, 3875, 893, 817, 4856, -1000, -1000,
+	-1000, -1000, 3875, -1000, 666, 354, 4856, 161, -1000, -1000,
+	76, 75, 3027, 3875, 4776, -1000, 2206, 4297, 664, 393,
+	4258, 22, 815, 4776, 549, 548, 321, 710, 542, -1000,
+	4287, -1000, 662, -1000, -1000, -1000, 74, 72, -1000, 930,
+	487, 895, -1000, -1000, -1000, -1000, 160, -1000, 71, 925,
+	925, 2103, 964, -1000, 68, 67, 4776, 159, 813, 65,
+	-1000, 803, 278, -1000, 3648, -1000, -1000, 64, 4776, -1000,
+	2206, 623, 3875, 2206, 1794, 4856, 4856, -1000, -1000, 2206,
+	-1000, 709, 2029, -1000, 3875, -1000, -1000, -1000, 894, -1000,
+	888, -1000, 495, -1000, -1000, -1000, 3875, 925, -1000, -1000,
+	-1000, -1000, -1000, 2598, 158, -1000, 652, 3875, 803, 63,
+	-1000, 605, 539, 2206, 4247, 538, 536, 95, -1000, -1000,
+	4052, 3875, -1000, -1000, -1000, 1794, 592, 589, 531, -1000,
+	699, 4224, 2810, 2810, 291, 537, 752, 750, 738, -1000,
+	-1000, 1128, -1000, 62, 60, 57, 2775, 1033, 4776, 651,
+	-1000, 530, 621, 2206, 3875, 728, -1000, 2206, 441, 680,
+	1794, 4214, 659, 350, 1794, 1794, -1000, -1000, 2029, 356,
+	356, -1000, 401, 802, 746, -1000, 742, 733, -1000, -1000,
+	-1000, 4856, 4856, 455, 54, 48, 47, 1037, -1000, 1026,
+	708, 529, -1000, 4179, -1000, 657, -1000, -1000, -1000, 1794,
+	620, 3875, 1794, 528, 517, -1000, -1000, 707, -1000, -1000,
+	288, 457, -1000, -1000, -1000, -1000, -1000, -1000, 964, -1000,
+	-1000, 38, 2873, 208, -1000, 706, 2206, -1000, 3875, 586,
+	516, 1794, 4043, 506, 679, 676, -1000, -1000, 291, 744,
+	-1000, -1000, -1000, -1000, 34, 2873, -1000, 697, 3831, 499,
+	609, 1794, 3875, 719, -1000, 1794, 439, -1000, -1000, -1000,
+	-1000, -1000, 26, -1000, 2206, 703, 497, -1000, 1689, -1000,
+	655, -1000, 1014, -1000, 702, 1794, -1000, 3875, 34, -1000,
+	695, 1428, -1000, -1000, 1794,
 }
 var yyPgo = [...]int{
 
-	0, 58, 38, 26, 65, 97, 89, 1145, 28, 1144,
-	24, 1134, 1133, 1125, 1124, 55, 6, 1123, 1121, 1120,
-	1119, 1118, 1117, 1113, 75, 35, 41, 1112, 1111, 52,
-	1110, 1109, 60, 51, 1108, 1105, 1104, 1103, 1102, 714,
-	92, 85, 1101, 71, 61, 1098, 1097, 27, 1096, 62,
-	1095, 30, 1094, 80, 1092, 99, 88, 146, 0, 68,
-	49, 16, 13, 1090, 1089, 1088, 1087, 1051, 1086, 94,
-	1085, 1084, 1081, 32, 1080, 1079, 1078, 5, 18, 53,
-	14, 1077, 1073, 2, 1072, 1070, 77, 82, 91, 1068,
-	31, 1065, 36, 1062, 1061, 1060, 9, 37, 1058, 50,
-	17, 73, 23, 72, 1056, 1055, 1053, 57, 1043, 34,
-	74, 10, 33, 7, 8, 1, 3, 63, 1042, 12,
-	1038, 11, 1036, 4, 1035, 973, 109, 15, 19, 1034,
-	93, 968, 1032, 306, 76, 70, 54, 69, 81, 1031,
-	56, 792,
+	0, 63, 24, 278, 161, 538, 142, 1282, 82, 1280,
+	70, 1279, 1276, 1275, 1274, 81, 3, 1273, 1271, 1270,
+	1267, 1260, 1258, 1256, 1254, 61, 74, 37, 34, 1252,
+	1251, 54, 1250, 1249, 45, 40, 1248, 1244, 1239, 1238,
+	1237, 1255, 200, 76, 1235, 68, 57, 1234, 1232, 17,
+	1231, 1230, 1225, 18, 1222, 60, 1220, 1216, 1218, 77,
+	1217, 99, 92, 102, 0, 56, 30, 39, 32, 1213,
+	1212, 1211, 1210, 359, 1209, 66, 1206, 1205, 1203, 19,
+	1200, 1192, 1190, 28, 31, 15, 26, 67, 29, 7,
+	1189, 11, 1186, 1185, 16, 1184, 5, 78, 80, 97,
+	120, 1183, 38, 1182, 1181, 1180, 36, 1170, 1169, 1168,
+	59, 58, 1163, 8, 86, 87, 35, 20, 41, 1161,
+	1160, 1159, 9, 1158, 1157, 1151, 21, 14, 33, 69,
+	13, 27, 4, 10, 1, 6, 55, 1148, 25, 1145,
+	12, 1141, 2, 1140, 674, 114, 44, 672, 1136, 79,
+	1096, 1135, 1130, 201, 88, 75, 53, 62, 90, 1121,
+	65, 845,
 }
 var yyR1 = [...]int{
 
 	0, 1, 1, 1, 2, 2, 3, 3, 4, 4,
 	5, 5, 5, 5, 5, 5, 5, 5, 5, 5,
-	5, 5, 5, 5, 5, 6, 6, 7, 7, 8,
-	8, 8, 8, 8, 9, 9, 10, 10, 12, 12,
-	11, 11, 11, 11, 11, 13, 13, 13, 13, 13,
-	13, 14, 14, 15, 15, 15, 16, 16, 17, 17,
-	18, 18, 18, 18, 18, 19, 19, 19, 19, 19,
-	19, 20, 20, 20, 20, 21, 21, 21, 21, 21,
-	22, 22, 23, 23, 23, 23, 23, 23, 23, 23,
-	23, 23, 24, 24, 25, 25, 26, 26, 26, 26,
-	26, 27, 27, 27, 27, 27, 28, 28, 28, 28,
-	29, 30, 30, 31, 32, 32, 33, 33, 33, 34,
-	34, 34, 34, 34, 35, 35, 35, 35, 35, 35,
-	35, 36, 36, 36, 37, 37, 37, 37, 37, 37,
-	37, 37, 37, 37, 37, 37, 37, 37, 37, 37,
-	37, 37, 37, 37, 37, 37, 37, 37, 38, 38,
-	38, 39, 40, 40, 40, 40, 41, 41, 42, 43,
-	43, 44, 44, 45, 45, 46, 46, 47, 47, 48,
-	48, 48, 49, 49, 50, 50, 51, 51, 52, 52,
-	53, 53, 54, 54, 54, 54, 54, 54, 55, 56,
-	57, 57, 57, 57, 57, 58, 58, 58, 58, 58,
-	58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
-	58, 59, 60, 60, 60, 61, 61, 62, 62, 63,
-	63, 64, 64, 65, 65, 65, 66, 66, 67, 68,
-	69, 69, 69, 70, 70, 70, 70, 70, 70, 70,
-	70, 70, 70, 70, 70, 70, 70, 70, 70, 70,
-	70, 70, 71, 71, 71, 71, 71, 71, 71, 72,
-	72, 72, 72, 73, 73, 74, 74, 74, 74, 75,
-	75, 75, 75, 75, 76, 76, 77, 77, 77, 77,
-	77, 77, 77, 77, 77, 77, 77, 78, 79, 79,
-	80, 80, 81, 81, 82, 82, 82, 83, 83, 83,
-	84, 84, 85, 85, 86, 86, 87, 87, 87, 89,
-	89, 89, 89, 89, 89, 89, 90, 90, 90, 90,
-	90, 90, 90, 91, 91, 91, 91, 91, 91, 92,
-	92, 93, 93, 94, 94, 94, 95, 96, 96, 97,
-	97, 98, 98, 99, 99, 100, 100, 101, 101, 88,
-	88, 102, 102, 103, 103, 104, 104, 104, 104, 105,
-	106, 107, 107, 108, 108, 109, 109, 110, 110, 111,
-	111, 112, 112, 113, 113, 114, 114, 115, 115, 116,
-	116, 117, 117, 118, 118, 119, 119, 120, 120, 121,
-	121, 122, 122, 123, 123, 124, 124, 125, 125, 125,
-	125, 126, 127, 127, 128, 129, 129, 130, 130, 131,
-	132, 133, 133, 134, 134, 135, 135, 136, 136, 137,
-	137, 138, 138, 139, 139, 140, 140, 141, 141,
+	5, 5, 5, 5, 5, 5, 6, 6, 7, 7,
+	8, 8, 8, 8, 8, 9, 9, 10, 10, 12,
+	12, 11, 11, 11, 11, 11, 11, 13, 13, 13,
+	13, 13, 13, 13, 14, 14, 15, 15, 15, 16,
+	16, 17, 17, 18, 18, 18, 18, 18, 18, 19,
+	19, 19, 19, 19, 19, 19, 20, 20, 20, 20,
+	21, 21, 21, 21, 21, 22, 22, 23, 23, 23,
+	23, 23, 23, 23, 23, 23, 23, 23, 23, 23,
+	24, 24, 24, 24, 25, 25, 26, 26, 26, 26,
+	26, 26, 26, 26, 27, 27, 28, 28, 28, 28,
+	28, 29, 29, 29, 29, 29, 30, 30, 30, 30,
+	31, 32, 32, 33, 34, 34, 35, 35, 35, 36,
+	36, 36, 36, 36, 37, 37, 37, 37, 37, 37,
+	37, 38, 38, 38, 39, 39, 39, 39, 39, 39,
+	39, 39, 39, 39, 39, 39, 39, 39, 39, 39,
+	39, 39, 39, 39, 39, 39, 39, 39, 39, 39,
+	39, 39, 40, 40, 40, 40, 41, 42, 42, 42,
+	42, 43, 43, 44, 45, 45, 46, 46, 47, 47,
+	48, 48, 48, 48, 48, 48, 49, 49, 50, 50,
+	51, 51, 117, 117, 52, 53, 53, 54, 54, 54,
+	55, 55, 56, 56, 57, 57, 58, 58, 59, 59,
+	60, 60, 60, 60, 60, 60, 61, 62, 63, 63,
+	63, 63, 63, 64, 64, 64, 64, 64, 64, 64,
+	64, 64, 64, 64, 64, 64, 64, 64, 64, 64,
+	65, 66, 66, 66, 67, 67, 68, 68, 69, 69,
+	70, 70, 71, 71, 71, 72, 72, 73, 74, 75,
+	75, 75, 76, 76, 76, 76, 76, 76, 76, 76,
+	76, 76, 76, 76, 76, 76, 76, 76, 76, 76,
+	76, 76, 76, 77, 77, 77, 77, 77, 77, 77,
+	78, 78, 78, 78, 79, 79, 80, 80, 80, 80,
+	80, 81, 81, 81, 81, 81, 82, 82, 83, 83,
+	83, 83, 83, 83, 83, 83, 83, 83, 83, 83,
+	84, 84, 85, 85, 85, 85, 86, 86, 87, 87,
+	88, 88, 89, 89, 95, 95, 95, 96, 96, 96,
+	96, 96, 94, 94, 94, 94, 90, 90, 90, 91,
+	91, 91, 92, 92, 93, 93, 97, 97, 98, 98,
+	98, 99, 99, 99, 99, 99, 99, 101, 101, 101,
+	101, 101, 101, 101, 102, 102, 102, 102, 102, 102,
+	102, 102, 102, 102, 103, 103, 103, 103, 103, 103,
+	104, 104, 104, 105, 105, 105, 106, 106, 107, 107,
+	108, 108, 108, 109, 110, 110, 111, 111, 112, 112,
+	113, 113, 114, 114, 115, 115, 100, 100, 100, 100,
+	116, 116, 118, 118, 119, 119, 119, 119, 120, 121,
+	122, 122, 123, 123, 124, 125, 125, 125, 125, 126,
+	126, 127, 127, 128, 128, 129, 129, 130, 130, 131,
+	131, 132, 132, 133, 133, 134, 134, 135, 135, 136,
+	136, 137, 137, 138, 138, 139, 139, 140, 140, 141,
+	141, 142, 142, 143, 143, 144, 144, 144, 144, 144,
+	144, 144, 144, 144, 144, 144, 144, 145, 146, 146,
+	147, 148, 148, 149, 149, 150, 151, 152, 153, 153,
+	154, 154, 155, 155, 156, 156, 157, 157, 158, 158,
+	159, 159, 160, 160, 161, 161,
 }
 var yyR2 = [...]int{
 
 	0, 0, 1, 3, 0, 3, 0, 3, 0, 3,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 1, 6,
-	8, 8, 9, 9, 1, 1, 1, 2, 1, 1,
-	7, 8, 6, 1, 1, 7, 8, 6, 1, 1,
-	1, 1, 1, 6, 8, 8, 1, 2, 1, 1,
-	7, 8, 6, 1, 1, 7, 8, 6, 1, 1,
-	1, 2, 2, 1, 2, 4, 4, 4, 4, 2,
-	1, 1, 6, 8, 5, 6, 8, 5, 7, 7,
-	7, 7, 1, 3, 1, 3, 0, 1, 1, 2,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	6, 8, 8, 9, 9, 1, 1, 1, 2, 1,
+	1, 7, 8, 6, 1, 1, 6, 7, 8, 6,
+	1, 1, 1, 6, 1, 1, 6, 8, 8, 1,
+	2, 1, 1, 7, 8, 6, 1, 1, 6, 7,
+	8, 6, 1, 1, 1, 6, 2, 2, 1, 2,
+	4, 4, 4, 4, 2, 1, 1, 6, 8, 5,
+	6, 8, 5, 7, 7, 7, 7, 7, 8, 5,
+	1, 2, 4, 3, 1, 3, 1, 3, 2, 4,
+	4, 6, 3, 5, 1, 3, 0, 1, 1, 2,
 	2, 5, 2, 2, 3, 5, 6, 8, 5, 3,
 	1, 1, 3, 3, 1, 3, 1, 1, 3, 9,
 	10, 10, 12, 3, 0, 1, 1, 1, 1, 2,
 	2, 5, 6, 3, 4, 4, 4, 4, 4, 4,
-	2, 2, 2, 2, 4, 4, 2, 2, 2, 4,
-	1, 2, 2, 4, 2, 2, 1, 2, 2, 3,
-	4, 5, 5, 4, 4, 4, 1, 1, 3, 0,
-	2, 0, 2, 0, 3, 0, 2, 0, 3, 0,
-	3, 4, 0, 2, 0, 2, 0, 2, 6, 9,
-	1, 3, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 3, 3, 3, 3, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	3, 1, 3, 1, 6, 1, 3, 1, 3, 2,
-	4, 1, 1, 0, 1, 1, 1, 1, 3, 3,
-	3, 1, 6, 3, 3, 3, 3, 4, 4, 5,
-	6, 6, 3, 4, 4, 3, 4, 4, 4, 4,
-	4, 2, 3, 3, 3, 3, 3, 2, 2, 3,
-	3, 2, 2, 0, 1, 4, 3, 4, 4, 5,
-	5, 5, 5, 1, 5, 10, 8, 9, 9, 9,
-	9, 9, 8, 8, 10, 8, 10, 2, 1, 5,
-	0, 3, 2, 5, 2, 2, 2, 2, 2, 2,
-	2, 1, 2, 1, 1, 1, 1, 2, 3, 1,
-	6, 6, 4, 6, 6, 8, 1, 1, 2, 3,
-	1, 1, 3, 4, 5, 6, 7, 5, 6, 2,
-	4, 1, 1, 1, 3, 1, 5, 0, 1, 4,
-	5, 0, 2, 1, 3, 1, 3, 1, 3, 1,
-	3, 1, 3, 1, 3, 6, 9, 5, 8, 7,
-	3, 1, 3, 5, 6, 4, 5, 0, 2, 4,
-	5, 0, 2, 4, 5, 0, 2, 4, 5, 0,
+	4, 4, 2, 2, 3, 2, 2, 2, 4, 4,
+	2, 2, 2, 4, 1, 2, 2, 4, 2, 2,
+	1, 2, 2, 3, 4, 1, 5, 6, 4, 4,
+	4, 1, 1, 3, 0, 2, 0, 2, 0, 3,
+	1, 2, 3, 4, 4, 5, 1, 3, 0, 2,
+	0, 2, 1, 3, 5, 0, 3, 0, 3, 4,
+	0, 2, 0, 2, 0, 2, 6, 9, 1, 3,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 3,
+	3, 3, 3, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 3,
+	1, 3, 1, 6, 1, 3, 1, 3, 2, 4,
+	1, 1, 0, 1, 1, 1, 1, 3, 3, 3,
+	1, 6, 3, 3, 3, 3, 4, 4, 5, 6,
+	6, 3, 4, 4, 3, 4, 3, 4, 4, 4,
+	4, 4, 2, 3, 3, 3, 3, 3, 2, 2,
+	3, 3, 2, 2, 0, 1, 4, 3, 4, 4,
+	4, 5, 5, 5, 5, 1, 5, 10, 6, 7,
+	7, 7, 7, 7, 12, 6, 6, 8, 6, 8,
+	2, 2, 1, 5, 5, 2, 3, 1, 3, 1,
+	0, 3, 3, 6, 1, 1, 1, 0, 3, 2,
+	2, 3, 1, 1, 3, 3, 2, 2, 2, 2,
+	2, 2, 2, 1, 2, 1, 1, 1, 6, 4,
+	4, 1, 2, 3, 1, 2, 3, 1, 6, 6,
+	4, 6, 6, 8, 1, 1, 2, 3, 1, 1,
+	2, 3, 1, 3, 4, 5, 6, 7, 5, 6,
+	11, 11, 12, 0, 2, 2, 2, 4, 1, 1,
+	1, 3, 1, 5, 0, 1, 4, 5, 0, 2,
+	1, 3, 1, 3, 1, 3, 1, 3, 1, 3,
+	1, 3, 1, 3, 6, 9, 5, 8, 7, 3,
+	1, 3, 5, 6, 9, 7, 5, 8, 11, 1,
+	2, 0, 2, 4, 5, 0, 2, 4, 5, 0,
 	2, 4, 5, 0, 2, 4, 5, 0, 2, 4,
-	5, 0, 2, 4, 5, 0, 2, 1, 1, 1,
-	1, 1, 1, 3, 3, 1, 3, 1, 3, 1,
-	1, 0, 1, 0, 1, 0, 1, 0, 1, 1,
-	1, 0, 1, 0, 1, 0, 1, 1, 1,
+	5, 0, 2, 4, 5, 0, 2, 4, 5, 0,
+	2, 4, 5, 0, 2, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 3,
+	3, 1, 3, 1, 3, 1, 1, 1, 0, 1,
+	0, 1, 0, 1, 0, 1, 1, 1, 0, 1,
+	0, 1, 0, 1, 1, 1,
 }
 var yyChk = [...]int{
 
-	-1000, -1, -7, -5, -11, -39, -104, -105, -108, -23,
-	-20, -21, -27, -28, -34, -22, -37, -38, -58, 15,
-	85, 84, -8, -10, -51, 30, 33, 129, 93, -128,
-	99, 19, 20, 97, 98, 96, 107, 108, 31, 120,
-	130, 112, 113, 114, 115, 116, 121, 117, 118, 119,
-	122, -57, -54, -71, -68, -67, -74, -75, -95, -70,
-	-72, -126, -131, -132, -36, 154, 87, 111, 77, -125,
-	28, 5, 6, 7, -55, 10, -56, 151, 152, 137,
-	138, 136, -76, -60, 67, 71, 153, 11, 13, 14,
-	94, 4, 131, 132, 133, 9, 75, 139, 134, 148,
-	144, 143, 150, 74, 72, 71, 68, 73, -141, 152,
-	151, 149, 156, 157, 70, 69, -58, 154, -128, 85,
-	84, -96, -58, -40, 23, 18, 21, -42, -41, 16,
-	-67, 154, 34, 34, -130, -129, -126, -130, -125, -126,
-	94, 42, 123, -131, 12, -131, -125, -125, -35, 100,
-	101, 35, 36, 102, 103, -58, -58, 12, -125, -58,
-	-58, -58, -125, -58, -58, -100, -58, -125, -58, -125,
-	-125, 145, -58, -100, -39, -51, -58, -126, -127, -9,
-	129, 93, 6, -53, -52, -139, 29, 159, 154, 159,
-	-58, -58, 154, 154, 154, 143, 150, -134, -141, 71,
-	-67, -58, -58, -125, 154, 154, -1, -58, -58, -58,
-	-134, -58, 72, 68, 73, -60, 154, -67, -58, 66,
-	65, -58, -58, -58, -58, -58, -58, -58, 89, -100,
-	-73, 154, -96, -117, -97, 88, -47, 43, 24, -88,
-	-86, -125, 28, 17, -88, -43, 17, 62, 63, 64,
-	-133, 76, -125, -86, 158, 145, 94, 42, 123, 124,
-	-125, -125, -125, 150, 41, 150, 41, -125, -58, -58,
-	41, 17, 17, 158, 60, 60, 158, -58, 6, -58,
-	155, 155, 155, 91, 68, 158, 68, -126, -127, 158,
-	-125, -125, 6, -73, -133, -100, -125, 6, 155, -103,
-	-94, -93, -59, -58, -77, 149, -125, 138, 136, 139,
-	140, 141, 142, -133, -133, -60, -60, 72, 68, 66,
-	65, 74, 136, -133, -58, -55, -56, 69, -58, -60,
-	-58, -60, -60, -1, 155, 88, -118, 90, -98, 90,
-	-58, -48, 49, 46, -87, -86, 19, 158, -101, -90,
-	-87, -89, -91, 27, 154, -67, 135, -125, 17, -44,
-	22, -101, -138, 65, -138, -138, -103, 154, -140, 26,
-	31, 32, 40, 19, -130, -58, 95, 154, 26, 154,
-	154, -58, -125, -58, -125, -125, -58, -125, -58, 24,
-	12, 12, -125, -100, -100, -100, -100, -58, -2, -12,
-	-5, -13, 85, 84, -8, -10, -6, 109, 110, -125,
-	-127, -126, -125, 68, 68, -53, 26, 154, 155, -73,
-	155, 158, 26, 154, 154, 154, 154, 154, 154, 154,
-	-73, -73, -59, -60, -69, 154, -67, 134, -69, -69,
-	-134, -73, 158, -58, 69, -110, -109, 90, 86, -58,
-	92, -1, 92, -58, 89, -50, 50, -58, -62, -63,
-	-64, -58, -77, 25, 154, -39, -125, 26, -107, -106,
-	-57, -125, -88, -44, 58, -135, -137, 57, 61, 158,
-	53, 55, 56, -125, 26, -90, 154, 154, -101, -45,
-	44, -58, -41, -40, -41, -41, -102, -125, -39, -24,
-	154, -125, -57, 154, -57, -125, -39, -102, -39, 155,
-	-33, -30, -32, -29, -31, -126, -125, -127, 92, 148,
-	-58, -96, 91, 91, -125, -125, 154, -102, 155, -103,
-	-125, -73, -133, -133, -133, -133, -73, -73, -73, 155,
-	155, 155, 69, -61, -60, 154, 97, 68, 155, -58,
-	-58, 92, -110, -1, -58, 89, 84, -58, -1, -58,
-	-49, 51, 77, 158, -65, 47, 48, -61, -99, -57,
-	-125, -43, 158, 150, 52, 52, -136, 54, -136, -135,
-	-137, -101, -125, 155, -58, -125, -58, -44, -46, 45,
-	46, 155, 158, -26, 35, 36, 37, 38, -25, -24,
-	39, -99, 41, 41, 155, 26, 155, 158, 158, 39,
-	155, 158, 87, -2, 89, -119, 88, -2, -2, 91,
-	91, -39, 155, 155, -73, -73, -73, -59, -73, 155,
-	155, 155, -60, 155, 158, -58, 78, 128, 155, 85,
-	92, 89, -58, -97, -117, 88, -49, 131, -62, 132,
-	155, 158, -44, -107, -58, -90, -90, 52, 52, 52,
-	-136, 158, 155, 158, 158, -58, -100, -140, -102, -57,
-	-57, 155, 158, -58, 155, -125, -125, -58, 26, 125,
-	26, -29, -32, -32, -126, -58, 26, -33, -2, -120,
-	90, -58, 92, 92, -2, -2, 155, 26, 106, 155,
-	155, 155, 155, 155, 106, 106, 127, 106, 127, -61,
-	158, 44, 85, -1, -58, -66, 35, 36, 25, -39,
-	-99, -92, 59, 60, -90, -90, -90, 52, -125, -58,
-	-73, -125, -39, -26, -25, -39, -3, -14, -5, -18,
-	85, 84, -15, -16, 87, 126, 125, 125, 155, -112,
-	-111, 90, 86, 92, -2, 89, 87, 87, 92, 92,
-	154, 154, 106, 106, 106, 106, 106, 154, 154, 132,
-	154, 132, -58, 154, -109, 89, -61, -58, 154, -92,
-	59, -90, 155, 155, 155, 155, 158, 92, 148, -58,
-	-96, -58, -126, -127, -58, -3, -3, 26, 92, -112,
-	-2, -58, 84, -2, 87, 87, -39, -79, -78, -80,
-	105, 154, 154, 154, 154, 154, -78, -80, -79, 106,
-	-78, 106, 155, -47, -102, -58, -73, -3, 89, -121,
-	88, 91, 68, 68, 92, 92, 125, 85, 92, 89,
-	-119, 88, 155, 155, -47, 43, 46, -79, -79, -79,
-	-79, -78, 155, 155, 154, 155, 154, 155, 155, 155,
-	-3, -122, 90, -58, -4, -17, -5, -19, 85, 84,
-	-15, -16, -6, -125, -125, -3, 85, -2, -58, 46,
-	-100, 155, 155, 155, 155, 155, -79, -78, -114, -113,
-	90, 86, 92, -3, 89, 92, 148, -58, -96, 91,
-	91, 92, -111, 89, -62, 155, 155, 92, -114, -3,
-	-58, 84, -3, 87, -4, 89, -123, 88, -4, -4,
-	-81, 133, 85, 92, 89, -121, 88, -4, -124, 90,
-	-58, 92, 92, -82, 72, 79, 6, 82, 85, -3,
-	-58, -116, -115, 90, 86, 92, -4, 89, 87, 87,
-	-84, 79, -83, 6, 82, 80, 80, 83, -113, 89,
-	92, -116, -4, -58, 84, -4, 69, 80, 80, 81,
-	83, 85, 92, 89, -123, 88, -85, 79, -83, 85,
-	-4, -58, 81, -115, 89,
+	-1000, -1, -7, -5, -11, -41, -119, -120, -123, -124,
+	-23, -20, -21, -29, -30, -36, -22, -39, -40, -64,
+	15, 94, 93, -8, -10, 122, -57, 32, 35, 34,
+	142, 102, -147, 108, 20, 21, 106, 107, 105, 117,
+	118, 33, 133, 143, 144, 125, 126, 127, 128, 129,
+	134, 130, 131, 132, 135, 124, -63, -60, -77, -74,
+	-73, -80, -81, -109, -76, -78, -145, -150, -151, -152,
+	-38, 180, 96, 121, 86, -144, 29, 5, 6, 7,
+	-61, 10, -62, 177, 178, 163, 57, 164, 162, -82,
+	-66, 75, 79, 179, 11, 13, 14, 16, 103, 4,
+	146, 147, 148, 149, 150, 151, 152, 153, 154, 155,
+	156, 9, 84, 165, 157, 174, 170, 169, 176, 83,
+	80, 79, 76, 81, 82, -161, 178, 177, 175, 182,
+	183, 78, 77, -64, 180, -147, 94, 93, -110, -64,
+	-1, -42, 24, 19, 22, 30, -44, -43, 17, -73,
+	180, 36, 45, 36, 45, -149, -148, -145, -149, -144,
+	-145, 103, 44, 136, -150, 12, -150, -144, -144, -37,
+	109, 110, 37, 38, 111, 112, -64, -64, 12, -144,
+	-41, 145, -57, -64, -64, -64, -144, -64, -64, -114,
+	-64, -144, -64, -144, -144, 171, -64, -114, -41, -64,
+	-145, -146, -9, 142, 102, 6, -59, -58, -159, 31,
+	185, 180, 185, -64, -64, 180, 180, 180, 180, 169,
+	176, -154, -161, 79, -73, -64, -64, -144, 180, 180,
+	-1, -64, -64, -64, -154, -64, 80, 76, 81, 82,
+	-66, 180, -73, -64, -64, 74, 73, -64, -64, -64,
+	-64, -64, -64, -64, 98, -114, -79, 180, -110, -136,
+	-111, 97, 123, -53, 46, 25, -100, -97, -98, -144,
+	29, 159, 160, 161, 18, -100, 25, -45, 18, 70,
+	71, 72, -153, 85, -144, -144, -97, -144, 184, 171,
+	103, 44, 136, 137, -144, -144, -144, 176, 43, 176,
+	43, -144, -64, -64, 43, 18, 18, -41, 184, 66,
+	66, 184, -64, 6, -64, 181, 181, 181, 100, 76,
+	184, 76, -145, -146, 184, -144, -144, 6, -79, -153,
+	-114, -144, 6, 181, -118, -108, -107, -65, -64, -83,
+	175, -144, 164, 162, 165, 166, 167, 168, -79, -153,
+	-153, -66, -66, 80, 76, 74, 73, 83, 162, -153,
+	-64, -61, -62, 77, -64, -66, -64, -64, -66, -66,
+	-1, 181, 97, -137, 99, -112, 99, -64, -1, -54,
+	52, 49, -99, -97, -98, 20, 184, 184, 180, 180,
+	180, -115, -102, -99, -101, -103, -104, 28, 180, -73,
+	158, -144, 18, -99, -46, 23, -115, -158, 73, -158,
+	-158, -118, 180, -160, 27, 65, 33, 34, 42, 20,
+	65, -149, -64, 104, 180, 27, 180, 180, -64, -144,
+	-64, -144, -144, -64, 160, -144, -64, 160, 25, 12,
+	12, -144, -114, -114, -114, -114, -64, -2, -12, -5,
+	-13, 94, 93, -8, -10, -6, 122, 119, 120, -144,
+	-146, -145, -144, 76, 76, -59, 27, 180, 181, -79,
+	181, 184, 27, 180, 180, 180, 180, 180, 180, 180,
+	181, -79, -79, -65, -66, -75, 180, -73, 157, -75,
+	-75, -154, -79, 184, -64, 77, -129, -128, 99, 95,
+	-64, 101, -1, 101, -64, 98, 101, -56, 53, -64,
+	-68, -69, -70, -64, -83, 26, 180, -41, -144, 27,
+	-144, 27, -122, -121, -63, -144, -100, -100, -64, -114,
+	-114, -46, 64, -155, -157, 63, 67, 68, 69, 184,
+	59, 61, 62, -144, 27, -144, 27, -102, 180, 180,
+	-115, 66, -47, 47, -64, -43, -42, -43, -43, -25,
+	-24, -144, -41, -144, -26, 180, -144, -63, 180, -63,
+	-144, -144, -41, -116, -144, -41, 181, -35, -32, -34,
+	-31, -33, -145, -144, -146, 101, 174, -64, -110, -2,
+	100, 100, -144, -144, 180, -116, 181, -118, -144, -79,
+	-153, -153, -153, -153, -79, -79, -79, 181, 181, 181,
+	77, -67, -66, 180, 106, 76, 181, -64, -64, 101,
+	-129, -1, -64, 98, 93, -64, -1, 122, -64, -55,
+	54, 86, 184, -71, 50, 51, -67, -113, -63, -144,
+	-144, -45, 184, 176, 184, 181, 181, 58, 58, -156,
+	60, -156, -155, -157, 180, -105, 155, 150, -115, -144,
+	-144, 181, -64, -144, -64, -46, -102, -50, 48, 49,
+	181, 184, -144, 79, 180, -28, 37, 38, 39, 40,
+	-27, -26, 41, -144, 79, -113, 43, 43, 181, 184,
+	27, 181, 184, 184, 41, 181, 184, 96, -2, 98,
+	-138, 97, 123, -2, -2, 100, 100, -41, 181, 181,
+	-79, -79, -79, -65, -79, 181, 181, 181, -66, 181,
+	184, -64, 87, 141, 181, 94, 101, 98, -64, -111,
+	-136, 97, -55, 146, -68, 147, 181, 184, -46, -122,
+	-64, -64, -102, -102, 58, 58, 58, -156, -118, 180,
+	147, 147, 184, 181, 184, 184, 65, -51, 116, -64,
+	-49, -48, -64, 180, 55, 56, 57, -160, -25, 79,
+	84, -116, -63, -63, 181, 184, -64, 41, 79, 84,
+	181, -144, -144, -64, 160, 27, -116, 138, 27, -31,
+	-34, -34, -145, -64, 27, -35, -2, -139, 99, -64,
+	-2, 101, 101, -2, -2, 181, 27, 115, 181, 181,
+	181, 181, 181, 115, 115, 140, 115, 140, -67, 184,
+	47, 94, -1, -64, -72, 37, 38, 26, -41, -113,
+	181, -106, 65, 66, -102, -102, -102, 58, 104, -144,
+	-144, -64, -79, -144, -64, -117, -52, -144, 184, 181,
+	-114, 180, 180, 154, -41, 84, 181, -28, -27, -64,
+	84, 41, -41, -3, -14, -5, -18, 94, 93, -15,
+	-16, 122, 96, 139, 138, 138, 181, -131, -130, 99,
+	95, 101, -2, 98, 101, 96, 96, 101, 101, 180,
+	-87, 180, -144, 115, 115, 115, 115, 141, 115, -86,
+	180, -144, -87, 147, -86, 147, -64, 180, -128, 98,
+	-67, -64, 180, -106, 65, -102, -63, 104, 181, 181,
+	181, 181, 184, -126, -125, 97, 184, 27, -49, 181,
+	-114, -114, 180, 41, -64, 101, 174, -64, -110, -3,
+	-64, -145, -146, -64, -3, -3, 27, 101, -131, -2,
+	-64, 93, -2, 122, 96, 96, -41, -85, -84, -88,
+	-144, 114, -87, -87, -87, -87, 47, -86, -84, -88,
+	-144, 115, 115, 181, -53, -116, -64, 76, -144, -79,
+	-126, 156, 79, -117, 180, 181, 181, -49, -64, -3,
+	98, -140, 97, 123, 100, 76, 76, 101, 101, 138,
+	94, 101, 98, -138, 97, 181, 181, -53, 46, -53,
+	46, -89, -95, 148, 87, 149, 49, 180, 181, -87,
+	-86, 181, 181, 180, 76, 181, -127, 77, 156, -85,
+	181, -3, -141, 99, -64, -3, -4, -17, -5, -19,
+	94, 93, -15, -16, -6, 122, -144, -144, -3, 94,
+	-2, -64, 49, 49, -90, 80, 88, -94, 91, 6,
+	7, 153, -114, -53, -118, 74, 180, 98, -64, -127,
+	181, -133, -132, 99, 95, 101, -3, 98, 101, 101,
+	174, -64, -110, -4, 100, 100, 101, -130, 98, -68,
+	-68, -96, 150, -92, 88, -91, -94, 91, 89, 89,
+	92, 6, 5, 181, 181, 181, -118, 19, 22, 98,
+	101, -133, -3, -64, 93, -3, 122, 96, -4, 98,
+	-142, 97, 123, -4, -4, -89, -89, 91, 47, 146,
+	151, 77, 89, 89, 90, 92, -144, -144, 115, 181,
+	181, 181, 20, 24, 94, 101, 98, -140, 97, -4,
+	-143, 99, -64, -4, 101, 101, 92, 152, -93, 88,
+	-91, -86, 181, -122, 26, 180, 94, -3, -64, -135,
+	-134, 99, 95, 101, -4, 98, 101, 96, 96, -96,
+	90, -66, -113, -132, 98, 101, -135, -4, -64, 93,
+	-4, 122, 181, 94, 101, 98, -142, 97, 26, 94,
+	-4, -64, -66, -134, 98,
 }
 var yyDef = [...]int{
 
-	-2, -2, 2, 27, 28, 10, 11, 12, 13, 14,
+	-2, -2, 2, 28, 29, 10, 11, 12, 13, 14,
 	15, 16, 17, 18, 19, 20, 21, 22, 23, 24,
-	0, 347, 43, 44, 0, 0, 0, 0, 0, -2,
-	0, 0, 0, 0, 0, 124, 80, 81, 0, 0,
-	0, 0, 0, 0, 0, 0, 150, 0, 156, 0,
-	0, 205, 206, 207, 208, 209, 210, 211, 212, 213,
-	214, 215, 217, 218, 219, 186, 0, 36, 433, 200,
-	0, 192, 193, 194, 195, 196, 197, 0, 0, 0,
-	0, 0, 283, 423, 0, 0, 0, 411, 419, 420,
-	0, 407, 408, 409, 410, 198, 199, 0, 0, -2,
-	0, 437, 438, 423, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, -2, 216, 0,
-	347, 0, 348, -2, 0, 0, 0, 169, 0, 421,
-	167, 186, 0, 0, 71, 417, 415, 72, 0, 74,
-	0, 0, 0, 0, 0, 79, 102, 103, 0, 125,
-	126, 127, 128, 0, 0, 0, 0, 140, 152, 141,
-	142, 143, -2, 147, 148, 151, 355, -2, 155, 157,
-	158, 0, 0, 0, 0, 0, 0, 215, 0, 0,
-	34, 35, 37, 187, 190, 0, 434, 0, 273, 0,
-	267, 268, 0, 421, 421, 437, 438, 0, 0, 424,
-	261, 271, 272, 0, 421, 0, 3, 239, -2, -2,
-	0, 0, 0, 0, 0, 252, 186, 223, -2, 0,
-	0, 262, 263, 264, 265, 266, 269, 270, -2, 0,
-	0, 273, 0, 393, 351, 0, 179, 0, 0, 0,
-	359, 314, 315, 0, 0, 171, 0, 431, 431, 431,
-	0, 422, 435, 0, 0, 0, 0, 0, 0, 0,
-	104, 109, 123, 0, 0, 0, 0, 0, 129, 130,
-	0, 0, 0, 0, 0, 0, 0, 159, 193, 414,
-	220, 222, 238, -2, 0, 0, 0, 0, 0, 433,
-	0, 201, 203, 0, 273, 274, 202, 204, 276, 0,
-	363, 343, 345, 341, 342, 221, 200, 0, 0, 0,
-	0, 0, 0, 273, 273, 244, 246, 0, 0, 0,
-	0, 423, 133, 273, 0, 247, 248, 0, 0, 253,
-	-2, 257, 259, 377, 278, 0, 0, -2, 0, 0,
-	0, 184, 0, 0, 186, 316, 0, 0, 171, -2,
-	326, 327, 330, 331, 186, 319, 0, 314, 0, 173,
-	0, 170, 0, 432, 0, 0, 168, 0, 186, 436,
-	0, 0, 0, 0, 418, 416, 186, 0, 186, 0,
-	0, 75, -2, 77, -2, -2, 135, -2, 137, 0,
-	138, 139, 153, 144, 145, 149, 356, 160, 0, 0,
-	38, 39, 0, 347, 48, 49, 50, 25, 26, 0,
-	413, 412, 0, 0, 0, 191, 0, 0, 275, 0,
-	277, 0, 0, 273, 421, 421, 421, 273, 273, 273,
-	0, 0, 0, 0, 254, 186, 241, 0, 258, 260,
-	0, 0, 0, 249, 0, 0, 377, -2, 0, 0,
-	0, 394, 346, 352, -2, 161, 0, 182, 178, 227,
-	233, 231, 232, 0, 0, 367, 317, 0, 169, 371,
-	0, 200, 360, 373, 0, 0, 427, 427, 425, 0,
-	426, 429, 430, 328, 0, 425, 0, 0, 171, 175,
-	0, 172, 163, 166, 164, 165, 0, 361, 84, 96,
-	0, 92, 87, 0, 0, 0, 101, 0, 108, 0,
-	0, 116, 117, 111, 114, 110, 0, 105, 0, -2,
-	0, 0, -2, -2, 0, 0, 186, 0, 279, 364,
-	344, 0, 273, 273, 273, 273, 0, 0, 0, 280,
-	281, 282, 0, 0, 225, 0, 131, 0, 284, 0,
-	250, 0, 0, 378, 0, 0, 42, 23, 391, 185,
-	180, 182, 0, 0, 229, 234, 235, 365, 0, 353,
-	318, 171, 0, 0, 0, 0, 0, 428, 0, 0,
-	427, 358, 329, 332, 0, 200, 0, 374, 162, 0,
-	0, -2, 0, 85, 97, 98, 0, 0, 0, 94,
-	0, 0, 0, 0, 106, 0, 0, 0, 0, 0,
-	0, 0, 29, 5, -2, 397, 0, 0, 0, -2,
-	-2, 0, 0, 275, 0, 0, 0, 0, 0, 0,
-	0, 0, 251, 240, 0, 0, 132, 0, 224, 40,
-	0, -2, 349, 350, 392, 0, 181, 183, 228, 0,
-	186, 0, 369, 372, 370, 333, 425, 0, 0, 0,
-	0, 0, 322, 273, 0, 176, 174, 186, 362, 99,
-	100, 96, 0, 93, 88, 89, -2, 91, 186, -2,
-	0, 112, 118, 115, 0, 113, 0, 0, 381, 0,
-	-2, 0, 0, 0, 0, 0, 188, 0, 0, 279,
-	280, 281, 282, 284, 0, 0, 0, 0, 0, 226,
-	0, 0, 41, 375, 0, 230, 236, 237, 0, 368,
-	354, 334, 0, 0, 425, 425, 337, 0, 200, 0,
-	0, 0, 83, 86, 95, 107, 0, 0, 51, 52,
-	0, 347, 63, 64, 0, 56, -2, -2, 0, 0,
-	381, -2, 0, 0, 398, -2, 30, 31, 0, 0,
-	186, 300, 0, 0, 0, 0, 0, 300, 300, 0,
-	300, 0, 0, 177, 376, -2, 366, 339, 0, 335,
-	0, 338, 320, 321, 323, 324, 273, 119, -2, 0,
-	0, 0, 215, 0, 57, 0, 0, 0, 0, 0,
-	382, 0, 47, 395, 32, 33, 0, 0, 298, 177,
-	0, 300, 300, 300, 300, 300, 0, 177, 0, 0,
-	0, 0, 242, 0, 0, 336, 0, 7, -2, 401,
-	0, -2, 0, 0, 120, 121, -2, 45, 0, -2,
-	396, 0, 189, 286, 297, 0, 0, 0, 0, 0,
-	0, 0, 292, 293, 300, 295, 300, 285, 340, 325,
-	385, 0, -2, 0, 0, 0, 58, 59, 0, 347,
-	68, 69, 70, 0, 0, 0, 46, 379, 0, 0,
-	301, 287, 288, 289, 290, 291, 0, 0, 0, 385,
-	-2, 0, 0, 402, -2, 0, -2, 0, 0, -2,
-	-2, 122, 380, -2, 178, 294, 296, 0, 0, 386,
-	0, 62, 399, 53, 9, -2, 405, 0, 0, 0,
-	299, 0, 60, 0, -2, 400, 0, 389, 0, -2,
-	0, 0, 0, 302, 0, 0, 0, 0, 61, 383,
-	0, 0, 389, -2, 0, 0, 406, -2, 54, 55,
-	0, 0, 311, 0, 0, 304, 305, 306, 384, -2,
-	0, 0, 390, 0, 67, 403, 0, 310, 307, 308,
-	309, 65, 0, -2, 404, 0, 303, 0, 313, 66,
-	387, 0, 312, 388, -2,
+	25, 0, 424, 44, 45, -2, 0, 0, 0, 0,
+	0, 0, -2, 0, 0, 0, 0, 0, 144, 85,
+	86, 0, 0, 0, 224, 0, 0, 0, 0, 0,
+	174, 0, 180, 0, 0, 185, 243, 244, 245, 246,
+	247, 248, 249, 250, 251, 252, 253, 255, 256, 257,
+	258, 224, 0, 37, 530, 238, 0, 230, 231, 232,
+	233, 234, 235, 0, 0, 0, 0, 0, 0, 325,
+	520, 0, 0, 0, 507, 515, 516, 517, 0, 495,
+	496, 497, 498, 499, 500, 501, 502, 503, 504, 505,
+	506, 236, 237, 0, 0, -2, 0, 534, 535, 520,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, -2, 254, 0, 424, 0, 425,
+	0, -2, 0, 0, 0, 0, 194, 0, 518, 192,
+	224, 0, 0, 0, 0, 76, 513, 511, 77, 0,
+	79, 0, 0, 0, 0, 0, 84, 122, 123, 0,
+	145, 146, 147, 148, 0, 0, 0, 0, 162, 176,
+	163, 224, 0, 165, 166, 167, -2, 171, 172, 175,
+	432, -2, 179, 181, 182, 0, 0, 0, 0, 0,
+	253, 0, 0, 35, 36, 38, 225, 228, 0, 531,
+	0, 314, 0, 308, 309, 0, 314, 518, 518, 534,
+	535, 0, 0, 521, 302, 312, 313, 0, 518, 0,
+	3, 278, -2, -2, 0, 0, 0, 0, 0, 0,
+	291, 224, 262, -2, -2, 0, 0, 303, 304, 305,
+	306, 307, 310, 311, -2, 0, 0, 314, 0, 481,
+	428, 0, -2, 217, 0, 0, 0, 436, 438, 376,
+	377, 0, 0, 0, 0, 0, 0, 196, 0, 528,
+	528, 528, 0, 519, 532, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 124, 129, 143, 0, 0, 0,
+	0, 0, 149, 150, 0, 0, 0, 164, 0, 0,
+	0, 0, 183, 231, 510, 259, 261, 277, -2, 0,
+	0, 0, 0, 0, 530, 0, 239, 241, 0, 314,
+	315, 240, 242, 317, 0, 442, 420, 422, 418, 419,
+	260, 238, 0, 0, 0, 0, 0, 0, 0, 314,
+	314, 283, 285, 0, 0, 0, 0, 520, 153, 314,
+	0, 286, 287, 0, 0, 292, -2, -2, 298, 300,
+	465, 319, 0, 0, -2, 0, 0, 0, 0, 222,
+	0, 0, 224, 381, 384, 0, 0, 0, 0, 0,
+	0, 196, -2, 394, 395, 398, 399, 402, 224, 387,
+	0, 376, 0, 0, 198, 0, 195, 0, 529, 0,
+	0, 193, 0, 224, 533, 0, 0, 0, 0, 0,
+	0, 514, 512, 224, 0, 224, 0, 0, 80, -2,
+	82, -2, -2, 155, 156, -2, 158, 159, 0, 160,
+	161, 177, 168, 169, 173, 433, 184, 0, 0, 39,
+	40, 0, 424, 50, 51, 52, -2, 26, 27, 0,
+	509, 508, 0, 0, 0, 229, 0, 0, 316, 0,
+	318, 0, 0, 314, 518, 518, 518, 314, 314, 314,
+	320, 0, 0, 0, 0, 293, 224, 280, 0, 299,
+	301, 0, 0, 0, 288, 0, 0, 465, -2, 0,
+	0, 0, 482, 423, 429, -2, 0, 186, 0, 220,
+	216, 266, 272, 270, 271, 0, 0, 446, 382, 0,
+	385, 0, 194, 450, 0, 238, 437, 439, 0, 0,
+	0, 452, 0, 0, 524, 524, 522, 0, 413, 0,
+	523, 526, 527, 396, 0, 400, 0, 522, 0, 0,
+	196, 0, 208, 0, 197, 188, 191, 189, 190, 0,
+	104, 100, 89, 0, 116, 0, 106, 92, 0, 0,
+	0, 99, 121, 0, 440, 128, 0, 0, 136, 137,
+	131, 134, 130, 0, 125, 0, -2, 0, 0, 0,
+	-2, -2, 0, 0, 224, 0, 321, 443, 421, 0,
+	314, 314, 314, 314, 0, 0, 0, 322, 323, 324,
+	0, 0, 264, 0, 151, 0, 326, 0, 289, 0,
+	0, 466, 0, 0, 43, 24, 479, 46, 223, 218,
+	220, 0, 0, 268, 273, 274, 444, 0, 430, 383,
+	386, 196, 0, 0, 0, 379, 380, 0, 0, 0,
+	525, 0, 0, 524, 0, 0, 0, 0, 435, 397,
+	401, 403, 0, 238, 0, 453, 522, 210, 0, 0,
+	-2, 0, 101, 0, 0, 90, 117, 118, 0, 0,
+	0, 114, 0, 108, 0, 0, 0, 0, 126, 0,
+	0, 0, 0, 0, 0, 0, 0, 30, 5, -2,
+	485, 0, -2, 0, 0, -2, -2, 0, 0, 316,
+	0, 0, 0, 0, 0, 0, 0, 0, 290, 279,
+	0, 0, 152, 0, 263, 41, 0, -2, 426, 427,
+	480, 0, 219, 221, 267, 0, 224, 0, 448, 451,
+	449, 0, 404, 522, 0, 0, 0, 0, 0, 0,
+	414, 415, 0, 390, 314, 0, 0, 187, 0, 209,
+	199, 206, 200, 224, 0, 0, 0, 224, 105, 0,
+	103, 0, 119, 120, 116, 0, 107, 0, 0, 112,
+	93, 94, -2, 96, 97, 224, 441, -2, 0, 132,
+	138, 135, 0, 133, 0, 0, 469, 0, -2, 0,
+	0, 0, 0, 0, 0, 226, 0, 0, 321, 322,
+	323, 324, 326, 0, 0, 0, 0, 0, 265, 0,
+	0, 42, 463, 0, 269, 275, 276, 0, 447, 431,
+	378, 405, 0, 0, 522, 522, 408, 0, 0, 0,
+	238, 0, 0, 0, 0, 211, 212, 0, 0, 201,
+	0, 0, 0, 0, 88, 102, 98, 91, 115, 109,
+	110, 0, 127, 0, 0, 54, 55, 0, 424, 66,
+	67, -2, 0, 59, -2, -2, 0, 0, 469, -2,
+	0, 0, 486, -2, 0, 31, 32, 0, 0, 224,
+	328, 350, 349, 0, 0, 0, 0, 0, 0, 335,
+	350, 347, 336, 0, 338, 0, 0, 215, 464, -2,
+	445, 416, 0, 406, 0, 409, 0, 0, 388, 389,
+	391, 392, 314, 454, 459, 0, 0, 0, 207, -2,
+	0, 0, 0, 0, 113, 139, -2, 0, 0, 0,
+	0, 253, 0, 60, 0, 0, 0, 0, 0, 470,
+	0, 49, 483, 53, 33, 34, 0, 0, 342, 215,
+	215, 0, 329, 330, 331, 332, 0, 333, 0, 215,
+	215, 0, 0, 281, 0, 0, 407, 0, 0, 0,
+	460, 461, 0, 213, 350, 203, 204, 0, 111, 7,
+	-2, 489, 0, -2, -2, 0, 0, 140, 141, -2,
+	47, 0, -2, 484, 0, 227, 348, 340, 0, 341,
+	0, 345, 0, 354, 355, 356, 0, 215, 346, 337,
+	339, 327, 417, 0, 0, 393, 0, 0, 461, 0,
+	205, 473, 0, -2, 0, 0, 0, 0, 61, 62,
+	0, 424, 72, 73, 74, -2, 0, 0, 0, 48,
+	467, 0, 0, 0, 357, 0, 0, 0, 0, 362,
+	363, 0, 351, 0, 0, 0, 0, 0, 462, 0,
+	214, 0, 473, -2, 0, 0, 490, -2, 0, 0,
+	-2, 0, 0, 0, -2, -2, 142, 468, -2, 216,
+	216, 352, 0, 0, 0, 373, 0, 0, 366, 367,
+	368, 0, 0, 327, 0, 0, 0, 0, 456, 0,
+	0, 0, 474, 0, 65, 487, 68, 56, 9, -2,
+	493, 0, -2, 0, 0, 343, 344, 0, 359, 360,
+	0, 0, 372, 369, 370, 371, 364, 365, 0, 410,
+	411, 0, 0, 0, 63, 0, -2, 488, 0, 477,
+	0, -2, 0, 0, 0, 0, 358, 361, 357, 0,
+	375, 334, 412, 455, 0, 0, 64, 471, 0, 0,
+	477, -2, 0, 0, 494, -2, 0, 57, 58, 353,
+	374, 457, 0, 472, -2, 0, 0, 478, 0, 71,
+	491, 75, 0, 69, 0, -2, 492, 0, 0, 70,
+	475, 0, 458, 476, -2,
 }
 var yyTok1 = [...]int{
 
 	1, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 153, 3, 3, 3, 157, 3, 3,
-	154, 155, 149, 152, 158, 151, 159, 156, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 148,
-	3, 150,
+	3, 3, 3, 179, 3, 3, 3, 183, 3, 3,
+	180, 181, 175, 178, 184, 177, 185, 182, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 174,
+	3, 176,
 }
 var yyTok2 = [...]int{
 
@@ -1473,7 +1813,10 @@ var yyTok2 = [...]int{
 	112, 113, 114, 115, 116, 117, 118, 119, 120, 121,
 	122, 123, 124, 125, 126, 127, 128, 129, 130, 131,
 	132, 133, 134, 135, 136, 137, 138, 139, 140, 141,
-	142, 143, 144, 145, 146, 147,
+	142, 143, 144, 145, 146, 147, 148, 149, 150, 151,
+	152, 153, 154, 155, 156, 157, 158, 159, 160, 161,
+	162, 163, 164, 165, 166, 167, 168, 169, 170, 171,
+	172, 173,
 }
 var yyTok3 = [...]int{
 	0,
@@ -1818,970 +2161,1120 @@ yydefault:
 
 	case 1:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:226
+//line parser.y:250
 		{
 			yyVAL.program = nil
 			yylex.(*Lexer).program = yyVAL.program
 		}
 	case 2:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:231
+//line parser.y:255
 		{
 			yyVAL.program = []Statement{yyDollar[1].statement}
 			yylex.(*Lexer).program = yyVAL.program
 		}
 	case 3:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:236
+//line parser.y:260
 		{
 			yyVAL.program = append([]Statement{yyDollar[1].statement}, yyDollar[3].program...)
 			yylex.(*Lexer).program = yyVAL.program
 		}
 	case 4:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:243
+//line parser.y:267
 		{
 			yyVAL.program = nil
 		}
 	case 5:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:247
+//line parser.y:271
 		{
 			yyVAL.program = append([]Statement{yyDollar[1].statement}, yyDollar[3].program...)
 		}
 	case 6:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:253
+//line parser.y:277
 		{
 			yyVAL.program = nil
 		}
 	case 7:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:257
+//line parser.y:281
 		{
 			yyVAL.program = append([]Statement{yyDollar[1].statement}, yyDollar[3].program...)
 		}
 	case 8:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:263
+//line parser.y:287
 		{
 			yyVAL.program = nil
 		}
 	case 9:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:267
+//line parser.y:291
 		{
 			yyVAL.program = append([]Statement{yyDollar[1].statement}, yyDollar[3].program...)
 		}
 	case 10:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:273
+//line parser.y:297
 		{
 			yyVAL.statement = yyDollar[1].queryexpr
 		}
 	case 11:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:277
+//line parser.y:301
 		{
 			yyVAL.statement = yyDollar[1].expression
 		}
 	case 12:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:281
+//line parser.y:305
 		{
 			yyVAL.statement = yyDollar[1].expression
 		}
 	case 13:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:285
+//line parser.y:309
 		{
 			yyVAL.statement = yyDollar[1].expression
 		}
 	case 14:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:289
+//line parser.y:313
 		{
-			yyVAL.statement = yyDollar[1].statement
+			yyVAL.statement = yyDollar[1].expression
 		}
 	case 15:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:293
+//line parser.y:317
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 16:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:297
+//line parser.y:321
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 17:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:301
+//line parser.y:325
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 18:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:305
+//line parser.y:329
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 19:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:309
+//line parser.y:333
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 20:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:313
+//line parser.y:337
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 21:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:317
+//line parser.y:341
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 22:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:321
+//line parser.y:345
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 23:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:325
+//line parser.y:349
 		{
-			yyVAL.statement = yyDollar[1].queryexpr
+			yyVAL.statement = yyDollar[1].statement
 		}
 	case 24:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:329
+//line parser.y:353
 		{
-			yyVAL.statement = ExternalCommand{BaseExpr: NewBaseExpr(yyDollar[1].token), Command: yyDollar[1].token.Literal}
+			yyVAL.statement = yyDollar[1].queryexpr
 		}
 	case 25:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:335
+//line parser.y:357
 		{
-			yyVAL.statement = FlowControl{Token: yyDollar[1].token.Token}
+			yyVAL.statement = ExternalCommand{BaseExpr: NewBaseExpr(yyDollar[1].token), Command: yyDollar[1].token.Literal}
 		}
 	case 26:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:339
+//line parser.y:363
 		{
 			yyVAL.statement = FlowControl{Token: yyDollar[1].token.Token}
 		}
 	case 27:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:345
+//line parser.y:367
 		{
-			yyVAL.statement = yyDollar[1].statement
+			yyVAL.statement = FlowControl{Token: yyDollar[1].token.Token}
 		}
 	case 28:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:349
+//line parser.y:373
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 29:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:377
+		{
+			yyVAL.statement = yyDollar[1].statement
+		}
+	case 30:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:355
+//line parser.y:383
 		{
 			yyVAL.statement = While{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}
 		}
-	case 30:
+	case 31:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:359
+//line parser.y:387
 		{
 			yyVAL.statement = WhileInCursor{Variables: []Variable{yyDollar[2].variable}, Cursor: yyDollar[4].identifier, Statements: yyDollar[6].program}
 		}
-	case 31:
+	case 32:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:363
+//line parser.y:391
 		{
 			yyVAL.statement = WhileInCursor{Variables: yyDollar[2].variables, Cursor: yyDollar[4].identifier, Statements: yyDollar[6].program}
 		}
-	case 32:
+	case 33:
 		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:367
+//line parser.y:395
 		{
 			yyVAL.statement = WhileInCursor{WithDeclaration: true, Variables: []Variable{yyDollar[3].variable}, Cursor: yyDollar[5].identifier, Statements: yyDollar[7].program}
 		}
-	case 33:
+	case 34:
 		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:371
+//line parser.y:399
 		{
 			yyVAL.statement = WhileInCursor{WithDeclaration: true, Variables: yyDollar[3].variables, Cursor: yyDollar[5].identifier, Statements: yyDollar[7].program}
 		}
-	case 34:
+	case 35:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:377
+//line parser.y:405
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 35:
+	case 36:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:381
+//line parser.y:409
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 36:
+	case 37:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:387
+//line parser.y:415
 		{
 			yyVAL.statement = Exit{}
 		}
-	case 37:
+	case 38:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:391
+//line parser.y:419
 		{
 			yyVAL.statement = Exit{Code: value.NewIntegerFromString(yyDollar[2].token.Literal)}
 		}
-	case 38:
+	case 39:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:397
+//line parser.y:425
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 39:
+	case 40:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:401
+//line parser.y:429
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 40:
+	case 41:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line parser.y:407
+//line parser.y:435
 		{
 			yyVAL.statement = If{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program, Else: yyDollar[5].elseexpr}
 		}
-	case 41:
+	case 42:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:411
+//line parser.y:439
 		{
 			yyVAL.statement = If{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program, ElseIf: yyDollar[5].elseif, Else: yyDollar[6].elseexpr}
 		}
-	case 42:
+	case 43:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:415
+//line parser.y:443
 		{
 			yyVAL.statement = Case{Value: yyDollar[2].queryexpr, When: yyDollar[3].casewhen, Else: yyDollar[4].caseelse}
 		}
-	case 43:
+	case 44:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:419
+//line parser.y:447
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 44:
+	case 45:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:423
+//line parser.y:451
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 45:
+	case 46:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:455
+		{
+			yyVAL.statement = TryCatch{BaseExpr: NewBaseExpr(yyDollar[1].token), TryStatements: yyDollar[2].program, CatchStatements: yyDollar[4].program}
+		}
+	case 47:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line parser.y:429
+//line parser.y:461
 		{
 			yyVAL.statement = If{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program, Else: yyDollar[5].elseexpr}
 		}
-	case 46:
+	case 48:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:433
+//line parser.y:465
 		{
 			yyVAL.statement = If{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program, ElseIf: yyDollar[5].elseif, Else: yyDollar[6].elseexpr}
 		}
-	case 47:
+	case 49:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:437
+//line parser.y:469
 		{
 			yyVAL.statement = Case{Value: yyDollar[2].queryexpr, When: yyDollar[3].casewhen, Else: yyDollar[4].caseelse}
 		}
-	case 48:
+	case 50:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:441
+//line parser.y:473
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 49:
+	case 51:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:445
+//line parser.y:477
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 50:
+	case 52:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:449
+//line parser.y:481
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 51:
+	case 53:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:485
+		{
+			yyVAL.statement = TryCatch{BaseExpr: NewBaseExpr(yyDollar[1].token), TryStatements: yyDollar[2].program, CatchStatements: yyDollar[4].program}
+		}
+	case 54:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:455
+//line parser.y:491
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 52:
+	case 55:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:459
+//line parser.y:495
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 53:
+	case 56:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:465
+//line parser.y:501
 		{
 			yyVAL.statement = While{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}
 		}
-	case 54:
+	case 57:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:469
+//line parser.y:505
 		{
 			yyVAL.statement = WhileInCursor{Variables: []Variable{yyDollar[2].variable}, Cursor: yyDollar[4].identifier, Statements: yyDollar[6].program}
 		}
-	case 55:
+	case 58:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:473
+//line parser.y:509
 		{
 			yyVAL.statement = WhileInCursor{Variables: yyDollar[2].variables, Cursor: yyDollar[4].identifier, Statements: yyDollar[6].program}
 		}
-	case 56:
+	case 59:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:479
+//line parser.y:515
 		{
 			yyVAL.statement = Return{Value: NewNullValue()}
 		}
-	case 57:
+	case 60:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:483
+//line parser.y:519
 		{
 			yyVAL.statement = Return{Value: yyDollar[2].queryexpr}
 		}
-	case 58:
+	case 61:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:489
+//line parser.y:525
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 59:
+	case 62:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:493
+//line parser.y:529
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 60:
+	case 63:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line parser.y:499
+//line parser.y:535
 		{
 			yyVAL.statement = If{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program, Else: yyDollar[5].elseexpr}
 		}
-	case 61:
+	case 64:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:503
+//line parser.y:539
 		{
 			yyVAL.statement = If{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program, ElseIf: yyDollar[5].elseif, Else: yyDollar[6].elseexpr}
 		}
-	case 62:
+	case 65:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:507
+//line parser.y:543
 		{
 			yyVAL.statement = Case{Value: yyDollar[2].queryexpr, When: yyDollar[3].casewhen, Else: yyDollar[4].caseelse}
 		}
-	case 63:
+	case 66:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:511
+//line parser.y:547
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 64:
+	case 67:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:515
+//line parser.y:551
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 65:
+	case 68:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:555
+		{
+			yyVAL.statement = TryCatch{BaseExpr: NewBaseExpr(yyDollar[1].token), TryStatements: yyDollar[2].program, CatchStatements: yyDollar[4].program}
+		}
+	case 69:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line parser.y:521
+//line parser.y:561
 		{
 			yyVAL.statement = If{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program, Else: yyDollar[5].elseexpr}
 		}
-	case 66:
+	case 70:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:525
+//line parser.y:565
 		{
 			yyVAL.statement = If{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program, ElseIf: yyDollar[5].elseif, Else: yyDollar[6].elseexpr}
 		}
-	case 67:
+	case 71:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:529
+//line parser.y:569
 		{
 			yyVAL.statement = Case{Value: yyDollar[2].queryexpr, When: yyDollar[3].casewhen, Else: yyDollar[4].caseelse}
 		}
-	case 68:
+	case 72:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:533
+//line parser.y:573
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 69:
+	case 73:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:537
+//line parser.y:577
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 70:
+	case 74:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:541
+//line parser.y:581
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 71:
+	case 75:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:585
+		{
+			yyVAL.statement = TryCatch{BaseExpr: NewBaseExpr(yyDollar[1].token), TryStatements: yyDollar[2].program, CatchStatements: yyDollar[4].program}
+		}
+	case 76:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:547
+//line parser.y:591
 		{
 			yyVAL.statement = VariableDeclaration{Assignments: yyDollar[2].varassigns}
 		}
-	case 72:
+	case 77:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:551
+//line parser.y:595
 		{
 			yyVAL.statement = VariableDeclaration{Assignments: yyDollar[2].varassigns}
 		}
-	case 73:
+	case 78:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:555
+//line parser.y:599
 		{
 			yyVAL.statement = yyDollar[1].queryexpr
 		}
-	case 74:
+	case 79:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:559
+//line parser.y:603
 		{
 			yyVAL.statement = DisposeVariable{Variable: yyDollar[2].variable}
 		}
-	case 75:
+	case 80:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:565
+//line parser.y:609
 		{
 			yyVAL.statement = SetEnvVar{EnvVar: yyDollar[2].envvar, Value: yyDollar[4].queryexpr}
 		}
-	case 76:
+	case 81:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:569
+//line parser.y:613
 		{
 			yyVAL.statement = SetEnvVar{EnvVar: yyDollar[2].envvar, Value: yyDollar[4].identifier}
 		}
-	case 77:
+	case 82:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:573
+//line parser.y:617
 		{
 			yyVAL.statement = SetEnvVar{EnvVar: yyDollar[2].envvar, Value: yyDollar[4].queryexpr}
 		}
-	case 78:
+	case 83:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:577
+//line parser.y:621
 		{
 			yyVAL.statement = SetEnvVar{EnvVar: yyDollar[2].envvar, Value: yyDollar[4].identifier}
 		}
-	case 79:
+	case 84:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:581
+//line parser.y:625
 		{
 			yyVAL.statement = UnsetEnvVar{EnvVar: yyDollar[2].envvar}
 		}
-	case 80:
+	case 85:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:587
+//line parser.y:631
 		{
 			yyVAL.statement = TransactionControl{BaseExpr: NewBaseExpr(yyDollar[1].token), Token: yyDollar[1].token.Token}
 		}
-	case 81:
+	case 86:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:591
+//line parser.y:635
 		{
 			yyVAL.statement = TransactionControl{BaseExpr: NewBaseExpr(yyDollar[1].token), Token: yyDollar[1].token.Token}
 		}
-	case 82:
+	case 87:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:597
+//line parser.y:641
 		{
 			yyVAL.statement = CreateTable{Table: yyDollar[3].identifier, Fields: yyDollar[5].queryexprs}
 		}
-	case 83:
+	case 88:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:601
+//line parser.y:645
 		{
 			yyVAL.statement = CreateTable{Table: yyDollar[3].identifier, Fields: yyDollar[5].queryexprs, Query: yyDollar[8].queryexpr}
 		}
-	case 84:
+	case 89:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:605
+//line parser.y:649
 		{
 			yyVAL.statement = CreateTable{Table: yyDollar[3].identifier, Query: yyDollar[5].queryexpr}
 		}
-	case 85:
+	case 90:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:609
+//line parser.y:653
 		{
 			yyVAL.statement = AddColumns{Table: yyDollar[3].queryexpr, Columns: []ColumnDefault{yyDollar[5].columndef}, Position: yyDollar[6].expression}
 		}
-	case 86:
+	case 91:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:613
+//line parser.y:657
 		{
 			yyVAL.statement = AddColumns{Table: yyDollar[3].queryexpr, Columns: yyDollar[6].columndefs, Position: yyDollar[8].expression}
 		}
-	case 87:
+	case 92:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:617
+//line parser.y:661
 		{
 			yyVAL.statement = DropColumns{Table: yyDollar[3].queryexpr, Columns: []QueryExpression{yyDollar[5].queryexpr}}
 		}
-	case 88:
+	case 93:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line parser.y:621
+//line parser.y:665
 		{
 			yyVAL.statement = DropColumns{Table: yyDollar[3].queryexpr, Columns: yyDollar[6].queryexprs}
 		}
-	case 89:
+	case 94:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line parser.y:625
+//line parser.y:669
 		{
 			yyVAL.statement = RenameColumn{Table: yyDollar[3].queryexpr, Old: yyDollar[5].queryexpr, New: yyDollar[7].identifier}
 		}
-	case 90:
+	case 95:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line parser.y:629
+//line parser.y:673
 		{
 			yyVAL.statement = SetTableAttribute{BaseExpr: NewBaseExpr(yyDollar[1].token), Table: yyDollar[3].queryexpr, Attribute: yyDollar[5].identifier, Value: yyDollar[7].identifier}
 		}
-	case 91:
+	case 96:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line parser.y:633
+//line parser.y:677
 		{
 			yyVAL.statement = SetTableAttribute{BaseExpr: NewBaseExpr(yyDollar[1].token), Table: yyDollar[3].queryexpr, Attribute: yyDollar[5].identifier, Value: yyDollar[7].queryexpr}
 		}
-	case 92:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:639
-		{
-			yyVAL.columndef = ColumnDefault{Column: yyDollar[1].identifier}
-		}
-	case 93:
-		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:643
-		{
-			yyVAL.columndef = ColumnDefault{Column: yyDollar[1].identifier, Value: yyDollar[3].queryexpr}
-		}
-	case 94:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:649
-		{
-			yyVAL.columndefs = []ColumnDefault{yyDollar[1].columndef}
-		}
-	case 95:
-		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:653
-		{
-			yyVAL.columndefs = append([]ColumnDefault{yyDollar[1].columndef}, yyDollar[3].columndefs...)
-		}
-	case 96:
-		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:659
-		{
-			yyVAL.expression = nil
-		}
 	case 97:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:663
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line parser.y:681
 		{
-			yyVAL.expression = ColumnPosition{Position: yyDollar[1].token}
+			yyVAL.statement = SetTableAttribute{BaseExpr: NewBaseExpr(yyDollar[1].token), Table: yyDollar[3].queryexpr, Attribute: yyDollar[5].identifier, Value: Identifier{BaseExpr: NewBaseExpr(yyDollar[7].token), Literal: yyDollar[7].token.Literal}}
 		}
 	case 98:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:667
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line parser.y:685
 		{
-			yyVAL.expression = ColumnPosition{Position: yyDollar[1].token}
+			yyVAL.statement = CreateIndex{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[3].identifier, Table: yyDollar[5].identifier, Columns: yyDollar[7].queryexprs}
 		}
 	case 99:
-		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:671
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:689
 		{
-			yyVAL.expression = ColumnPosition{Position: yyDollar[1].token, Column: yyDollar[2].queryexpr}
+			yyVAL.statement = DropIndex{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[3].identifier, Table: yyDollar[5].identifier}
 		}
 	case 100:
-		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:675
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:695
 		{
-			yyVAL.expression = ColumnPosition{Position: yyDollar[1].token, Column: yyDollar[2].queryexpr}
+			yyVAL.queryexpr = yyDollar[1].identifier
 		}
 	case 101:
-		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:681
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:699
 		{
-			yyVAL.statement = CursorDeclaration{Cursor: yyDollar[2].identifier, Query: yyDollar[5].queryexpr.(SelectQuery)}
+			yyVAL.queryexpr = ColumnDefinition{BaseExpr: yyDollar[1].identifier.BaseExpr, Column: yyDollar[1].identifier, Type: yyDollar[2].identifier}
 		}
 	case 102:
-		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:685
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:703
 		{
-			yyVAL.statement = OpenCursor{Cursor: yyDollar[2].identifier}
+			yyVAL.queryexpr = ColumnDefinition{BaseExpr: yyDollar[1].identifier.BaseExpr, Column: yyDollar[1].identifier, Type: yyDollar[2].identifier, NotNull: true}
 		}
 	case 103:
-		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:689
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:707
 		{
-			yyVAL.statement = CloseCursor{Cursor: yyDollar[2].identifier}
+			yyVAL.queryexpr = ColumnDefinition{BaseExpr: yyDollar[1].identifier.BaseExpr, Column: yyDollar[1].identifier, NotNull: true}
 		}
 	case 104:
-		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:693
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:713
 		{
-			yyVAL.statement = DisposeCursor{Cursor: yyDollar[3].identifier}
+			yyVAL.queryexprs = []QueryExpression{yyDollar[1].queryexpr}
 		}
 	case 105:
-		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:697
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:717
 		{
-			yyVAL.statement = FetchCursor{Position: yyDollar[2].fetchpos, Cursor: yyDollar[3].identifier, Variables: yyDollar[5].variables}
+			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].queryexpr}, yyDollar[3].queryexprs...)
 		}
 	case 106:
-		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:703
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:723
 		{
-			yyVAL.statement = ViewDeclaration{View: yyDollar[2].identifier, Fields: yyDollar[5].queryexprs}
+			yyVAL.columndef = ColumnDefault{Column: yyDollar[1].identifier}
 		}
 	case 107:
-		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:707
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:727
 		{
-			yyVAL.statement = ViewDeclaration{View: yyDollar[2].identifier, Fields: yyDollar[5].queryexprs, Query: yyDollar[8].queryexpr}
+			yyVAL.columndef = ColumnDefault{BaseExpr: NewBaseExpr(yyDollar[2].token), Column: yyDollar[1].identifier, Value: yyDollar[3].queryexpr}
 		}
 	case 108:
-		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:711
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:731
 		{
-			yyVAL.statement = ViewDeclaration{View: yyDollar[2].identifier, Query: yyDollar[5].queryexpr}
+			yyVAL.columndef = ColumnDefault{Column: yyDollar[1].identifier, Type: yyDollar[2].identifier}
 		}
 	case 109:
-		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:715
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:735
 		{
-			yyVAL.statement = DisposeView{View: yyDollar[3].identifier}
+			yyVAL.columndef = ColumnDefault{BaseExpr: NewBaseExpr(yyDollar[3].token), Column: yyDollar[1].identifier, Type: yyDollar[2].identifier, Value: yyDollar[4].queryexpr}
 		}
 	case 110:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:721
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:739
 		{
-			yyVAL.varassign = VariableAssignment{Variable: yyDollar[1].variable}
+			yyVAL.columndef = ColumnDefault{Column: yyDollar[1].identifier, Type: yyDollar[2].identifier, NotNull: true}
 		}
 	case 111:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:727
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:743
 		{
-			yyVAL.varassigns = []VariableAssignment{yyDollar[1].varassign}
+			yyVAL.columndef = ColumnDefault{BaseExpr: NewBaseExpr(yyDollar[5].token), Column: yyDollar[1].identifier, Type: yyDollar[2].identifier, NotNull: true, Value: yyDollar[6].queryexpr}
 		}
 	case 112:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:731
+//line parser.y:747
 		{
-			yyVAL.varassigns = append(yyDollar[1].varassigns, yyDollar[3].varassign)
+			yyVAL.columndef = ColumnDefault{Column: yyDollar[1].identifier, NotNull: true}
 		}
 	case 113:
-		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:737
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:751
 		{
-			yyVAL.varassign = VariableAssignment{Variable: yyDollar[1].variable, Value: yyDollar[3].queryexpr}
+			yyVAL.columndef = ColumnDefault{BaseExpr: NewBaseExpr(yyDollar[4].token), Column: yyDollar[1].identifier, NotNull: true, Value: yyDollar[5].queryexpr}
 		}
 	case 114:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:743
+//line parser.y:757
 		{
-			yyVAL.varassigns = []VariableAssignment{yyDollar[1].varassign}
+			yyVAL.columndefs = []ColumnDefault{yyDollar[1].columndef}
 		}
 	case 115:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:747
+//line parser.y:761
 		{
-			yyVAL.varassigns = append([]VariableAssignment{yyDollar[1].varassign}, yyDollar[3].varassigns...)
+			yyVAL.columndefs = append([]ColumnDefault{yyDollar[1].columndef}, yyDollar[3].columndefs...)
 		}
 	case 116:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:753
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:767
 		{
-			yyVAL.varassigns = yyDollar[1].varassigns
+			yyVAL.expression = nil
 		}
 	case 117:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:757
+//line parser.y:771
 		{
-			yyVAL.varassigns = yyDollar[1].varassigns
+			yyVAL.expression = ColumnPosition{Position: yyDollar[1].token}
 		}
 	case 118:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:775
+		{
+			yyVAL.expression = ColumnPosition{Position: yyDollar[1].token}
+		}
+	case 119:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:779
+		{
+			yyVAL.expression = ColumnPosition{Position: yyDollar[1].token, Column: yyDollar[2].queryexpr}
+		}
+	case 120:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:783
+		{
+			yyVAL.expression = ColumnPosition{Position: yyDollar[1].token, Column: yyDollar[2].queryexpr}
+		}
+	case 121:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:789
+		{
+			yyVAL.statement = CursorDeclaration{Cursor: yyDollar[2].identifier, Query: yyDollar[5].queryexpr.(SelectQuery)}
+		}
+	case 122:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:793
+		{
+			yyVAL.statement = OpenCursor{Cursor: yyDollar[2].identifier}
+		}
+	case 123:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:797
+		{
+			yyVAL.statement = CloseCursor{Cursor: yyDollar[2].identifier}
+		}
+	case 124:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:801
+		{
+			yyVAL.statement = DisposeCursor{Cursor: yyDollar[3].identifier}
+		}
+	case 125:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:805
+		{
+			yyVAL.statement = FetchCursor{Position: yyDollar[2].fetchpos, Cursor: yyDollar[3].identifier, Variables: yyDollar[5].variables}
+		}
+	case 126:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:811
+		{
+			yyVAL.statement = ViewDeclaration{View: yyDollar[2].identifier, Fields: yyDollar[5].queryexprs}
+		}
+	case 127:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line parser.y:815
+		{
+			yyVAL.statement = ViewDeclaration{View: yyDollar[2].identifier, Fields: yyDollar[5].queryexprs, Query: yyDollar[8].queryexpr}
+		}
+	case 128:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:819
+		{
+			yyVAL.statement = ViewDeclaration{View: yyDollar[2].identifier, Query: yyDollar[5].queryexpr}
+		}
+	case 129:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:823
+		{
+			yyVAL.statement = DisposeView{View: yyDollar[3].identifier}
+		}
+	case 130:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:829
+		{
+			yyVAL.varassign = VariableAssignment{Variable: yyDollar[1].variable}
+		}
+	case 131:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:835
+		{
+			yyVAL.varassigns = []VariableAssignment{yyDollar[1].varassign}
+		}
+	case 132:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:839
+		{
+			yyVAL.varassigns = append(yyDollar[1].varassigns, yyDollar[3].varassign)
+		}
+	case 133:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:845
+		{
+			yyVAL.varassign = VariableAssignment{Variable: yyDollar[1].variable, Value: yyDollar[3].queryexpr}
+		}
+	case 134:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:851
+		{
+			yyVAL.varassigns = []VariableAssignment{yyDollar[1].varassign}
+		}
+	case 135:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:855
+		{
+			yyVAL.varassigns = append([]VariableAssignment{yyDollar[1].varassign}, yyDollar[3].varassigns...)
+		}
+	case 136:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:861
+		{
+			yyVAL.varassigns = yyDollar[1].varassigns
+		}
+	case 137:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:865
+		{
+			yyVAL.varassigns = yyDollar[1].varassigns
+		}
+	case 138:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:761
+//line parser.y:869
 		{
 			yyVAL.varassigns = append(yyDollar[1].varassigns, yyDollar[3].varassigns...)
 		}
-	case 119:
+	case 139:
 		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:767
+//line parser.y:875
 		{
 			yyVAL.statement = FunctionDeclaration{Name: yyDollar[2].identifier, Statements: yyDollar[8].program}
 		}
-	case 120:
+	case 140:
 		yyDollar = yyS[yypt-10 : yypt+1]
-		//line parser.y:771
+//line parser.y:879
 		{
 			yyVAL.statement = FunctionDeclaration{Name: yyDollar[2].identifier, Parameters: yyDollar[5].varassigns, Statements: yyDollar[9].program}
 		}
-	case 121:
+	case 141:
 		yyDollar = yyS[yypt-10 : yypt+1]
-		//line parser.y:775
+//line parser.y:883
 		{
 			yyVAL.statement = AggregateDeclaration{Name: yyDollar[2].identifier, Cursor: yyDollar[5].identifier, Statements: yyDollar[9].program}
 		}
-	case 122:
+	case 142:
 		yyDollar = yyS[yypt-12 : yypt+1]
-		//line parser.y:779
+//line parser.y:887
 		{
 			yyVAL.statement = AggregateDeclaration{Name: yyDollar[2].identifier, Cursor: yyDollar[5].identifier, Parameters: yyDollar[7].varassigns, Statements: yyDollar[11].program}
 		}
-	case 123:
+	case 143:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:783
+//line parser.y:891
 		{
 			yyVAL.statement = DisposeFunction{Name: yyDollar[3].identifier}
 		}
-	case 124:
+	case 144:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:789
+//line parser.y:897
 		{
 			yyVAL.fetchpos = FetchPosition{}
 		}
-	case 125:
+	case 145:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:793
+//line parser.y:901
 		{
 			yyVAL.fetchpos = FetchPosition{Position: yyDollar[1].token}
 		}
-	case 126:
+	case 146:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:797
+//line parser.y:905
 		{
 			yyVAL.fetchpos = FetchPosition{Position: yyDollar[1].token}
 		}
-	case 127:
+	case 147:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:801
+//line parser.y:909
 		{
 			yyVAL.fetchpos = FetchPosition{Position: yyDollar[1].token}
 		}
-	case 128:
+	case 148:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:805
+//line parser.y:913
 		{
 			yyVAL.fetchpos = FetchPosition{Position: yyDollar[1].token}
 		}
-	case 129:
+	case 149:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:809
+//line parser.y:917
 		{
 			yyVAL.fetchpos = FetchPosition{BaseExpr: NewBaseExpr(yyDollar[1].token), Position: yyDollar[1].token, Number: yyDollar[2].queryexpr}
 		}
-	case 130:
+	case 150:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:813
+//line parser.y:921
 		{
 			yyVAL.fetchpos = FetchPosition{BaseExpr: NewBaseExpr(yyDollar[1].token), Position: yyDollar[1].token, Number: yyDollar[2].queryexpr}
 		}
-	case 131:
+	case 151:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:819
+//line parser.y:927
 		{
 			yyVAL.queryexpr = CursorStatus{CursorLit: yyDollar[1].token.Literal, Cursor: yyDollar[2].identifier, Is: yyDollar[3].token.Literal, Negation: yyDollar[4].token, Type: yyDollar[5].token.Token, TypeLit: yyDollar[5].token.Literal}
 		}
-	case 132:
+	case 152:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:823
+//line parser.y:931
 		{
 			yyVAL.queryexpr = CursorStatus{CursorLit: yyDollar[1].token.Literal, Cursor: yyDollar[2].identifier, Is: yyDollar[3].token.Literal, Negation: yyDollar[4].token, Type: yyDollar[6].token.Token, TypeLit: yyDollar[5].token.Literal + " " + yyDollar[6].token.Literal}
 		}
-	case 133:
+	case 153:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:827
+//line parser.y:935
 		{
 			yyVAL.queryexpr = CursorAttrebute{CursorLit: yyDollar[1].token.Literal, Cursor: yyDollar[2].identifier, Attrebute: yyDollar[3].token}
 		}
-	case 134:
+	case 154:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:833
+//line parser.y:941
 		{
 			yyVAL.statement = SetFlag{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[2].token.Literal, Value: yyDollar[4].identifier}
 		}
-	case 135:
+	case 155:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:837
+//line parser.y:945
 		{
 			yyVAL.statement = SetFlag{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[2].token.Literal, Value: yyDollar[4].queryexpr}
 		}
-	case 136:
+	case 156:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:949
+		{
+			yyVAL.statement = SetFlag{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[2].token.Literal, Value: Identifier{BaseExpr: NewBaseExpr(yyDollar[4].token), Literal: yyDollar[4].token.Literal}}
+		}
+	case 157:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:841
+//line parser.y:953
 		{
 			yyVAL.statement = SetFlag{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[2].token.Literal, Value: yyDollar[4].identifier}
 		}
-	case 137:
+	case 158:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:845
+//line parser.y:957
 		{
 			yyVAL.statement = SetFlag{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[2].token.Literal, Value: yyDollar[4].queryexpr}
 		}
-	case 138:
+	case 159:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:961
+		{
+			yyVAL.statement = SetFlag{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[2].token.Literal, Value: Identifier{BaseExpr: NewBaseExpr(yyDollar[4].token), Literal: yyDollar[4].token.Literal}}
+		}
+	case 160:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:849
+//line parser.y:965
 		{
 			yyVAL.statement = AddFlagElement{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[4].token.Literal, Value: yyDollar[2].queryexpr}
 		}
-	case 139:
+	case 161:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:853
+//line parser.y:969
 		{
 			yyVAL.statement = RemoveFlagElement{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[4].token.Literal, Value: yyDollar[2].queryexpr}
 		}
-	case 140:
+	case 162:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:857
+//line parser.y:973
 		{
 			yyVAL.statement = ShowFlag{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[2].token.Literal}
 		}
-	case 141:
+	case 163:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:977
+		{
+			yyVAL.statement = Explain{BaseExpr: NewBaseExpr(yyDollar[1].token), Query: yyDollar[2].queryexpr.(SelectQuery)}
+		}
+	case 164:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:981
+		{
+			yyVAL.statement = Explain{BaseExpr: NewBaseExpr(yyDollar[1].token), Analyze: true, Query: yyDollar[3].queryexpr.(SelectQuery)}
+		}
+	case 165:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:861
+//line parser.y:985
 		{
 			yyVAL.statement = Echo{Value: yyDollar[2].queryexpr}
 		}
-	case 142:
+	case 166:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:865
+//line parser.y:989
 		{
 			yyVAL.statement = Print{Value: yyDollar[2].queryexpr}
 		}
-	case 143:
+	case 167:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:869
+//line parser.y:993
 		{
 			yyVAL.statement = Printf{BaseExpr: NewBaseExpr(yyDollar[1].token), Format: yyDollar[2].queryexpr}
 		}
-	case 144:
+	case 168:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:873
+//line parser.y:997
 		{
 			yyVAL.statement = Printf{BaseExpr: NewBaseExpr(yyDollar[1].token), Format: yyDollar[2].queryexpr, Values: yyDollar[4].queryexprs}
 		}
-	case 145:
+	case 169:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:877
+//line parser.y:1001
 		{
 			yyVAL.statement = Printf{BaseExpr: NewBaseExpr(yyDollar[1].token), Format: yyDollar[2].queryexpr, Values: yyDollar[4].queryexprs}
 		}
-	case 146:
+	case 170:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:881
+//line parser.y:1005
 		{
 			yyVAL.statement = Source{BaseExpr: NewBaseExpr(yyDollar[1].token), FilePath: yyDollar[2].identifier}
 		}
-	case 147:
+	case 171:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:885
+//line parser.y:1009
 		{
 			yyVAL.statement = Source{BaseExpr: NewBaseExpr(yyDollar[1].token), FilePath: yyDollar[2].queryexpr}
 		}
-	case 148:
+	case 172:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:889
+//line parser.y:1013
 		{
 			yyVAL.statement = Execute{BaseExpr: NewBaseExpr(yyDollar[1].token), Statements: yyDollar[2].queryexpr}
 		}
-	case 149:
+	case 173:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:893
+//line parser.y:1017
 		{
 			yyVAL.statement = Execute{BaseExpr: NewBaseExpr(yyDollar[1].token), Statements: yyDollar[2].queryexpr, Values: yyDollar[4].queryexprs}
 		}
-	case 150:
+	case 174:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:897
+//line parser.y:1021
 		{
 			yyVAL.statement = Syntax{BaseExpr: NewBaseExpr(yyDollar[1].token)}
 		}
-	case 151:
+	case 175:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:901
+//line parser.y:1025
 		{
 			yyVAL.statement = Syntax{BaseExpr: NewBaseExpr(yyDollar[1].token), Keywords: yyDollar[2].queryexprs}
 		}
-	case 152:
+	case 176:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:905
+//line parser.y:1029
 		{
 			yyVAL.statement = ShowObjects{BaseExpr: NewBaseExpr(yyDollar[1].token), Type: yyDollar[2].identifier}
 		}
-	case 153:
+	case 177:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:909
+//line parser.y:1033
 		{
 			yyVAL.statement = ShowFields{BaseExpr: NewBaseExpr(yyDollar[1].token), Type: yyDollar[2].identifier, Table: yyDollar[4].identifier}
 		}
-	case 154:
+	case 178:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:913
+//line parser.y:1037
 		{
 			yyVAL.statement = Chdir{BaseExpr: NewBaseExpr(yyDollar[1].token), DirPath: yyDollar[2].identifier}
 		}
-	case 155:
+	case 179:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:917
+//line parser.y:1041
 		{
 			yyVAL.statement = Chdir{BaseExpr: NewBaseExpr(yyDollar[1].token), DirPath: yyDollar[2].queryexpr}
 		}
-	case 156:
+	case 180:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:921
+//line parser.y:1045
 		{
 			yyVAL.statement = Pwd{BaseExpr: NewBaseExpr(yyDollar[1].token)}
 		}
-	case 157:
+	case 181:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:925
+//line parser.y:1049
 		{
 			yyVAL.statement = Reload{BaseExpr: NewBaseExpr(yyDollar[1].token), Type: yyDollar[2].identifier}
 		}
-	case 158:
+	case 182:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:931
+//line parser.y:1055
 		{
 			yyVAL.statement = Trigger{BaseExpr: NewBaseExpr(yyDollar[1].token), Event: yyDollar[2].identifier}
 		}
-	case 159:
+	case 183:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:935
+//line parser.y:1059
 		{
 			yyVAL.statement = Trigger{BaseExpr: NewBaseExpr(yyDollar[1].token), Event: yyDollar[2].identifier, Message: yyDollar[3].queryexpr}
 		}
-	case 160:
+	case 184:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:939
+//line parser.y:1063
 		{
 			yyVAL.statement = Trigger{BaseExpr: NewBaseExpr(yyDollar[1].token), Event: yyDollar[2].identifier, Message: yyDollar[4].queryexpr, Code: value.NewIntegerFromString(yyDollar[3].token.Literal)}
 		}
-	case 161:
+	case 185:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:1067
+		{
+			yyVAL.statement = Raise{BaseExpr: NewBaseExpr(yyDollar[1].token)}
+		}
+	case 186:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:945
+//line parser.y:1073
 		{
 			yyVAL.queryexpr = SelectQuery{
 				WithClause:    yyDollar[1].queryexpr,
@@ -2791,9 +3284,9 @@ yydefault:
 				OffsetClause:  yyDollar[5].queryexpr,
 			}
 		}
-	case 162:
-		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:957
+	case 187:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:1085
 		{
 			yyVAL.queryexpr = SelectEntity{
 				SelectClause:  yyDollar[1].queryexpr,
@@ -2801,11 +3294,12 @@ yydefault:
 				WhereClause:   yyDollar[3].queryexpr,
 				GroupByClause: yyDollar[4].queryexpr,
 				HavingClause:  yyDollar[5].queryexpr,
+				WindowClause:  yyDollar[6].queryexpr,
 			}
 		}
-	case 163:
+	case 188:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:967
+//line parser.y:1096
 		{
 			yyVAL.queryexpr = SelectSet{
 				LHS:      yyDollar[1].queryexpr,
@@ -2814,9 +3308,9 @@ yydefault:
 				RHS:      yyDollar[4].queryexpr,
 			}
 		}
-	case 164:
+	case 189:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:976
+//line parser.y:1105
 		{
 			yyVAL.queryexpr = SelectSet{
 				LHS:      yyDollar[1].queryexpr,
@@ -2825,9 +3319,9 @@ yydefault:
 				RHS:      yyDollar[4].queryexpr,
 			}
 		}
-	case 165:
+	case 190:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:985
+//line parser.y:1114
 		{
 			yyVAL.queryexpr = SelectSet{
 				LHS:      yyDollar[1].queryexpr,
@@ -2836,447 +3330,531 @@ yydefault:
 				RHS:      yyDollar[4].queryexpr,
 			}
 		}
-	case 166:
+	case 191:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:996
+//line parser.y:1125
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 167:
+	case 192:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1000
+//line parser.y:1129
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 168:
+	case 193:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1006
+//line parser.y:1135
 		{
 			yyVAL.queryexpr = SelectClause{BaseExpr: NewBaseExpr(yyDollar[1].token), Select: yyDollar[1].token.Literal, Distinct: yyDollar[2].token, Fields: yyDollar[3].queryexprs}
 		}
-	case 169:
+	case 194:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1012
+//line parser.y:1141
 		{
 			yyVAL.queryexpr = nil
 		}
-	case 170:
+	case 195:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1016
+//line parser.y:1145
 		{
 			yyVAL.queryexpr = FromClause{From: yyDollar[1].token.Literal, Tables: yyDollar[2].queryexprs}
 		}
-	case 171:
+	case 196:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1022
+//line parser.y:1151
 		{
 			yyVAL.queryexpr = nil
 		}
-	case 172:
+	case 197:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1026
+//line parser.y:1155
 		{
 			yyVAL.queryexpr = WhereClause{Where: yyDollar[1].token.Literal, Filter: yyDollar[2].queryexpr}
 		}
-	case 173:
+	case 198:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1032
+//line parser.y:1161
 		{
 			yyVAL.queryexpr = nil
 		}
-	case 174:
+	case 199:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1036
+//line parser.y:1165
 		{
 			yyVAL.queryexpr = GroupByClause{GroupBy: yyDollar[1].token.Literal + " " + yyDollar[2].token.Literal, Items: yyDollar[3].queryexprs}
 		}
-	case 175:
-		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1042
+	case 200:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:1171
 		{
-			yyVAL.queryexpr = nil
+			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 176:
+	case 201:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1046
-		{
-			yyVAL.queryexpr = HavingClause{Having: yyDollar[1].token.Literal, Filter: yyDollar[2].queryexpr}
-		}
-	case 177:
-		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1052
+//line parser.y:1175
 		{
-			yyVAL.queryexpr = nil
+			yyVAL.queryexpr = GroupingSet{BaseExpr: NewBaseExpr(yyDollar[1].token)}
 		}
-	case 178:
+	case 202:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1056
+//line parser.y:1179
 		{
-			yyVAL.queryexpr = OrderByClause{OrderBy: yyDollar[1].token.Literal + " " + yyDollar[2].token.Literal, Items: yyDollar[3].queryexprs}
+			yyVAL.queryexpr = GroupingSet{BaseExpr: NewBaseExpr(yyDollar[1].token), Values: yyDollar[2].queryexprs}
 		}
-	case 179:
+	case 203:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:1183
+		{
+			yyVAL.queryexpr = Rollup{BaseExpr: NewBaseExpr(yyDollar[1].token), Rollup: yyDollar[1].token.Literal, Values: yyDollar[3].queryexprs}
+		}
+	case 204:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:1187
+		{
+			yyVAL.queryexpr = Cube{BaseExpr: NewBaseExpr(yyDollar[1].token), Cube: yyDollar[1].token.Literal, Values: yyDollar[3].queryexprs}
+		}
+	case 205:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:1191
+		{
+			yyVAL.queryexpr = GroupingSets{BaseExpr: NewBaseExpr(yyDollar[1].token), GroupingSets: yyDollar[1].token.Literal + " " + yyDollar[2].token.Literal, Sets: yyDollar[4].queryexprs}
+		}
+	case 206:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:1197
+		{
+			yyVAL.queryexprs = []QueryExpression{yyDollar[1].queryexpr}
+		}
+	case 207:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1201
+		{
+			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].queryexpr}, yyDollar[3].queryexprs...)
+		}
+	case 208:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1062
+//line parser.y:1207
 		{
 			yyVAL.queryexpr = nil
 		}
-	case 180:
+	case 209:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:1211
+		{
+			yyVAL.queryexpr = HavingClause{Having: yyDollar[1].token.Literal, Filter: yyDollar[2].queryexpr}
+		}
+	case 210:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:1217
+		{
+			yyVAL.queryexpr = nil
+		}
+	case 211:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:1221
+		{
+			yyVAL.queryexpr = WindowClause{Window: yyDollar[1].token.Literal, Definitions: yyDollar[2].queryexprs}
+		}
+	case 212:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:1227
+		{
+			yyVAL.queryexprs = []QueryExpression{yyDollar[1].queryexpr}
+		}
+	case 213:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1231
+		{
+			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].queryexpr}, yyDollar[3].queryexprs...)
+		}
+	case 214:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:1237
+		{
+			yyVAL.queryexpr = WindowDefinition{BaseExpr: yyDollar[1].identifier.BaseExpr, Name: yyDollar[1].identifier, As: yyDollar[2].token.Literal, Clause: yyDollar[4].queryexpr.(AnalyticClause)}
+		}
+	case 215:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:1243
+		{
+			yyVAL.queryexpr = nil
+		}
+	case 216:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1247
+		{
+			yyVAL.queryexpr = OrderByClause{OrderBy: yyDollar[1].token.Literal + " " + yyDollar[2].token.Literal, Items: yyDollar[3].queryexprs}
+		}
+	case 217:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:1253
+		{
+			yyVAL.queryexpr = nil
+		}
+	case 218:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1066
+//line parser.y:1257
 		{
 			yyVAL.queryexpr = LimitClause{BaseExpr: NewBaseExpr(yyDollar[1].token), Limit: yyDollar[1].token.Literal, Value: yyDollar[2].queryexpr, With: yyDollar[3].queryexpr}
 		}
-	case 181:
+	case 219:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1070
+//line parser.y:1261
 		{
 			yyVAL.queryexpr = LimitClause{BaseExpr: NewBaseExpr(yyDollar[1].token), Limit: yyDollar[1].token.Literal, Value: yyDollar[2].queryexpr, Percent: yyDollar[3].token.Literal, With: yyDollar[4].queryexpr}
 		}
-	case 182:
+	case 220:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1076
+//line parser.y:1267
 		{
 			yyVAL.queryexpr = nil
 		}
-	case 183:
+	case 221:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1080
+//line parser.y:1271
 		{
 			yyVAL.queryexpr = LimitWith{With: yyDollar[1].token.Literal, Type: yyDollar[2].token}
 		}
-	case 184:
+	case 222:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1086
+//line parser.y:1277
 		{
 			yyVAL.queryexpr = nil
 		}
-	case 185:
+	case 223:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1090
+//line parser.y:1281
 		{
 			yyVAL.queryexpr = OffsetClause{BaseExpr: NewBaseExpr(yyDollar[1].token), Offset: yyDollar[1].token.Literal, Value: yyDollar[2].queryexpr}
 		}
-	case 186:
+	case 224:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1096
+//line parser.y:1287
 		{
 			yyVAL.queryexpr = nil
 		}
-	case 187:
+	case 225:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1100
+//line parser.y:1291
 		{
 			yyVAL.queryexpr = WithClause{With: yyDollar[1].token.Literal, InlineTables: yyDollar[2].queryexprs}
 		}
-	case 188:
+	case 226:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:1106
+//line parser.y:1297
 		{
 			yyVAL.queryexpr = InlineTable{Recursive: yyDollar[1].token, Name: yyDollar[2].identifier, As: yyDollar[3].token.Literal, Query: yyDollar[5].queryexpr.(SelectQuery)}
 		}
-	case 189:
+	case 227:
 		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:1110
+//line parser.y:1301
 		{
 			yyVAL.queryexpr = InlineTable{Recursive: yyDollar[1].token, Name: yyDollar[2].identifier, Fields: yyDollar[4].queryexprs, As: yyDollar[6].token.Literal, Query: yyDollar[8].queryexpr.(SelectQuery)}
 		}
-	case 190:
+	case 228:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1116
+//line parser.y:1307
 		{
 			yyVAL.queryexprs = []QueryExpression{yyDollar[1].queryexpr}
 		}
-	case 191:
+	case 229:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1120
+//line parser.y:1311
 		{
 			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].queryexpr}, yyDollar[3].queryexprs...)
 		}
-	case 192:
+	case 230:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1126
+//line parser.y:1317
 		{
 			yyVAL.queryexpr = NewStringValue(yyDollar[1].token.Literal)
 		}
-	case 193:
+	case 231:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1130
+//line parser.y:1321
 		{
 			yyVAL.queryexpr = NewIntegerValueFromString(yyDollar[1].token.Literal)
 		}
-	case 194:
+	case 232:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1134
+//line parser.y:1325
 		{
 			yyVAL.queryexpr = NewFloatValueFromString(yyDollar[1].token.Literal)
 		}
-	case 195:
+	case 233:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1138
+//line parser.y:1329
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 196:
+	case 234:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1142
+//line parser.y:1333
 		{
 			yyVAL.queryexpr = NewDatetimeValueFromString(yyDollar[1].token.Literal)
 		}
-	case 197:
+	case 235:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1146
+//line parser.y:1337
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 198:
+	case 236:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1152
+//line parser.y:1343
 		{
 			yyVAL.queryexpr = NewTernaryValueFromString(yyDollar[1].token.Literal)
 		}
-	case 199:
+	case 237:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1158
+//line parser.y:1349
 		{
 			yyVAL.queryexpr = NewNullValueFromString(yyDollar[1].token.Literal)
 		}
-	case 200:
+	case 238:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1164
+//line parser.y:1355
 		{
 			yyVAL.queryexpr = FieldReference{BaseExpr: yyDollar[1].identifier.BaseExpr, Column: yyDollar[1].identifier}
 		}
-	case 201:
+	case 239:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1168
+//line parser.y:1359
 		{
 			yyVAL.queryexpr = FieldReference{BaseExpr: yyDollar[1].identifier.BaseExpr, View: yyDollar[1].identifier, Column: yyDollar[3].identifier}
 		}
-	case 202:
+	case 240:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1172
+//line parser.y:1363
 		{
 			yyVAL.queryexpr = FieldReference{BaseExpr: NewBaseExpr(yyDollar[1].token), View: Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal}, Column: yyDollar[3].identifier}
 		}
-	case 203:
+	case 241:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1176
+//line parser.y:1367
 		{
 			yyVAL.queryexpr = ColumnNumber{BaseExpr: yyDollar[1].identifier.BaseExpr, View: yyDollar[1].identifier, Number: value.NewIntegerFromString(yyDollar[3].token.Literal)}
 		}
-	case 204:
+	case 242:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1180
+//line parser.y:1371
 		{
 			yyVAL.queryexpr = ColumnNumber{BaseExpr: NewBaseExpr(yyDollar[1].token), View: Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal}, Number: value.NewIntegerFromString(yyDollar[3].token.Literal)}
 		}
-	case 205:
+	case 243:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1186
+//line parser.y:1377
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 206:
+	case 244:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1190
+//line parser.y:1381
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 207:
+	case 245:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1194
+//line parser.y:1385
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 208:
+	case 246:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1198
+//line parser.y:1389
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 209:
+	case 247:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1202
+//line parser.y:1393
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 210:
+	case 248:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1206
+//line parser.y:1397
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 211:
+	case 249:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1210
+//line parser.y:1401
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 212:
+	case 250:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1214
+//line parser.y:1405
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 213:
+	case 251:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1218
+//line parser.y:1409
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 214:
+	case 252:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1222
+//line parser.y:1413
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 215:
+	case 253:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1226
+//line parser.y:1417
 		{
 			yyVAL.queryexpr = yyDollar[1].variable
 		}
-	case 216:
+	case 254:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1230
+//line parser.y:1421
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 217:
+	case 255:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1234
+//line parser.y:1425
 		{
 			yyVAL.queryexpr = yyDollar[1].envvar
 		}
-	case 218:
+	case 256:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1238
+//line parser.y:1429
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 219:
+	case 257:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1242
+//line parser.y:1433
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 220:
+	case 258:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:1437
+		{
+			yyVAL.queryexpr = yyDollar[1].queryexpr
+		}
+	case 259:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1246
+//line parser.y:1441
 		{
 			yyVAL.queryexpr = Parentheses{Expr: yyDollar[2].queryexpr}
 		}
-	case 221:
+	case 260:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1252
+//line parser.y:1447
 		{
 			yyVAL.queryexpr = AllColumns{BaseExpr: NewBaseExpr(yyDollar[1].token)}
 		}
-	case 222:
+	case 261:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1258
+//line parser.y:1453
 		{
 			yyVAL.queryexpr = RowValue{BaseExpr: NewBaseExpr(yyDollar[1].token), Value: ValueList{Values: yyDollar[2].queryexprs}}
 		}
-	case 223:
+	case 262:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1262
+//line parser.y:1457
 		{
 			yyVAL.queryexpr = RowValue{BaseExpr: yyDollar[1].queryexpr.GetBaseExpr(), Value: yyDollar[1].queryexpr}
 		}
-	case 224:
+	case 263:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:1266
+//line parser.y:1461
 		{
 			yyVAL.queryexpr = RowValue{BaseExpr: NewBaseExpr(yyDollar[1].token), Value: JsonQuery{JsonQuery: yyDollar[1].token.Literal, Query: yyDollar[3].queryexpr, JsonText: yyDollar[5].queryexpr}}
 		}
-	case 225:
+	case 264:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1272
+//line parser.y:1467
 		{
 			yyVAL.queryexprs = []QueryExpression{yyDollar[1].queryexpr}
 		}
-	case 226:
+	case 265:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1276
+//line parser.y:1471
 		{
 			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].queryexpr}, yyDollar[3].queryexprs...)
 		}
-	case 227:
+	case 266:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1282
+//line parser.y:1477
 		{
 			yyVAL.queryexprs = []QueryExpression{yyDollar[1].queryexpr}
 		}
-	case 228:
+	case 267:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1286
+//line parser.y:1481
 		{
 			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].queryexpr}, yyDollar[3].queryexprs...)
 		}
-	case 229:
+	case 268:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1292
+//line parser.y:1487
 		{
 			yyVAL.queryexpr = OrderItem{Value: yyDollar[1].queryexpr, Direction: yyDollar[2].token}
 		}
-	case 230:
+	case 269:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1296
+//line parser.y:1491
 		{
 			yyVAL.queryexpr = OrderItem{Value: yyDollar[1].queryexpr, Direction: yyDollar[2].token, Nulls: yyDollar[3].token.Literal, Position: yyDollar[4].token}
 		}
-	case 231:
+	case 270:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1302
+//line parser.y:1497
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 232:
+	case 271:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1306
+//line parser.y:1501
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 233:
+	case 272:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1312
+//line parser.y:1507
 		{
 			yyVAL.token = Token{}
 		}
-	case 234:
+	case 273:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1316
+//line parser.y:1511
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 235:
+	case 274:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1320
+//line parser.y:1515
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 236:
+	case 275:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1326
+//line parser.y:1521
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 237:
+	case 276:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1330
+//line parser.y:1525
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 238:
+	case 277:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1336
+//line parser.y:1531
 		{
 			yyVAL.queryexpr = Subquery{BaseExpr: NewBaseExpr(yyDollar[1].token), Query: yyDollar[2].queryexpr.(SelectQuery)}
 		}
-	case 239:
+	case 278:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1342
+//line parser.y:1537
 		{
 			var item1 []QueryExpression
 			var item2 []QueryExpression
@@ -3297,1203 +3875,1569 @@ yydefault:
 
 			yyVAL.queryexpr = Concat{Items: append(item1, item2...)}
 		}
-	case 240:
+	case 279:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1365
+//line parser.y:1560
 		{
 			yyVAL.queryexpr = RowValueList{RowValues: yyDollar[2].queryexprs}
 		}
-	case 241:
+	case 280:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1369
+//line parser.y:1564
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 242:
+	case 281:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:1373
+//line parser.y:1568
 		{
 			yyVAL.queryexpr = JsonQuery{BaseExpr: NewBaseExpr(yyDollar[1].token), JsonQuery: yyDollar[1].token.Literal, Query: yyDollar[3].queryexpr, JsonText: yyDollar[5].queryexpr}
 		}
-	case 243:
+	case 282:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1379
+//line parser.y:1574
 		{
 			yyVAL.queryexpr = Comparison{LHS: yyDollar[1].queryexpr, Operator: yyDollar[2].token.Literal, RHS: yyDollar[3].queryexpr}
 		}
-	case 244:
+	case 283:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1383
+//line parser.y:1578
 		{
 			yyVAL.queryexpr = Comparison{LHS: yyDollar[1].queryexpr, Operator: yyDollar[2].token.Literal, RHS: yyDollar[3].queryexpr}
 		}
-	case 245:
+	case 284:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1387
+//line parser.y:1582
 		{
 			yyVAL.queryexpr = Comparison{LHS: yyDollar[1].queryexpr, Operator: "=", RHS: yyDollar[3].queryexpr}
 		}
-	case 246:
+	case 285:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1391
+//line parser.y:1586
 		{
 			yyVAL.queryexpr = Comparison{LHS: yyDollar[1].queryexpr, Operator: "=", RHS: yyDollar[3].queryexpr}
 		}
-	case 247:
+	case 286:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1395
+//line parser.y:1590
 		{
 			yyVAL.queryexpr = Is{Is: yyDollar[2].token.Literal, LHS: yyDollar[1].queryexpr, RHS: yyDollar[4].queryexpr, Negation: yyDollar[3].token}
 		}
-	case 248:
+	case 287:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1399
+//line parser.y:1594
 		{
 			yyVAL.queryexpr = Is{Is: yyDollar[2].token.Literal, LHS: yyDollar[1].queryexpr, RHS: yyDollar[4].queryexpr, Negation: yyDollar[3].token}
 		}
-	case 249:
+	case 288:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1403
+//line parser.y:1598
 		{
 			yyVAL.queryexpr = Between{Between: yyDollar[2].token.Literal, And: yyDollar[4].token.Literal, LHS: yyDollar[1].queryexpr, Low: yyDollar[3].queryexpr, High: yyDollar[5].queryexpr}
 		}
-	case 250:
+	case 289:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:1407
+//line parser.y:1602
 		{
 			yyVAL.queryexpr = Between{Between: yyDollar[3].token.Literal, And: yyDollar[5].token.Literal, LHS: yyDollar[1].queryexpr, Low: yyDollar[4].queryexpr, High: yyDollar[6].queryexpr, Negation: yyDollar[2].token}
 		}
-	case 251:
+	case 290:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:1411
+//line parser.y:1606
 		{
 			yyVAL.queryexpr = Between{Between: yyDollar[3].token.Literal, And: yyDollar[5].token.Literal, LHS: yyDollar[1].queryexpr, Low: yyDollar[4].queryexpr, High: yyDollar[6].queryexpr, Negation: yyDollar[2].token}
 		}
-	case 252:
+	case 291:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1415
+//line parser.y:1610
 		{
 			yyVAL.queryexpr = In{In: yyDollar[2].token.Literal, LHS: yyDollar[1].queryexpr, Values: yyDollar[3].queryexpr}
 		}
-	case 253:
+	case 292:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1419
+//line parser.y:1614
 		{
 			yyVAL.queryexpr = In{In: yyDollar[3].token.Literal, LHS: yyDollar[1].queryexpr, Values: yyDollar[4].queryexpr, Negation: yyDollar[2].token}
 		}
-	case 254:
+	case 293:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1423
+//line parser.y:1618
 		{
 			yyVAL.queryexpr = In{In: yyDollar[3].token.Literal, LHS: yyDollar[1].queryexpr, Values: yyDollar[4].queryexpr, Negation: yyDollar[2].token}
 		}
-	case 255:
+	case 294:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1427
+//line parser.y:1622
 		{
 			yyVAL.queryexpr = Like{Like: yyDollar[2].token.Literal, LHS: yyDollar[1].queryexpr, Pattern: yyDollar[3].queryexpr}
 		}
-	case 256:
+	case 295:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1431
+//line parser.y:1626
 		{
 			yyVAL.queryexpr = Like{Like: yyDollar[3].token.Literal, LHS: yyDollar[1].queryexpr, Pattern: yyDollar[4].queryexpr, Negation: yyDollar[2].token}
 		}
-	case 257:
+	case 296:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1630
+		{
+			yyVAL.queryexpr = RegExp{BaseExpr: NewBaseExpr(yyDollar[2].token), RegExp: yyDollar[2].token.Literal, LHS: yyDollar[1].queryexpr, Pattern: yyDollar[3].queryexpr}
+		}
+	case 297:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:1634
+		{
+			yyVAL.queryexpr = RegExp{BaseExpr: NewBaseExpr(yyDollar[3].token), RegExp: yyDollar[3].token.Literal, LHS: yyDollar[1].queryexpr, Pattern: yyDollar[4].queryexpr, Negation: yyDollar[2].token}
+		}
+	case 298:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1435
+//line parser.y:1638
 		{
 			yyVAL.queryexpr = Any{Any: yyDollar[3].token.Literal, LHS: yyDollar[1].queryexpr, Operator: yyDollar[2].token.Literal, Values: yyDollar[4].queryexpr}
 		}
-	case 258:
+	case 299:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1439
+//line parser.y:1642
 		{
 			yyVAL.queryexpr = Any{Any: yyDollar[3].token.Literal, LHS: yyDollar[1].queryexpr, Operator: yyDollar[2].token.Literal, Values: yyDollar[4].queryexpr}
 		}
-	case 259:
+	case 300:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1443
+//line parser.y:1646
 		{
 			yyVAL.queryexpr = All{All: yyDollar[3].token.Literal, LHS: yyDollar[1].queryexpr, Operator: yyDollar[2].token.Literal, Values: yyDollar[4].queryexpr}
 		}
-	case 260:
+	case 301:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1447
+//line parser.y:1650
 		{
 			yyVAL.queryexpr = All{All: yyDollar[3].token.Literal, LHS: yyDollar[1].queryexpr, Operator: yyDollar[2].token.Literal, Values: yyDollar[4].queryexpr}
 		}
-	case 261:
+	case 302:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1451
+//line parser.y:1654
 		{
 			yyVAL.queryexpr = Exists{Exists: yyDollar[1].token.Literal, Query: yyDollar[2].queryexpr.(Subquery)}
 		}
-	case 262:
+	case 303:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1457
+//line parser.y:1660
 		{
 			yyVAL.queryexpr = Arithmetic{LHS: yyDollar[1].queryexpr, Operator: int('+'), RHS: yyDollar[3].queryexpr}
 		}
-	case 263:
+	case 304:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1461
+//line parser.y:1664
 		{
 			yyVAL.queryexpr = Arithmetic{LHS: yyDollar[1].queryexpr, Operator: int('-'), RHS: yyDollar[3].queryexpr}
 		}
-	case 264:
+	case 305:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1465
+//line parser.y:1668
 		{
 			yyVAL.queryexpr = Arithmetic{LHS: yyDollar[1].queryexpr, Operator: int('*'), RHS: yyDollar[3].queryexpr}
 		}
-	case 265:
+	case 306:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1469
+//line parser.y:1672
 		{
 			yyVAL.queryexpr = Arithmetic{LHS: yyDollar[1].queryexpr, Operator: int('/'), RHS: yyDollar[3].queryexpr}
 		}
-	case 266:
+	case 307:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1473
+//line parser.y:1676
 		{
 			yyVAL.queryexpr = Arithmetic{LHS: yyDollar[1].queryexpr, Operator: int('%'), RHS: yyDollar[3].queryexpr}
 		}
-	case 267:
+	case 308:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1477
+//line parser.y:1680
 		{
 			yyVAL.queryexpr = UnaryArithmetic{Operand: yyDollar[2].queryexpr, Operator: yyDollar[1].token}
 		}
-	case 268:
+	case 309:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1481
+//line parser.y:1684
 		{
 			yyVAL.queryexpr = UnaryArithmetic{Operand: yyDollar[2].queryexpr, Operator: yyDollar[1].token}
 		}
-	case 269:
+	case 310:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1487
+//line parser.y:1690
 		{
 			yyVAL.queryexpr = Logic{LHS: yyDollar[1].queryexpr, Operator: yyDollar[2].token, RHS: yyDollar[3].queryexpr}
 		}
-	case 270:
+	case 311:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1491
+//line parser.y:1694
 		{
 			yyVAL.queryexpr = Logic{LHS: yyDollar[1].queryexpr, Operator: yyDollar[2].token, RHS: yyDollar[3].queryexpr}
 		}
-	case 271:
+	case 312:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1495
+//line parser.y:1698
 		{
 			yyVAL.queryexpr = UnaryLogic{Operand: yyDollar[2].queryexpr, Operator: yyDollar[1].token}
 		}
-	case 272:
+	case 313:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1499
+//line parser.y:1702
 		{
 			yyVAL.queryexpr = UnaryLogic{Operand: yyDollar[2].queryexpr, Operator: yyDollar[1].token}
 		}
-	case 273:
+	case 314:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1505
+//line parser.y:1708
 		{
 			yyVAL.queryexprs = nil
 		}
-	case 274:
+	case 315:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1509
+//line parser.y:1712
 		{
 			yyVAL.queryexprs = yyDollar[1].queryexprs
 		}
-	case 275:
+	case 316:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1515
+//line parser.y:1718
 		{
 			yyVAL.queryexpr = Function{BaseExpr: yyDollar[1].identifier.BaseExpr, Name: yyDollar[1].identifier.Literal, Args: yyDollar[3].queryexprs}
 		}
-	case 276:
+	case 317:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1519
+//line parser.y:1722
 		{
 			yyVAL.queryexpr = Function{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal}
 		}
-	case 277:
+	case 318:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1523
+//line parser.y:1726
 		{
 			yyVAL.queryexpr = Function{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: yyDollar[3].queryexprs}
 		}
-	case 278:
+	case 319:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1527
+//line parser.y:1730
 		{
 			yyVAL.queryexpr = Function{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: yyDollar[3].queryexprs}
 		}
-	case 279:
+	case 320:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:1734
+		{
+			yyVAL.queryexpr = Function{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: yyDollar[3].queryexprs}
+		}
+	case 321:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1534
+//line parser.y:1741
 		{
 			yyVAL.queryexpr = AggregateFunction{BaseExpr: yyDollar[1].identifier.BaseExpr, Name: yyDollar[1].identifier.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs}
 		}
-	case 280:
+	case 322:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1538
+//line parser.y:1745
 		{
 			yyVAL.queryexpr = AggregateFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs}
 		}
-	case 281:
+	case 323:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1542
+//line parser.y:1749
 		{
 			yyVAL.queryexpr = AggregateFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs}
 		}
-	case 282:
+	case 324:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1546
+//line parser.y:1753
 		{
 			yyVAL.queryexpr = AggregateFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: []QueryExpression{yyDollar[4].queryexpr}}
 		}
-	case 283:
+	case 325:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1550
+//line parser.y:1757
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 284:
+	case 326:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1556
+//line parser.y:1763
 		{
 			yyVAL.queryexpr = ListFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs}
 		}
-	case 285:
+	case 327:
 		yyDollar = yyS[yypt-10 : yypt+1]
-		//line parser.y:1560
+//line parser.y:1767
 		{
 			yyVAL.queryexpr = ListFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs, WithinGroup: yyDollar[6].token.Literal + " " + yyDollar[7].token.Literal, OrderBy: yyDollar[9].queryexpr}
 		}
-	case 286:
-		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:1566
+	case 328:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:1773
 		{
-			yyVAL.queryexpr = AnalyticFunction{BaseExpr: yyDollar[1].identifier.BaseExpr, Name: yyDollar[1].identifier.Literal, Args: yyDollar[3].queryexprs, Over: yyDollar[5].token.Literal, AnalyticClause: yyDollar[7].queryexpr.(AnalyticClause)}
+			yyVAL.queryexpr = AnalyticFunction{BaseExpr: yyDollar[1].identifier.BaseExpr, Name: yyDollar[1].identifier.Literal, Args: yyDollar[3].queryexprs, Over: yyDollar[5].token.Literal, AnalyticClause: yyDollar[6].queryexpr.(AnalyticClause)}
 		}
-	case 287:
-		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:1570
+	case 329:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line parser.y:1777
 		{
-			yyVAL.queryexpr = AnalyticFunction{BaseExpr: yyDollar[1].identifier.BaseExpr, Name: yyDollar[1].identifier.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs, Over: yyDollar[6].token.Literal, AnalyticClause: yyDollar[8].queryexpr.(AnalyticClause)}
+			yyVAL.queryexpr = AnalyticFunction{BaseExpr: yyDollar[1].identifier.BaseExpr, Name: yyDollar[1].identifier.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs, Over: yyDollar[6].token.Literal, AnalyticClause: yyDollar[7].queryexpr.(AnalyticClause)}
 		}
-	case 288:
-		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:1574
+	case 330:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line parser.y:1781
 		{
-			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs, Over: yyDollar[6].token.Literal, AnalyticClause: yyDollar[8].queryexpr.(AnalyticClause)}
+			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs, Over: yyDollar[6].token.Literal, AnalyticClause: yyDollar[7].queryexpr.(AnalyticClause)}
 		}
-	case 289:
-		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:1578
+	case 331:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line parser.y:1785
 		{
-			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs, Over: yyDollar[6].token.Literal, AnalyticClause: yyDollar[8].queryexpr.(AnalyticClause)}
+			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs, Over: yyDollar[6].token.Literal, AnalyticClause: yyDollar[7].queryexpr.(AnalyticClause)}
 		}
-	case 290:
-		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:1582
+	case 332:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line parser.y:1789
 		{
-			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: []QueryExpression{yyDollar[4].queryexpr}, Over: yyDollar[6].token.Literal, AnalyticClause: yyDollar[8].queryexpr.(AnalyticClause)}
+			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: []QueryExpression{yyDollar[4].queryexpr}, Over: yyDollar[6].token.Literal, AnalyticClause: yyDollar[7].queryexpr.(AnalyticClause)}
 		}
-	case 291:
-		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:1586
+	case 333:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line parser.y:1793
 		{
-			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs, Over: yyDollar[6].token.Literal, AnalyticClause: yyDollar[8].queryexpr.(AnalyticClause)}
+			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs, Over: yyDollar[6].token.Literal, AnalyticClause: yyDollar[7].queryexpr.(AnalyticClause)}
 		}
-	case 292:
-		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:1590
+	case 334:
+		yyDollar = yyS[yypt-12 : yypt+1]
+//line parser.y:1797
 		{
-			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: yyDollar[3].queryexprs, Over: yyDollar[5].token.Literal, AnalyticClause: yyDollar[7].queryexpr.(AnalyticClause)}
+			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs, WithinGroup: yyDollar[6].token.Literal + " " + yyDollar[7].token.Literal, OrderBy: yyDollar[9].queryexpr, Over: yyDollar[11].token.Literal, AnalyticClause: yyDollar[12].queryexpr.(AnalyticClause)}
 		}
-	case 293:
-		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:1594
+	case 335:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:1801
 		{
-			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: yyDollar[3].queryexprs, Over: yyDollar[5].token.Literal, AnalyticClause: yyDollar[7].queryexpr.(AnalyticClause)}
+			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: yyDollar[3].queryexprs, Over: yyDollar[5].token.Literal, AnalyticClause: yyDollar[6].queryexpr.(AnalyticClause)}
 		}
-	case 294:
-		yyDollar = yyS[yypt-10 : yypt+1]
-		//line parser.y:1598
+	case 336:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:1805
 		{
-			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: yyDollar[3].queryexprs, IgnoreNulls: true, IgnoreNullsLit: yyDollar[5].token.Literal + " " + yyDollar[6].token.Literal, Over: yyDollar[7].token.Literal, AnalyticClause: yyDollar[9].queryexpr.(AnalyticClause)}
+			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: yyDollar[3].queryexprs, Over: yyDollar[5].token.Literal, AnalyticClause: yyDollar[6].queryexpr.(AnalyticClause)}
 		}
-	case 295:
+	case 337:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:1602
+//line parser.y:1809
 		{
-			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: yyDollar[3].queryexprs, Over: yyDollar[5].token.Literal, AnalyticClause: yyDollar[7].queryexpr.(AnalyticClause)}
+			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: yyDollar[3].queryexprs, IgnoreNulls: true, IgnoreNullsLit: yyDollar[5].token.Literal + " " + yyDollar[6].token.Literal, Over: yyDollar[7].token.Literal, AnalyticClause: yyDollar[8].queryexpr.(AnalyticClause)}
 		}
-	case 296:
-		yyDollar = yyS[yypt-10 : yypt+1]
-		//line parser.y:1606
+	case 338:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:1813
 		{
-			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: yyDollar[3].queryexprs, IgnoreNulls: true, IgnoreNullsLit: yyDollar[5].token.Literal + " " + yyDollar[6].token.Literal, Over: yyDollar[7].token.Literal, AnalyticClause: yyDollar[9].queryexpr.(AnalyticClause)}
+			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: yyDollar[3].queryexprs, Over: yyDollar[5].token.Literal, AnalyticClause: yyDollar[6].queryexpr.(AnalyticClause)}
 		}
-	case 297:
+	case 339:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line parser.y:1817
+		{
+			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: yyDollar[3].queryexprs, IgnoreNulls: true, IgnoreNullsLit: yyDollar[5].token.Literal + " " + yyDollar[6].token.Literal, Over: yyDollar[7].token.Literal, AnalyticClause: yyDollar[8].queryexpr.(AnalyticClause)}
+		}
+	case 340:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1612
+//line parser.y:1823
 		{
 			yyVAL.queryexpr = AnalyticClause{PartitionClause: yyDollar[1].queryexpr, OrderByClause: yyDollar[2].queryexpr}
 		}
-	case 298:
+	case 341:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:1827
+		{
+			yyVAL.queryexpr = AnalyticClause{WindowName: yyDollar[1].identifier, OrderByClause: yyDollar[2].queryexpr}
+		}
+	case 342:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1618
+//line parser.y:1833
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 299:
+	case 343:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1622
+//line parser.y:1837
 		{
 			orderByClause := OrderByClause{OrderBy: yyDollar[2].token.Literal + " " + yyDollar[3].token.Literal, Items: yyDollar[4].queryexprs}
 			yyVAL.queryexpr = AnalyticClause{PartitionClause: yyDollar[1].queryexpr, OrderByClause: orderByClause, WindowingClause: yyDollar[5].queryexpr}
 		}
-	case 300:
+	case 344:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:1842
+		{
+			orderByClause := OrderByClause{OrderBy: yyDollar[2].token.Literal + " " + yyDollar[3].token.Literal, Items: yyDollar[4].queryexprs}
+			yyVAL.queryexpr = AnalyticClause{WindowName: yyDollar[1].identifier, OrderByClause: orderByClause, WindowingClause: yyDollar[5].queryexpr}
+		}
+	case 345:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:1847
+		{
+			yyVAL.queryexpr = AnalyticClause{WindowName: yyDollar[1].identifier, WindowingClause: yyDollar[2].queryexpr}
+		}
+	case 346:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1853
+		{
+			yyVAL.queryexpr = yyDollar[2].queryexpr
+		}
+	case 347:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:1857
+		{
+			yyVAL.queryexpr = AnalyticClause{WindowName: yyDollar[1].identifier}
+		}
+	case 348:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1863
+		{
+			yyVAL.queryexpr = yyDollar[2].queryexpr
+		}
+	case 349:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:1867
+		{
+			yyVAL.queryexpr = AnalyticClause{WindowName: yyDollar[1].identifier}
+		}
+	case 350:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1629
+//line parser.y:1873
 		{
 			yyVAL.queryexpr = nil
 		}
-	case 301:
+	case 351:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1633
+//line parser.y:1877
 		{
 			yyVAL.queryexpr = PartitionClause{PartitionBy: yyDollar[1].token.Literal + " " + yyDollar[2].token.Literal, Values: yyDollar[3].queryexprs}
 		}
-	case 302:
+	case 352:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1883
+		{
+			yyVAL.queryexpr = WindowingClause{Unit: yyDollar[1].token, FrameLow: yyDollar[2].queryexpr, Exclude: yyDollar[3].token}
+		}
+	case 353:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:1887
+		{
+			yyVAL.queryexpr = WindowingClause{Unit: yyDollar[1].token, FrameLow: yyDollar[3].queryexpr, FrameHigh: yyDollar[5].queryexpr, Between: yyDollar[2].token.Literal, And: yyDollar[4].token.Literal, Exclude: yyDollar[6].token}
+		}
+	case 354:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:1893
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 355:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:1897
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 356:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:1901
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 357:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:1907
+		{
+			yyVAL.token = Token{}
+		}
+	case 358:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1911
+		{
+			yyDollar[2].token.Literal = yyDollar[1].token.Literal + " " + yyDollar[2].token.Literal + " " + yyDollar[3].token.Literal
+			yyVAL.token = yyDollar[2].token
+		}
+	case 359:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1639
+//line parser.y:1916
 		{
-			yyVAL.queryexpr = WindowingClause{Rows: yyDollar[1].token.Literal, FrameLow: yyDollar[2].queryexpr}
+			yyDollar[2].token.Literal = yyDollar[1].token.Literal + " " + yyDollar[2].token.Literal
+			yyVAL.token = yyDollar[2].token
 		}
-	case 303:
-		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1643
+	case 360:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:1921
 		{
-			yyVAL.queryexpr = WindowingClause{Rows: yyDollar[1].token.Literal, FrameLow: yyDollar[3].queryexpr, FrameHigh: yyDollar[5].queryexpr, Between: yyDollar[2].token.Literal, And: yyDollar[4].token.Literal}
+			yyDollar[2].token.Literal = yyDollar[1].token.Literal + " " + yyDollar[2].token.Literal
+			yyVAL.token = yyDollar[2].token
 		}
-	case 304:
+	case 361:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1926
+		{
+			yyDollar[2].token.Literal = yyDollar[1].token.Literal + " " + yyDollar[2].token.Literal + " " + yyDollar[3].token.Literal
+			yyVAL.token = yyDollar[2].token
+		}
+	case 362:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:1933
+		{
+			yyVAL.queryexpr = WindowFramePosition{Offset: value.NewIntegerFromString(yyDollar[1].token.Literal), Literal: yyDollar[1].token.Literal}
+		}
+	case 363:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:1937
+		{
+			yyVAL.queryexpr = WindowFramePosition{Offset: value.NewFloatFromString(yyDollar[1].token.Literal), Literal: yyDollar[1].token.Literal}
+		}
+	case 364:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1941
+		{
+			yyVAL.queryexpr = WindowFramePosition{Offset: value.NewIntegerFromString(yyDollar[2].token.Literal), IntervalUnit: yyDollar[3].identifier.Literal, Literal: yyDollar[1].token.Literal + " " + yyDollar[2].token.Literal + " " + yyDollar[3].identifier.Literal}
+		}
+	case 365:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1945
+		{
+			yyVAL.queryexpr = WindowFramePosition{Offset: value.NewString(yyDollar[2].token.Literal), IntervalUnit: yyDollar[3].identifier.Literal, Literal: yyDollar[1].token.Literal + " " + value.NewString(yyDollar[2].token.Literal).String() + " " + yyDollar[3].identifier.Literal}
+		}
+	case 366:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1649
+//line parser.y:1951
 		{
 			yyVAL.queryexpr = WindowFramePosition{Direction: yyDollar[2].token.Token, Unbounded: true, Literal: yyDollar[1].token.Literal + " " + yyDollar[2].token.Literal}
 		}
-	case 305:
+	case 367:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1653
+//line parser.y:1955
 		{
-			i, _ := strconv.Atoi(yyDollar[1].token.Literal)
-			yyVAL.queryexpr = WindowFramePosition{Direction: yyDollar[2].token.Token, Offset: i, Literal: yyDollar[1].token.Literal + " " + yyDollar[2].token.Literal}
+			pos := yyDollar[1].queryexpr.(WindowFramePosition)
+			pos.Direction = yyDollar[2].token.Token
+			pos.Literal = pos.Literal + " " + yyDollar[2].token.Literal
+			yyVAL.queryexpr = pos
 		}
-	case 306:
+	case 368:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1658
+//line parser.y:1962
 		{
 			yyVAL.queryexpr = WindowFramePosition{Direction: yyDollar[1].token.Token, Literal: yyDollar[1].token.Literal + " " + yyDollar[2].token.Literal}
 		}
-	case 307:
+	case 369:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1664
+//line parser.y:1968
 		{
-			i, _ := strconv.Atoi(yyDollar[1].token.Literal)
-			yyVAL.queryexpr = WindowFramePosition{Direction: yyDollar[2].token.Token, Offset: i, Literal: yyDollar[1].token.Literal + " " + yyDollar[2].token.Literal}
+			pos := yyDollar[1].queryexpr.(WindowFramePosition)
+			pos.Direction = yyDollar[2].token.Token
+			pos.Literal = pos.Literal + " " + yyDollar[2].token.Literal
+			yyVAL.queryexpr = pos
 		}
-	case 308:
+	case 370:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1669
+//line parser.y:1975
 		{
-			i, _ := strconv.Atoi(yyDollar[1].token.Literal)
-			yyVAL.queryexpr = WindowFramePosition{Direction: yyDollar[2].token.Token, Offset: i, Literal: yyDollar[1].token.Literal + " " + yyDollar[2].token.Literal}
+			pos := yyDollar[1].queryexpr.(WindowFramePosition)
+			pos.Direction = yyDollar[2].token.Token
+			pos.Literal = pos.Literal + " " + yyDollar[2].token.Literal
+			yyVAL.queryexpr = pos
 		}
-	case 309:
+	case 371:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1674
+//line parser.y:1982
 		{
 			yyVAL.queryexpr = WindowFramePosition{Direction: yyDollar[1].token.Token, Literal: yyDollar[1].token.Literal + " " + yyDollar[2].token.Literal}
 		}
-	case 310:
+	case 372:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1680
+//line parser.y:1988
 		{
 			yyVAL.queryexpr = WindowFramePosition{Direction: yyDollar[2].token.Token, Unbounded: true, Literal: yyDollar[1].token.Literal + " " + yyDollar[2].token.Literal}
 		}
-	case 311:
+	case 373:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1684
+//line parser.y:1992
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 312:
+	case 374:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1690
+//line parser.y:1998
 		{
 			yyVAL.queryexpr = WindowFramePosition{Direction: yyDollar[2].token.Token, Unbounded: true, Literal: yyDollar[1].token.Literal + " " + yyDollar[2].token.Literal}
 		}
-	case 313:
+	case 375:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1694
+//line parser.y:2002
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 314:
+	case 376:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1700
+//line parser.y:2008
 		{
 			yyVAL.queryexpr = yyDollar[1].identifier
 		}
-	case 315:
+	case 377:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1704
+//line parser.y:2012
 		{
 			yyVAL.queryexpr = Stdin{BaseExpr: NewBaseExpr(yyDollar[1].token), Stdin: yyDollar[1].token.Literal}
 		}
-	case 316:
+	case 378:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:2018
+		{
+			yyVAL.queryexpr = TableObject{BaseExpr: NewBaseExpr(yyDollar[1].token), Type: Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal}, FormatElement: yyDollar[3].queryexpr, Args: []QueryExpression{yyDollar[5].queryexpr}}
+		}
+	case 379:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:2022
+		{
+			yyVAL.queryexpr = TableObject{BaseExpr: NewBaseExpr(yyDollar[1].token), Type: Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal}, Args: yyDollar[3].queryexprs}
+		}
+	case 380:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:2026
+		{
+			yyVAL.queryexpr = TableObject{BaseExpr: NewBaseExpr(yyDollar[1].token), Type: Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal}, Args: yyDollar[3].queryexprs}
+		}
+	case 381:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1710
+//line parser.y:2032
 		{
 			yyVAL.table = Table{Object: yyDollar[1].queryexpr}
 		}
-	case 317:
+	case 382:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1714
+//line parser.y:2036
 		{
 			yyVAL.table = Table{Object: yyDollar[1].queryexpr, Alias: yyDollar[2].identifier}
 		}
-	case 318:
+	case 383:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1718
+//line parser.y:2040
 		{
 			yyVAL.table = Table{Object: yyDollar[1].queryexpr, As: yyDollar[2].token.Literal, Alias: yyDollar[3].identifier}
 		}
-	case 319:
+	case 384:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1724
+//line parser.y:2044
+		{
+			yyVAL.table = Table{Object: yyDollar[1].queryexpr}
+		}
+	case 385:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:2048
+		{
+			yyVAL.table = Table{Object: yyDollar[1].queryexpr, Alias: yyDollar[2].identifier}
+		}
+	case 386:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:2052
+		{
+			yyVAL.table = Table{Object: yyDollar[1].queryexpr, As: yyDollar[2].token.Literal, Alias: yyDollar[3].identifier}
+		}
+	case 387:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2058
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 320:
+	case 388:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:1728
+//line parser.y:2062
 		{
 			yyVAL.queryexpr = JsonQuery{BaseExpr: NewBaseExpr(yyDollar[1].token), JsonQuery: yyDollar[1].token.Literal, Query: yyDollar[3].queryexpr, JsonText: yyDollar[5].identifier}
 		}
-	case 321:
+	case 389:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:1732
+//line parser.y:2066
 		{
 			yyVAL.queryexpr = JsonQuery{BaseExpr: NewBaseExpr(yyDollar[1].token), JsonQuery: yyDollar[1].token.Literal, Query: yyDollar[3].queryexpr, JsonText: yyDollar[5].queryexpr}
 		}
-	case 322:
+	case 390:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1736
+//line parser.y:2070
 		{
 			yyVAL.queryexpr = TableObject{BaseExpr: yyDollar[1].identifier.BaseExpr, Type: yyDollar[1].identifier, Path: yyDollar[3].identifier, Args: nil}
 		}
-	case 323:
+	case 391:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:1740
+//line parser.y:2074
 		{
 			yyVAL.queryexpr = TableObject{BaseExpr: yyDollar[1].identifier.BaseExpr, Type: yyDollar[1].identifier, Path: yyDollar[3].identifier, Args: yyDollar[5].queryexprs}
 		}
-	case 324:
+	case 392:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:1744
+//line parser.y:2078
 		{
 			yyVAL.queryexpr = TableObject{BaseExpr: yyDollar[1].identifier.BaseExpr, Type: yyDollar[1].identifier, FormatElement: yyDollar[3].queryexpr, Path: yyDollar[5].identifier, Args: nil}
 		}
-	case 325:
+	case 393:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:1748
+//line parser.y:2082
+		{
+			yyVAL.queryexpr = TableObject{BaseExpr: yyDollar[1].identifier.BaseExpr, Type: yyDollar[1].identifier, FormatElement: yyDollar[3].queryexpr, Path: yyDollar[5].identifier, Args: yyDollar[7].queryexprs}
+		}
+	case 394:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2088
+		{
+			yyVAL.queryexpr = yyDollar[1].table
+		}
+	case 395:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2092
+		{
+			yyVAL.queryexpr = Table{Object: yyDollar[1].queryexpr}
+		}
+	case 396:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:2096
+		{
+			yyVAL.queryexpr = Table{Object: yyDollar[1].queryexpr, Alias: yyDollar[2].identifier}
+		}
+	case 397:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:2100
 		{
-			yyVAL.queryexpr = TableObject{BaseExpr: yyDollar[1].identifier.BaseExpr, Type: yyDollar[1].identifier, FormatElement: yyDollar[3].queryexpr, Path: yyDollar[5].identifier, Args: yyDollar[7].queryexprs}
+			yyVAL.queryexpr = Table{Object: yyDollar[1].queryexpr, As: yyDollar[2].token.Literal, Alias: yyDollar[3].identifier}
 		}
-	case 326:
+	case 398:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1754
+//line parser.y:2104
 		{
-			yyVAL.queryexpr = yyDollar[1].table
+			yyVAL.queryexpr = Table{Object: yyDollar[1].queryexpr}
 		}
-	case 327:
+	case 399:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1758
+//line parser.y:2108
 		{
 			yyVAL.queryexpr = Table{Object: yyDollar[1].queryexpr}
 		}
-	case 328:
+	case 400:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1762
+//line parser.y:2112
 		{
 			yyVAL.queryexpr = Table{Object: yyDollar[1].queryexpr, Alias: yyDollar[2].identifier}
 		}
-	case 329:
+	case 401:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1766
+//line parser.y:2116
 		{
 			yyVAL.queryexpr = Table{Object: yyDollar[1].queryexpr, As: yyDollar[2].token.Literal, Alias: yyDollar[3].identifier}
 		}
-	case 330:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1770
-		{
-			yyVAL.queryexpr = Table{Object: yyDollar[1].queryexpr}
-		}
-	case 331:
+	case 402:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1774
+//line parser.y:2120
 		{
 			yyVAL.queryexpr = Table{Object: Dual{Dual: yyDollar[1].token.Literal}}
 		}
-	case 332:
+	case 403:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1778
+//line parser.y:2124
 		{
 			yyVAL.queryexpr = Parentheses{Expr: yyDollar[2].queryexpr}
 		}
-	case 333:
+	case 404:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1784
+//line parser.y:2130
 		{
 			yyVAL.queryexpr = Join{Join: yyDollar[3].token.Literal, Table: yyDollar[1].queryexpr, JoinTable: yyDollar[4].queryexpr, JoinType: yyDollar[2].token, Condition: nil}
 		}
-	case 334:
+	case 405:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1788
+//line parser.y:2134
 		{
 			yyVAL.queryexpr = Join{Join: yyDollar[3].token.Literal, Table: yyDollar[1].queryexpr, JoinTable: yyDollar[4].queryexpr, JoinType: yyDollar[2].token, Condition: yyDollar[5].queryexpr}
 		}
-	case 335:
+	case 406:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:1792
+//line parser.y:2138
 		{
 			yyVAL.queryexpr = Join{Join: yyDollar[4].token.Literal, Table: yyDollar[1].queryexpr, JoinTable: yyDollar[5].queryexpr, JoinType: yyDollar[3].token, Direction: yyDollar[2].token, Condition: yyDollar[6].queryexpr}
 		}
-	case 336:
+	case 407:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line parser.y:1796
+//line parser.y:2142
 		{
 			yyVAL.queryexpr = Join{Join: yyDollar[4].token.Literal, Table: yyDollar[1].queryexpr, JoinTable: yyDollar[5].queryexpr, JoinType: yyDollar[3].token, Direction: yyDollar[2].token, Condition: JoinCondition{Literal: yyDollar[6].token.Literal, On: yyDollar[7].queryexpr}}
 		}
-	case 337:
+	case 408:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1800
+//line parser.y:2146
 		{
 			yyVAL.queryexpr = Join{Join: yyDollar[4].token.Literal, Table: yyDollar[1].queryexpr, JoinTable: yyDollar[5].queryexpr, JoinType: yyDollar[3].token, Natural: yyDollar[2].token}
 		}
-	case 338:
+	case 409:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:1804
+//line parser.y:2150
 		{
 			yyVAL.queryexpr = Join{Join: yyDollar[5].token.Literal, Table: yyDollar[1].queryexpr, JoinTable: yyDollar[6].queryexpr, JoinType: yyDollar[4].token, Direction: yyDollar[3].token, Natural: yyDollar[2].token}
 		}
-	case 339:
+	case 410:
+		yyDollar = yyS[yypt-11 : yypt+1]
+//line parser.y:2156
+		{
+			for i, v := range yyDollar[9].queryexprs {
+				field := v.(Field)
+				field.BaseExpr = NewBaseExpr(yyDollar[7].token)
+				yyDollar[9].queryexprs[i] = field
+			}
+			yyVAL.queryexpr = PivotTable{BaseExpr: NewBaseExpr(yyDollar[2].token), Table: yyDollar[1].queryexpr, Pivot: yyDollar[2].token.Literal, Aggregates: yyDollar[4].queryexprs, For: yyDollar[5].token.Literal, Key: yyDollar[6].queryexpr, In: yyDollar[7].token.Literal, Values: yyDollar[9].queryexprs}
+		}
+	case 411:
+		yyDollar = yyS[yypt-11 : yypt+1]
+//line parser.y:2165
+		{
+			yyVAL.queryexpr = PivotTable{BaseExpr: NewBaseExpr(yyDollar[2].token), Table: yyDollar[1].queryexpr, Pivot: yyDollar[2].token.Literal, Aggregates: yyDollar[4].queryexprs, For: yyDollar[5].token.Literal, Key: yyDollar[6].queryexpr, In: yyDollar[7].token.Literal, Any: yyDollar[9].token.Literal}
+		}
+	case 412:
+		yyDollar = yyS[yypt-12 : yypt+1]
+//line parser.y:2169
+		{
+			yyVAL.queryexpr = UnpivotTable{BaseExpr: NewBaseExpr(yyDollar[2].token), Table: yyDollar[1].queryexpr, Unpivot: yyDollar[2].token.Literal, Nulls: yyDollar[3].token, Value: yyDollar[5].identifier, For: yyDollar[6].token.Literal, Key: yyDollar[7].identifier, In: yyDollar[8].token.Literal, Columns: yyDollar[10].queryexprs}
+		}
+	case 413:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:2175
+		{
+			yyVAL.token = Token{}
+		}
+	case 414:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:2179
+		{
+			yyDollar[1].token.Literal = yyDollar[1].token.Literal + " " + yyDollar[2].token.Literal
+			yyVAL.token = yyDollar[1].token
+		}
+	case 415:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:2184
+		{
+			yyDollar[1].token.Literal = yyDollar[1].token.Literal + " " + yyDollar[2].token.Literal
+			yyVAL.token = yyDollar[1].token
+		}
+	case 416:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1810
+//line parser.y:2191
 		{
 			yyVAL.queryexpr = JoinCondition{Literal: yyDollar[1].token.Literal, On: yyDollar[2].queryexpr}
 		}
-	case 340:
+	case 417:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1814
+//line parser.y:2195
 		{
 			yyVAL.queryexpr = JoinCondition{Literal: yyDollar[1].token.Literal, Using: yyDollar[3].queryexprs}
 		}
-	case 341:
+	case 418:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1820
+//line parser.y:2201
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 342:
+	case 419:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1824
+//line parser.y:2205
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 343:
+	case 420:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1830
+//line parser.y:2211
 		{
 			yyVAL.queryexpr = Field{Object: yyDollar[1].queryexpr}
 		}
-	case 344:
+	case 421:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1834
+//line parser.y:2215
 		{
 			yyVAL.queryexpr = Field{Object: yyDollar[1].queryexpr, As: yyDollar[2].token.Literal, Alias: yyDollar[3].identifier}
 		}
-	case 345:
+	case 422:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1838
+//line parser.y:2219
 		{
 			yyVAL.queryexpr = Field{Object: yyDollar[1].queryexpr}
 		}
-	case 346:
+	case 423:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1844
+//line parser.y:2225
 		{
 			yyVAL.queryexpr = CaseExpr{Case: yyDollar[1].token.Literal, End: yyDollar[5].token.Literal, Value: yyDollar[2].queryexpr, When: yyDollar[3].queryexprs, Else: yyDollar[4].queryexpr}
 		}
-	case 347:
+	case 424:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1850
+//line parser.y:2231
 		{
 			yyVAL.queryexpr = nil
 		}
-	case 348:
+	case 425:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1854
+//line parser.y:2235
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 349:
+	case 426:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1860
+//line parser.y:2241
 		{
 			yyVAL.queryexprs = []QueryExpression{CaseExprWhen{When: yyDollar[1].token.Literal, Then: yyDollar[3].token.Literal, Condition: yyDollar[2].queryexpr, Result: yyDollar[4].queryexpr}}
 		}
-	case 350:
+	case 427:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1864
+//line parser.y:2245
 		{
 			yyVAL.queryexprs = append([]QueryExpression{CaseExprWhen{When: yyDollar[1].token.Literal, Then: yyDollar[3].token.Literal, Condition: yyDollar[2].queryexpr, Result: yyDollar[4].queryexpr}}, yyDollar[5].queryexprs...)
 		}
-	case 351:
+	case 428:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1870
+//line parser.y:2251
 		{
 			yyVAL.queryexpr = nil
 		}
-	case 352:
+	case 429:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1874
+//line parser.y:2255
 		{
 			yyVAL.queryexpr = CaseExprElse{Else: yyDollar[1].token.Literal, Result: yyDollar[2].queryexpr}
 		}
-	case 353:
+	case 430:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1880
+//line parser.y:2261
 		{
 			yyVAL.queryexprs = []QueryExpression{yyDollar[1].queryexpr}
 		}
-	case 354:
+	case 431:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1884
+//line parser.y:2265
 		{
 			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].queryexpr}, yyDollar[3].queryexprs...)
 		}
-	case 355:
+	case 432:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1890
+//line parser.y:2271
 		{
 			yyVAL.queryexprs = []QueryExpression{yyDollar[1].queryexpr}
 		}
-	case 356:
+	case 433:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1894
+//line parser.y:2275
 		{
 			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].queryexpr}, yyDollar[3].queryexprs...)
 		}
-	case 357:
+	case 434:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1900
+//line parser.y:2281
 		{
 			yyVAL.queryexprs = []QueryExpression{yyDollar[1].queryexpr}
 		}
-	case 358:
+	case 435:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1904
+//line parser.y:2285
 		{
 			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].queryexpr}, yyDollar[3].queryexprs...)
 		}
-	case 359:
+	case 436:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1910
+//line parser.y:2291
 		{
 			yyVAL.queryexprs = []QueryExpression{Table{Object: yyDollar[1].queryexpr}}
 		}
-	case 360:
+	case 437:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1914
+//line parser.y:2295
 		{
 			yyVAL.queryexprs = append([]QueryExpression{Table{Object: yyDollar[1].queryexpr}}, yyDollar[3].queryexprs...)
 		}
-	case 361:
+	case 438:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2299
+		{
+			yyVAL.queryexprs = []QueryExpression{Table{Object: yyDollar[1].queryexpr}}
+		}
+	case 439:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:2303
+		{
+			yyVAL.queryexprs = append([]QueryExpression{Table{Object: yyDollar[1].queryexpr}}, yyDollar[3].queryexprs...)
+		}
+	case 440:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1920
+//line parser.y:2309
 		{
 			yyVAL.queryexprs = []QueryExpression{yyDollar[1].identifier}
 		}
-	case 362:
+	case 441:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1924
+//line parser.y:2313
 		{
 			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].identifier}, yyDollar[3].queryexprs...)
 		}
-	case 363:
+	case 442:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1930
+//line parser.y:2319
 		{
 			yyVAL.queryexprs = []QueryExpression{yyDollar[1].queryexpr}
 		}
-	case 364:
+	case 443:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1934
+//line parser.y:2323
 		{
 			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].queryexpr}, yyDollar[3].queryexprs...)
 		}
-	case 365:
+	case 444:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:1940
+//line parser.y:2329
 		{
 			yyVAL.expression = InsertQuery{WithClause: yyDollar[1].queryexpr, Table: yyDollar[4].table, ValuesList: yyDollar[6].queryexprs}
 		}
-	case 366:
+	case 445:
 		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:1944
+//line parser.y:2333
 		{
 			yyVAL.expression = InsertQuery{WithClause: yyDollar[1].queryexpr, Table: yyDollar[4].table, Fields: yyDollar[6].queryexprs, ValuesList: yyDollar[9].queryexprs}
 		}
-	case 367:
+	case 446:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1948
+//line parser.y:2337
 		{
 			yyVAL.expression = InsertQuery{WithClause: yyDollar[1].queryexpr, Table: yyDollar[4].table, Query: yyDollar[5].queryexpr.(SelectQuery)}
 		}
-	case 368:
+	case 447:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:1952
+//line parser.y:2341
 		{
 			yyVAL.expression = InsertQuery{WithClause: yyDollar[1].queryexpr, Table: yyDollar[4].table, Fields: yyDollar[6].queryexprs, Query: yyDollar[8].queryexpr.(SelectQuery)}
 		}
-	case 369:
+	case 448:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line parser.y:1958
+//line parser.y:2347
 		{
 			yyVAL.expression = UpdateQuery{WithClause: yyDollar[1].queryexpr, Tables: yyDollar[3].queryexprs, SetList: yyDollar[5].updatesets, FromClause: yyDollar[6].queryexpr, WhereClause: yyDollar[7].queryexpr}
 		}
-	case 370:
+	case 449:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1964
+//line parser.y:2353
 		{
 			yyVAL.updateset = UpdateSet{Field: yyDollar[1].queryexpr, Value: yyDollar[3].queryexpr}
 		}
-	case 371:
+	case 450:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1970
+//line parser.y:2359
 		{
 			yyVAL.updatesets = []UpdateSet{yyDollar[1].updateset}
 		}
-	case 372:
+	case 451:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1974
+//line parser.y:2363
 		{
 			yyVAL.updatesets = append([]UpdateSet{yyDollar[1].updateset}, yyDollar[3].updatesets...)
 		}
-	case 373:
+	case 452:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1980
+//line parser.y:2369
 		{
 			from := FromClause{From: yyDollar[3].token.Literal, Tables: yyDollar[4].queryexprs}
 			yyVAL.expression = DeleteQuery{BaseExpr: NewBaseExpr(yyDollar[2].token), WithClause: yyDollar[1].queryexpr, FromClause: from, WhereClause: yyDollar[5].queryexpr}
 		}
-	case 374:
+	case 453:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:1985
+//line parser.y:2374
 		{
 			from := FromClause{From: yyDollar[4].token.Literal, Tables: yyDollar[5].queryexprs}
 			yyVAL.expression = DeleteQuery{BaseExpr: NewBaseExpr(yyDollar[2].token), WithClause: yyDollar[1].queryexpr, Tables: yyDollar[3].queryexprs, FromClause: from, WhereClause: yyDollar[6].queryexpr}
 		}
-	case 375:
+	case 454:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line parser.y:2381
+		{
+			yyVAL.expression = MergeQuery{BaseExpr: NewBaseExpr(yyDollar[2].token), WithClause: yyDollar[1].queryexpr, Table: yyDollar[4].table, Source: yyDollar[6].queryexpr, On: yyDollar[8].queryexpr, WhenList: yyDollar[9].mergewhens}
+		}
+	case 455:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line parser.y:2387
+		{
+			yyVAL.mergewhen = MergeWhen{BaseExpr: NewBaseExpr(yyDollar[1].token), Matched: true, Condition: yyDollar[3].queryexpr, Operation: yyDollar[5].token, SetList: yyDollar[7].updatesets}
+		}
+	case 456:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:2391
+		{
+			yyVAL.mergewhen = MergeWhen{BaseExpr: NewBaseExpr(yyDollar[1].token), Matched: true, Condition: yyDollar[3].queryexpr, Operation: yyDollar[5].token}
+		}
+	case 457:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line parser.y:2395
+		{
+			yyVAL.mergewhen = MergeWhen{BaseExpr: NewBaseExpr(yyDollar[1].token), Condition: yyDollar[4].queryexpr, Operation: yyDollar[6].token, Values: yyDollar[8].queryexpr}
+		}
+	case 458:
+		yyDollar = yyS[yypt-11 : yypt+1]
+//line parser.y:2399
+		{
+			yyVAL.mergewhen = MergeWhen{BaseExpr: NewBaseExpr(yyDollar[1].token), Condition: yyDollar[4].queryexpr, Operation: yyDollar[6].token, Fields: yyDollar[8].queryexprs, Values: yyDollar[11].queryexpr}
+		}
+	case 459:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2405
+		{
+			yyVAL.mergewhens = []MergeWhen{yyDollar[1].mergewhen}
+		}
+	case 460:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:2409
+		{
+			yyVAL.mergewhens = append([]MergeWhen{yyDollar[1].mergewhen}, yyDollar[2].mergewhens...)
+		}
+	case 461:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:2415
+		{
+			yyVAL.queryexpr = nil
+		}
+	case 462:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:2419
+		{
+			yyVAL.queryexpr = yyDollar[2].queryexpr
+		}
+	case 463:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1992
+//line parser.y:2425
 		{
 			yyVAL.elseif = []ElseIf{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}
 		}
-	case 376:
+	case 464:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1996
+//line parser.y:2429
 		{
 			yyVAL.elseif = append([]ElseIf{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}, yyDollar[5].elseif...)
 		}
-	case 377:
+	case 465:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2002
+//line parser.y:2435
 		{
 			yyVAL.elseexpr = Else{}
 		}
-	case 378:
+	case 466:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2006
+//line parser.y:2439
 		{
 			yyVAL.elseexpr = Else{Statements: yyDollar[2].program}
 		}
-	case 379:
+	case 467:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:2012
+//line parser.y:2445
 		{
 			yyVAL.elseif = []ElseIf{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}
 		}
-	case 380:
+	case 468:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:2016
+//line parser.y:2449
 		{
 			yyVAL.elseif = append([]ElseIf{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}, yyDollar[5].elseif...)
 		}
-	case 381:
+	case 469:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2022
+//line parser.y:2455
 		{
 			yyVAL.elseexpr = Else{}
 		}
-	case 382:
+	case 470:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2026
+//line parser.y:2459
 		{
 			yyVAL.elseexpr = Else{Statements: yyDollar[2].program}
 		}
-	case 383:
+	case 471:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:2032
+//line parser.y:2465
 		{
 			yyVAL.elseif = []ElseIf{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}
 		}
-	case 384:
+	case 472:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:2036
+//line parser.y:2469
 		{
 			yyVAL.elseif = append([]ElseIf{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}, yyDollar[5].elseif...)
 		}
-	case 385:
+	case 473:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2042
+//line parser.y:2475
 		{
 			yyVAL.elseexpr = Else{}
 		}
-	case 386:
+	case 474:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2046
+//line parser.y:2479
 		{
 			yyVAL.elseexpr = Else{Statements: yyDollar[2].program}
 		}
-	case 387:
+	case 475:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:2052
+//line parser.y:2485
 		{
 			yyVAL.elseif = []ElseIf{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}
 		}
-	case 388:
+	case 476:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:2056
+//line parser.y:2489
 		{
 			yyVAL.elseif = append([]ElseIf{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}, yyDollar[5].elseif...)
 		}
-	case 389:
+	case 477:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2062
+//line parser.y:2495
 		{
 			yyVAL.elseexpr = Else{}
 		}
-	case 390:
+	case 478:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2066
+//line parser.y:2499
 		{
 			yyVAL.elseexpr = Else{Statements: yyDollar[2].program}
 		}
-	case 391:
+	case 479:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:2072
+//line parser.y:2505
 		{
 			yyVAL.casewhen = []CaseWhen{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}
 		}
-	case 392:
+	case 480:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:2076
+//line parser.y:2509
 		{
 			yyVAL.casewhen = append([]CaseWhen{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}, yyDollar[5].casewhen...)
 		}
-	case 393:
+	case 481:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2082
+//line parser.y:2515
 		{
 			yyVAL.caseelse = CaseElse{}
 		}
-	case 394:
+	case 482:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2086
+//line parser.y:2519
 		{
 			yyVAL.caseelse = CaseElse{Statements: yyDollar[2].program}
 		}
-	case 395:
+	case 483:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:2092
+//line parser.y:2525
 		{
 			yyVAL.casewhen = []CaseWhen{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}
 		}
-	case 396:
+	case 484:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:2096
+//line parser.y:2529
 		{
 			yyVAL.casewhen = append([]CaseWhen{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}, yyDollar[5].casewhen...)
 		}
-	case 397:
+	case 485:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2102
+//line parser.y:2535
 		{
 			yyVAL.caseelse = CaseElse{}
 		}
-	case 398:
+	case 486:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2106
+//line parser.y:2539
 		{
 			yyVAL.caseelse = CaseElse{Statements: yyDollar[2].program}
 		}
-	case 399:
+	case 487:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:2112
+//line parser.y:2545
 		{
 			yyVAL.casewhen = []CaseWhen{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}
 		}
-	case 400:
+	case 488:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:2116
+//line parser.y:2549
 		{
 			yyVAL.casewhen = append([]CaseWhen{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}, yyDollar[5].casewhen...)
 		}
-	case 401:
+	case 489:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2122
+//line parser.y:2555
 		{
 			yyVAL.caseelse = CaseElse{}
 		}
-	case 402:
+	case 490:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2126
+//line parser.y:2559
 		{
 			yyVAL.caseelse = CaseElse{Statements: yyDollar[2].program}
 		}
-	case 403:
+	case 491:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:2132
+//line parser.y:2565
 		{
 			yyVAL.casewhen = []CaseWhen{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}
 		}
-	case 404:
+	case 492:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:2136
+//line parser.y:2569
 		{
 			yyVAL.casewhen = append([]CaseWhen{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}, yyDollar[5].casewhen...)
 		}
-	case 405:
+	case 493:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2142
+//line parser.y:2575
 		{
 			yyVAL.caseelse = CaseElse{}
 		}
-	case 406:
+	case 494:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2146
+//line parser.y:2579
 		{
 			yyVAL.caseelse = CaseElse{Statements: yyDollar[2].program}
 		}
-	case 407:
+	case 495:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2152
+//line parser.y:2585
 		{
 			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
 		}
-	case 408:
+	case 496:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2156
+//line parser.y:2589
 		{
 			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
 		}
-	case 409:
+	case 497:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2160
+//line parser.y:2593
 		{
 			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
 		}
-	case 410:
+	case 498:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2164
+//line parser.y:2597
 		{
 			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
 		}
-	case 411:
+	case 499:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2601
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 500:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2605
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 501:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2609
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 502:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2613
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 503:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2617
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 504:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2621
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 505:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2625
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 506:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2629
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 507:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2170
+//line parser.y:2635
 		{
 			yyVAL.variable = Variable{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal}
 		}
-	case 412:
+	case 508:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2176
+//line parser.y:2641
 		{
 			yyVAL.variables = []Variable{yyDollar[1].variable}
 		}
-	case 413:
+	case 509:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2180
+//line parser.y:2645
 		{
 			yyVAL.variables = append([]Variable{yyDollar[1].variable}, yyDollar[3].variables...)
 		}
-	case 414:
+	case 510:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2186
+//line parser.y:2651
 		{
 			yyVAL.queryexpr = VariableSubstitution{Variable: yyDollar[1].variable, Value: yyDollar[3].queryexpr}
 		}
-	case 415:
+	case 511:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2192
+//line parser.y:2657
 		{
 			yyVAL.varassign = VariableAssignment{Variable: yyDollar[1].variable}
 		}
-	case 416:
+	case 512:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2196
+//line parser.y:2661
 		{
 			yyVAL.varassign = VariableAssignment{Variable: yyDollar[1].variable, Value: yyDollar[3].queryexpr}
 		}
-	case 417:
+	case 513:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2202
+//line parser.y:2667
 		{
 			yyVAL.varassigns = []VariableAssignment{yyDollar[1].varassign}
 		}
-	case 418:
+	case 514:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2206
+//line parser.y:2671
 		{
 			yyVAL.varassigns = append([]VariableAssignment{yyDollar[1].varassign}, yyDollar[3].varassigns...)
 		}
-	case 419:
+	case 515:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2212
+//line parser.y:2677
 		{
 			yyVAL.envvar = EnvironmentVariable{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
 		}
-	case 420:
+	case 516:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2218
+//line parser.y:2683
 		{
 			yyVAL.queryexpr = RuntimeInformation{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal}
 		}
-	case 421:
+	case 517:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2689
+		{
+			yyVAL.queryexpr = Placeholder{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Ordinal: yyDollar[1].token.HolderOrdinal}
+		}
+	case 518:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2224
+//line parser.y:2695
 		{
 			yyVAL.token = Token{}
 		}
-	case 422:
+	case 519:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2228
+//line parser.y:2699
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 423:
+	case 520:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2234
+//line parser.y:2705
 		{
 			yyVAL.token = Token{}
 		}
-	case 424:
+	case 521:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2238
+//line parser.y:2709
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 425:
+	case 522:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2244
+//line parser.y:2715
 		{
 			yyVAL.token = Token{}
 		}
-	case 426:
+	case 523:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2248
+//line parser.y:2719
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 427:
+	case 524:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2254
+//line parser.y:2725
 		{
 			yyVAL.token = Token{}
 		}
-	case 428:
+	case 525:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2258
+//line parser.y:2729
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 429:
+	case 526:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2264
+//line parser.y:2735
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 430:
+	case 527:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2268
+//line parser.y:2739
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 431:
+	case 528:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2274
+//line parser.y:2745
 		{
 			yyVAL.token = Token{}
 		}
-	case 432:
+	case 529:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2278
+//line parser.y:2749
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 433:
+	case 530:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2284
+//line parser.y:2755
 		{
 			yyVAL.token = Token{}
 		}
-	case 434:
+	case 531:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2288
+//line parser.y:2759
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 435:
+	case 532:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2294
+//line parser.y:2765
 		{
 			yyVAL.token = Token{}
 		}
-	case 436:
+	case 533:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2298
+//line parser.y:2769
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 437:
+	case 534:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2304
+//line parser.y:2775
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 438:
+	case 535:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2308
+//line parser.y:2779
 		{
 			yyDollar[1].token.Token = COMPARISON_OP
 			yyVAL.token = yyDollar[1].token
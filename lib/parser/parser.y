@@ -2,8 +2,6 @@
 package parser
 
 import (
-	"strconv"
-
 	"github.com/mithrandie/csvq/lib/value"
 )
 %}
@@ -24,6 +22,8 @@ import (
     envvar      EnvironmentVariable
     updateset   UpdateSet
     updatesets  []UpdateSet
+    mergewhen   MergeWhen
+    mergewhens  []MergeWhen
     columndef   ColumnDefault
     columndefs  []ColumnDefault
     elseif      []ElseIf
@@ -57,6 +57,8 @@ import (
 %type<statement>   environment_variable_statement
 %type<statement>   transaction_statement
 %type<statement>   table_operation_statement
+%type<queryexpr>   column_definition
+%type<queryexprs>  column_definitions
 %type<columndef>   column_default
 %type<columndefs>  column_defaults
 %type<expression>  column_position
@@ -79,7 +81,11 @@ import (
 %type<queryexpr>   from_clause
 %type<queryexpr>   where_clause
 %type<queryexpr>   group_by_clause
+%type<queryexpr>   group_by_item
+%type<queryexprs>  group_by_items
 %type<queryexpr>   having_clause
+%type<queryexpr>   window_clause
+%type<queryexpr>   window_definition
 %type<queryexpr>   order_by_clause
 %type<queryexpr>   limit_clause
 %type<queryexpr>   limit_with
@@ -113,18 +119,26 @@ import (
 %type<queryexpr>   analytic_function
 %type<queryexpr>   analytic_clause
 %type<queryexpr>   analytic_clause_with_windowing
+%type<queryexpr>   window_specification
+%type<queryexpr>   window_specification_with_windowing
 %type<queryexpr>   partition_clause
 %type<queryexpr>   windowing_clause
 %type<queryexpr>   window_position
 %type<queryexpr>   window_relative_position
 %type<queryexpr>   window_frame_low
 %type<queryexpr>   window_frame_high
+%type<queryexpr>   window_offset
+%type<token>       window_unit
+%type<token>       window_exclusion
 %type<queryexpr>   table_identifier
+%type<queryexpr>   external_table
 %type<table>       identified_table
 %type<queryexprs>  operate_tables
 %type<queryexpr>   virtual_table_object
 %type<queryexpr>   table
 %type<queryexpr>   join
+%type<queryexpr>   pivot_table
+%type<token>       unpivot_nulls
 %type<queryexpr>   join_condition
 %type<queryexpr>   field_object
 %type<queryexpr>   field
@@ -136,12 +150,17 @@ import (
 %type<queryexprs>  values
 %type<queryexprs>  tables
 %type<queryexprs>  identifiers
+%type<queryexprs>  window_definitions
 %type<queryexprs>  fields
 %type<expression>  insert_query
 %type<expression>  update_query
 %type<updateset>   update_set
 %type<updatesets>  update_set_list
 %type<expression>  delete_query
+%type<expression>  merge_query
+%type<mergewhen>   merge_when
+%type<mergewhens>  merge_when_list
+%type<queryexpr>   merge_condition
 %type<elseif>      elseif
 %type<elseexpr>    else
 %type<elseif>      in_loop_elseif
@@ -166,6 +185,7 @@ import (
 %type<varassigns>  variable_assignments
 %type<envvar>      environment_variable
 %type<queryexpr>   runtime_information
+%type<queryexpr>   placeholder
 %type<token>       distinct
 %type<token>       negation
 %type<token>       join_type_inner
@@ -177,29 +197,33 @@ import (
 %type<token>       comparison_operator
 
 %token<token> IDENTIFIER STRING INTEGER FLOAT BOOLEAN TERNARY DATETIME
-%token<token> VARIABLE FLAG ENVIRONMENT_VARIABLE RUNTIME_INFORMATION EXTERNAL_COMMAND
+%token<token> VARIABLE FLAG ENVIRONMENT_VARIABLE RUNTIME_INFORMATION EXTERNAL_COMMAND PLACEHOLDER
 %token<token> SELECT FROM UPDATE SET UNSET DELETE WHERE INSERT INTO VALUES AS DUAL STDIN
+%token<token> MERGE
 %token<token> RECURSIVE
-%token<token> CREATE ADD DROP ALTER TABLE FIRST LAST AFTER BEFORE DEFAULT RENAME TO VIEW
+%token<token> CREATE ADD DROP ALTER TABLE FIRST LAST AFTER BEFORE DEFAULT RENAME TO VIEW INDEX
 %token<token> ORDER GROUP HAVING BY ASC DESC LIMIT OFFSET PERCENT
+%token<token> ROLLUP CUBE GROUPING
 %token<token> JOIN INNER OUTER LEFT RIGHT FULL CROSS ON USING NATURAL
+%token<token> PIVOT UNPIVOT
 %token<token> UNION INTERSECT EXCEPT
 %token<token> ALL ANY EXISTS IN
-%token<token> AND OR NOT BETWEEN LIKE IS NULL
+%token<token> AND OR NOT BETWEEN LIKE REGEXP IS NULL
 %token<token> DISTINCT WITH
 %token<token> RANGE UNBOUNDED PRECEDING FOLLOWING CURRENT ROW
 %token<token> CASE IF ELSEIF WHILE WHEN THEN ELSE DO END
 %token<token> DECLARE CURSOR FOR FETCH OPEN CLOSE DISPOSE
 %token<token> NEXT PRIOR ABSOLUTE RELATIVE
-%token<token> SEPARATOR PARTITION OVER
+%token<token> SEPARATOR PARTITION OVER WINDOW
 %token<token> COMMIT ROLLBACK
 %token<token> CONTINUE BREAK EXIT
+%token<token> TRY CATCH RAISE
 %token<token> ECHO PRINT PRINTF SOURCE EXECUTE CHDIR PWD RELOAD REMOVE SYNTAX TRIGGER
 %token<token> FUNCTION AGGREGATE BEGIN RETURN
 %token<token> IGNORE WITHIN
-%token<token> VAR SHOW
-%token<token> TIES NULLS ROWS
-%token<token> JSON_ROW JSON_TABLE
+%token<token> VAR SHOW EXPLAIN ANALYZE
+%token<token> TIES NULLS ROWS GROUPS EXCLUDE NO OTHERS INTERVAL SETS INCLUDE MATCHED
+%token<token> JSON_ROW JSON_TABLE SQLITE XLSX FILES
 %token<token> COUNT JSON_OBJECT
 %token<token> AGGREGATE_FUNCTION LIST_FUNCTION ANALYTIC_FUNCTION FUNCTION_NTH FUNCTION_WITH_INS
 %token<token> COMPARISON_OP STRING_OP SUBSTITUTION_OP
@@ -209,11 +233,11 @@ import (
 %right SUBSTITUTION_OP
 %left UNION EXCEPT
 %left INTERSECT
-%left CROSS FULL NATURAL JOIN
+%left CROSS FULL NATURAL JOIN PIVOT UNPIVOT
 %left OR
 %left AND
 %right NOT
-%nonassoc '=' COMPARISON_OP IS BETWEEN IN LIKE
+%nonassoc '=' COMPARISON_OP IS BETWEEN IN LIKE REGEXP
 %left STRING_OP
 %left '+' '-'
 %left '*' '/' '%'
@@ -285,6 +309,10 @@ common_statement
     {
         $$ = $1
     }
+    | merge_query
+    {
+        $$ = $1
+    }
     | table_operation_statement
     {
         $$ = $1
@@ -423,6 +451,10 @@ flow_control_statement
     {
         $$ = $1
     }
+    | TRY program CATCH program END TRY
+    {
+        $$ = TryCatch{BaseExpr: NewBaseExpr($1), TryStatements: $2, CatchStatements: $4}
+    }
 
 loop_flow_control_statement
     : IF value THEN loop_program in_loop_else END IF
@@ -449,6 +481,10 @@ loop_flow_control_statement
     {
         $$ = $1
     }
+    | TRY loop_program CATCH loop_program END TRY
+    {
+        $$ = TryCatch{BaseExpr: NewBaseExpr($1), TryStatements: $2, CatchStatements: $4}
+    }
 
 function_statement
     : common_statement
@@ -515,6 +551,10 @@ function_flow_control_statement
     {
         $$ = $1
     }
+    | TRY function_program CATCH function_program END TRY
+    {
+        $$ = TryCatch{BaseExpr: NewBaseExpr($1), TryStatements: $2, CatchStatements: $4}
+    }
 
 function_loop_flow_control_statement
     : IF value THEN function_loop_program in_function_in_loop_else END IF
@@ -541,6 +581,10 @@ function_loop_flow_control_statement
     {
         $$ = $1
     }
+    | TRY function_loop_program CATCH function_loop_program END TRY
+    {
+        $$ = TryCatch{BaseExpr: NewBaseExpr($1), TryStatements: $2, CatchStatements: $4}
+    }
 
 variable_statement
     : VAR variable_assignments
@@ -593,11 +637,11 @@ transaction_statement
     }
 
 table_operation_statement
-    : CREATE TABLE identifier '(' identifiers ')'
+    : CREATE TABLE identifier '(' column_definitions ')'
     {
         $$ = CreateTable{Table: $3, Fields: $5}
     }
-    | CREATE TABLE identifier '(' identifiers ')' as select_query
+    | CREATE TABLE identifier '(' column_definitions ')' as select_query
     {
         $$ = CreateTable{Table: $3, Fields: $5, Query: $8}
     }
@@ -633,6 +677,46 @@ table_operation_statement
     {
         $$ = SetTableAttribute{BaseExpr: NewBaseExpr($1), Table: $3, Attribute: $5, Value: $7}
     }
+    | ALTER TABLE table_identifier SET identifier TO XLSX
+    {
+        $$ = SetTableAttribute{BaseExpr: NewBaseExpr($1), Table: $3, Attribute: $5, Value: Identifier{BaseExpr: NewBaseExpr($7), Literal: $7.Literal}}
+    }
+    | CREATE INDEX identifier ON identifier '(' identifiers ')'
+    {
+        $$ = CreateIndex{BaseExpr: NewBaseExpr($1), Name: $3, Table: $5, Columns: $7}
+    }
+    | DROP INDEX identifier ON identifier
+    {
+        $$ = DropIndex{BaseExpr: NewBaseExpr($1), Name: $3, Table: $5}
+    }
+
+column_definition
+    : identifier
+    {
+        $$ = $1
+    }
+    | identifier identifier
+    {
+        $$ = ColumnDefinition{BaseExpr: $1.BaseExpr, Column: $1, Type: $2}
+    }
+    | identifier identifier NOT NULL
+    {
+        $$ = ColumnDefinition{BaseExpr: $1.BaseExpr, Column: $1, Type: $2, NotNull: true}
+    }
+    | identifier NOT NULL
+    {
+        $$ = ColumnDefinition{BaseExpr: $1.BaseExpr, Column: $1, NotNull: true}
+    }
+
+column_definitions
+    : column_definition
+    {
+        $$ = []QueryExpression{$1}
+    }
+    | column_definition ',' column_definitions
+    {
+        $$ = append([]QueryExpression{$1}, $3...)
+    }
 
 column_default
     : identifier
@@ -641,7 +725,31 @@ column_default
     }
     | identifier DEFAULT value
     {
-        $$ = ColumnDefault{Column: $1, Value: $3}
+        $$ = ColumnDefault{BaseExpr: NewBaseExpr($2), Column: $1, Value: $3}
+    }
+    | identifier identifier
+    {
+        $$ = ColumnDefault{Column: $1, Type: $2}
+    }
+    | identifier identifier DEFAULT value
+    {
+        $$ = ColumnDefault{BaseExpr: NewBaseExpr($3), Column: $1, Type: $2, Value: $4}
+    }
+    | identifier identifier NOT NULL
+    {
+        $$ = ColumnDefault{Column: $1, Type: $2, NotNull: true}
+    }
+    | identifier identifier NOT NULL DEFAULT value
+    {
+        $$ = ColumnDefault{BaseExpr: NewBaseExpr($5), Column: $1, Type: $2, NotNull: true, Value: $6}
+    }
+    | identifier NOT NULL
+    {
+        $$ = ColumnDefault{Column: $1, NotNull: true}
+    }
+    | identifier NOT NULL DEFAULT value
+    {
+        $$ = ColumnDefault{BaseExpr: NewBaseExpr($4), Column: $1, NotNull: true, Value: $5}
     }
 
 column_defaults
@@ -837,6 +945,10 @@ command_statement
     {
         $$ = SetFlag{BaseExpr: NewBaseExpr($1), Name: $2.Literal, Value: $4}
     }
+    | SET FLAG '=' XLSX
+    {
+        $$ = SetFlag{BaseExpr: NewBaseExpr($1), Name: $2.Literal, Value: Identifier{BaseExpr: NewBaseExpr($4), Literal: $4.Literal}}
+    }
     | SET FLAG TO identifier
     {
         $$ = SetFlag{BaseExpr: NewBaseExpr($1), Name: $2.Literal, Value: $4}
@@ -845,6 +957,10 @@ command_statement
     {
         $$ = SetFlag{BaseExpr: NewBaseExpr($1), Name: $2.Literal, Value: $4}
     }
+    | SET FLAG TO XLSX
+    {
+        $$ = SetFlag{BaseExpr: NewBaseExpr($1), Name: $2.Literal, Value: Identifier{BaseExpr: NewBaseExpr($4), Literal: $4.Literal}}
+    }
     | ADD value TO FLAG
     {
         $$ = AddFlagElement{BaseExpr: NewBaseExpr($1), Name: $4.Literal, Value: $2}
@@ -857,6 +973,14 @@ command_statement
     {
         $$ = ShowFlag{BaseExpr: NewBaseExpr($1), Name: $2.Literal}
     }
+    | EXPLAIN select_query
+    {
+        $$ = Explain{BaseExpr: NewBaseExpr($1), Query: $2.(SelectQuery)}
+    }
+    | EXPLAIN ANALYZE select_query
+    {
+        $$ = Explain{BaseExpr: NewBaseExpr($1), Analyze: true, Query: $3.(SelectQuery)}
+    }
     | ECHO value
     {
         $$ = Echo{Value: $2}
@@ -939,6 +1063,10 @@ trigger_statement
     {
         $$ = Trigger{BaseExpr: NewBaseExpr($1), Event: $2, Message: $4, Code: value.NewIntegerFromString($3.Literal)}
     }
+    | RAISE
+    {
+        $$ = Raise{BaseExpr: NewBaseExpr($1)}
+    }
 
 select_query
     : with_clause select_entity order_by_clause limit_clause offset_clause
@@ -953,7 +1081,7 @@ select_query
     }
 
 select_entity
-    : select_clause from_clause where_clause group_by_clause having_clause
+    : select_clause from_clause where_clause group_by_clause having_clause window_clause
     {
         $$ = SelectEntity{
             SelectClause:  $1,
@@ -961,6 +1089,7 @@ select_entity
             WhereClause:   $3,
             GroupByClause: $4,
             HavingClause:  $5,
+            WindowClause:  $6,
         }
     }
     | select_set_entity UNION all select_set_entity
@@ -1032,11 +1161,47 @@ group_by_clause
     {
         $$ = nil
     }
-    | GROUP BY values
+    | GROUP BY group_by_items
     {
         $$ = GroupByClause{GroupBy: $1.Literal + " " + $2.Literal, Items: $3}
     }
 
+group_by_item
+    : value
+    {
+        $$ = $1
+    }
+    | '(' ')'
+    {
+        $$ = GroupingSet{BaseExpr: NewBaseExpr($1)}
+    }
+    | '(' values ')'
+    {
+        $$ = GroupingSet{BaseExpr: NewBaseExpr($1), Values: $2}
+    }
+    | ROLLUP '(' values ')'
+    {
+        $$ = Rollup{BaseExpr: NewBaseExpr($1), Rollup: $1.Literal, Values: $3}
+    }
+    | CUBE '(' values ')'
+    {
+        $$ = Cube{BaseExpr: NewBaseExpr($1), Cube: $1.Literal, Values: $3}
+    }
+    | GROUPING SETS '(' group_by_items ')'
+    {
+        $$ = GroupingSets{BaseExpr: NewBaseExpr($1), GroupingSets: $1.Literal + " " + $2.Literal, Sets: $4}
+    }
+
+group_by_items
+    : group_by_item
+    {
+        $$ = []QueryExpression{$1}
+    }
+    | group_by_item ',' group_by_items
+    {
+        $$ = append([]QueryExpression{$1}, $3...)
+    }
+
 having_clause
     :
     {
@@ -1047,6 +1212,32 @@ having_clause
         $$ = HavingClause{Having: $1.Literal, Filter: $2}
     }
 
+window_clause
+    :
+    {
+        $$ = nil
+    }
+    | WINDOW window_definitions
+    {
+        $$ = WindowClause{Window: $1.Literal, Definitions: $2}
+    }
+
+window_definitions
+    : window_definition
+    {
+        $$ = []QueryExpression{$1}
+    }
+    | window_definition ',' window_definitions
+    {
+        $$ = append([]QueryExpression{$1}, $3...)
+    }
+
+window_definition
+    : identifier AS '(' analytic_clause_with_windowing ')'
+    {
+        $$ = WindowDefinition{BaseExpr: $1.BaseExpr, Name: $1, As: $2.Literal, Clause: $4.(AnalyticClause)}
+    }
+
 order_by_clause
     :
     {
@@ -1238,6 +1429,10 @@ value
     {
         $$ = $1
     }
+    | placeholder
+    {
+        $$ = $1
+    }
     | cursor_status
     {
         $$ = $1
@@ -1431,6 +1626,14 @@ comparison
     {
         $$ = Like{Like: $3.Literal, LHS: $1, Pattern: $4, Negation: $2}
     }
+    | value REGEXP value
+    {
+        $$ = RegExp{BaseExpr: NewBaseExpr($2), RegExp: $2.Literal, LHS: $1, Pattern: $3}
+    }
+    | value NOT REGEXP value
+    {
+        $$ = RegExp{BaseExpr: NewBaseExpr($3), RegExp: $3.Literal, LHS: $1, Pattern: $4, Negation: $2}
+    }
     | value comparison_operator ANY row_value
     {
         $$ = Any{Any: $3.Literal, LHS: $1, Operator: $2.Literal, Values: $4}
@@ -1527,6 +1730,10 @@ function
     {
         $$ = Function{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Args: $3}
     }
+    | GROUPING '(' arguments ')'
+    {
+        $$ = Function{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Args: $3}
+    }
 
 
 aggregate_function
@@ -1562,49 +1769,53 @@ list_function
     }
 
 analytic_function
-    : identifier '(' arguments ')' OVER '(' analytic_clause_with_windowing ')'
+    : identifier '(' arguments ')' OVER window_specification_with_windowing
+    {
+        $$ = AnalyticFunction{BaseExpr: $1.BaseExpr, Name: $1.Literal, Args: $3, Over: $5.Literal, AnalyticClause: $6.(AnalyticClause)}
+    }
+    | identifier '(' distinct arguments ')' OVER window_specification_with_windowing
     {
-        $$ = AnalyticFunction{BaseExpr: $1.BaseExpr, Name: $1.Literal, Args: $3, Over: $5.Literal, AnalyticClause: $7.(AnalyticClause)}
+        $$ = AnalyticFunction{BaseExpr: $1.BaseExpr, Name: $1.Literal, Distinct: $3, Args: $4, Over: $6.Literal, AnalyticClause: $7.(AnalyticClause)}
     }
-    | identifier '(' distinct arguments ')' OVER '(' analytic_clause_with_windowing ')'
+    | AGGREGATE_FUNCTION '(' distinct arguments ')' OVER window_specification_with_windowing
     {
-        $$ = AnalyticFunction{BaseExpr: $1.BaseExpr, Name: $1.Literal, Distinct: $3, Args: $4, Over: $6.Literal, AnalyticClause: $8.(AnalyticClause)}
+        $$ = AnalyticFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Distinct: $3, Args: $4, Over: $6.Literal, AnalyticClause: $7.(AnalyticClause)}
     }
-    | AGGREGATE_FUNCTION '(' distinct arguments ')' OVER '(' analytic_clause_with_windowing ')'
+    | COUNT '(' distinct arguments ')' OVER window_specification_with_windowing
     {
-        $$ = AnalyticFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Distinct: $3, Args: $4, Over: $6.Literal, AnalyticClause: $8.(AnalyticClause)}
+        $$ = AnalyticFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Distinct: $3, Args: $4, Over: $6.Literal, AnalyticClause: $7.(AnalyticClause)}
     }
-    | COUNT '(' distinct arguments ')' OVER '(' analytic_clause_with_windowing ')'
+    | COUNT '(' distinct wildcard ')' OVER window_specification_with_windowing
     {
-        $$ = AnalyticFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Distinct: $3, Args: $4, Over: $6.Literal, AnalyticClause: $8.(AnalyticClause)}
+        $$ = AnalyticFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Distinct: $3, Args: []QueryExpression{$4}, Over: $6.Literal, AnalyticClause: $7.(AnalyticClause)}
     }
-    | COUNT '(' distinct wildcard ')' OVER '(' analytic_clause_with_windowing ')'
+    | LIST_FUNCTION '(' distinct arguments ')' OVER window_specification
     {
-        $$ = AnalyticFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Distinct: $3, Args: []QueryExpression{$4}, Over: $6.Literal, AnalyticClause: $8.(AnalyticClause)}
+        $$ = AnalyticFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Distinct: $3, Args: $4, Over: $6.Literal, AnalyticClause: $7.(AnalyticClause)}
     }
-    | LIST_FUNCTION '(' distinct arguments ')' OVER '(' analytic_clause ')'
+    | LIST_FUNCTION '(' distinct arguments ')' WITHIN GROUP '(' order_by_clause ')' OVER window_specification
     {
-        $$ = AnalyticFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Distinct: $3, Args: $4, Over: $6.Literal, AnalyticClause: $8.(AnalyticClause)}
+        $$ = AnalyticFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Distinct: $3, Args: $4, WithinGroup: $6.Literal + " " + $7.Literal, OrderBy: $9, Over: $11.Literal, AnalyticClause: $12.(AnalyticClause)}
     }
-    | ANALYTIC_FUNCTION '(' arguments ')' OVER '(' analytic_clause ')'
+    | ANALYTIC_FUNCTION '(' arguments ')' OVER window_specification
     {
-        $$ = AnalyticFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Args: $3, Over: $5.Literal, AnalyticClause: $7.(AnalyticClause)}
+        $$ = AnalyticFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Args: $3, Over: $5.Literal, AnalyticClause: $6.(AnalyticClause)}
     }
-    | FUNCTION_NTH '(' arguments ')' OVER '(' analytic_clause_with_windowing ')'
+    | FUNCTION_NTH '(' arguments ')' OVER window_specification_with_windowing
     {
-        $$ = AnalyticFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Args: $3, Over: $5.Literal, AnalyticClause: $7.(AnalyticClause)}
+        $$ = AnalyticFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Args: $3, Over: $5.Literal, AnalyticClause: $6.(AnalyticClause)}
     }
-    | FUNCTION_NTH '(' arguments ')' IGNORE NULLS OVER '(' analytic_clause_with_windowing ')'
+    | FUNCTION_NTH '(' arguments ')' IGNORE NULLS OVER window_specification_with_windowing
     {
-        $$ = AnalyticFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Args: $3, IgnoreNulls: true, IgnoreNullsLit: $5.Literal + " " + $6.Literal, Over: $7.Literal, AnalyticClause: $9.(AnalyticClause)}
+        $$ = AnalyticFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Args: $3, IgnoreNulls: true, IgnoreNullsLit: $5.Literal + " " + $6.Literal, Over: $7.Literal, AnalyticClause: $8.(AnalyticClause)}
     }
-    | FUNCTION_WITH_INS '(' arguments ')' OVER '(' analytic_clause ')'
+    | FUNCTION_WITH_INS '(' arguments ')' OVER window_specification
     {
-        $$ = AnalyticFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Args: $3, Over: $5.Literal, AnalyticClause: $7.(AnalyticClause)}
+        $$ = AnalyticFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Args: $3, Over: $5.Literal, AnalyticClause: $6.(AnalyticClause)}
     }
-    | FUNCTION_WITH_INS '(' arguments ')' IGNORE NULLS OVER '(' analytic_clause ')'
+    | FUNCTION_WITH_INS '(' arguments ')' IGNORE NULLS OVER window_specification
     {
-        $$ = AnalyticFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Args: $3, IgnoreNulls: true, IgnoreNullsLit: $5.Literal + " " + $6.Literal, Over: $7.Literal, AnalyticClause: $9.(AnalyticClause)}
+        $$ = AnalyticFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Args: $3, IgnoreNulls: true, IgnoreNullsLit: $5.Literal + " " + $6.Literal, Over: $7.Literal, AnalyticClause: $8.(AnalyticClause)}
     }
 
 analytic_clause
@@ -1612,6 +1823,10 @@ analytic_clause
     {
         $$ = AnalyticClause{PartitionClause: $1, OrderByClause: $2}
     }
+    | identifier order_by_clause
+    {
+        $$ = AnalyticClause{WindowName: $1, OrderByClause: $2}
+    }
 
 analytic_clause_with_windowing
     : analytic_clause
@@ -1623,6 +1838,35 @@ analytic_clause_with_windowing
         orderByClause := OrderByClause{OrderBy: $2.Literal + " " + $3.Literal, Items: $4}
         $$ = AnalyticClause{PartitionClause: $1, OrderByClause: orderByClause, WindowingClause: $5}
     }
+    | identifier ORDER BY order_items windowing_clause
+    {
+        orderByClause := OrderByClause{OrderBy: $2.Literal + " " + $3.Literal, Items: $4}
+        $$ = AnalyticClause{WindowName: $1, OrderByClause: orderByClause, WindowingClause: $5}
+    }
+    | identifier windowing_clause
+    {
+        $$ = AnalyticClause{WindowName: $1, WindowingClause: $2}
+    }
+
+window_specification
+    : '(' analytic_clause ')'
+    {
+        $$ = $2
+    }
+    | identifier
+    {
+        $$ = AnalyticClause{WindowName: $1}
+    }
+
+window_specification_with_windowing
+    : '(' analytic_clause_with_windowing ')'
+    {
+        $$ = $2
+    }
+    | identifier
+    {
+        $$ = AnalyticClause{WindowName: $1}
+    }
 
 partition_clause
     :
@@ -1635,13 +1879,71 @@ partition_clause
     }
 
 windowing_clause
-    : ROWS window_position
+    : window_unit window_position window_exclusion
+    {
+        $$ = WindowingClause{Unit: $1, FrameLow: $2, Exclude: $3}
+    }
+    | window_unit BETWEEN window_frame_low AND window_frame_high window_exclusion
+    {
+        $$ = WindowingClause{Unit: $1, FrameLow: $3, FrameHigh: $5, Between: $2.Literal, And: $4.Literal, Exclude: $6}
+    }
+
+window_unit
+    : ROWS
     {
-        $$ = WindowingClause{Rows: $1.Literal, FrameLow: $2}
+        $$ = $1
     }
-    | ROWS BETWEEN window_frame_low AND window_frame_high
+    | RANGE
     {
-        $$ = WindowingClause{Rows: $1.Literal, FrameLow: $3, FrameHigh: $5, Between: $2.Literal, And: $4.Literal}
+        $$ = $1
+    }
+    | GROUPS
+    {
+        $$ = $1
+    }
+
+window_exclusion
+    :
+    {
+        $$ = Token{}
+    }
+    | EXCLUDE CURRENT ROW
+    {
+        $2.Literal = $1.Literal + " " + $2.Literal + " " + $3.Literal
+        $$ = $2
+    }
+    | EXCLUDE GROUP
+    {
+        $2.Literal = $1.Literal + " " + $2.Literal
+        $$ = $2
+    }
+    | EXCLUDE TIES
+    {
+        $2.Literal = $1.Literal + " " + $2.Literal
+        $$ = $2
+    }
+    | EXCLUDE NO OTHERS
+    {
+        $2.Literal = $1.Literal + " " + $2.Literal + " " + $3.Literal
+        $$ = $2
+    }
+
+window_offset
+    : INTEGER
+    {
+        $$ = WindowFramePosition{Offset: value.NewIntegerFromString($1.Literal), Literal: $1.Literal}
+    }
+    | FLOAT
+    {
+        $$ = WindowFramePosition{Offset: value.NewFloatFromString($1.Literal), Literal: $1.Literal}
+    }
+    | INTERVAL INTEGER identifier
+    {
+        $$ = WindowFramePosition{Offset: value.NewIntegerFromString($2.Literal), IntervalUnit: $3.Literal, Literal: $1.Literal + " " + $2.Literal + " " + $3.Literal}
+    }
+    | INTERVAL STRING identifier
+    {
+        $$ = WindowFramePosition{Offset: value.NewString($2.Literal), IntervalUnit: $3.Literal, Literal: $1.Literal + " " + value.NewString($2.Literal).String() + " " + $3.Literal}
     }
 
 window_position
@@ -1649,10 +1951,12 @@ window_position
     {
         $$ = WindowFramePosition{Direction: $2.Token, Unbounded: true, Literal: $1.Literal + " " + $2.Literal}
     }
-    | INTEGER PRECEDING
+    | window_offset PRECEDING
     {
-        i, _ := strconv.Atoi($1.Literal)
-        $$ = WindowFramePosition{Direction: $2.Token, Offset: i, Literal: $1.Literal + " " + $2.Literal}
+        pos := $1.(WindowFramePosition)
+        pos.Direction = $2.Token
+        pos.Literal = pos.Literal + " " + $2.Literal
+        $$ = pos
     }
     | CURRENT ROW
     {
@@ -1660,15 +1964,19 @@ window_position
     }
 
 window_relative_position
-    : INTEGER PRECEDING
+    : window_offset PRECEDING
     {
-        i, _ := strconv.Atoi($1.Literal)
-        $$ = WindowFramePosition{Direction: $2.Token, Offset: i, Literal: $1.Literal + " " + $2.Literal}
+        pos := $1.(WindowFramePosition)
+        pos.Direction = $2.Token
+        pos.Literal = pos.Literal + " " + $2.Literal
+        $$ = pos
     }
-    | INTEGER FOLLOWING
+    | window_offset FOLLOWING
     {
-        i, _ := strconv.Atoi($1.Literal)
-        $$ = WindowFramePosition{Direction: $2.Token, Offset: i, Literal: $1.Literal + " " + $2.Literal}
+        pos := $1.(WindowFramePosition)
+        pos.Direction = $2.Token
+        pos.Literal = pos.Literal + " " + $2.Literal
+        $$ = pos
     }
     | CURRENT ROW
     {
@@ -1705,6 +2013,20 @@ table_identifier
         $$ = Stdin{BaseExpr: NewBaseExpr($1), Stdin: $1.Literal}
     }
 
+external_table
+    : SQLITE '(' value ',' value ')'
+    {
+        $$ = TableObject{BaseExpr: NewBaseExpr($1), Type: Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal}, FormatElement: $3, Args: []QueryExpression{$5}}
+    }
+    | XLSX '(' values ')'
+    {
+        $$ = TableObject{BaseExpr: NewBaseExpr($1), Type: Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal}, Args: $3}
+    }
+    | FILES '(' values ')'
+    {
+        $$ = TableObject{BaseExpr: NewBaseExpr($1), Type: Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal}, Args: $3}
+    }
+
 identified_table
     : table_identifier
     {
@@ -1718,6 +2040,18 @@ identified_table
     {
         $$ = Table{Object: $1, As: $2.Literal, Alias: $3}
     }
+    | external_table
+    {
+        $$ = Table{Object: $1}
+    }
+    | external_table identifier
+    {
+        $$ = Table{Object: $1, Alias: $2}
+    }
+    | external_table AS identifier
+    {
+        $$ = Table{Object: $1, As: $2.Literal, Alias: $3}
+    }
 
 virtual_table_object
     : subquery
@@ -1770,6 +2104,18 @@ table
     {
         $$ = Table{Object: $1}
     }
+    | pivot_table
+    {
+        $$ = Table{Object: $1}
+    }
+    | pivot_table identifier
+    {
+        $$ = Table{Object: $1, Alias: $2}
+    }
+    | pivot_table AS identifier
+    {
+        $$ = Table{Object: $1, As: $2.Literal, Alias: $3}
+    }
     | DUAL
     {
         $$ = Table{Object: Dual{Dual: $1.Literal}}
@@ -1805,6 +2151,41 @@ join
         $$ = Join{Join: $5.Literal, Table: $1, JoinTable: $6, JoinType: $4, Direction: $3, Natural: $2}
     }
 
+pivot_table
+    : table PIVOT '(' fields FOR field_reference IN '(' fields ')' ')'
+    {
+        for i, v := range $9 {
+            field := v.(Field)
+            field.BaseExpr = NewBaseExpr($7)
+            $9[i] = field
+        }
+        $$ = PivotTable{BaseExpr: NewBaseExpr($2), Table: $1, Pivot: $2.Literal, Aggregates: $4, For: $5.Literal, Key: $6, In: $7.Literal, Values: $9}
+    }
+    | table PIVOT '(' fields FOR field_reference IN '(' ANY ')' ')'
+    {
+        $$ = PivotTable{BaseExpr: NewBaseExpr($2), Table: $1, Pivot: $2.Literal, Aggregates: $4, For: $5.Literal, Key: $6, In: $7.Literal, Any: $9.Literal}
+    }
+    | table UNPIVOT unpivot_nulls '(' identifier FOR identifier IN '(' fields ')' ')'
+    {
+        $$ = UnpivotTable{BaseExpr: NewBaseExpr($2), Table: $1, Unpivot: $2.Literal, Nulls: $3, Value: $5, For: $6.Literal, Key: $7, In: $8.Literal, Columns: $10}
+    }
+
+unpivot_nulls
+    :
+    {
+        $$ = Token{}
+    }
+    | INCLUDE NULLS
+    {
+        $1.Literal = $1.Literal + " " + $2.Literal
+        $$ = $1
+    }
+    | EXCLUDE NULLS
+    {
+        $1.Literal = $1.Literal + " " + $2.Literal
+        $$ = $1
+    }
+
 join_condition
     : ON value
     {
@@ -1914,6 +2295,14 @@ operate_tables
     {
         $$ = append([]QueryExpression{Table{Object: $1}}, $3...)
     }
+    | external_table
+    {
+        $$ = []QueryExpression{Table{Object: $1}}
+    }
+    | external_table ',' operate_tables
+    {
+        $$ = append([]QueryExpression{Table{Object: $1}}, $3...)
+    }
 
 identifiers
     : identifier
@@ -1987,6 +2376,50 @@ delete_query
         $$ = DeleteQuery{BaseExpr: NewBaseExpr($2), WithClause: $1, Tables: $3, FromClause: from, WhereClause: $6}
     }
 
+merge_query
+    : with_clause MERGE INTO identified_table USING table ON value merge_when_list
+    {
+        $$ = MergeQuery{BaseExpr: NewBaseExpr($2), WithClause: $1, Table: $4, Source: $6, On: $8, WhenList: $9}
+    }
+
+merge_when
+    : WHEN MATCHED merge_condition THEN UPDATE SET update_set_list
+    {
+        $$ = MergeWhen{BaseExpr: NewBaseExpr($1), Matched: true, Condition: $3, Operation: $5, SetList: $7}
+    }
+    | WHEN MATCHED merge_condition THEN DELETE
+    {
+        $$ = MergeWhen{BaseExpr: NewBaseExpr($1), Matched: true, Condition: $3, Operation: $5}
+    }
+    | WHEN NOT MATCHED merge_condition THEN INSERT VALUES row_value
+    {
+        $$ = MergeWhen{BaseExpr: NewBaseExpr($1), Condition: $4, Operation: $6, Values: $8}
+    }
+    | WHEN NOT MATCHED merge_condition THEN INSERT '(' field_references ')' VALUES row_value
+    {
+        $$ = MergeWhen{BaseExpr: NewBaseExpr($1), Condition: $4, Operation: $6, Fields: $8, Values: $11}
+    }
+
+merge_when_list
+    : merge_when
+    {
+        $$ = []MergeWhen{$1}
+    }
+    | merge_when merge_when_list
+    {
+        $$ = append([]MergeWhen{$1}, $2...)
+    }
+
+merge_condition
+    :
+    {
+        $$ = nil
+    }
+    | AND value
+    {
+        $$ = $2
+    }
+
 elseif
     : ELSEIF value THEN program
     {
@@ -2164,6 +2597,38 @@ identifier
     {
         $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
     }
+    | GROUPS
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | EXCLUDE
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | NO
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | OTHERS
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | INTERVAL
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | SETS
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | INCLUDE
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | MATCHED
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
 
 variable
     : VARIABLE
@@ -2219,6 +2684,12 @@ runtime_information
         $$ = RuntimeInformation{BaseExpr: NewBaseExpr($1), Name: $1.Literal}
     }
 
+placeholder
+    : PLACEHOLDER
+    {
+        $$ = Placeholder{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Ordinal: $1.HolderOrdinal}
+    }
+
 distinct
     :
     {
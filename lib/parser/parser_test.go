@@ -179,6 +179,100 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "select c1 from sqlite(`data.db`, users) u",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields: []QueryExpression{
+							Field{
+								Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "c1"}},
+							},
+						},
+					},
+					FromClause: FromClause{From: "from", Tables: []QueryExpression{
+						Table{
+							Object: TableObject{
+								BaseExpr:      &BaseExpr{line: 1, char: 16},
+								Type:          Identifier{BaseExpr: &BaseExpr{line: 1, char: 16}, Literal: "sqlite"},
+								FormatElement: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 23}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 23}, Literal: "data.db", Quoted: true}},
+								Args:          []QueryExpression{FieldReference{BaseExpr: &BaseExpr{line: 1, char: 34}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 34}, Literal: "users"}}},
+							},
+							Alias: Identifier{BaseExpr: &BaseExpr{line: 1, char: 41}, Literal: "u"},
+						},
+					}},
+				},
+			},
+		},
+	},
+	{
+		Input: "select c1 from xlsx('book.xlsx', 'Sheet1', 'A1:F200') as b",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields: []QueryExpression{
+							Field{
+								Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "c1"}},
+							},
+						},
+					},
+					FromClause: FromClause{From: "from", Tables: []QueryExpression{
+						Table{
+							Object: TableObject{
+								BaseExpr: &BaseExpr{line: 1, char: 16},
+								Type:     Identifier{BaseExpr: &BaseExpr{line: 1, char: 16}, Literal: "xlsx"},
+								Args: []QueryExpression{
+									NewStringValue("book.xlsx"),
+									NewStringValue("Sheet1"),
+									NewStringValue("A1:F200"),
+								},
+							},
+							As:    "as",
+							Alias: Identifier{BaseExpr: &BaseExpr{line: 1, char: 58}, Literal: "b"},
+						},
+					}},
+				},
+			},
+		},
+	},
+	{
+		Input: "select c1 from files('logs', '2026-*.csv') as l",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields: []QueryExpression{
+							Field{
+								Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "c1"}},
+							},
+						},
+					},
+					FromClause: FromClause{From: "from", Tables: []QueryExpression{
+						Table{
+							Object: TableObject{
+								BaseExpr: &BaseExpr{line: 1, char: 16},
+								Type:     Identifier{BaseExpr: &BaseExpr{line: 1, char: 16}, Literal: "files"},
+								Args: []QueryExpression{
+									NewStringValue("logs"),
+									NewStringValue("2026-*.csv"),
+								},
+							},
+							As:    "as",
+							Alias: Identifier{BaseExpr: &BaseExpr{line: 1, char: 47}, Literal: "l"},
+						},
+					}},
+				},
+			},
+		},
+	},
 	{
 		Input: "select c1 from ltsv(`table.ltsv`)",
 		Output: []Statement{
@@ -489,6 +583,65 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "select grouping(column1, column2) from dual group by rollup(column1, column2), cube(column3), grouping sets((column1, column2), (), column4)",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields: []QueryExpression{
+							Field{Object: Function{
+								BaseExpr: &BaseExpr{line: 1, char: 8},
+								Name:     "grouping",
+								Args: []QueryExpression{
+									FieldReference{BaseExpr: &BaseExpr{line: 1, char: 17}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 17}, Literal: "column1"}},
+									FieldReference{BaseExpr: &BaseExpr{line: 1, char: 26}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 26}, Literal: "column2"}},
+								},
+							}},
+						},
+					},
+					FromClause: FromClause{From: "from", Tables: []QueryExpression{Table{Object: Dual{Dual: "dual"}}}},
+					GroupByClause: GroupByClause{
+						GroupBy: "group by",
+						Items: []QueryExpression{
+							Rollup{
+								BaseExpr: &BaseExpr{line: 1, char: 54},
+								Rollup:   "rollup",
+								Values: []QueryExpression{
+									FieldReference{BaseExpr: &BaseExpr{line: 1, char: 61}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 61}, Literal: "column1"}},
+									FieldReference{BaseExpr: &BaseExpr{line: 1, char: 70}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 70}, Literal: "column2"}},
+								},
+							},
+							Cube{
+								BaseExpr: &BaseExpr{line: 1, char: 80},
+								Cube:     "cube",
+								Values: []QueryExpression{
+									FieldReference{BaseExpr: &BaseExpr{line: 1, char: 85}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 85}, Literal: "column3"}},
+								},
+							},
+							GroupingSets{
+								BaseExpr:     &BaseExpr{line: 1, char: 95},
+								GroupingSets: "grouping sets",
+								Sets: []QueryExpression{
+									GroupingSet{
+										BaseExpr: &BaseExpr{line: 1, char: 109},
+										Values: []QueryExpression{
+											FieldReference{BaseExpr: &BaseExpr{line: 1, char: 110}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 110}, Literal: "column1"}},
+											FieldReference{BaseExpr: &BaseExpr{line: 1, char: 119}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 119}, Literal: "column2"}},
+										},
+									},
+									GroupingSet{BaseExpr: &BaseExpr{line: 1, char: 129}},
+									FieldReference{BaseExpr: &BaseExpr{line: 1, char: 133}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 133}, Literal: "column4"}},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	},
 	{
 		Input: "select 1 \n" +
 			" from dual \n" +
@@ -1276,6 +1429,37 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "select column1 regexp '^a' and column2 not regexp 'b$'",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields: []QueryExpression{
+							Field{Object: Logic{
+								LHS: RegExp{
+									BaseExpr: &BaseExpr{line: 1, char: 16},
+									RegExp:   "regexp",
+									LHS:      FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "column1"}},
+									Pattern:  NewStringValue("^a"),
+								},
+								Operator: Token{Token: AND, Literal: "and", Line: 1, Char: 28},
+								RHS: RegExp{
+									BaseExpr: &BaseExpr{line: 1, char: 44},
+									RegExp:   "regexp",
+									LHS:      FieldReference{BaseExpr: &BaseExpr{line: 1, char: 32}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 32}, Literal: "column2"}},
+									Pattern:  NewStringValue("b$"),
+									Negation: Token{Token: NOT, Literal: "not", Line: 1, Char: 40},
+								},
+							}},
+						},
+					},
+				},
+			},
+		},
+	},
 	{
 		Input: "select column1 = any (select 1)",
 		Output: []Statement{
@@ -2210,6 +2394,58 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "select corr(column1, column2)",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields: []QueryExpression{
+							Field{Object: AggregateFunction{
+								BaseExpr: &BaseExpr{line: 1, char: 8},
+								Name:     "corr",
+								Args: []QueryExpression{
+									FieldReference{BaseExpr: &BaseExpr{line: 1, char: 13}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 13}, Literal: "column1"}},
+									FieldReference{BaseExpr: &BaseExpr{line: 1, char: 22}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 22}, Literal: "column2"}},
+								},
+							}},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select percentile_cont(0.5) within group (order by column1)",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields: []QueryExpression{
+							Field{Object: ListFunction{
+								BaseExpr: &BaseExpr{line: 1, char: 8},
+								Name:     "percentile_cont",
+								Args: []QueryExpression{
+									NewFloatValueFromString("0.5"),
+								},
+								WithinGroup: "within group",
+								OrderBy: OrderByClause{
+									OrderBy: "order by",
+									Items: []QueryExpression{
+										OrderItem{Value: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 52}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 52}, Literal: "column1"}}},
+									},
+								},
+							}},
+						},
+					},
+				},
+			},
+		},
+	},
 	{
 		Input: "select cursor cur is not open",
 		Output: []Statement{
@@ -2335,7 +2571,7 @@ var parseTests = []struct {
 										},
 									},
 									WindowingClause: WindowingClause{
-										Rows: "rows",
+										Unit: Token{Token: ROWS, Literal: "rows", Line: 1, Char: 42},
 										FrameLow: WindowFramePosition{
 											Direction: CURRENT,
 											Literal:   "current row",
@@ -2372,7 +2608,7 @@ var parseTests = []struct {
 										},
 									},
 									WindowingClause: WindowingClause{
-										Rows: "rows",
+										Unit: Token{Token: ROWS, Literal: "rows", Line: 1, Char: 42},
 										FrameLow: WindowFramePosition{
 											Direction: PRECEDING,
 											Unbounded: true,
@@ -2410,10 +2646,10 @@ var parseTests = []struct {
 										},
 									},
 									WindowingClause: WindowingClause{
-										Rows: "rows",
+										Unit: Token{Token: ROWS, Literal: "rows", Line: 1, Char: 42},
 										FrameLow: WindowFramePosition{
 											Direction: PRECEDING,
-											Offset:    1,
+											Offset:    value.NewInteger(1),
 											Literal:   "1 preceding",
 										},
 									},
@@ -2426,7 +2662,7 @@ var parseTests = []struct {
 		},
 	},
 	{
-		Input: "select userfunc() over (order by column2 rows between unbounded preceding and 1 following)",
+		Input: "select userfunc() over (order by column2 range between interval 7 day preceding and 1.5 following exclude ties)",
 		Output: []Statement{
 			SelectQuery{
 				SelectEntity: SelectEntity{
@@ -2448,19 +2684,21 @@ var parseTests = []struct {
 										},
 									},
 									WindowingClause: WindowingClause{
-										Rows: "rows",
+										Unit: Token{Token: RANGE, Literal: "range", Line: 1, Char: 42},
 										FrameLow: WindowFramePosition{
-											Direction: PRECEDING,
-											Unbounded: true,
-											Literal:   "unbounded preceding",
+											Direction:    PRECEDING,
+											Offset:       value.NewInteger(7),
+											IntervalUnit: "day",
+											Literal:      "interval 7 day preceding",
 										},
 										FrameHigh: WindowFramePosition{
 											Direction: FOLLOWING,
-											Offset:    1,
-											Literal:   "1 following",
+											Offset:    value.NewFloat(1.5),
+											Literal:   "1.5 following",
 										},
 										Between: "between",
 										And:     "and",
+										Exclude: Token{Token: TIES, Literal: "exclude ties", Line: 1, Char: 107},
 									},
 								},
 							}},
@@ -2471,7 +2709,7 @@ var parseTests = []struct {
 		},
 	},
 	{
-		Input: "select userfunc() over (order by column2 rows between 1 preceding and unbounded following)",
+		Input: "select userfunc() over w from dual window w as (partition by column1), w2 as (w order by column2 groups current row exclude no others)",
 		Output: []Statement{
 			SelectQuery{
 				SelectEntity: SelectEntity{
@@ -2484,31 +2722,54 @@ var parseTests = []struct {
 								Name:     "userfunc",
 								Over:     "over",
 								AnalyticClause: AnalyticClause{
+									WindowName: Identifier{BaseExpr: &BaseExpr{line: 1, char: 24}, Literal: "w"},
+								},
+							}},
+						},
+					},
+					FromClause: FromClause{From: "from", Tables: []QueryExpression{
+						Table{Object: Dual{Dual: "dual"}},
+					}},
+					WindowClause: WindowClause{
+						Window: "window",
+						Definitions: []QueryExpression{
+							WindowDefinition{
+								BaseExpr: &BaseExpr{line: 1, char: 43},
+								Name:     Identifier{BaseExpr: &BaseExpr{line: 1, char: 43}, Literal: "w"},
+								As:       "as",
+								Clause: AnalyticClause{
+									PartitionClause: PartitionClause{
+										PartitionBy: "partition by",
+										Values: []QueryExpression{
+											FieldReference{BaseExpr: &BaseExpr{line: 1, char: 62}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 62}, Literal: "column1"}},
+										},
+									},
+								},
+							},
+							WindowDefinition{
+								BaseExpr: &BaseExpr{line: 1, char: 72},
+								Name:     Identifier{BaseExpr: &BaseExpr{line: 1, char: 72}, Literal: "w2"},
+								As:       "as",
+								Clause: AnalyticClause{
+									WindowName: Identifier{BaseExpr: &BaseExpr{line: 1, char: 79}, Literal: "w"},
 									OrderByClause: OrderByClause{
 										OrderBy: "order by",
 										Items: []QueryExpression{
 											OrderItem{
-												Value: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 34}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 34}, Literal: "column2"}},
+												Value: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 90}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 90}, Literal: "column2"}},
 											},
 										},
 									},
 									WindowingClause: WindowingClause{
-										Rows: "rows",
+										Unit: Token{Token: GROUPS, Literal: "groups", Line: 1, Char: 98},
 										FrameLow: WindowFramePosition{
-											Direction: PRECEDING,
-											Offset:    1,
-											Literal:   "1 preceding",
-										},
-										FrameHigh: WindowFramePosition{
-											Direction: FOLLOWING,
-											Unbounded: true,
-											Literal:   "unbounded following",
+											Direction: CURRENT,
+											Literal:   "current row",
 										},
-										Between: "between",
-										And:     "and",
+										Exclude: Token{Token: NO, Literal: "exclude no others", Line: 1, Char: 125},
 									},
 								},
-							}},
+							},
 						},
 					},
 				},
@@ -2516,7 +2777,7 @@ var parseTests = []struct {
 		},
 	},
 	{
-		Input: "select userfunc() over (order by column2 rows between current row and unbounded following)",
+		Input: "select userfunc() over (order by column2 rows between unbounded preceding and 1 following)",
 		Output: []Statement{
 			SelectQuery{
 				SelectEntity: SelectEntity{
@@ -2538,15 +2799,16 @@ var parseTests = []struct {
 										},
 									},
 									WindowingClause: WindowingClause{
-										Rows: "rows",
+										Unit: Token{Token: ROWS, Literal: "rows", Line: 1, Char: 42},
 										FrameLow: WindowFramePosition{
-											Direction: CURRENT,
-											Literal:   "current row",
+											Direction: PRECEDING,
+											Unbounded: true,
+											Literal:   "unbounded preceding",
 										},
 										FrameHigh: WindowFramePosition{
 											Direction: FOLLOWING,
-											Unbounded: true,
-											Literal:   "unbounded following",
+											Offset:    value.NewInteger(1),
+											Literal:   "1 following",
 										},
 										Between: "between",
 										And:     "and",
@@ -2560,7 +2822,7 @@ var parseTests = []struct {
 		},
 	},
 	{
-		Input: "select f(column1) over (partition by column1 order by column2)",
+		Input: "select userfunc() over (order by column2 rows between 1 preceding and unbounded following)",
 		Output: []Statement{
 			SelectQuery{
 				SelectEntity: SelectEntity{
@@ -2570,26 +2832,32 @@ var parseTests = []struct {
 						Fields: []QueryExpression{
 							Field{Object: AnalyticFunction{
 								BaseExpr: &BaseExpr{line: 1, char: 8},
-								Name:     "f",
-								Args: []QueryExpression{
-									FieldReference{BaseExpr: &BaseExpr{line: 1, char: 10}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 10}, Literal: "column1"}},
-								},
-								Over: "over",
+								Name:     "userfunc",
+								Over:     "over",
 								AnalyticClause: AnalyticClause{
-									PartitionClause: PartitionClause{
-										PartitionBy: "partition by",
-										Values: []QueryExpression{
-											FieldReference{BaseExpr: &BaseExpr{line: 1, char: 38}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 38}, Literal: "column1"}},
-										},
-									},
 									OrderByClause: OrderByClause{
 										OrderBy: "order by",
 										Items: []QueryExpression{
 											OrderItem{
-												Value: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 55}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 55}, Literal: "column2"}},
+												Value: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 34}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 34}, Literal: "column2"}},
 											},
 										},
 									},
+									WindowingClause: WindowingClause{
+										Unit: Token{Token: ROWS, Literal: "rows", Line: 1, Char: 42},
+										FrameLow: WindowFramePosition{
+											Direction: PRECEDING,
+											Offset:    value.NewInteger(1),
+											Literal:   "1 preceding",
+										},
+										FrameHigh: WindowFramePosition{
+											Direction: FOLLOWING,
+											Unbounded: true,
+											Literal:   "unbounded following",
+										},
+										Between: "between",
+										And:     "and",
+									},
 								},
 							}},
 						},
@@ -2599,7 +2867,7 @@ var parseTests = []struct {
 		},
 	},
 	{
-		Input: "select f(distinct column1) over (partition by column1 order by column2)",
+		Input: "select userfunc() over (order by column2 rows between current row and unbounded following)",
 		Output: []Statement{
 			SelectQuery{
 				SelectEntity: SelectEntity{
@@ -2609,9 +2877,92 @@ var parseTests = []struct {
 						Fields: []QueryExpression{
 							Field{Object: AnalyticFunction{
 								BaseExpr: &BaseExpr{line: 1, char: 8},
-								Name:     "f",
-								Distinct: Token{Token: DISTINCT, Literal: "distinct", Line: 1, Char: 10},
-								Args: []QueryExpression{
+								Name:     "userfunc",
+								Over:     "over",
+								AnalyticClause: AnalyticClause{
+									OrderByClause: OrderByClause{
+										OrderBy: "order by",
+										Items: []QueryExpression{
+											OrderItem{
+												Value: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 34}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 34}, Literal: "column2"}},
+											},
+										},
+									},
+									WindowingClause: WindowingClause{
+										Unit: Token{Token: ROWS, Literal: "rows", Line: 1, Char: 42},
+										FrameLow: WindowFramePosition{
+											Direction: CURRENT,
+											Literal:   "current row",
+										},
+										FrameHigh: WindowFramePosition{
+											Direction: FOLLOWING,
+											Unbounded: true,
+											Literal:   "unbounded following",
+										},
+										Between: "between",
+										And:     "and",
+									},
+								},
+							}},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select f(column1) over (partition by column1 order by column2)",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields: []QueryExpression{
+							Field{Object: AnalyticFunction{
+								BaseExpr: &BaseExpr{line: 1, char: 8},
+								Name:     "f",
+								Args: []QueryExpression{
+									FieldReference{BaseExpr: &BaseExpr{line: 1, char: 10}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 10}, Literal: "column1"}},
+								},
+								Over: "over",
+								AnalyticClause: AnalyticClause{
+									PartitionClause: PartitionClause{
+										PartitionBy: "partition by",
+										Values: []QueryExpression{
+											FieldReference{BaseExpr: &BaseExpr{line: 1, char: 38}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 38}, Literal: "column1"}},
+										},
+									},
+									OrderByClause: OrderByClause{
+										OrderBy: "order by",
+										Items: []QueryExpression{
+											OrderItem{
+												Value: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 55}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 55}, Literal: "column2"}},
+											},
+										},
+									},
+								},
+							}},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select f(distinct column1) over (partition by column1 order by column2)",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields: []QueryExpression{
+							Field{Object: AnalyticFunction{
+								BaseExpr: &BaseExpr{line: 1, char: 8},
+								Name:     "f",
+								Distinct: Token{Token: DISTINCT, Literal: "distinct", Line: 1, Char: 10},
+								Args: []QueryExpression{
 									FieldReference{BaseExpr: &BaseExpr{line: 1, char: 19}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 19}, Literal: "column1"}},
 								},
 								Over: "over",
@@ -2670,7 +3021,7 @@ var parseTests = []struct {
 										},
 									},
 									WindowingClause: WindowingClause{
-										Rows: "rows",
+										Unit: Token{Token: ROWS, Literal: "rows", Line: 1, Char: 65},
 										FrameLow: WindowFramePosition{
 											Direction: CURRENT,
 											Literal:   "current row",
@@ -2716,7 +3067,7 @@ var parseTests = []struct {
 										},
 									},
 									WindowingClause: WindowingClause{
-										Rows: "rows",
+										Unit: Token{Token: ROWS, Literal: "rows", Line: 1, Char: 67},
 										FrameLow: WindowFramePosition{
 											Direction: CURRENT,
 											Literal:   "current row",
@@ -2762,7 +3113,7 @@ var parseTests = []struct {
 										},
 									},
 									WindowingClause: WindowingClause{
-										Rows: "rows",
+										Unit: Token{Token: ROWS, Literal: "rows", Line: 1, Char: 61},
 										FrameLow: WindowFramePosition{
 											Direction: CURRENT,
 											Literal:   "current row",
@@ -2815,6 +3166,44 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "select percentile_disc(0.5) within group (order by column1) over (partition by column2)",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields: []QueryExpression{
+							Field{Object: AnalyticFunction{
+								BaseExpr: &BaseExpr{line: 1, char: 8},
+								Name:     "percentile_disc",
+								Args: []QueryExpression{
+									NewFloatValueFromString("0.5"),
+								},
+								WithinGroup: "within group",
+								OrderBy: OrderByClause{
+									OrderBy: "order by",
+									Items: []QueryExpression{
+										OrderItem{Value: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 52}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 52}, Literal: "column1"}}},
+									},
+								},
+								Over: "over",
+								AnalyticClause: AnalyticClause{
+									PartitionClause: PartitionClause{
+										PartitionBy: "partition by",
+										Values: []QueryExpression{
+											FieldReference{BaseExpr: &BaseExpr{line: 1, char: 80}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 80}, Literal: "column2"}},
+										},
+									},
+								},
+							}},
+						},
+					},
+				},
+			},
+		},
+	},
 	{
 		Input: "select listagg(column1, ',') over (partition by column1 order by column2)",
 		Output: []Statement{
@@ -2923,7 +3312,7 @@ var parseTests = []struct {
 										},
 									},
 									WindowingClause: WindowingClause{
-										Rows: "rows",
+										Unit: Token{Token: ROWS, Literal: "rows", Line: 1, Char: 73},
 										FrameLow: WindowFramePosition{
 											Direction: CURRENT,
 											Literal:   "current row",
@@ -2971,7 +3360,7 @@ var parseTests = []struct {
 										},
 									},
 									WindowingClause: WindowingClause{
-										Rows: "rows",
+										Unit: Token{Token: ROWS, Literal: "rows", Line: 1, Char: 86},
 										FrameLow: WindowFramePosition{
 											Direction: CURRENT,
 											Literal:   "current row",
@@ -3088,6 +3477,112 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "select 1 from table1 pivot (sum(column1) for column2 in (1, 2 as two))",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Select: "select", Fields: []QueryExpression{Field{Object: NewIntegerValueFromString("1")}}},
+					FromClause: FromClause{
+						From: "from",
+						Tables: []QueryExpression{
+							Table{
+								Object: PivotTable{
+									BaseExpr: &BaseExpr{line: 1, char: 22},
+									Table:    Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 15}, Literal: "table1"}},
+									Pivot:    "pivot",
+									Aggregates: []QueryExpression{
+										Field{Object: AggregateFunction{
+											BaseExpr: &BaseExpr{line: 1, char: 29},
+											Name:     "sum",
+											Args: []QueryExpression{
+												FieldReference{BaseExpr: &BaseExpr{line: 1, char: 33}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 33}, Literal: "column1"}},
+											},
+										}},
+									},
+									For: "for",
+									Key: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 46}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 46}, Literal: "column2"}},
+									In:  "in",
+									Values: []QueryExpression{
+										Field{BaseExpr: &BaseExpr{line: 1, char: 54}, Object: NewIntegerValueFromString("1")},
+										Field{BaseExpr: &BaseExpr{line: 1, char: 54}, Object: NewIntegerValueFromString("2"), As: "as", Alias: Identifier{BaseExpr: &BaseExpr{line: 1, char: 66}, Literal: "two"}},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select 1 from table1 pivot (count(*) for column2 in (any)) as p",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Select: "select", Fields: []QueryExpression{Field{Object: NewIntegerValueFromString("1")}}},
+					FromClause: FromClause{
+						From: "from",
+						Tables: []QueryExpression{
+							Table{
+								Object: PivotTable{
+									BaseExpr: &BaseExpr{line: 1, char: 22},
+									Table:    Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 15}, Literal: "table1"}},
+									Pivot:    "pivot",
+									Aggregates: []QueryExpression{
+										Field{Object: AggregateFunction{
+											BaseExpr: &BaseExpr{line: 1, char: 29},
+											Name:     "count",
+											Args: []QueryExpression{
+												AllColumns{BaseExpr: &BaseExpr{line: 1, char: 35}},
+											},
+										}},
+									},
+									For: "for",
+									Key: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 42}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 42}, Literal: "column2"}},
+									In:  "in",
+									Any: "any",
+								},
+								As:    "as",
+								Alias: Identifier{BaseExpr: &BaseExpr{line: 1, char: 63}, Literal: "p"},
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select 1 from table1 unpivot include nulls (val for col in (column1, column2 as c2))",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Select: "select", Fields: []QueryExpression{Field{Object: NewIntegerValueFromString("1")}}},
+					FromClause: FromClause{
+						From: "from",
+						Tables: []QueryExpression{
+							Table{
+								Object: UnpivotTable{
+									BaseExpr: &BaseExpr{line: 1, char: 22},
+									Table:    Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 15}, Literal: "table1"}},
+									Unpivot:  "unpivot",
+									Nulls:    Token{Token: INCLUDE, Literal: "include nulls", Line: 1, Char: 30},
+									Value:    Identifier{BaseExpr: &BaseExpr{line: 1, char: 45}, Literal: "val"},
+									For:      "for",
+									Key:      Identifier{BaseExpr: &BaseExpr{line: 1, char: 53}, Literal: "col"},
+									In:       "in",
+									Columns: []QueryExpression{
+										Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 61}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 61}, Literal: "column1"}}},
+										Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 70}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 70}, Literal: "column2"}}, As: "as", Alias: Identifier{BaseExpr: &BaseExpr{line: 1, char: 81}, Literal: "c2"}},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	},
 	{
 		Input: "select 1 from table1 cross join table2 cross join table3",
 		Output: []Statement{
@@ -3598,6 +4093,29 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "insert into sqlite('data.db', 'users') values (1)",
+		Output: []Statement{
+			InsertQuery{
+				Table: Table{Object: TableObject{
+					BaseExpr:      &BaseExpr{line: 1, char: 13},
+					Type:          Identifier{BaseExpr: &BaseExpr{line: 1, char: 13}, Literal: "sqlite"},
+					FormatElement: NewStringValue("data.db"),
+					Args:          []QueryExpression{NewStringValue("users")},
+				}},
+				ValuesList: []QueryExpression{
+					RowValue{
+						BaseExpr: &BaseExpr{line: 1, char: 47},
+						Value: ValueList{
+							Values: []QueryExpression{
+								NewIntegerValueFromString("1"),
+							},
+						},
+					},
+				},
+			},
+		},
+	},
 	{
 		Input: "insert into table1 (column1, column2) select 1, 2",
 		Output: []Statement{
@@ -3722,6 +4240,56 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "merge into table1 t using table2 on t.c1 = table2.c1 when matched and table2.c2 = 1 then delete when matched then update set c2 = table2.c2 when not matched then insert (c1) values (table2.c1)",
+		Output: []Statement{
+			MergeQuery{
+				BaseExpr: &BaseExpr{line: 1, char: 1},
+				Table:    Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 12}, Literal: "table1"}, Alias: Identifier{BaseExpr: &BaseExpr{line: 1, char: 19}, Literal: "t"}},
+				Source:   Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 27}, Literal: "table2"}},
+				On: Comparison{
+					LHS:      FieldReference{BaseExpr: &BaseExpr{line: 1, char: 37}, View: Identifier{BaseExpr: &BaseExpr{line: 1, char: 37}, Literal: "t"}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 39}, Literal: "c1"}},
+					Operator: "=",
+					RHS:      FieldReference{BaseExpr: &BaseExpr{line: 1, char: 44}, View: Identifier{BaseExpr: &BaseExpr{line: 1, char: 44}, Literal: "table2"}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 51}, Literal: "c1"}},
+				},
+				WhenList: []MergeWhen{
+					{
+						BaseExpr: &BaseExpr{line: 1, char: 54},
+						Matched:  true,
+						Condition: Comparison{
+							LHS:      FieldReference{BaseExpr: &BaseExpr{line: 1, char: 71}, View: Identifier{BaseExpr: &BaseExpr{line: 1, char: 71}, Literal: "table2"}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 78}, Literal: "c2"}},
+							Operator: "=",
+							RHS:      NewIntegerValueFromString("1"),
+						},
+						Operation: Token{Token: DELETE, Literal: "delete", Line: 1, Char: 90},
+					},
+					{
+						BaseExpr:  &BaseExpr{line: 1, char: 97},
+						Matched:   true,
+						Operation: Token{Token: UPDATE, Literal: "update", Line: 1, Char: 115},
+						SetList: []UpdateSet{
+							{Field: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 126}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 126}, Literal: "c2"}}, Value: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 131}, View: Identifier{BaseExpr: &BaseExpr{line: 1, char: 131}, Literal: "table2"}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 138}, Literal: "c2"}}},
+						},
+					},
+					{
+						BaseExpr:  &BaseExpr{line: 1, char: 141},
+						Operation: Token{Token: INSERT, Literal: "insert", Line: 1, Char: 163},
+						Fields: []QueryExpression{
+							FieldReference{BaseExpr: &BaseExpr{line: 1, char: 171}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 171}, Literal: "c1"}},
+						},
+						Values: RowValue{
+							BaseExpr: &BaseExpr{line: 1, char: 182},
+							Value: ValueList{
+								Values: []QueryExpression{
+									FieldReference{BaseExpr: &BaseExpr{line: 1, char: 183}, View: Identifier{BaseExpr: &BaseExpr{line: 1, char: 183}, Literal: "table2"}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 190}, Literal: "c1"}},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	},
 	{
 		Input: "create table newtable (column1, column2)",
 		Output: []Statement{
@@ -3734,6 +4302,33 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "create table newtable (column1 integer not null, column2 float, column3 not null, column4)",
+		Output: []Statement{
+			CreateTable{
+				Table: Identifier{BaseExpr: &BaseExpr{line: 1, char: 14}, Literal: "newtable"},
+				Fields: []QueryExpression{
+					ColumnDefinition{
+						BaseExpr: &BaseExpr{line: 1, char: 24},
+						Column:   Identifier{BaseExpr: &BaseExpr{line: 1, char: 24}, Literal: "column1"},
+						Type:     Identifier{BaseExpr: &BaseExpr{line: 1, char: 32}, Literal: "integer"},
+						NotNull:  true,
+					},
+					ColumnDefinition{
+						BaseExpr: &BaseExpr{line: 1, char: 50},
+						Column:   Identifier{BaseExpr: &BaseExpr{line: 1, char: 50}, Literal: "column2"},
+						Type:     Identifier{BaseExpr: &BaseExpr{line: 1, char: 58}, Literal: "float"},
+					},
+					ColumnDefinition{
+						BaseExpr: &BaseExpr{line: 1, char: 65},
+						Column:   Identifier{BaseExpr: &BaseExpr{line: 1, char: 65}, Literal: "column3"},
+						NotNull:  true,
+					},
+					Identifier{BaseExpr: &BaseExpr{line: 1, char: 83}, Literal: "column4"},
+				},
+			},
+		},
+	},
 	{
 		Input: "create table newtable (column1, column2) select 1, 2",
 		Output: []Statement{
@@ -3851,6 +4446,33 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "alter table table1 add (column1 integer, column2 datetime not null default now(), column3 not null default 1)",
+		Output: []Statement{
+			AddColumns{
+				Table: Identifier{BaseExpr: &BaseExpr{line: 1, char: 13}, Literal: "table1"},
+				Columns: []ColumnDefault{
+					{
+						Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 25}, Literal: "column1"},
+						Type:   Identifier{BaseExpr: &BaseExpr{line: 1, char: 33}, Literal: "integer"},
+					},
+					{
+						BaseExpr: &BaseExpr{line: 1, char: 68},
+						Column:   Identifier{BaseExpr: &BaseExpr{line: 1, char: 42}, Literal: "column2"},
+						Type:     Identifier{BaseExpr: &BaseExpr{line: 1, char: 50}, Literal: "datetime"},
+						NotNull:  true,
+						Value:    Function{BaseExpr: &BaseExpr{line: 1, char: 76}, Name: "now"},
+					},
+					{
+						BaseExpr: &BaseExpr{line: 1, char: 100},
+						Column:   Identifier{BaseExpr: &BaseExpr{line: 1, char: 83}, Literal: "column3"},
+						NotNull:  true,
+						Value:    NewIntegerValueFromString("1"),
+					},
+				},
+			},
+		},
+	},
 	{
 		Input: "alter table table1 add (column1, column2 default 1) first",
 		Output: []Statement{
@@ -3861,8 +4483,9 @@ var parseTests = []struct {
 						Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 25}, Literal: "column1"},
 					},
 					{
-						Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 34}, Literal: "column2"},
-						Value:  NewIntegerValueFromString("1"),
+						BaseExpr: &BaseExpr{line: 1, char: 42},
+						Column:   Identifier{BaseExpr: &BaseExpr{line: 1, char: 34}, Literal: "column2"},
+						Value:    NewIntegerValueFromString("1"),
 					},
 				},
 				Position: ColumnPosition{
@@ -3985,6 +4608,41 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "alter table table1 set format to xlsx",
+		Output: []Statement{
+			SetTableAttribute{
+				BaseExpr:  &BaseExpr{line: 1, char: 1},
+				Table:     Identifier{BaseExpr: &BaseExpr{line: 1, char: 13}, Literal: "table1"},
+				Attribute: Identifier{BaseExpr: &BaseExpr{line: 1, char: 24}, Literal: "format"},
+				Value:     Identifier{BaseExpr: &BaseExpr{line: 1, char: 34}, Literal: "xlsx"},
+			},
+		},
+	},
+	{
+		Input: "create index idx on table1 (column1, column2)",
+		Output: []Statement{
+			CreateIndex{
+				BaseExpr: &BaseExpr{line: 1, char: 1},
+				Name:     Identifier{BaseExpr: &BaseExpr{line: 1, char: 14}, Literal: "idx"},
+				Table:    Identifier{BaseExpr: &BaseExpr{line: 1, char: 21}, Literal: "table1"},
+				Columns: []QueryExpression{
+					Identifier{BaseExpr: &BaseExpr{line: 1, char: 29}, Literal: "column1"},
+					Identifier{BaseExpr: &BaseExpr{line: 1, char: 38}, Literal: "column2"},
+				},
+			},
+		},
+	},
+	{
+		Input: "drop index idx on table1",
+		Output: []Statement{
+			DropIndex{
+				BaseExpr: &BaseExpr{line: 1, char: 1},
+				Name:     Identifier{BaseExpr: &BaseExpr{line: 1, char: 12}, Literal: "idx"},
+				Table:    Identifier{BaseExpr: &BaseExpr{line: 1, char: 19}, Literal: "table1"},
+			},
+		},
+	},
 	{
 		Input: "commit",
 		Output: []Statement{
@@ -4185,6 +4843,26 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "set @@format = xlsx",
+		Output: []Statement{
+			SetFlag{
+				BaseExpr: &BaseExpr{line: 1, char: 1},
+				Name:     "format",
+				Value:    Identifier{BaseExpr: &BaseExpr{line: 1, char: 16}, Literal: "xlsx"},
+			},
+		},
+	},
+	{
+		Input: "set @@format to xlsx",
+		Output: []Statement{
+			SetFlag{
+				BaseExpr: &BaseExpr{line: 1, char: 1},
+				Name:     "format",
+				Value:    Identifier{BaseExpr: &BaseExpr{line: 1, char: 17}, Literal: "xlsx"},
+			},
+		},
+	},
 	{
 		Input: "add '%Y%m%d' to @@datetime_format",
 		Output: []Statement{
@@ -4214,6 +4892,52 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "explain select c1 from stdin",
+		Output: []Statement{
+			Explain{
+				BaseExpr: &BaseExpr{line: 1, char: 1},
+				Query: SelectQuery{
+					SelectEntity: SelectEntity{
+						SelectClause: SelectClause{
+							BaseExpr: &BaseExpr{line: 1, char: 9},
+							Select:   "select",
+							Fields: []QueryExpression{
+								Field{
+									Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 16}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 16}, Literal: "c1"}},
+								},
+							},
+						},
+						FromClause: FromClause{From: "from", Tables: []QueryExpression{
+							Table{Object: Stdin{BaseExpr: &BaseExpr{line: 1, char: 24}, Stdin: "stdin"}},
+						}},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "explain analyze select 1",
+		Output: []Statement{
+			Explain{
+				BaseExpr: &BaseExpr{line: 1, char: 1},
+				Analyze:  true,
+				Query: SelectQuery{
+					SelectEntity: SelectEntity{
+						SelectClause: SelectClause{
+							BaseExpr: &BaseExpr{line: 1, char: 17},
+							Select:   "select",
+							Fields: []QueryExpression{
+								Field{
+									Object: NewIntegerValueFromString("1"),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	},
 	{
 		Input: "show tables",
 		Output: []Statement{
@@ -4699,6 +5423,91 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "try print 1; catch print @#error_message; raise; end try",
+		Output: []Statement{
+			TryCatch{
+				BaseExpr: &BaseExpr{line: 1, char: 1},
+				TryStatements: []Statement{
+					Print{Value: NewIntegerValueFromString("1")},
+				},
+				CatchStatements: []Statement{
+					Print{Value: RuntimeInformation{BaseExpr: &BaseExpr{line: 1, char: 26}, Name: "error_message"}},
+					Raise{BaseExpr: &BaseExpr{line: 1, char: 43}},
+				},
+			},
+		},
+	},
+	{
+		Input: "try catch end try",
+		Output: []Statement{
+			TryCatch{
+				BaseExpr: &BaseExpr{line: 1, char: 1},
+			},
+		},
+	},
+	{
+		Input: "while true do try break; catch continue; end try; end while",
+		Output: []Statement{
+			While{
+				Condition: NewTernaryValueFromString("true"),
+				Statements: []Statement{
+					TryCatch{
+						BaseExpr: &BaseExpr{line: 1, char: 15},
+						TryStatements: []Statement{
+							FlowControl{Token: BREAK},
+						},
+						CatchStatements: []Statement{
+							FlowControl{Token: CONTINUE},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "declare func1 function () as begin try return 1; catch return 0; end try; end",
+		Output: []Statement{
+			FunctionDeclaration{
+				Name: Identifier{BaseExpr: &BaseExpr{line: 1, char: 9}, Literal: "func1"},
+				Statements: []Statement{
+					TryCatch{
+						BaseExpr: &BaseExpr{line: 1, char: 36},
+						TryStatements: []Statement{
+							Return{Value: NewIntegerValueFromString("1")},
+						},
+						CatchStatements: []Statement{
+							Return{Value: NewIntegerValueFromString("0")},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "declare func1 function () as begin while true do try break; catch continue; end try; end while; end",
+		Output: []Statement{
+			FunctionDeclaration{
+				Name: Identifier{BaseExpr: &BaseExpr{line: 1, char: 9}, Literal: "func1"},
+				Statements: []Statement{
+					While{
+						Condition: NewTernaryValueFromString("true"),
+						Statements: []Statement{
+							TryCatch{
+								BaseExpr: &BaseExpr{line: 1, char: 50},
+								TryStatements: []Statement{
+									FlowControl{Token: BREAK},
+								},
+								CatchStatements: []Statement{
+									FlowControl{Token: CONTINUE},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	},
 	{
 		Input: "while true do if @var1 = 1 then continue; end if; end while",
 		Output: []Statement{
@@ -5203,6 +6012,25 @@ var parseTests = []struct {
 			}},
 		},
 	},
+	{
+		Input: "select ?, ?",
+		Output: []Statement{
+			SelectQuery{SelectEntity: SelectEntity{
+				SelectClause: SelectClause{
+					BaseExpr: &BaseExpr{line: 1, char: 1},
+					Select:   "select",
+					Fields: []QueryExpression{
+						Field{
+							Object: Placeholder{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "?", Ordinal: 1},
+						},
+						Field{
+							Object: Placeholder{BaseExpr: &BaseExpr{line: 1, char: 11}, Literal: "?", Ordinal: 2},
+						},
+					},
+				},
+			}},
+		},
+	},
 	{
 		Input: "select ties",
 		Output: []Statement{
@@ -30,6 +30,7 @@ const (
 	EnvironmentVariableSign = '%'
 	ExternalCommandSign     = '$'
 	RuntimeInformationSign  = '#'
+	PlaceholderSign         = '?'
 
 	SubstitutionOperator = ":="
 
@@ -57,11 +58,23 @@ var aggregateFunctions = []string{
 	"SUM",
 	"AVG",
 	"MEDIAN",
+	"STDDEV_POP",
+	"STDDEV_SAMP",
+	"VAR_POP",
+	"VAR_SAMP",
+	"MODE",
+	"CORR",
+	"COVAR_POP",
+	"COVAR_SAMP",
+	"REGR_SLOPE",
+	"REGR_INTERCEPT",
 }
 
 var listFunctions = []string{
 	"LISTAGG",
 	"JSON_AGG",
+	"PERCENTILE_CONT",
+	"PERCENTILE_DISC",
 }
 
 var analyticFunctions = []string{
@@ -100,6 +113,8 @@ type Scanner struct {
 	line       int
 	char       int
 	sourceFile string
+
+	holderOrdinal int
 }
 
 func (s *Scanner) Init(src string, sourceFile string) *Scanner {
@@ -110,6 +125,7 @@ func (s *Scanner) Init(src string, sourceFile string) *Scanner {
 	s.line = 1
 	s.char = 0
 	s.sourceFile = sourceFile
+	s.holderOrdinal = 0
 	return s
 }
 
@@ -158,6 +174,7 @@ func (s *Scanner) Scan() (Token, error) {
 	token := ch
 	literal := string(ch)
 	quoted := false
+	holderOrdinal := 0
 	line := s.line
 	char := s.char
 
@@ -230,6 +247,10 @@ func (s *Scanner) Scan() (Token, error) {
 		if len(literal) < 1 {
 			s.err = errors.New("invalid variable symbol")
 		}
+	case ch == PlaceholderSign:
+		s.holderOrdinal++
+		holderOrdinal = s.holderOrdinal
+		token = PLACEHOLDER
 	case ch == ExternalCommandSign:
 		s.scanExternalCommand()
 		literal = s.literal.String()
@@ -260,7 +281,7 @@ func (s *Scanner) Scan() (Token, error) {
 		}
 	}
 
-	return Token{Token: int(token), Literal: literal, Quoted: quoted, Line: line, Char: char, SourceFile: s.sourceFile}, s.err
+	return Token{Token: int(token), Literal: literal, Quoted: quoted, HolderOrdinal: holderOrdinal, Line: line, Char: char, SourceFile: s.sourceFile}, s.err
 }
 
 func (s *Scanner) scanString(quote rune) {
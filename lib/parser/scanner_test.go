@@ -139,6 +139,16 @@ var scanTests = []struct {
 			},
 		},
 	},
+	{
+		Name:  "Placeholder",
+		Input: "?",
+		Output: []scanResult{
+			{
+				Token:   PLACEHOLDER,
+				Literal: "?",
+			},
+		},
+	},
 	{
 		Name:  "Environment Variable",
 		Input: "@%var",
@@ -0,0 +1,1062 @@
+package pgserver
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/query"
+	"github.com/mithrandie/csvq/lib/value"
+)
+
+// Transaction status indicators
+const (
+	txIdle   = 'I'
+	txActive = 'T'
+	txFailed = 'E'
+)
+
+// SQLSTATE codes
+const (
+	stateSyntaxError         = "42601"
+	stateProtocolViolation   = "08P01"
+	stateInvalidPassword     = "28P01"
+	stateFeatureNotSupported = "0A000"
+	stateInvalidParameter    = "22P02"
+	stateQueryCanceled       = "57014"
+	stateInFailedTransaction = "25P02"
+	stateDuplicateStatement  = "42P05"
+	stateUndefinedStatement  = "26000"
+	stateUndefinedPortal     = "34000"
+	stateInternalError       = "XX000"
+)
+
+const serverVersion = "14.0 (csvq)"
+
+// authenticationTimeout is the time limit to complete the startup and the
+// authentication of a connection.
+const authenticationTimeout = time.Minute
+
+type pgError struct {
+	Code    string
+	Message string
+}
+
+func (e *pgError) Error() string {
+	return e.Message
+}
+
+func newPgError(code string, format string, args ...interface{}) error {
+	return &pgError{
+		Code:    code,
+		Message: fmt.Sprintf(format, args...),
+	}
+}
+
+type preparedStatement struct {
+	command    commandType
+	tag        string
+	statement  parser.Statement
+	ordinals   []int
+	paramTypes []int
+
+	// columns is set when the statement is described. Results of portals
+	// created from a described statement are sent as text columns.
+	columns []string
+}
+
+func (ps *preparedStatement) isEmpty() bool {
+	return ps.command == commandNone && ps.statement == nil
+}
+
+func (ps *preparedStatement) isSelect() bool {
+	_, ok := ps.statement.(parser.SelectQuery)
+	return ok
+}
+
+func (ps *preparedStatement) numParams() int {
+	n := len(ps.paramTypes)
+	for _, o := range ps.ordinals {
+		if n < o {
+			n = o
+		}
+	}
+	return n
+}
+
+func (ps *preparedStatement) paramType(i int) int {
+	if i < len(ps.paramTypes) && ps.paramTypes[i] != oidUnspecified {
+		return ps.paramTypes[i]
+	}
+	return oidText
+}
+
+type portal struct {
+	statement     *preparedStatement
+	params        []query.Parameter
+	resultFormats []int
+
+	result   *result
+	pos      int
+	executed bool
+}
+
+func (p *portal) resultFormat(i int) int {
+	switch len(p.resultFormats) {
+	case 0:
+		return formatText
+	case 1:
+		return p.resultFormats[0]
+	}
+	return p.resultFormats[i]
+}
+
+type result struct {
+	columns []string
+	types   []int
+	records [][]value.Primary
+	tag     string
+}
+
+type conn struct {
+	server  *Server
+	netConn net.Conn
+	reader  *bufio.Reader
+	writer  *messageWriter
+	session *query.Session
+
+	pid    int32
+	secret int32
+
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	mtx         sync.Mutex
+	queryCancel context.CancelFunc
+
+	txStatus          byte
+	lastEndRolledBack bool
+	statements        map[string]*preparedStatement
+	portals           map[string]*portal
+}
+
+func newConn(server *Server, nc net.Conn) *conn {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &conn{
+		server:     server,
+		netConn:    nc,
+		reader:     bufio.NewReader(nc),
+		writer:     newMessageWriter(nc),
+		ctx:        ctx,
+		cancel:     cancel,
+		txStatus:   txIdle,
+		statements: make(map[string]*preparedStatement, 10),
+		portals:    make(map[string]*portal, 10),
+	}
+}
+
+func (c *conn) serve() {
+	defer func() {
+		c.cancel()
+		_ = c.netConn.Close()
+	}()
+
+	_ = c.netConn.SetDeadline(time.Now().Add(authenticationTimeout))
+	ok, err := c.startup()
+	if err != nil || !ok {
+		return
+	}
+	_ = c.netConn.SetDeadline(time.Time{})
+
+	flags := c.server.Flags.Copy()
+	flags.Quiet = true
+	c.session = query.NewSession(flags)
+	c.session.DisallowExternalCommand = true
+	c.session.RootDirectory = c.server.RootDirectory
+	defer func() {
+		_ = c.session.Close()
+		_ = c.session.ReleaseResources()
+	}()
+
+	if err := c.sendStartupResponse(); err != nil {
+		return
+	}
+	_ = c.loop()
+}
+
+// startup reads startup packets and authenticates the client.
+// False is returned when the connection has to be closed.
+func (c *conn) startup() (bool, error) {
+	for {
+		buf, err := readStartupMessage(c.reader)
+		if err != nil {
+			return false, err
+		}
+		r := &messageReader{buf: buf}
+		code := r.int32()
+
+		switch code {
+		case sslRequestCode, gssRequestCode:
+			if _, err := c.netConn.Write([]byte{'N'}); err != nil {
+				return false, err
+			}
+			continue
+		case cancelCode:
+			pid := r.int32()
+			secret := r.int32()
+			if r.err == nil {
+				c.server.cancel(int32(pid), int32(secret))
+			}
+			return false, nil
+		case protocolVersion:
+		default:
+			_ = c.sendFatal(stateProtocolViolation, fmt.Sprintf("unsupported frontend protocol %d.%d", code>>16, code&0xffff))
+			return false, errInvalidMessage
+		}
+
+		params := make(map[string]string, 4)
+		for r.err == nil && 0 < len(r.buf) && r.buf[0] != 0 {
+			key := r.string()
+			params[key] = r.string()
+		}
+		if r.err != nil {
+			return false, r.err
+		}
+		return c.authenticate(params["user"])
+	}
+}
+
+// authenticate requires the password with SCRAM-SHA-256 if the server has
+// the password.
+func (c *conn) authenticate(user string) (bool, error) {
+	if len(c.server.Password) < 1 {
+		return true, nil
+	}
+
+	c.writer.begin(msgAuthentication)
+	c.writer.int32(authSASL)
+	c.writer.string(scramMechanism)
+	c.writer.byte(0)
+	if err := c.writer.end(); err != nil {
+		return false, err
+	}
+	if err := c.writer.Flush(); err != nil {
+		return false, err
+	}
+
+	buf, err := c.readPasswordMessage()
+	if err != nil {
+		return false, err
+	}
+	r := &messageReader{buf: buf}
+	mechanism := r.string()
+	clientFirst := r.bytes(r.int32())
+	if r.err != nil {
+		_ = c.sendFatal(stateProtocolViolation, "invalid SASL initial response")
+		return false, errInvalidMessage
+	}
+	if mechanism != scramMechanism {
+		_ = c.sendFatal(stateProtocolViolation, fmt.Sprintf("unsupported SASL mechanism %q", mechanism))
+		return false, errInvalidMessage
+	}
+
+	scram := newScramServer(c.server.Password)
+	serverFirst, err := scram.serverFirstMessage(string(clientFirst))
+	if err != nil {
+		_ = c.sendFatal(stateProtocolViolation, err.Error())
+		return false, errInvalidMessage
+	}
+	if err := c.sendAuthentication(authSASLContinue, serverFirst); err != nil {
+		return false, err
+	}
+
+	clientFinal, err := c.readPasswordMessage()
+	if err != nil {
+		return false, err
+	}
+	serverFinal, err := scram.serverFinalMessage(string(clientFinal))
+	if err != nil {
+		if err == errScramProof {
+			_ = c.sendFatal(stateInvalidPassword, fmt.Sprintf("password authentication failed for user %q", user))
+			return false, nil
+		}
+		_ = c.sendFatal(stateProtocolViolation, err.Error())
+		return false, errInvalidMessage
+	}
+	if err := c.sendAuthentication(authSASLFinal, serverFinal); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (c *conn) sendAuthentication(code int, data string) error {
+	c.writer.begin(msgAuthentication)
+	c.writer.int32(code)
+	c.writer.bytes([]byte(data))
+	if err := c.writer.end(); err != nil {
+		return err
+	}
+	return c.writer.Flush()
+}
+
+func (c *conn) readPasswordMessage() ([]byte, error) {
+	t, buf, err := readMessage(c.reader, maxStartupMessageLength)
+	if err != nil {
+		return nil, err
+	}
+	if t != msgPassword {
+		_ = c.sendFatal(stateProtocolViolation, fmt.Sprintf("expected password response, got message type %q", t))
+		return nil, errInvalidMessage
+	}
+	return buf, nil
+}
+
+func (c *conn) sendStartupResponse() error {
+	c.writer.begin(msgAuthentication)
+	c.writer.int32(authOk)
+	if err := c.writer.end(); err != nil {
+		return err
+	}
+
+	params := [][2]string{
+		{"server_version", serverVersion},
+		{"server_encoding", "UTF8"},
+		{"client_encoding", "UTF8"},
+		{"DateStyle", "ISO, YMD"},
+		{"integer_datetimes", "on"},
+		{"standard_conforming_strings", "on"},
+		{"TimeZone", timeZoneName()},
+	}
+	for _, p := range params {
+		c.writer.begin(msgParameterStatus)
+		c.writer.string(p[0])
+		c.writer.string(p[1])
+		if err := c.writer.end(); err != nil {
+			return err
+		}
+	}
+
+	c.writer.begin(msgBackendKeyData)
+	c.writer.int32(int(c.pid))
+	c.writer.int32(int(c.secret))
+	if err := c.writer.end(); err != nil {
+		return err
+	}
+	return c.sendReadyForQuery()
+}
+
+func timeZoneName() string {
+	loc := cmd.GetLocation()
+	if loc.String() != "Local" {
+		return loc.String()
+	}
+	name, _ := time.Now().In(loc).Zone()
+	return name
+}
+
+func (c *conn) loop() error {
+	ignoreUntilSync := false
+
+	for {
+		t, buf, err := readMessage(c.reader, maxMessageLength)
+		if err != nil {
+			return err
+		}
+		r := &messageReader{buf: buf}
+
+		if ignoreUntilSync && t != msgSync && t != msgTerminate {
+			continue
+		}
+
+		switch t {
+		case msgQuery:
+			err = c.handleQuery(r)
+		case msgParse:
+			err = c.handleParse(r)
+		case msgBind:
+			err = c.handleBind(r)
+		case msgDescribe:
+			err = c.handleDescribe(r)
+		case msgExecute:
+			err = c.handleExecute(r)
+		case msgClose:
+			err = c.handleClose(r)
+		case msgSync:
+			ignoreUntilSync = false
+			err = c.sendReadyForQuery()
+		case msgFlush:
+			err = c.writer.Flush()
+		case msgTerminate:
+			return nil
+		default:
+			err = newPgError(stateProtocolViolation, "invalid frontend message type %q", t)
+		}
+
+		if err != nil {
+			if _, ok := err.(*pgError); !ok {
+				if _, ok := err.(query.AppError); !ok {
+					return err
+				}
+			}
+			if t != msgQuery {
+				ignoreUntilSync = true
+			}
+			if err = c.sendError(err); err != nil {
+				return err
+			}
+			if t == msgQuery {
+				if err = c.sendReadyForQuery(); err != nil {
+					return err
+				}
+			}
+		}
+	}
+}
+
+func (c *conn) handleQuery(r *messageReader) error {
+	sql := r.string()
+	if r.err != nil {
+		return newPgError(stateProtocolViolation, "invalid query message")
+	}
+
+	// The unnamed portal is dropped by simple queries.
+	delete(c.portals, "")
+
+	if isEmptyQuery(sql) {
+		c.writer.begin(msgEmptyQueryResponse)
+		if err := c.writer.end(); err != nil {
+			return err
+		}
+		return c.sendReadyForQuery()
+	}
+
+	for {
+		head, tail := splitFirstStatement(sql)
+		command, tag := pgCommand(head)
+		if command == commandNone {
+			break
+		}
+		if err := c.execCommand(command); err != nil {
+			return err
+		}
+		if err := c.sendCommandComplete(c.transactionTag(command, tag)); err != nil {
+			return err
+		}
+		sql = tail
+		if isEmptyQuery(sql) {
+			return c.sendReadyForQuery()
+		}
+	}
+
+	statements, err := parser.Parse(sql, "")
+	if err != nil {
+		return query.NewSyntaxError(err.(*parser.SyntaxError))
+	}
+
+	for _, stmt := range statements {
+		res, err := c.execute(stmt, nil)
+		if err != nil {
+			return err
+		}
+		if res.columns != nil {
+			if err := c.sendRowDescription(res.columns, res.types, nil); err != nil {
+				return err
+			}
+			for _, record := range res.records {
+				if err := c.sendDataRow(record, res.types, nil); err != nil {
+					return err
+				}
+			}
+		}
+		if err := c.sendCommandComplete(res.tag); err != nil {
+			return err
+		}
+	}
+	return c.sendReadyForQuery()
+}
+
+func (c *conn) handleParse(r *messageReader) error {
+	name := r.string()
+	sql := r.string()
+	n := r.int16()
+	paramTypes := make([]int, 0, n)
+	for i := 0; i < n; i++ {
+		paramTypes = append(paramTypes, r.int32())
+	}
+	if r.err != nil {
+		return newPgError(stateProtocolViolation, "invalid parse message")
+	}
+	if _, ok := c.statements[name]; ok && 0 < len(name) {
+		return newPgError(stateDuplicateStatement, "prepared statement %q already exists", name)
+	}
+
+	ps := &preparedStatement{
+		paramTypes: paramTypes,
+	}
+
+	if !isEmptyQuery(sql) {
+		head, tail := splitFirstStatement(sql)
+		ps.command, ps.tag = pgCommand(head)
+		if ps.command != commandNone {
+			if !isEmptyQuery(tail) {
+				return newPgError(stateSyntaxError, "cannot insert multiple commands into a prepared statement")
+			}
+		} else {
+			rewritten, ordinals := rewritePlaceholders(sql)
+			statements, err := parser.Parse(rewritten, "")
+			if err != nil {
+				return query.NewSyntaxError(err.(*parser.SyntaxError))
+			}
+			if 1 < len(statements) {
+				return newPgError(stateSyntaxError, "cannot insert multiple commands into a prepared statement")
+			}
+			ps.statement = statements[0]
+			ps.ordinals = ordinals
+		}
+	}
+
+	c.statements[name] = ps
+	c.writer.begin(msgParseComplete)
+	return c.writer.end()
+}
+
+func (c *conn) handleBind(r *messageReader) error {
+	portalName := r.string()
+	statementName := r.string()
+
+	n := r.int16()
+	paramFormats := make([]int, 0, n)
+	for i := 0; i < n; i++ {
+		paramFormats = append(paramFormats, r.int16())
+	}
+
+	n = r.int16()
+	values := make([][]byte, 0, n)
+	for i := 0; i < n; i++ {
+		length := r.int32()
+		if length < 0 {
+			values = append(values, nil)
+		} else {
+			values = append(values, r.bytes(length))
+		}
+	}
+
+	n = r.int16()
+	resultFormats := make([]int, 0, n)
+	for i := 0; i < n; i++ {
+		resultFormats = append(resultFormats, r.int16())
+	}
+
+	if r.err != nil {
+		return newPgError(stateProtocolViolation, "invalid bind message")
+	}
+
+	ps, ok := c.statements[statementName]
+	if !ok {
+		return newPgError(stateUndefinedStatement, "prepared statement %q does not exist", statementName)
+	}
+	if _, ok := c.portals[portalName]; ok && 0 < len(portalName) {
+		return newPgError(stateDuplicateStatement, "portal %q already exists", portalName)
+	}
+	if len(values) != ps.numParams() {
+		return newPgError(stateProtocolViolation, "bind message supplies %d parameters, but prepared statement %q requires %d", len(values), statementName, ps.numParams())
+	}
+	if 1 < len(paramFormats) && len(paramFormats) != len(values) {
+		return newPgError(stateProtocolViolation, "bind message has %d parameter formats but %d parameters", len(paramFormats), len(values))
+	}
+
+	decoded := make([]value.Primary, len(values))
+	for i, b := range values {
+		format := formatText
+		switch len(paramFormats) {
+		case 0:
+		case 1:
+			format = paramFormats[0]
+		default:
+			format = paramFormats[i]
+		}
+
+		oid := oidUnspecified
+		if i < len(ps.paramTypes) {
+			oid = ps.paramTypes[i]
+		}
+
+		p, err := decodeParameter(b, oid, format)
+		if err != nil {
+			return newPgError(stateInvalidParameter, "parameter $%d: %s", i+1, err.Error())
+		}
+		decoded[i] = p
+	}
+
+	p := &portal{
+		statement:     ps,
+		params:        bindParameters(ps.ordinals, decoded),
+		resultFormats: resultFormats,
+	}
+	c.portals[portalName] = p
+
+	c.writer.begin(msgBindComplete)
+	return c.writer.end()
+}
+
+func bindParameters(ordinals []int, values []value.Primary) []query.Parameter {
+	params := make([]query.Parameter, 0, len(ordinals))
+	for i, o := range ordinals {
+		params = append(params, query.Parameter{
+			Ordinal: i + 1,
+			Value:   values[o-1],
+		})
+	}
+	return params
+}
+
+func (c *conn) handleDescribe(r *messageReader) error {
+	t := r.byte()
+	name := r.string()
+	if r.err != nil {
+		return newPgError(stateProtocolViolation, "invalid describe message")
+	}
+
+	switch t {
+	case 'S':
+		ps, ok := c.statements[name]
+		if !ok {
+			return newPgError(stateUndefinedStatement, "prepared statement %q does not exist", name)
+		}
+		return c.describeStatement(ps)
+	case 'P':
+		p, ok := c.portals[name]
+		if !ok {
+			return newPgError(stateUndefinedPortal, "portal %q does not exist", name)
+		}
+		return c.describePortal(p)
+	}
+	return newPgError(stateProtocolViolation, "invalid describe message subtype %q", t)
+}
+
+// describeStatement sends the description of the statement. Column names of
+// a select query are retrieved by running the query with null parameters.
+func (c *conn) describeStatement(ps *preparedStatement) error {
+	n := ps.numParams()
+	c.writer.begin(msgParameterDescription)
+	c.writer.int16(n)
+	for i := 0; i < n; i++ {
+		c.writer.int32(ps.paramType(i))
+	}
+	if err := c.writer.end(); err != nil {
+		return err
+	}
+
+	if !ps.isSelect() {
+		c.writer.begin(msgNoData)
+		return c.writer.end()
+	}
+
+	if ps.columns == nil {
+		nulls := make([]value.Primary, n)
+		for i := range nulls {
+			nulls[i] = value.NewNull()
+		}
+		res, err := c.execute(ps.statement, bindParameters(ps.ordinals, nulls))
+		if err != nil {
+			return err
+		}
+		ps.columns = res.columns
+	}
+	return c.sendRowDescription(ps.columns, textTypes(len(ps.columns)), nil)
+}
+
+func (c *conn) describePortal(p *portal) error {
+	if !p.statement.isSelect() {
+		c.writer.begin(msgNoData)
+		return c.writer.end()
+	}
+
+	if err := c.runPortal(p); err != nil {
+		return err
+	}
+	if err := c.checkResultFormats(p); err != nil {
+		return err
+	}
+	return c.sendRowDescription(p.result.columns, p.result.types, p)
+}
+
+func (c *conn) runPortal(p *portal) error {
+	if p.executed {
+		return nil
+	}
+	if p.statement.isEmpty() {
+		p.executed = true
+		return nil
+	}
+	if p.statement.command != commandNone {
+		if err := c.execCommand(p.statement.command); err != nil {
+			return err
+		}
+		p.result = &result{tag: c.transactionTag(p.statement.command, p.statement.tag)}
+		p.executed = true
+		return nil
+	}
+
+	res, err := c.execute(p.statement.statement, p.params)
+	if err != nil {
+		return err
+	}
+	if p.statement.columns != nil {
+		res.types = textTypes(len(res.columns))
+	}
+	p.result = res
+	p.executed = true
+	return nil
+}
+
+func (c *conn) checkResultFormats(p *portal) error {
+	if 1 < len(p.resultFormats) && len(p.resultFormats) != len(p.result.columns) {
+		return newPgError(stateProtocolViolation, "bind message has %d result formats but query has %d columns", len(p.resultFormats), len(p.result.columns))
+	}
+	for _, f := range p.resultFormats {
+		if f != formatText && f != formatBinary {
+			return newPgError(stateFeatureNotSupported, "unsupported format code: %d", f)
+		}
+	}
+	return nil
+}
+
+func (c *conn) handleExecute(r *messageReader) error {
+	name := r.string()
+	maxRows := r.int32()
+	if r.err != nil {
+		return newPgError(stateProtocolViolation, "invalid execute message")
+	}
+
+	p, ok := c.portals[name]
+	if !ok {
+		return newPgError(stateUndefinedPortal, "portal %q does not exist", name)
+	}
+
+	if p.statement.isEmpty() {
+		c.writer.begin(msgEmptyQueryResponse)
+		return c.writer.end()
+	}
+
+	if err := c.runPortal(p); err != nil {
+		return err
+	}
+
+	if p.result.columns == nil {
+		return c.sendCommandComplete(p.result.tag)
+	}
+
+	if err := c.checkResultFormats(p); err != nil {
+		return err
+	}
+
+	sent := 0
+	for p.pos < len(p.result.records) {
+		if 0 < maxRows && maxRows <= sent {
+			c.writer.begin(msgPortalSuspended)
+			return c.writer.end()
+		}
+		if err := c.sendDataRow(p.result.records[p.pos], p.result.types, p); err != nil {
+			return err
+		}
+		p.pos++
+		sent++
+	}
+	return c.sendCommandComplete(fmt.Sprintf("SELECT %d", sent))
+}
+
+func (c *conn) handleClose(r *messageReader) error {
+	t := r.byte()
+	name := r.string()
+	if r.err != nil {
+		return newPgError(stateProtocolViolation, "invalid close message")
+	}
+
+	switch t {
+	case 'S':
+		if ps, ok := c.statements[name]; ok {
+			for k, p := range c.portals {
+				if p.statement == ps {
+					delete(c.portals, k)
+				}
+			}
+			delete(c.statements, name)
+		}
+	case 'P':
+		delete(c.portals, name)
+	default:
+		return newPgError(stateProtocolViolation, "invalid close message subtype %q", t)
+	}
+
+	c.writer.begin(msgCloseComplete)
+	return c.writer.end()
+}
+
+// execCommand runs a transaction command of PostgreSQL.
+func (c *conn) execCommand(command commandType) error {
+	switch command {
+	case commandBegin:
+		if c.txStatus == txFailed {
+			return c.failedTransactionError()
+		}
+		c.txStatus = txActive
+	case commandCommit:
+		return c.endTransaction(c.txStatus != txFailed)
+	case commandRollback:
+		return c.endTransaction(false)
+	case commandIgnore:
+		if c.txStatus == txFailed {
+			return c.failedTransactionError()
+		}
+	}
+	return nil
+}
+
+// transactionTag returns the tag for the command. COMMIT in a failed
+// transaction block is reported as ROLLBACK.
+func (c *conn) transactionTag(command commandType, tag string) string {
+	if command == commandCommit && c.lastEndRolledBack {
+		return "ROLLBACK"
+	}
+	return tag
+}
+
+func (c *conn) endTransaction(commit bool) error {
+	ctx, done := c.queryContext()
+	defer done()
+
+	c.lastEndRolledBack = !commit
+	c.txStatus = txIdle
+	if commit {
+		return c.session.Commit(ctx)
+	}
+	return c.session.Rollback(ctx)
+}
+
+func (c *conn) failedTransactionError() error {
+	return newPgError(stateInFailedTransaction, "current transaction is aborted, commands ignored until end of transaction block")
+}
+
+// execute runs the statement. Outside of transaction blocks, changes are
+// committed if the statement succeeded, otherwise they are rolled back.
+func (c *conn) execute(stmt parser.Statement, params []query.Parameter) (*result, error) {
+	if tc, ok := stmt.(parser.TransactionControl); ok {
+		command := commandRollback
+		tag := "ROLLBACK"
+		if tc.Token == parser.COMMIT {
+			command = commandCommit
+			tag = "COMMIT"
+		}
+		if err := c.execCommand(command); err != nil {
+			return nil, err
+		}
+		return &result{tag: c.transactionTag(command, tag)}, nil
+	}
+
+	if c.txStatus == txFailed {
+		return nil, c.failedTransactionError()
+	}
+
+	ctx, done := c.queryContext()
+	defer done()
+
+	res, err := c.executeStatement(ctx, stmt, params)
+	if err != nil {
+		if c.txStatus == txActive {
+			c.txStatus = txFailed
+		} else {
+			_ = c.session.Rollback(context.Background())
+		}
+		return nil, c.convertError(ctx, err)
+	}
+
+	if c.txStatus == txIdle {
+		if err := c.session.Commit(ctx); err != nil {
+			_ = c.session.Rollback(context.Background())
+			return nil, c.convertError(ctx, err)
+		}
+	}
+	return res, nil
+}
+
+func (c *conn) executeStatement(ctx context.Context, stmt parser.Statement, params []query.Parameter) (*result, error) {
+	statements := []parser.Statement{stmt}
+
+	if _, ok := stmt.(parser.SelectQuery); !ok {
+		affected, err := c.session.ExecStatements(ctx, statements, params)
+		if err != nil {
+			return nil, err
+		}
+		return &result{tag: commandTag(stmt, affected)}, nil
+	}
+
+	rows, err := c.session.QueryStatements(ctx, statements, params)
+	if err != nil {
+		return nil, err
+	}
+
+	columns := rows.Columns()
+	if columns == nil {
+		columns = []string{}
+	}
+	records := make([][]value.Primary, 0, 100)
+	for rows.Next() {
+		records = append(records, rows.Values())
+	}
+	if err := rows.Err(); err != nil {
+		_ = rows.Close()
+		return nil, err
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+
+	return &result{
+		columns: columns,
+		types:   inferColumnTypes(len(columns), records),
+		records: records,
+		tag:     commandTag(stmt, len(records)),
+	}, nil
+}
+
+func (c *conn) convertError(ctx context.Context, err error) error {
+	if ctx.Err() != nil && errors.Is(err, context.Canceled) {
+		return newPgError(stateQueryCanceled, "canceling statement due to user request")
+	}
+	if _, ok := err.(*pgError); ok {
+		return err
+	}
+	if _, ok := err.(*query.SyntaxError); ok {
+		return newPgError(stateSyntaxError, "%s", err.Error())
+	}
+	return newPgError(stateInternalError, "%s", err.Error())
+}
+
+// queryContext returns a context that is canceled by cancel requests from
+// clients.
+func (c *conn) queryContext() (context.Context, func()) {
+	ctx, cancel := context.WithCancel(c.ctx)
+
+	c.mtx.Lock()
+	c.queryCancel = cancel
+	c.mtx.Unlock()
+
+	return ctx, func() {
+		c.mtx.Lock()
+		c.queryCancel = nil
+		c.mtx.Unlock()
+		cancel()
+	}
+}
+
+func (c *conn) cancelQuery() {
+	c.mtx.Lock()
+	if c.queryCancel != nil {
+		c.queryCancel()
+	}
+	c.mtx.Unlock()
+}
+
+func textTypes(n int) []int {
+	types := make([]int, n)
+	for i := range types {
+		types[i] = oidText
+	}
+	return types
+}
+
+func (c *conn) sendRowDescription(columns []string, types []int, p *portal) error {
+	c.writer.begin(msgRowDescription)
+	c.writer.int16(len(columns))
+	for i, name := range columns {
+		format := formatText
+		if p != nil {
+			format = p.resultFormat(i)
+		}
+
+		c.writer.string(name)
+		c.writer.int32(0)
+		c.writer.int16(0)
+		c.writer.int32(types[i])
+		c.writer.int16(typeSize(types[i]))
+		c.writer.int32(-1)
+		c.writer.int16(format)
+	}
+	return c.writer.end()
+}
+
+func (c *conn) sendDataRow(record []value.Primary, types []int, p *portal) error {
+	c.writer.begin(msgDataRow)
+	c.writer.int16(len(record))
+	for i, v := range record {
+		format := formatText
+		if p != nil {
+			format = p.resultFormat(i)
+		}
+
+		b := encodeValue(v, types[i], format)
+		if b == nil {
+			c.writer.int32(-1)
+			continue
+		}
+		c.writer.int32(len(b))
+		c.writer.bytes(b)
+	}
+	return c.writer.end()
+}
+
+func (c *conn) sendCommandComplete(tag string) error {
+	c.writer.begin(msgCommandComplete)
+	c.writer.string(tag)
+	return c.writer.end()
+}
+
+func (c *conn) sendReadyForQuery() error {
+	c.writer.begin(msgReadyForQuery)
+	c.writer.byte(c.txStatus)
+	if err := c.writer.end(); err != nil {
+		return err
+	}
+	return c.writer.Flush()
+}
+
+func (c *conn) sendError(err error) error {
+	code := stateInternalError
+	message := err.Error()
+	switch e := err.(type) {
+	case *pgError:
+		code = e.Code
+	case *query.SyntaxError:
+		code = stateSyntaxError
+	}
+	return c.sendErrorResponse("ERROR", code, message)
+}
+
+func (c *conn) sendFatal(code string, message string) error {
+	if err := c.sendErrorResponse("FATAL", code, message); err != nil {
+		return err
+	}
+	return c.writer.Flush()
+}
+
+func (c *conn) sendErrorResponse(severity string, code string, message string) error {
+	c.writer.begin(msgErrorResponse)
+	c.writer.byte('S')
+	c.writer.string(severity)
+	c.writer.byte('V')
+	c.writer.string(severity)
+	c.writer.byte('C')
+	c.writer.string(code)
+	c.writer.byte('M')
+	c.writer.string(strings.TrimSpace(message))
+	c.writer.byte(0)
+	return c.writer.end()
+}
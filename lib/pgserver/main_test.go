@@ -0,0 +1,67 @@
+package pgserver
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+var tempdir, _ = filepath.Abs(os.TempDir())
+var TestDir = filepath.Join(tempdir, "csvq_pgserver_test")
+var TestDataDir string
+
+func GetWD() string {
+	wdir, _ := os.Getwd()
+	return wdir
+}
+
+func TestMain(m *testing.M) {
+	os.Exit(run(m))
+}
+
+func run(m *testing.M) int {
+	defer teardown()
+
+	setup()
+	return m.Run()
+}
+
+func setup() {
+	if _, err := os.Stat(TestDir); err == nil {
+		os.RemoveAll(TestDir)
+	}
+
+	TestDataDir = filepath.Join(GetWD(), "..", "..", "testdata", "csv")
+
+	if _, err := os.Stat(TestDir); os.IsNotExist(err) {
+		os.Mkdir(TestDir, 0755)
+	}
+
+	copyfile(filepath.Join(TestDir, "table1.csv"), filepath.Join(TestDataDir, "table1.csv"))
+	copyfile(filepath.Join(TestDir, "insert_query.csv"), filepath.Join(TestDataDir, "table1.csv"))
+	copyfile(filepath.Join(TestDir, "rollback_query.csv"), filepath.Join(TestDataDir, "table1.csv"))
+}
+
+func teardown() {
+	if _, err := os.Stat(TestDir); err == nil {
+		os.RemoveAll(TestDir)
+	}
+}
+
+func copyfile(dstfile string, srcfile string) error {
+	src, err := os.Open(srcfile)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	dst, err := os.Create(dstfile)
+	if err != nil {
+		return err
+	}
+	defer dst.Close()
+
+	_, err = io.Copy(dst, src)
+	return err
+}
@@ -0,0 +1,203 @@
+package pgserver
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"io"
+)
+
+const (
+	protocolVersion = 196608 // 3.0
+	sslRequestCode  = 80877103
+	gssRequestCode  = 80877104
+	cancelCode      = 80877102
+
+	// maxStartupMessageLength limits the messages read before clients are
+	// authenticated.
+	maxStartupMessageLength = 10000
+	maxMessageLength        = 1 << 30
+)
+
+var errInvalidMessage = errors.New("invalid message")
+
+// Frontend messages
+const (
+	msgQuery     = 'Q'
+	msgParse     = 'P'
+	msgBind      = 'B'
+	msgDescribe  = 'D'
+	msgExecute   = 'E'
+	msgSync      = 'S'
+	msgFlush     = 'H'
+	msgClose     = 'C'
+	msgTerminate = 'X'
+	msgPassword  = 'p'
+)
+
+// Backend messages
+const (
+	msgAuthentication       = 'R'
+	msgParameterStatus      = 'S'
+	msgBackendKeyData       = 'K'
+	msgReadyForQuery        = 'Z'
+	msgRowDescription       = 'T'
+	msgDataRow              = 'D'
+	msgCommandComplete      = 'C'
+	msgErrorResponse        = 'E'
+	msgEmptyQueryResponse   = 'I'
+	msgParseComplete        = '1'
+	msgBindComplete         = '2'
+	msgCloseComplete        = '3'
+	msgNoData               = 'n'
+	msgParameterDescription = 't'
+	msgPortalSuspended      = 's'
+)
+
+const (
+	authOk           = 0
+	authSASL         = 10
+	authSASLContinue = 11
+	authSASLFinal    = 12
+)
+
+func readStartupMessage(r io.Reader) ([]byte, error) {
+	var header [4]byte
+	if _, err := io.ReadFull(r, header[:]); err != nil {
+		return nil, err
+	}
+	length := int(binary.BigEndian.Uint32(header[:]))
+	if length < 8 || maxStartupMessageLength < length {
+		return nil, errInvalidMessage
+	}
+	buf := make([]byte, length-4)
+	if _, err := io.ReadFull(r, buf); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+func readMessage(r io.Reader, maxLength int) (byte, []byte, error) {
+	var header [5]byte
+	if _, err := io.ReadFull(r, header[:]); err != nil {
+		return 0, nil, err
+	}
+	length := int(binary.BigEndian.Uint32(header[1:]))
+	if length < 4 || maxLength < length {
+		return 0, nil, errInvalidMessage
+	}
+	buf := make([]byte, length-4)
+	if _, err := io.ReadFull(r, buf); err != nil {
+		return 0, nil, err
+	}
+	return header[0], buf, nil
+}
+
+// messageReader reads fields from the body of a message.
+type messageReader struct {
+	buf []byte
+	err error
+}
+
+func (r *messageReader) byte() byte {
+	if r.err != nil || len(r.buf) < 1 {
+		r.err = errInvalidMessage
+		return 0
+	}
+	b := r.buf[0]
+	r.buf = r.buf[1:]
+	return b
+}
+
+func (r *messageReader) int16() int {
+	if r.err != nil || len(r.buf) < 2 {
+		r.err = errInvalidMessage
+		return 0
+	}
+	i := int(int16(binary.BigEndian.Uint16(r.buf)))
+	r.buf = r.buf[2:]
+	return i
+}
+
+func (r *messageReader) int32() int {
+	if r.err != nil || len(r.buf) < 4 {
+		r.err = errInvalidMessage
+		return 0
+	}
+	i := int(int32(binary.BigEndian.Uint32(r.buf)))
+	r.buf = r.buf[4:]
+	return i
+}
+
+func (r *messageReader) string() string {
+	if r.err != nil {
+		return ""
+	}
+	for i, b := range r.buf {
+		if b == 0 {
+			s := string(r.buf[:i])
+			r.buf = r.buf[i+1:]
+			return s
+		}
+	}
+	r.err = errInvalidMessage
+	return ""
+}
+
+func (r *messageReader) bytes(n int) []byte {
+	if r.err != nil || n < 0 || len(r.buf) < n {
+		r.err = errInvalidMessage
+		return nil
+	}
+	b := r.buf[:n]
+	r.buf = r.buf[n:]
+	return b
+}
+
+// messageWriter buffers backend messages until Flush is called.
+type messageWriter struct {
+	w   *bufio.Writer
+	buf []byte
+}
+
+func newMessageWriter(w io.Writer) *messageWriter {
+	return &messageWriter{
+		w:   bufio.NewWriter(w),
+		buf: make([]byte, 0, 1024),
+	}
+}
+
+func (w *messageWriter) begin(t byte) {
+	w.buf = append(w.buf[:0], t, 0, 0, 0, 0)
+}
+
+func (w *messageWriter) byte(b byte) {
+	w.buf = append(w.buf, b)
+}
+
+func (w *messageWriter) int16(i int) {
+	w.buf = binary.BigEndian.AppendUint16(w.buf, uint16(i))
+}
+
+func (w *messageWriter) int32(i int) {
+	w.buf = binary.BigEndian.AppendUint32(w.buf, uint32(i))
+}
+
+func (w *messageWriter) string(s string) {
+	w.buf = append(w.buf, s...)
+	w.buf = append(w.buf, 0)
+}
+
+func (w *messageWriter) bytes(b []byte) {
+	w.buf = append(w.buf, b...)
+}
+
+func (w *messageWriter) end() error {
+	binary.BigEndian.PutUint32(w.buf[1:], uint32(len(w.buf)-1))
+	_, err := w.w.Write(w.buf)
+	return err
+}
+
+func (w *messageWriter) Flush() error {
+	return w.w.Flush()
+}
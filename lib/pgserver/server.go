@@ -0,0 +1,152 @@
+// Package pgserver provides a server that speaks the frontend/backend
+// protocol of PostgreSQL, so that psql and other PostgreSQL clients can run
+// statements against a csvq repository.
+//
+//	server := pgserver.NewServer(flags)
+//	err := server.ListenAndServe("127.0.0.1:5432")
+//
+// Each connection runs statements in its own query.Session. Outside of
+// transaction blocks started by BEGIN, changes are committed after each
+// statement.
+package pgserver
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"net"
+	"sync"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+)
+
+var ErrServerClosed = errors.New("server closed")
+
+type Server struct {
+	Flags *cmd.Flags
+
+	// Password is required for clients to connect if it is not empty.
+	// Clients are authenticated with SCRAM-SHA-256.
+	Password string
+
+	// RootDirectory confines the files that clients can read and write to
+	// the directory if it is not empty.
+	RootDirectory string
+
+	mtx      sync.Mutex
+	listener net.Listener
+	conns    map[int32]*conn
+	lastPid  int32
+	closed   bool
+	wg       sync.WaitGroup
+}
+
+func NewServer(flags *cmd.Flags) *Server {
+	if flags == nil {
+		flags = cmd.NewFlags()
+	}
+	return &Server{
+		Flags: flags,
+		conns: make(map[int32]*conn, 10),
+	}
+}
+
+func (s *Server) ListenAndServe(addr string) error {
+	l, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+	return s.Serve(l)
+}
+
+// Serve accepts connections on the listener until Close is called.
+func (s *Server) Serve(l net.Listener) error {
+	s.mtx.Lock()
+	if s.closed {
+		s.mtx.Unlock()
+		_ = l.Close()
+		return ErrServerClosed
+	}
+	s.listener = l
+	s.mtx.Unlock()
+
+	for {
+		nc, err := l.Accept()
+		if err != nil {
+			s.mtx.Lock()
+			closed := s.closed
+			s.mtx.Unlock()
+			if closed {
+				return ErrServerClosed
+			}
+			return err
+		}
+
+		c := newConn(s, nc)
+		if !s.register(c) {
+			_ = nc.Close()
+			return ErrServerClosed
+		}
+
+		go func() {
+			defer s.wg.Done()
+			defer s.unregister(c)
+			c.serve()
+		}()
+	}
+}
+
+// Close stops accepting connections, closes all connections and waits for
+// their uncommitted changes to be rolled back.
+func (s *Server) Close() error {
+	s.mtx.Lock()
+	s.closed = true
+	var err error
+	if s.listener != nil {
+		err = s.listener.Close()
+	}
+	for _, c := range s.conns {
+		_ = c.netConn.Close()
+	}
+	s.mtx.Unlock()
+
+	s.wg.Wait()
+	return err
+}
+
+func (s *Server) register(c *conn) bool {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if s.closed {
+		return false
+	}
+	s.lastPid++
+	c.pid = s.lastPid
+	c.secret = randomInt32()
+	s.conns[c.pid] = c
+	s.wg.Add(1)
+	return true
+}
+
+func (s *Server) unregister(c *conn) {
+	s.mtx.Lock()
+	delete(s.conns, c.pid)
+	s.mtx.Unlock()
+}
+
+func (s *Server) cancel(pid int32, secret int32) {
+	s.mtx.Lock()
+	c, ok := s.conns[pid]
+	s.mtx.Unlock()
+
+	if ok && c.secret == secret {
+		c.cancelQuery()
+	}
+}
+
+func randomInt32() int32 {
+	var b [4]byte
+	_, _ = rand.Read(b[:])
+	return int32(binary.BigEndian.Uint32(b[:]))
+}
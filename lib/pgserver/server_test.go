@@ -0,0 +1,429 @@
+package pgserver
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+
+	"golang.org/x/crypto/pbkdf2"
+)
+
+type testMessage struct {
+	Type byte
+	Body []byte
+}
+
+type testClient struct {
+	t      *testing.T
+	conn   net.Conn
+	reader *bufio.Reader
+	writer *messageWriter
+}
+
+func startTestServer(t *testing.T, password string) (*Server, string) {
+	flags := cmd.NewFlags()
+	if err := flags.SetRepository(TestDir); err != nil {
+		t.Fatal(err)
+	}
+	server := NewServer(flags)
+	server.Password = password
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go func() {
+		_ = server.Serve(l)
+	}()
+	return server, l.Addr().String()
+}
+
+func dialTestServer(t *testing.T, addr string) *testClient {
+	conn, err := net.Dial("tcp", addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := &testClient{
+		t:      t,
+		conn:   conn,
+		reader: bufio.NewReader(conn),
+		writer: newMessageWriter(conn),
+	}
+
+	body := binary.BigEndian.AppendUint32(nil, protocolVersion)
+	body = append(body, "user\x00test\x00\x00"...)
+	packet := binary.BigEndian.AppendUint32(nil, uint32(len(body)+4))
+	if _, err := conn.Write(append(packet, body...)); err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func (c *testClient) send(t byte, fn func(w *messageWriter)) {
+	c.writer.begin(t)
+	if fn != nil {
+		fn(c.writer)
+	}
+	if err := c.writer.end(); err != nil {
+		c.t.Fatal(err)
+	}
+}
+
+func (c *testClient) query(sql string) []testMessage {
+	c.send(msgQuery, func(w *messageWriter) { w.string(sql) })
+	return c.receive()
+}
+
+// receive returns the messages until ReadyForQuery, a fatal error or a
+// password request.
+func (c *testClient) receive() []testMessage {
+	if err := c.writer.Flush(); err != nil {
+		c.t.Fatal(err)
+	}
+	messages := make([]testMessage, 0, 10)
+	for {
+		t, body, err := readMessage(c.reader, maxMessageLength)
+		if err != nil {
+			c.t.Fatal(err)
+		}
+		messages = append(messages, testMessage{Type: t, Body: body})
+		switch {
+		case t == msgReadyForQuery:
+			return messages
+		case t == msgErrorResponse && errorField(body, 'S') == "FATAL":
+			return messages
+		case t == msgAuthentication && (binary.BigEndian.Uint32(body) == authSASL || binary.BigEndian.Uint32(body) == authSASLContinue):
+			return messages
+		}
+	}
+}
+
+func (c *testClient) close() {
+	c.send(msgTerminate, nil)
+	_ = c.writer.Flush()
+	_ = c.conn.Close()
+}
+
+func errorField(body []byte, field byte) string {
+	r := &messageReader{buf: body}
+	for {
+		f := r.byte()
+		if r.err != nil || f == 0 {
+			return ""
+		}
+		s := r.string()
+		if f == field {
+			return s
+		}
+	}
+}
+
+// summarize converts messages to strings to compare them easily.
+func summarize(messages []testMessage) []string {
+	result := make([]string, 0, len(messages))
+	for _, m := range messages {
+		r := &messageReader{buf: m.Body}
+		s := string(m.Type)
+		switch m.Type {
+		case msgRowDescription:
+			n := r.int16()
+			for i := 0; i < n; i++ {
+				name := r.string()
+				r.int32()
+				r.int16()
+				oid := r.int32()
+				r.int16()
+				r.int32()
+				format := r.int16()
+				s += " " + name + ":" + strconv.Itoa(oid) + ":" + strconv.Itoa(format)
+			}
+		case msgDataRow:
+			n := r.int16()
+			for i := 0; i < n; i++ {
+				length := r.int32()
+				if length < 0 {
+					s += " NULL"
+				} else {
+					s += " " + string(r.bytes(length))
+				}
+			}
+		case msgCommandComplete:
+			s += " " + r.string()
+		case msgErrorResponse:
+			s += " " + errorField(m.Body, 'C') + " " + errorField(m.Body, 'M')
+		case msgAuthentication:
+			s += " " + strconv.Itoa(r.int32())
+		case msgReadyForQuery:
+			s += " " + string(m.Body)
+		case msgParameterStatus, msgBackendKeyData:
+			continue
+		}
+		result = append(result, s)
+	}
+	return result
+}
+
+func TestServer_SimpleQuery(t *testing.T) {
+	server, addr := startTestServer(t, "")
+	defer server.Close()
+
+	c := dialTestServer(t, addr)
+	defer c.close()
+
+	expect := []string{"R 0", "Z I"}
+	if result := summarize(c.receive()); !reflect.DeepEqual(result, expect) {
+		t.Fatalf("startup = %q, want %q", result, expect)
+	}
+
+	tests := []struct {
+		Query  string
+		Expect []string
+	}{
+		{
+			Query: "SELECT column1, column2 FROM table1 WHERE column1 = 1; SELECT 1 AS i, 1.5 AS f, TRUE AS b, NULL AS n",
+			Expect: []string{
+				"T column1:25:0 column2:25:0",
+				"D 1 str1",
+				"C SELECT 1",
+				"T i:20:0 f:701:0 b:16:0 n:25:0",
+				"D 1 1.5 t NULL",
+				"C SELECT 1",
+				"Z I",
+			},
+		},
+		{
+			Query:  " -- comment\n;",
+			Expect: []string{"I", "Z I"},
+		},
+		{
+			Query:  "SET application_name = 'psql'; SELECT FROM",
+			Expect: []string{"C SET", "E 42601 [L:1 C:9] syntax error: unexpected token \"FROM\"", "Z I"},
+		},
+		{
+			Query:  "$echo foo",
+			Expect: []string{"E XX000 [L:1 C:1] external commands are not allowed in this session", "Z I"},
+		},
+		{
+			Query:  "BEGIN; INSERT INTO rollback_query VALUES (4, 'str4')",
+			Expect: []string{"C BEGIN", "C INSERT 0 1", "Z T"},
+		},
+		{
+			Query:  "SELECT notexist FROM rollback_query",
+			Expect: []string{"E XX000 [L:1 C:8] field notexist does not exist", "Z E"},
+		},
+		{
+			Query:  "SELECT 1",
+			Expect: []string{"E 25P02 current transaction is aborted, commands ignored until end of transaction block", "Z E"},
+		},
+		{
+			Query:  "COMMIT",
+			Expect: []string{"C ROLLBACK", "Z I"},
+		},
+		{
+			Query:  "SELECT COUNT(*) AS cnt FROM rollback_query",
+			Expect: []string{"T cnt:20:0", "D 3", "C SELECT 1", "Z I"},
+		},
+		{
+			Query:  "UPDATE rollback_query SET column2 = 'updated' WHERE column1 = 1",
+			Expect: []string{"C UPDATE 1", "Z I"},
+		},
+	}
+
+	for _, v := range tests {
+		result := summarize(c.query(v.Query))
+		if !reflect.DeepEqual(result, v.Expect) {
+			t.Errorf("result = %q, want %q for %q", result, v.Expect, v.Query)
+		}
+	}
+
+	b, _ := os.ReadFile(filepath.Join(TestDir, "rollback_query.csv"))
+	expectFile := "column1,column2\n1,updated\n2,str2\n3,str3"
+	if string(b) != expectFile {
+		t.Errorf("file = %q, want %q", string(b), expectFile)
+	}
+}
+
+func TestServer_ExtendedQuery(t *testing.T) {
+	server, addr := startTestServer(t, "")
+	defer server.Close()
+
+	c := dialTestServer(t, addr)
+	defer c.close()
+	c.receive()
+
+	c.send(msgParse, func(w *messageWriter) {
+		w.string("stmt")
+		w.string("SELECT column2, $1 + 1 AS n FROM table1 WHERE column1 = $1")
+		w.int16(1)
+		w.int32(oidInt4)
+	})
+	c.send(msgDescribe, func(w *messageWriter) {
+		w.byte('S')
+		w.string("stmt")
+	})
+	c.send(msgSync, nil)
+
+	expect := []string{"1", "t", "T column2:25:0 n:25:0", "Z I"}
+	if result := summarize(c.receive()); !reflect.DeepEqual(result, expect) {
+		t.Errorf("describe = %q, want %q", result, expect)
+	}
+
+	c.send(msgBind, func(w *messageWriter) {
+		w.string("")
+		w.string("stmt")
+		w.int16(1)
+		w.int16(formatBinary)
+		w.int16(1)
+		w.int32(4)
+		w.int32(2)
+		w.int16(0)
+	})
+	c.send(msgExecute, func(w *messageWriter) {
+		w.string("")
+		w.int32(0)
+	})
+	c.send(msgSync, nil)
+
+	expect = []string{"2", "D str2 3", "C SELECT 1", "Z I"}
+	if result := summarize(c.receive()); !reflect.DeepEqual(result, expect) {
+		t.Errorf("execute = %q, want %q", result, expect)
+	}
+
+	c.send(msgParse, func(w *messageWriter) {
+		w.string("")
+		w.string("SELECT 1 AS i UNION ALL SELECT 2")
+		w.int16(0)
+	})
+	c.send(msgBind, func(w *messageWriter) {
+		w.string("")
+		w.string("")
+		w.int16(0)
+		w.int16(0)
+		w.int16(1)
+		w.int16(formatBinary)
+	})
+	c.send(msgDescribe, func(w *messageWriter) {
+		w.byte('P')
+		w.string("")
+	})
+	c.send(msgExecute, func(w *messageWriter) {
+		w.string("")
+		w.int32(1)
+	})
+	c.send(msgExecute, func(w *messageWriter) {
+		w.string("")
+		w.int32(1)
+	})
+	c.send(msgSync, nil)
+
+	expect = []string{"1", "2", "T i:20:1", "D \x00\x00\x00\x00\x00\x00\x00\x01", "s", "D \x00\x00\x00\x00\x00\x00\x00\x02", "C SELECT 1", "Z I"}
+	if result := summarize(c.receive()); !reflect.DeepEqual(result, expect) {
+		t.Errorf("execute = %q, want %q", result, expect)
+	}
+
+	c.send(msgParse, func(w *messageWriter) {
+		w.string("")
+		w.string("SELECT 1; SELECT 2")
+		w.int16(0)
+	})
+	c.send(msgBind, func(w *messageWriter) {
+		w.string("")
+		w.string("")
+		w.int16(0)
+		w.int16(0)
+		w.int16(0)
+	})
+	c.send(msgSync, nil)
+
+	expect = []string{"E 42601 cannot insert multiple commands into a prepared statement", "Z I"}
+	if result := summarize(c.receive()); !reflect.DeepEqual(result, expect) {
+		t.Errorf("error = %q, want %q", result, expect)
+	}
+}
+
+// scramExchange sends the SASL messages of SCRAM-SHA-256 with the password
+// and returns the messages after the client-final-message.
+func (c *testClient) scramExchange(password string) []testMessage {
+	clientFirstBare := "n=,r=clientnonce"
+	c.send(msgPassword, func(w *messageWriter) {
+		w.string(scramMechanism)
+		w.int32(len("n,," + clientFirstBare))
+		w.bytes([]byte("n,," + clientFirstBare))
+	})
+	messages := c.receive()
+	if result := summarize(messages); !reflect.DeepEqual(result, []string{"R 11"}) {
+		c.t.Fatalf("SASL continue = %q, want %q", result, []string{"R 11"})
+	}
+	serverFirst := string(messages[0].Body[4:])
+
+	attrs := scramAttributes(serverFirst)
+	salt, _ := base64.StdEncoding.DecodeString(attrs['s'])
+	iterations, _ := strconv.Atoi(attrs['i'])
+
+	withoutProof := "c=biws,r=" + attrs['r']
+	saltedPassword := pbkdf2.Key([]byte(password), salt, iterations, sha256.Size, sha256.New)
+	clientKey := scramHmac(saltedPassword, "Client Key")
+	storedKey := sha256.Sum256(clientKey)
+	clientSignature := scramHmac(storedKey[:], clientFirstBare+","+serverFirst+","+withoutProof)
+	proof := make([]byte, len(clientKey))
+	for i := range clientKey {
+		proof[i] = clientKey[i] ^ clientSignature[i]
+	}
+
+	c.send(msgPassword, func(w *messageWriter) {
+		w.bytes([]byte(withoutProof + ",p=" + base64.StdEncoding.EncodeToString(proof)))
+	})
+	return c.receive()
+}
+
+func TestServer_Password(t *testing.T) {
+	server, addr := startTestServer(t, "secret")
+	defer server.Close()
+
+	c := dialTestServer(t, addr)
+	if result := summarize(c.receive()); !reflect.DeepEqual(result, []string{"R 10"}) {
+		t.Fatalf("authentication = %q, want %q", result, []string{"R 10"})
+	}
+	expect := []string{"E 28P01 password authentication failed for user \"test\""}
+	if result := summarize(c.scramExchange("wrong")); !reflect.DeepEqual(result, expect) {
+		t.Errorf("result = %q, want %q", result, expect)
+	}
+	_ = c.conn.Close()
+
+	c = dialTestServer(t, addr)
+	defer c.close()
+	c.receive()
+	expect = []string{"R 12", "R 0", "Z I"}
+	if result := summarize(c.scramExchange("secret")); !reflect.DeepEqual(result, expect) {
+		t.Errorf("result = %q, want %q", result, expect)
+	}
+}
+
+func TestServer_PasswordMessageLength(t *testing.T) {
+	server, addr := startTestServer(t, "secret")
+	defer server.Close()
+
+	c := dialTestServer(t, addr)
+	defer func() { _ = c.conn.Close() }()
+	c.receive()
+
+	header := append([]byte{msgPassword}, binary.BigEndian.AppendUint32(nil, maxStartupMessageLength+5)...)
+	if _, err := c.conn.Write(header); err != nil {
+		t.Fatal(err)
+	}
+	_ = c.conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	if _, err := c.reader.ReadByte(); err != io.EOF {
+		t.Errorf("error = %v, want the connection to be closed", err)
+	}
+}
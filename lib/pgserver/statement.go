@@ -0,0 +1,190 @@
+package pgserver
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/mithrandie/csvq/lib/parser"
+)
+
+const (
+	segmentNone = iota
+	segmentLiteral
+	segmentComment
+)
+
+// skipSegment returns the kind and the end position of the literal or the
+// comment that starts at pos.
+func skipSegment(runes []rune, pos int) (int, int) {
+	switch {
+	case runes[pos] == '\'' || runes[pos] == '"' || runes[pos] == '`':
+		quote := runes[pos]
+		for i := pos + 1; i < len(runes); i++ {
+			switch runes[i] {
+			case '\\':
+				i++
+			case quote:
+				return segmentLiteral, i + 1
+			}
+		}
+		return segmentLiteral, len(runes)
+	case runes[pos] == '-' && pos+1 < len(runes) && runes[pos+1] == '-':
+		for i := pos + 2; i < len(runes); i++ {
+			if runes[i] == '\n' {
+				return segmentComment, i
+			}
+		}
+		return segmentComment, len(runes)
+	case runes[pos] == '/' && pos+1 < len(runes) && runes[pos+1] == '*':
+		for i := pos + 3; i < len(runes); i++ {
+			if runes[i-1] == '*' && runes[i] == '/' {
+				return segmentComment, i + 1
+			}
+		}
+		return segmentComment, len(runes)
+	}
+	return segmentNone, pos
+}
+
+// rewritePlaceholders replaces the placeholders $1, $2, ... of PostgreSQL
+// with the placeholders "?" of csvq. The n-th "?" in the result refers to
+// the parameter ordinals[n-1].
+func rewritePlaceholders(sql string) (string, []int) {
+	var buf strings.Builder
+	ordinals := make([]int, 0, 4)
+
+	runes := []rune(sql)
+	for i := 0; i < len(runes); i++ {
+		if kind, end := skipSegment(runes, i); kind != segmentNone {
+			buf.WriteString(string(runes[i:end]))
+			i = end - 1
+			continue
+		}
+
+		if runes[i] == '$' && i+1 < len(runes) && '1' <= runes[i+1] && runes[i+1] <= '9' {
+			n := 0
+			end := i + 1
+			for end < len(runes) && '0' <= runes[end] && runes[end] <= '9' {
+				n = n*10 + int(runes[end]-'0')
+				end++
+			}
+			buf.WriteRune(parser.PlaceholderSign)
+			ordinals = append(ordinals, n)
+			i = end - 1
+			continue
+		}
+
+		buf.WriteRune(runes[i])
+	}
+	return buf.String(), ordinals
+}
+
+// splitFirstStatement returns the text before the first semicolon outside of
+// literals and comments, and the rest of the text.
+func splitFirstStatement(sql string) (string, string) {
+	runes := []rune(sql)
+	for i := 0; i < len(runes); i++ {
+		if kind, end := skipSegment(runes, i); kind != segmentNone {
+			i = end - 1
+			continue
+		}
+		if runes[i] == ';' {
+			return string(runes[:i]), string(runes[i+1:])
+		}
+	}
+	return sql, ""
+}
+
+// isEmptyQuery returns whether the text contains only whitespaces, comments
+// and semicolons.
+func isEmptyQuery(sql string) bool {
+	runes := []rune(sql)
+	for i := 0; i < len(runes); i++ {
+		switch kind, end := skipSegment(runes, i); kind {
+		case segmentComment:
+			i = end - 1
+		case segmentLiteral:
+			return false
+		default:
+			if runes[i] != ';' && !unicode.IsSpace(runes[i]) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+type commandType int
+
+const (
+	commandNone commandType = iota
+	commandBegin
+	commandCommit
+	commandRollback
+	commandIgnore
+)
+
+var (
+	beginCommandExp    = regexp.MustCompile(`(?is)^(BEGIN|START\s+TRANSACTION)(\s+.*)?$`)
+	commitCommandExp   = regexp.MustCompile(`(?is)^(COMMIT|END)(\s+(WORK|TRANSACTION))?$`)
+	rollbackCommandExp = regexp.MustCompile(`(?is)^(ROLLBACK|ABORT)(\s+(WORK|TRANSACTION))?$`)
+	ignoreCommandExp   = regexp.MustCompile(`(?is)^(SET|RESET|DISCARD|DEALLOCATE)\s+[A-Za-z_"].*$`)
+)
+
+// pgCommand returns the type and the command tag of a statement that is
+// handled by the server instead of csvq.
+// Settings of PostgreSQL are accepted and ignored.
+func pgCommand(stmt string) (commandType, string) {
+	stmt = strings.TrimSpace(stmt)
+	switch {
+	case beginCommandExp.MatchString(stmt):
+		return commandBegin, "BEGIN"
+	case commitCommandExp.MatchString(stmt):
+		return commandCommit, "COMMIT"
+	case rollbackCommandExp.MatchString(stmt):
+		return commandRollback, "ROLLBACK"
+	case ignoreCommandExp.MatchString(stmt):
+		keyword := strings.ToUpper(strings.Fields(stmt)[0])
+		if keyword == "DISCARD" {
+			keyword = "DISCARD ALL"
+		}
+		return commandIgnore, keyword
+	}
+	return commandNone, ""
+}
+
+func commandTag(stmt parser.Statement, rows int) string {
+	switch s := stmt.(type) {
+	case parser.SelectQuery:
+		return fmt.Sprintf("SELECT %d", rows)
+	case parser.InsertQuery:
+		return fmt.Sprintf("INSERT 0 %d", rows)
+	case parser.UpdateQuery:
+		return fmt.Sprintf("UPDATE %d", rows)
+	case parser.DeleteQuery:
+		return fmt.Sprintf("DELETE %d", rows)
+	case parser.MergeQuery:
+		return fmt.Sprintf("MERGE %d", rows)
+	case parser.CreateTable:
+		return "CREATE TABLE"
+	case parser.AddColumns, parser.DropColumns, parser.RenameColumn, parser.SetTableAttribute:
+		return "ALTER TABLE"
+	case parser.CreateIndex:
+		return "CREATE INDEX"
+	case parser.DropIndex:
+		return "DROP INDEX"
+	case parser.TransactionControl:
+		if s.Token == parser.COMMIT {
+			return "COMMIT"
+		}
+		return "ROLLBACK"
+	case parser.SetFlag, parser.AddFlagElement, parser.RemoveFlagElement, parser.SetEnvVar, parser.UnsetEnvVar:
+		return "SET"
+	case parser.VariableDeclaration, parser.FunctionDeclaration, parser.AggregateDeclaration,
+		parser.CursorDeclaration, parser.ViewDeclaration:
+		return "DECLARE"
+	}
+	return "DO"
+}
@@ -0,0 +1,160 @@
+package pgserver
+
+import (
+	"reflect"
+	"testing"
+)
+
+var rewritePlaceholdersTests = []struct {
+	Sql      string
+	Result   string
+	Ordinals []int
+}{
+	{
+		Sql:      "SELECT * FROM tbl WHERE a = $1 AND b = $2",
+		Result:   "SELECT * FROM tbl WHERE a = ? AND b = ?",
+		Ordinals: []int{1, 2},
+	},
+	{
+		Sql:      "SELECT $2, $1, $2",
+		Result:   "SELECT ?, ?, ?",
+		Ordinals: []int{2, 1, 2},
+	},
+	{
+		Sql:      "SELECT '$1', \"a\\\"$2\", `$3`, $10 -- $4\n/* $5 */ FROM tbl",
+		Result:   "SELECT '$1', \"a\\\"$2\", `$3`, ? -- $4\n/* $5 */ FROM tbl",
+		Ordinals: []int{10},
+	},
+	{
+		Sql:      "$echo $0",
+		Result:   "$echo $0",
+		Ordinals: []int{},
+	},
+}
+
+func TestRewritePlaceholders(t *testing.T) {
+	for _, v := range rewritePlaceholdersTests {
+		result, ordinals := rewritePlaceholders(v.Sql)
+		if result != v.Result {
+			t.Errorf("result = %q, want %q for %q", result, v.Result, v.Sql)
+		}
+		if !reflect.DeepEqual(ordinals, v.Ordinals) {
+			t.Errorf("ordinals = %v, want %v for %q", ordinals, v.Ordinals, v.Sql)
+		}
+	}
+}
+
+var splitFirstStatementTests = []struct {
+	Sql  string
+	Head string
+	Tail string
+}{
+	{
+		Sql:  "BEGIN; SELECT 1;",
+		Head: "BEGIN",
+		Tail: " SELECT 1;",
+	},
+	{
+		Sql:  "SELECT ';' -- ;\n/* ; */;SELECT 2",
+		Head: "SELECT ';' -- ;\n/* ; */",
+		Tail: "SELECT 2",
+	},
+	{
+		Sql:  "SELECT 1",
+		Head: "SELECT 1",
+		Tail: "",
+	},
+}
+
+func TestSplitFirstStatement(t *testing.T) {
+	for _, v := range splitFirstStatementTests {
+		head, tail := splitFirstStatement(v.Sql)
+		if head != v.Head || tail != v.Tail {
+			t.Errorf("result = %q, %q, want %q, %q for %q", head, tail, v.Head, v.Tail, v.Sql)
+		}
+	}
+}
+
+var isEmptyQueryTests = []struct {
+	Sql    string
+	Result bool
+}{
+	{
+		Sql:    " ;\n-- comment\n/* comment */ ;",
+		Result: true,
+	},
+	{
+		Sql:    "-- comment\nSELECT 1",
+		Result: false,
+	},
+	{
+		Sql:    "''",
+		Result: false,
+	},
+}
+
+func TestIsEmptyQuery(t *testing.T) {
+	for _, v := range isEmptyQueryTests {
+		if result := isEmptyQuery(v.Sql); result != v.Result {
+			t.Errorf("result = %t, want %t for %q", result, v.Result, v.Sql)
+		}
+	}
+}
+
+var pgCommandTests = []struct {
+	Sql     string
+	Command commandType
+	Tag     string
+}{
+	{
+		Sql:     "begin",
+		Command: commandBegin,
+		Tag:     "BEGIN",
+	},
+	{
+		Sql:     " START TRANSACTION ISOLATION LEVEL READ COMMITTED ",
+		Command: commandBegin,
+		Tag:     "BEGIN",
+	},
+	{
+		Sql:     "END TRANSACTION",
+		Command: commandCommit,
+		Tag:     "COMMIT",
+	},
+	{
+		Sql:     "abort",
+		Command: commandRollback,
+		Tag:     "ROLLBACK",
+	},
+	{
+		Sql:     "SET extra_float_digits = 3",
+		Command: commandIgnore,
+		Tag:     "SET",
+	},
+	{
+		Sql:     "discard all",
+		Command: commandIgnore,
+		Tag:     "DISCARD ALL",
+	},
+	{
+		Sql:     "SET @@DELIMITER = ';'",
+		Command: commandNone,
+	},
+	{
+		Sql:     "COMMIT; SELECT 1",
+		Command: commandNone,
+	},
+	{
+		Sql:     "SELECT 1",
+		Command: commandNone,
+	},
+}
+
+func TestPgCommand(t *testing.T) {
+	for _, v := range pgCommandTests {
+		command, tag := pgCommand(v.Sql)
+		if command != v.Command || tag != v.Tag {
+			t.Errorf("result = %d, %q, want %d, %q for %q", command, tag, v.Command, v.Tag, v.Sql)
+		}
+	}
+}
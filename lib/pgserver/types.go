@@ -0,0 +1,259 @@
+package pgserver
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/ternary"
+)
+
+// Type OIDs
+const (
+	oidUnspecified = 0
+	oidBool        = 16
+	oidBytea       = 17
+	oidInt8        = 20
+	oidInt2        = 21
+	oidInt4        = 23
+	oidText        = 25
+	oidFloat4      = 700
+	oidFloat8      = 701
+	oidUnknown     = 705
+	oidBpchar      = 1042
+	oidVarchar     = 1043
+	oidDate        = 1082
+	oidTimestamp   = 1114
+	oidTimestamptz = 1184
+	oidNumeric     = 1700
+)
+
+const (
+	formatText   = 0
+	formatBinary = 1
+)
+
+const timestampFormat = "2006-01-02 15:04:05.999999-07:00"
+
+var pgEpoch = time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func typeSize(oid int) int {
+	switch oid {
+	case oidBool:
+		return 1
+	case oidInt8, oidFloat8, oidTimestamptz:
+		return 8
+	}
+	return -1
+}
+
+func valueType(p value.Primary) int {
+	switch p := p.(type) {
+	case value.String:
+		return oidText
+	case value.Integer:
+		return oidInt8
+	case value.Float:
+		return oidFloat8
+	case value.Boolean:
+		return oidBool
+	case value.Ternary:
+		if p.Ternary() == ternary.UNKNOWN {
+			return oidUnspecified
+		}
+		return oidBool
+	case value.Datetime:
+		return oidTimestamptz
+	}
+	return oidUnspecified
+}
+
+// inferColumnTypes returns the type of each column in the records.
+// A column that contains integers and floats is a float8 column, and a
+// column that contains other mixed types or only nulls is a text column.
+func inferColumnTypes(columns int, records [][]value.Primary) []int {
+	types := make([]int, columns)
+	for _, record := range records {
+		for i, p := range record {
+			t := valueType(p)
+			switch {
+			case t == oidUnspecified || t == types[i] || types[i] == oidText:
+			case types[i] == oidUnspecified:
+				types[i] = t
+			case (t == oidInt8 && types[i] == oidFloat8) || (t == oidFloat8 && types[i] == oidInt8):
+				types[i] = oidFloat8
+			default:
+				types[i] = oidText
+			}
+		}
+	}
+	for i := range types {
+		if types[i] == oidUnspecified {
+			types[i] = oidText
+		}
+	}
+	return types
+}
+
+// encodeValue returns the representation of the value in a column of the
+// type. Nil is returned for nulls.
+func encodeValue(p value.Primary, oid int, format int) []byte {
+	if t, ok := p.(value.Ternary); ok {
+		if t.Ternary() == ternary.UNKNOWN {
+			return nil
+		}
+		p = value.NewBoolean(t.Ternary() == ternary.TRUE)
+	}
+	if value.IsNull(p) {
+		return nil
+	}
+
+	if format == formatBinary {
+		switch oid {
+		case oidInt8:
+			return binary.BigEndian.AppendUint64(nil, uint64(p.(value.Integer).Raw()))
+		case oidFloat8:
+			return binary.BigEndian.AppendUint64(nil, math.Float64bits(toFloat(p)))
+		case oidBool:
+			if p.(value.Boolean).Raw() {
+				return []byte{1}
+			}
+			return []byte{0}
+		case oidTimestamptz:
+			return binary.BigEndian.AppendUint64(nil, uint64(p.(value.Datetime).Raw().UnixMicro()-pgEpoch.UnixMicro()))
+		}
+	}
+
+	switch p := p.(type) {
+	case value.String:
+		return []byte(p.Raw())
+	case value.Integer:
+		if oid == oidFloat8 {
+			return []byte(formatFloat(float64(p.Raw())))
+		}
+		return []byte(strconv.FormatInt(p.Raw(), 10))
+	case value.Float:
+		return []byte(formatFloat(p.Raw()))
+	case value.Boolean:
+		if p.Raw() {
+			return []byte("t")
+		}
+		return []byte("f")
+	case value.Datetime:
+		return []byte(p.Raw().Format(timestampFormat))
+	}
+	return []byte(p.String())
+}
+
+func toFloat(p value.Primary) float64 {
+	if i, ok := p.(value.Integer); ok {
+		return float64(i.Raw())
+	}
+	return p.(value.Float).Raw()
+}
+
+func formatFloat(f float64) string {
+	switch {
+	case math.IsInf(f, 1):
+		return "Infinity"
+	case math.IsInf(f, -1):
+		return "-Infinity"
+	case math.IsNaN(f):
+		return "NaN"
+	}
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
+
+// decodeParameter converts a parameter value sent by the client.
+// Values of unspecified types in text format are passed as strings, and
+// they are converted implicitly in the same way as strings in CSV files.
+func decodeParameter(b []byte, oid int, format int) (value.Primary, error) {
+	if b == nil {
+		return value.NewNull(), nil
+	}
+
+	if format == formatBinary {
+		return decodeBinaryParameter(b, oid)
+	}
+
+	s := string(b)
+	switch oid {
+	case oidInt2, oidInt4, oidInt8:
+		i, err := strconv.ParseInt(strings.TrimSpace(s), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid input syntax for type integer: %q", s)
+		}
+		return value.NewInteger(i), nil
+	case oidFloat4, oidFloat8, oidNumeric:
+		f, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid input syntax for type double precision: %q", s)
+		}
+		return value.NewFloat(f), nil
+	case oidBool:
+		switch strings.ToLower(strings.TrimSpace(s)) {
+		case "t", "true", "y", "yes", "on", "1":
+			return value.NewBoolean(true), nil
+		case "f", "false", "n", "no", "off", "0":
+			return value.NewBoolean(false), nil
+		}
+		return nil, fmt.Errorf("invalid input syntax for type boolean: %q", s)
+	case oidDate, oidTimestamp, oidTimestamptz:
+		t, err := value.StrToTime(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid input syntax for type timestamp: %q", s)
+		}
+		return value.NewDatetime(t), nil
+	}
+	return value.NewString(s), nil
+}
+
+func decodeBinaryParameter(b []byte, oid int) (value.Primary, error) {
+	switch oid {
+	case oidUnspecified, oidText, oidVarchar, oidBpchar, oidUnknown, oidBytea:
+		return value.NewString(string(b)), nil
+	case oidBool:
+		if len(b) == 1 {
+			return value.NewBoolean(b[0] != 0), nil
+		}
+	case oidInt2:
+		if len(b) == 2 {
+			return value.NewInteger(int64(int16(binary.BigEndian.Uint16(b)))), nil
+		}
+	case oidInt4:
+		if len(b) == 4 {
+			return value.NewInteger(int64(int32(binary.BigEndian.Uint32(b)))), nil
+		}
+	case oidInt8:
+		if len(b) == 8 {
+			return value.NewInteger(int64(binary.BigEndian.Uint64(b))), nil
+		}
+	case oidFloat4:
+		if len(b) == 4 {
+			return value.NewFloat(float64(math.Float32frombits(binary.BigEndian.Uint32(b)))), nil
+		}
+	case oidFloat8:
+		if len(b) == 8 {
+			return value.NewFloat(math.Float64frombits(binary.BigEndian.Uint64(b))), nil
+		}
+	case oidDate:
+		if len(b) == 4 {
+			days := int(int32(binary.BigEndian.Uint32(b)))
+			return value.NewDatetime(pgEpoch.AddDate(0, 0, days)), nil
+		}
+	case oidTimestamp, oidTimestamptz:
+		if len(b) == 8 {
+			us := int64(binary.BigEndian.Uint64(b))
+			return value.NewDatetime(time.UnixMicro(pgEpoch.UnixMicro() + us).UTC()), nil
+		}
+	default:
+		return nil, fmt.Errorf("binary format for type %d is not supported", oid)
+	}
+	return nil, errors.New("invalid binary parameter length")
+}
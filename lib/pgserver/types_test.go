@@ -0,0 +1,197 @@
+package pgserver
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/ternary"
+)
+
+func TestInferColumnTypes(t *testing.T) {
+	records := [][]value.Primary{
+		{value.NewInteger(1), value.NewInteger(1), value.NewString("a"), value.NewNull(), value.NewTernary(ternary.TRUE), value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 0, time.UTC)), value.NewInteger(1)},
+		{value.NewInteger(2), value.NewFloat(1.5), value.NewString("b"), value.NewNull(), value.NewBoolean(false), value.NewNull(), value.NewString("a")},
+	}
+	expect := []int{oidInt8, oidFloat8, oidText, oidText, oidBool, oidTimestamptz, oidText}
+
+	result := inferColumnTypes(7, records)
+	if !reflect.DeepEqual(result, expect) {
+		t.Errorf("result = %v, want %v", result, expect)
+	}
+}
+
+var encodeValueTests = []struct {
+	Value  value.Primary
+	Type   int
+	Format int
+	Result []byte
+}{
+	{
+		Value:  value.NewInteger(-1),
+		Type:   oidInt8,
+		Format: formatText,
+		Result: []byte("-1"),
+	},
+	{
+		Value:  value.NewInteger(2),
+		Type:   oidInt8,
+		Format: formatBinary,
+		Result: []byte{0, 0, 0, 0, 0, 0, 0, 2},
+	},
+	{
+		Value:  value.NewInteger(2),
+		Type:   oidFloat8,
+		Format: formatText,
+		Result: []byte("2"),
+	},
+	{
+		Value:  value.NewFloat(1.5),
+		Type:   oidFloat8,
+		Format: formatBinary,
+		Result: []byte{0x3f, 0xf8, 0, 0, 0, 0, 0, 0},
+	},
+	{
+		Value:  value.NewBoolean(true),
+		Type:   oidBool,
+		Format: formatText,
+		Result: []byte("t"),
+	},
+	{
+		Value:  value.NewTernary(ternary.FALSE),
+		Type:   oidBool,
+		Format: formatBinary,
+		Result: []byte{0},
+	},
+	{
+		Value:  value.NewTernary(ternary.UNKNOWN),
+		Type:   oidText,
+		Format: formatText,
+		Result: nil,
+	},
+	{
+		Value:  value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123000000, time.FixedZone("", 9*3600))),
+		Type:   oidTimestamptz,
+		Format: formatText,
+		Result: []byte("2012-02-03 09:18:15.123+09:00"),
+	},
+	{
+		Value:  value.NewDatetime(time.Date(2000, 1, 1, 0, 0, 1, 0, time.UTC)),
+		Type:   oidTimestamptz,
+		Format: formatBinary,
+		Result: []byte{0, 0, 0, 0, 0, 0x0f, 0x42, 0x40},
+	},
+	{
+		Value:  value.NewInteger(1),
+		Type:   oidText,
+		Format: formatBinary,
+		Result: []byte("1"),
+	},
+	{
+		Value:  value.NewNull(),
+		Type:   oidText,
+		Format: formatText,
+		Result: nil,
+	},
+}
+
+func TestEncodeValue(t *testing.T) {
+	for _, v := range encodeValueTests {
+		result := encodeValue(v.Value, v.Type, v.Format)
+		if !reflect.DeepEqual(result, v.Result) {
+			t.Errorf("result = %v, want %v for %s (type %d, format %d)", result, v.Result, v.Value, v.Type, v.Format)
+		}
+	}
+}
+
+var decodeParameterTests = []struct {
+	Value  []byte
+	Type   int
+	Format int
+	Result value.Primary
+	Error  string
+}{
+	{
+		Value:  nil,
+		Type:   oidInt8,
+		Format: formatText,
+		Result: value.NewNull(),
+	},
+	{
+		Value:  []byte("1"),
+		Type:   oidUnspecified,
+		Format: formatText,
+		Result: value.NewString("1"),
+	},
+	{
+		Value:  []byte("12"),
+		Type:   oidInt4,
+		Format: formatText,
+		Result: value.NewInteger(12),
+	},
+	{
+		Value:  []byte("a"),
+		Type:   oidInt4,
+		Format: formatText,
+		Error:  "invalid input syntax for type integer: \"a\"",
+	},
+	{
+		Value:  []byte("true"),
+		Type:   oidBool,
+		Format: formatText,
+		Result: value.NewBoolean(true),
+	},
+	{
+		Value:  []byte{0, 0, 0, 12},
+		Type:   oidInt4,
+		Format: formatBinary,
+		Result: value.NewInteger(12),
+	},
+	{
+		Value:  []byte{0x3f, 0xf8, 0, 0, 0, 0, 0, 0},
+		Type:   oidFloat8,
+		Format: formatBinary,
+		Result: value.NewFloat(1.5),
+	},
+	{
+		Value:  []byte{0, 0, 0, 1},
+		Type:   oidDate,
+		Format: formatBinary,
+		Result: value.NewDatetime(time.Date(2000, 1, 2, 0, 0, 0, 0, time.UTC)),
+	},
+	{
+		Value:  []byte{0, 0, 12},
+		Type:   oidInt4,
+		Format: formatBinary,
+		Error:  "invalid binary parameter length",
+	},
+	{
+		Value:  []byte{0},
+		Type:   oidNumeric,
+		Format: formatBinary,
+		Error:  "binary format for type 1700 is not supported",
+	},
+}
+
+func TestDecodeParameter(t *testing.T) {
+	for _, v := range decodeParameterTests {
+		result, err := decodeParameter(v.Value, v.Type, v.Format)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("unexpected error %q for %v (type %d)", err, v.Value, v.Type)
+			} else if err.Error() != v.Error {
+				t.Errorf("error %q, want error %q for %v (type %d)", err, v.Error, v.Value, v.Type)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("no error, want error %q for %v (type %d)", v.Error, v.Value, v.Type)
+			continue
+		}
+		if !reflect.DeepEqual(result, v.Result) {
+			t.Errorf("result = %#v, want %#v for %v (type %d)", result, v.Result, v.Value, v.Type)
+		}
+	}
+}
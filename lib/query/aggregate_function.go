@@ -1,6 +1,8 @@
 package query
 
 import (
+	"bytes"
+	"math"
 	"sort"
 	"strings"
 
@@ -14,12 +16,27 @@ import (
 type AggregateFunction func([]value.Primary) value.Primary
 
 var AggregateFunctions = map[string]AggregateFunction{
-	"COUNT":  Count,
-	"MAX":    Max,
-	"MIN":    Min,
-	"SUM":    Sum,
-	"AVG":    Avg,
-	"MEDIAN": Median,
+	"COUNT":       Count,
+	"MAX":         Max,
+	"MIN":         Min,
+	"SUM":         Sum,
+	"AVG":         Avg,
+	"MEDIAN":      Median,
+	"STDDEV_POP":  StdDevPop,
+	"STDDEV_SAMP": StdDevSamp,
+	"VAR_POP":     VarPop,
+	"VAR_SAMP":    VarSamp,
+	"MODE":        Mode,
+}
+
+type BinaryAggregateFunction func([]value.Primary, []value.Primary) value.Primary
+
+var BinaryAggregateFunctions = map[string]BinaryAggregateFunction{
+	"CORR":           Corr,
+	"COVAR_POP":      CovarPop,
+	"COVAR_SAMP":     CovarSamp,
+	"REGR_SLOPE":     RegrSlope,
+	"REGR_INTERCEPT": RegrIntercept,
 }
 
 func Count(list []value.Primary) value.Primary {
@@ -150,6 +167,223 @@ func Median(list []value.Primary) value.Primary {
 	return value.ParseFloat64(median)
 }
 
+func StdDevPop(list []value.Primary) value.Primary {
+	v, ok := variance(list, 0)
+	if !ok {
+		return value.NewNull()
+	}
+	return value.ParseFloat64(math.Sqrt(v))
+}
+
+func StdDevSamp(list []value.Primary) value.Primary {
+	v, ok := variance(list, 1)
+	if !ok {
+		return value.NewNull()
+	}
+	return value.ParseFloat64(math.Sqrt(v))
+}
+
+func VarPop(list []value.Primary) value.Primary {
+	v, ok := variance(list, 0)
+	if !ok {
+		return value.NewNull()
+	}
+	return value.ParseFloat64(v)
+}
+
+func VarSamp(list []value.Primary) value.Primary {
+	v, ok := variance(list, 1)
+	if !ok {
+		return value.NewNull()
+	}
+	return value.ParseFloat64(v)
+}
+
+func variance(list []value.Primary, ddof int) (float64, bool) {
+	values := floatValues(list)
+	if len(values) <= ddof {
+		return 0, false
+	}
+
+	mean := average(values)
+	var sum float64
+	for _, f := range values {
+		sum += (f - mean) * (f - mean)
+	}
+	return sum / float64(len(values)-ddof), true
+}
+
+func Mode(list []value.Primary) value.Primary {
+	counts := make(map[string]int)
+	keys := make([]string, 0, len(list))
+	values := make([]value.Primary, 0, len(list))
+
+	keyBuf := new(bytes.Buffer)
+
+	for _, v := range list {
+		if value.IsNull(v) {
+			continue
+		}
+
+		keyBuf.Reset()
+		SerializeComparisonKeys(keyBuf, []value.Primary{v})
+		key := keyBuf.String()
+
+		if _, ok := counts[key]; !ok {
+			keys = append(keys, key)
+			values = append(values, v)
+		}
+		counts[key]++
+	}
+
+	var result value.Primary = value.NewNull()
+	maxCount := 0
+	for i, key := range keys {
+		if maxCount < counts[key] {
+			maxCount = counts[key]
+			result = values[i]
+		}
+	}
+	return result
+}
+
+func Corr(ylist []value.Primary, xlist []value.Primary) value.Primary {
+	ys, xs := floatPairs(ylist, xlist)
+	if len(ys) < 1 {
+		return value.NewNull()
+	}
+
+	sxy, sxx, syy := deviationSums(ys, xs)
+	if sxx == 0 || syy == 0 {
+		return value.NewNull()
+	}
+	return value.ParseFloat64(sxy / math.Sqrt(sxx*syy))
+}
+
+func CovarPop(ylist []value.Primary, xlist []value.Primary) value.Primary {
+	ys, xs := floatPairs(ylist, xlist)
+	if len(ys) < 1 {
+		return value.NewNull()
+	}
+
+	sxy, _, _ := deviationSums(ys, xs)
+	return value.ParseFloat64(sxy / float64(len(ys)))
+}
+
+func CovarSamp(ylist []value.Primary, xlist []value.Primary) value.Primary {
+	ys, xs := floatPairs(ylist, xlist)
+	if len(ys) < 2 {
+		return value.NewNull()
+	}
+
+	sxy, _, _ := deviationSums(ys, xs)
+	return value.ParseFloat64(sxy / float64(len(ys)-1))
+}
+
+func RegrSlope(ylist []value.Primary, xlist []value.Primary) value.Primary {
+	ys, xs := floatPairs(ylist, xlist)
+	if len(ys) < 1 {
+		return value.NewNull()
+	}
+
+	sxy, sxx, _ := deviationSums(ys, xs)
+	if sxx == 0 {
+		return value.NewNull()
+	}
+	return value.ParseFloat64(sxy / sxx)
+}
+
+func RegrIntercept(ylist []value.Primary, xlist []value.Primary) value.Primary {
+	ys, xs := floatPairs(ylist, xlist)
+	if len(ys) < 1 {
+		return value.NewNull()
+	}
+
+	sxy, sxx, _ := deviationSums(ys, xs)
+	if sxx == 0 {
+		return value.NewNull()
+	}
+	return value.ParseFloat64(average(ys) - (sxy/sxx)*average(xs))
+}
+
+func floatValues(list []value.Primary) []float64 {
+	values := make([]float64, 0, len(list))
+	for _, v := range list {
+		if f := value.ToFloat(v); !value.IsNull(f) {
+			values = append(values, f.(value.Float).Raw())
+		}
+	}
+	return values
+}
+
+func floatPairs(ylist []value.Primary, xlist []value.Primary) ([]float64, []float64) {
+	ys := make([]float64, 0, len(ylist))
+	xs := make([]float64, 0, len(xlist))
+	for i := 0; i < len(ylist) && i < len(xlist); i++ {
+		y := value.ToFloat(ylist[i])
+		x := value.ToFloat(xlist[i])
+		if value.IsNull(y) || value.IsNull(x) {
+			continue
+		}
+		ys = append(ys, y.(value.Float).Raw())
+		xs = append(xs, x.(value.Float).Raw())
+	}
+	return ys, xs
+}
+
+func average(values []float64) float64 {
+	var sum float64
+	for _, f := range values {
+		sum += f
+	}
+	return sum / float64(len(values))
+}
+
+func deviationSums(ys []float64, xs []float64) (sxy float64, sxx float64, syy float64) {
+	ymean := average(ys)
+	xmean := average(xs)
+	for i := range ys {
+		dy := ys[i] - ymean
+		dx := xs[i] - xmean
+		sxy += dx * dy
+		sxx += dx * dx
+		syy += dy * dy
+	}
+	return
+}
+
+func PercentileCont(list []value.Primary, fraction float64) value.Primary {
+	values := floatValues(list)
+	if len(values) < 1 {
+		return value.NewNull()
+	}
+
+	pos := fraction * float64(len(values)-1)
+	lower := math.Floor(pos)
+	upper := math.Ceil(pos)
+	lv := values[int(lower)]
+	uv := values[int(upper)]
+	return value.ParseFloat64(lv + (pos-lower)*(uv-lv))
+}
+
+func PercentileDisc(list []value.Primary, fraction float64) value.Primary {
+	values := make([]value.Primary, 0, len(list))
+	for _, v := range list {
+		if !value.IsNull(v) {
+			values = append(values, v)
+		}
+	}
+	if len(values) < 1 {
+		return value.NewNull()
+	}
+
+	idx := int(math.Ceil(fraction*float64(len(values)))) - 1
+	if idx < 0 {
+		idx = 0
+	}
+	return values[idx]
+}
+
 func ListAgg(list []value.Primary, separator string) value.Primary {
 	strlist := make([]string, 0)
 	for _, v := range list {
@@ -208,6 +208,373 @@ func TestMedian(t *testing.T) {
 	}
 }
 
+var varianceTestList = []value.Primary{
+	value.NewInteger(2),
+	value.NewInteger(4),
+	value.NewInteger(4),
+	value.NewNull(),
+	value.NewInteger(4),
+	value.NewInteger(5),
+	value.NewString("5"),
+	value.NewInteger(7),
+	value.NewInteger(9),
+}
+
+var stdDevPopTests = []aggregateTests{
+	{
+		List:   varianceTestList,
+		Result: value.NewInteger(2),
+	},
+	{
+		List: []value.Primary{
+			value.NewNull(),
+		},
+		Result: value.NewNull(),
+	},
+}
+
+func TestStdDevPop(t *testing.T) {
+	for _, v := range stdDevPopTests {
+		r := StdDevPop(v.List)
+		if !reflect.DeepEqual(r, v.Result) {
+			t.Errorf("stddev_pop list = %s: result = %s, want %s", v.List, r, v.Result)
+		}
+	}
+}
+
+var stdDevSampTests = []aggregateTests{
+	{
+		List:   varianceTestList,
+		Result: value.NewFloat(2.138089935299395),
+	},
+	{
+		List: []value.Primary{
+			value.NewInteger(1),
+			value.NewNull(),
+		},
+		Result: value.NewNull(),
+	},
+}
+
+func TestStdDevSamp(t *testing.T) {
+	for _, v := range stdDevSampTests {
+		r := StdDevSamp(v.List)
+		if !reflect.DeepEqual(r, v.Result) {
+			t.Errorf("stddev_samp list = %s: result = %s, want %s", v.List, r, v.Result)
+		}
+	}
+}
+
+var varPopTests = []aggregateTests{
+	{
+		List:   varianceTestList,
+		Result: value.NewInteger(4),
+	},
+	{
+		List: []value.Primary{
+			value.NewNull(),
+		},
+		Result: value.NewNull(),
+	},
+}
+
+func TestVarPop(t *testing.T) {
+	for _, v := range varPopTests {
+		r := VarPop(v.List)
+		if !reflect.DeepEqual(r, v.Result) {
+			t.Errorf("var_pop list = %s: result = %s, want %s", v.List, r, v.Result)
+		}
+	}
+}
+
+var varSampTests = []aggregateTests{
+	{
+		List:   varianceTestList,
+		Result: value.NewFloat(4.571428571428571),
+	},
+	{
+		List: []value.Primary{
+			value.NewInteger(1),
+			value.NewNull(),
+		},
+		Result: value.NewNull(),
+	},
+}
+
+func TestVarSamp(t *testing.T) {
+	for _, v := range varSampTests {
+		r := VarSamp(v.List)
+		if !reflect.DeepEqual(r, v.Result) {
+			t.Errorf("var_samp list = %s: result = %s, want %s", v.List, r, v.Result)
+		}
+	}
+}
+
+var modeTests = []aggregateTests{
+	{
+		List: []value.Primary{
+			value.NewString("b"),
+			value.NewNull(),
+			value.NewString("a"),
+			value.NewNull(),
+			value.NewString("a"),
+			value.NewString("b"),
+			value.NewString("c"),
+		},
+		Result: value.NewString("b"),
+	},
+	{
+		List: []value.Primary{
+			value.NewInteger(2),
+			value.NewFloat(1),
+			value.NewInteger(1),
+		},
+		Result: value.NewFloat(1),
+	},
+	{
+		List: []value.Primary{
+			value.NewNull(),
+		},
+		Result: value.NewNull(),
+	},
+}
+
+func TestMode(t *testing.T) {
+	for _, v := range modeTests {
+		r := Mode(v.List)
+		if !reflect.DeepEqual(r, v.Result) {
+			t.Errorf("mode list = %s: result = %s, want %s", v.List, r, v.Result)
+		}
+	}
+}
+
+type binaryAggregateTests struct {
+	YList  []value.Primary
+	XList  []value.Primary
+	Result value.Primary
+}
+
+var binaryAggregateTestYList = []value.Primary{
+	value.NewInteger(1),
+	value.NewInteger(3),
+	value.NewNull(),
+	value.NewInteger(2),
+	value.NewInteger(5),
+	value.NewInteger(4),
+}
+
+var binaryAggregateTestXList = []value.Primary{
+	value.NewInteger(1),
+	value.NewInteger(2),
+	value.NewInteger(10),
+	value.NewInteger(3),
+	value.NewInteger(4),
+	value.NewString("5"),
+}
+
+var corrTests = []binaryAggregateTests{
+	{
+		YList:  binaryAggregateTestYList,
+		XList:  binaryAggregateTestXList,
+		Result: value.NewFloat(0.8),
+	},
+	{
+		YList:  []value.Primary{value.NewInteger(1), value.NewInteger(2)},
+		XList:  []value.Primary{value.NewInteger(3), value.NewInteger(3)},
+		Result: value.NewNull(),
+	},
+	{
+		YList:  []value.Primary{value.NewNull()},
+		XList:  []value.Primary{value.NewInteger(1)},
+		Result: value.NewNull(),
+	},
+}
+
+func TestCorr(t *testing.T) {
+	for _, v := range corrTests {
+		r := Corr(v.YList, v.XList)
+		if !reflect.DeepEqual(r, v.Result) {
+			t.Errorf("corr list = %s, %s: result = %s, want %s", v.YList, v.XList, r, v.Result)
+		}
+	}
+}
+
+var covarPopTests = []binaryAggregateTests{
+	{
+		YList:  binaryAggregateTestYList,
+		XList:  binaryAggregateTestXList,
+		Result: value.NewFloat(1.6),
+	},
+	{
+		YList:  []value.Primary{value.NewNull()},
+		XList:  []value.Primary{value.NewInteger(1)},
+		Result: value.NewNull(),
+	},
+}
+
+func TestCovarPop(t *testing.T) {
+	for _, v := range covarPopTests {
+		r := CovarPop(v.YList, v.XList)
+		if !reflect.DeepEqual(r, v.Result) {
+			t.Errorf("covar_pop list = %s, %s: result = %s, want %s", v.YList, v.XList, r, v.Result)
+		}
+	}
+}
+
+var covarSampTests = []binaryAggregateTests{
+	{
+		YList:  binaryAggregateTestYList,
+		XList:  binaryAggregateTestXList,
+		Result: value.NewInteger(2),
+	},
+	{
+		YList:  []value.Primary{value.NewInteger(1)},
+		XList:  []value.Primary{value.NewInteger(1)},
+		Result: value.NewNull(),
+	},
+}
+
+func TestCovarSamp(t *testing.T) {
+	for _, v := range covarSampTests {
+		r := CovarSamp(v.YList, v.XList)
+		if !reflect.DeepEqual(r, v.Result) {
+			t.Errorf("covar_samp list = %s, %s: result = %s, want %s", v.YList, v.XList, r, v.Result)
+		}
+	}
+}
+
+var regrSlopeTests = []binaryAggregateTests{
+	{
+		YList:  binaryAggregateTestYList,
+		XList:  binaryAggregateTestXList,
+		Result: value.NewFloat(0.8),
+	},
+	{
+		YList:  []value.Primary{value.NewInteger(1), value.NewInteger(2)},
+		XList:  []value.Primary{value.NewInteger(3), value.NewInteger(3)},
+		Result: value.NewNull(),
+	},
+}
+
+func TestRegrSlope(t *testing.T) {
+	for _, v := range regrSlopeTests {
+		r := RegrSlope(v.YList, v.XList)
+		if !reflect.DeepEqual(r, v.Result) {
+			t.Errorf("regr_slope list = %s, %s: result = %s, want %s", v.YList, v.XList, r, v.Result)
+		}
+	}
+}
+
+var regrInterceptTests = []binaryAggregateTests{
+	{
+		YList: []value.Primary{
+			value.NewInteger(3),
+			value.NewInteger(5),
+			value.NewNull(),
+			value.NewInteger(7),
+		},
+		XList: []value.Primary{
+			value.NewInteger(1),
+			value.NewInteger(2),
+			value.NewInteger(5),
+			value.NewInteger(3),
+		},
+		Result: value.NewInteger(1),
+	},
+	{
+		YList:  []value.Primary{value.NewNull()},
+		XList:  []value.Primary{value.NewInteger(1)},
+		Result: value.NewNull(),
+	},
+}
+
+func TestRegrIntercept(t *testing.T) {
+	for _, v := range regrInterceptTests {
+		r := RegrIntercept(v.YList, v.XList)
+		if !reflect.DeepEqual(r, v.Result) {
+			t.Errorf("regr_intercept list = %s, %s: result = %s, want %s", v.YList, v.XList, r, v.Result)
+		}
+	}
+}
+
+type percentileTests struct {
+	List     []value.Primary
+	Fraction float64
+	Result   value.Primary
+}
+
+var percentileTestList = []value.Primary{
+	value.NewNull(),
+	value.NewInteger(10),
+	value.NewInteger(20),
+	value.NewInteger(30),
+	value.NewInteger(40),
+}
+
+var percentileContTests = []percentileTests{
+	{
+		List:     percentileTestList,
+		Fraction: 0.5,
+		Result:   value.NewInteger(25),
+	},
+	{
+		List:     percentileTestList,
+		Fraction: 0.1,
+		Result:   value.NewInteger(13),
+	},
+	{
+		List:     percentileTestList,
+		Fraction: 1,
+		Result:   value.NewInteger(40),
+	},
+	{
+		List:     []value.Primary{value.NewNull()},
+		Fraction: 0.5,
+		Result:   value.NewNull(),
+	},
+}
+
+func TestPercentileCont(t *testing.T) {
+	for _, v := range percentileContTests {
+		r := PercentileCont(v.List, v.Fraction)
+		if !reflect.DeepEqual(r, v.Result) {
+			t.Errorf("percentile_cont list = %s, fraction = %f: result = %s, want %s", v.List, v.Fraction, r, v.Result)
+		}
+	}
+}
+
+var percentileDiscTests = []percentileTests{
+	{
+		List:     percentileTestList,
+		Fraction: 0.5,
+		Result:   value.NewInteger(20),
+	},
+	{
+		List:     percentileTestList,
+		Fraction: 0.51,
+		Result:   value.NewInteger(30),
+	},
+	{
+		List:     percentileTestList,
+		Fraction: 0,
+		Result:   value.NewInteger(10),
+	},
+	{
+		List:     []value.Primary{value.NewNull()},
+		Fraction: 0.5,
+		Result:   value.NewNull(),
+	},
+}
+
+func TestPercentileDisc(t *testing.T) {
+	for _, v := range percentileDiscTests {
+		r := PercentileDisc(v.List, v.Fraction)
+		if !reflect.DeepEqual(r, v.Result) {
+			t.Errorf("percentile_disc list = %s, fraction = %f: result = %s, want %s", v.List, v.Fraction, r, v.Result)
+		}
+	}
+}
+
 var listAggTests = []struct {
 	List      []value.Primary
 	Separator string
@@ -2,27 +2,32 @@ package query
 
 import (
 	"bytes"
+	"fmt"
 	"sort"
 	"strings"
+	"time"
 
+	"github.com/mithrandie/csvq/lib/cmd"
 	"github.com/mithrandie/csvq/lib/parser"
 	"github.com/mithrandie/csvq/lib/value"
 )
 
 var AnalyticFunctions = map[string]AnalyticFunction{
-	"ROW_NUMBER":   RowNumber{},
-	"RANK":         Rank{},
-	"DENSE_RANK":   DenseRank{},
-	"CUME_DIST":    CumeDist{},
-	"PERCENT_RANK": PercentRank{},
-	"NTILE":        NTile{},
-	"FIRST_VALUE":  FirstValue{},
-	"LAST_VALUE":   LastValue{},
-	"NTH_VALUE":    NthValue{},
-	"LAG":          Lag{},
-	"LEAD":         Lead{},
-	"LISTAGG":      AnalyticListAgg{},
-	"JSON_AGG":     AnalyticJsonAgg{},
+	"ROW_NUMBER":      RowNumber{},
+	"RANK":            Rank{},
+	"DENSE_RANK":      DenseRank{},
+	"CUME_DIST":       CumeDist{},
+	"PERCENT_RANK":    PercentRank{},
+	"NTILE":           NTile{},
+	"FIRST_VALUE":     FirstValue{},
+	"LAST_VALUE":      LastValue{},
+	"NTH_VALUE":       NthValue{},
+	"LAG":             Lag{},
+	"LEAD":            Lead{},
+	"LISTAGG":         AnalyticListAgg{},
+	"JSON_AGG":        AnalyticJsonAgg{},
+	"PERCENTILE_CONT": AnalyticPercentileCont{},
+	"PERCENTILE_DISC": AnalyticPercentileDisc{},
 }
 
 type AnalyticFunction interface {
@@ -42,11 +47,13 @@ func Analyze(view *View, fn parser.AnalyticFunction, partitionIndices []int) err
 	const (
 		Analytic = iota
 		Aggregate
+		BinaryAggregate
 		UserDefined
 	)
 
 	var anfn AnalyticFunction
 	var aggfn AggregateFunction
+	var binfn BinaryAggregateFunction
 	var udfn *UserDefinedFunction
 
 	fnType := -1
@@ -59,6 +66,9 @@ func Analyze(view *View, fn parser.AnalyticFunction, partitionIndices []int) err
 	} else if f, ok := AggregateFunctions[uname]; ok {
 		aggfn = f
 		fnType = Aggregate
+	} else if f, ok := BinaryAggregateFunctions[uname]; ok {
+		binfn = f
+		fnType = BinaryAggregate
 	} else {
 		if udfn, err = view.Filter.Functions.Get(fn, uname); err != nil || !udfn.IsAggregate {
 			return NewFunctionNotExistError(fn, fn.Name)
@@ -75,6 +85,13 @@ func Analyze(view *View, fn parser.AnalyticFunction, partitionIndices []int) err
 		if len(fn.Args) != 1 {
 			return NewFunctionArgumentLengthError(fn, fn.Name, []int{1})
 		}
+	case BinaryAggregate:
+		if len(fn.Args) != 2 {
+			return NewFunctionArgumentLengthError(fn, fn.Name, []int{2})
+		}
+		if fn.IsDistinct() {
+			return NewFunctionInvalidArgumentError(fn, fn.Name, "DISTINCT is not allowed")
+		}
 	case UserDefined:
 		if err := udfn.CheckArgsLen(fn, fn.Name, len(fn.Args)-1); err != nil {
 			return err
@@ -86,7 +103,7 @@ func Analyze(view *View, fn parser.AnalyticFunction, partitionIndices []int) err
 	}
 
 	partitionKeys := make([]string, view.RecordLen())
-	NewGoroutineTaskManager(view.RecordLen(), -1).Run(func(index int) {
+	NewGoroutineTaskManager(view.RecordLen(), -1, view.cpu()).Run(func(index int) {
 		keyBuf := new(bytes.Buffer)
 
 		if view.sortValuesInEachCell[index] == nil {
@@ -121,7 +138,7 @@ func Analyze(view *View, fn parser.AnalyticFunction, partitionIndices []int) err
 		}
 	}
 
-	gm := NewGoroutineTaskManager(len(partitionMapKeys), -1)
+	gm := NewGoroutineTaskManager(len(partitionMapKeys), -1, view.cpu())
 	for i := 0; i < gm.Number; i++ {
 		gm.Add()
 		go func(thIdx int) {
@@ -152,7 +169,11 @@ func Analyze(view *View, fn parser.AnalyticFunction, partitionIndices []int) err
 
 					if fnType == Aggregate {
 						partition := partitions[partitionMapKeys[i]]
-						frameSet := WindowFrameSet(partition, fn.AnalyticClause)
+						frameSet, e := WindowFrameSet(partition, fn, view)
+						if e != nil {
+							gm.SetError(e)
+							break AnalyzeLoop
+						}
 
 						valueCache := make(map[int]value.Primary, len(partition))
 
@@ -164,13 +185,47 @@ func Analyze(view *View, fn parser.AnalyticFunction, partitionIndices []int) err
 							}
 							val := aggfn(values)
 
+							for _, idx := range frame.Records {
+								view.RecordSet[idx] = append(view.RecordSet[idx], NewCell(val))
+							}
+						}
+					} else if fnType == BinaryAggregate {
+						partition := partitions[partitionMapKeys[i]]
+						frameSet, e := WindowFrameSet(partition, fn, view)
+						if e != nil {
+							gm.SetError(e)
+							break AnalyzeLoop
+						}
+
+						xfn := fn
+						xfn.Args = fn.Args[1:]
+						yValueCache := make(map[int]value.Primary, len(partition))
+						xValueCache := make(map[int]value.Primary, len(partition))
+
+						for _, frame := range frameSet {
+							yValues, e := windowValues(frame, partition, fn, filter, yValueCache)
+							if e != nil {
+								gm.SetError(e)
+								break AnalyzeLoop
+							}
+							xValues, e := windowValues(frame, partition, xfn, filter, xValueCache)
+							if e != nil {
+								gm.SetError(e)
+								break AnalyzeLoop
+							}
+							val := binfn(yValues, xValues)
+
 							for _, idx := range frame.Records {
 								view.RecordSet[idx] = append(view.RecordSet[idx], NewCell(val))
 							}
 						}
 					} else { //User Defined Function
 						partition := partitions[partitionMapKeys[i]]
-						frameSet := WindowFrameSet(partition, fn.AnalyticClause)
+						frameSet, e := WindowFrameSet(partition, fn, view)
+						if e != nil {
+							gm.SetError(e)
+							break AnalyzeLoop
+						}
 
 						valueCache := make(map[int]value.Primary, len(partition))
 
@@ -219,53 +274,265 @@ func Analyze(view *View, fn parser.AnalyticFunction, partitionIndices []int) err
 }
 
 type WindowFrame struct {
-	Low     int
-	High    int
-	Records []int
+	Low      int
+	High     int
+	Records  []int
+	Excluded []WindowFrameRange
 }
 
-func WindowFrameSet(partition Partition, expr parser.AnalyticClause) []WindowFrame {
-	var singleFrameSet = func(partition Partition) []WindowFrame {
-		indices := make([]int, len(partition))
-		for i, idx := range partition {
-			indices[i] = idx
+type WindowFrameRange struct {
+	Low  int
+	High int
+}
+
+func (frame WindowFrame) IsExcluded(i int) bool {
+	for _, r := range frame.Excluded {
+		if r.Low <= i && i <= r.High {
+			return true
 		}
-		return []WindowFrame{{Low: 0, High: len(partition) - 1, Records: indices}}
 	}
+	return false
+}
 
-	var frameIndex = func(current int, length int, framePosition parser.WindowFramePosition) int {
-		var idx int
+var windowIntervalUnits = map[string]func(time.Time, int) time.Time{
+	"YEAR":        addYear,
+	"MONTH":       addMonth,
+	"DAY":         addDay,
+	"HOUR":        addHour,
+	"MINUTE":      addMinute,
+	"SECOND":      addSecond,
+	"MILLISECOND": addMilli,
+	"MICROSECOND": addMicro,
+	"NANOSECOND":  addNano,
+}
 
-		switch framePosition.Direction {
-		case parser.CURRENT:
-			idx = current
-		case parser.PRECEDING:
-			if framePosition.Unbounded {
-				idx = 0
+type windowPeerGroups struct {
+	Group []int
+	Start []int
+	End   []int
+}
+
+func newWindowPeerGroups(partition Partition, view *View) *windowPeerGroups {
+	groups := &windowPeerGroups{
+		Group: make([]int, len(partition)),
+		Start: make([]int, 0),
+		End:   make([]int, 0),
+	}
+
+	var sortValues []SortValues
+	if view != nil {
+		sortValues = view.sortValuesInEachRecord
+	}
+
+	for i, idx := range partition {
+		if i == 0 || (sortValues != nil && !sortValues[idx].EquivalentTo(sortValues[partition[i-1]])) {
+			groups.Start = append(groups.Start, i)
+			groups.End = append(groups.End, i)
+		}
+		g := len(groups.Start) - 1
+		groups.Group[i] = g
+		groups.End[g] = i
+	}
+	return groups
+}
+
+type windowRangeKeys struct {
+	IsNull   []bool
+	Floats   []float64
+	Times    []time.Time
+	Interval bool
+
+	NonNullLow  int
+	NonNullHigh int
+	Ascending   bool
+}
+
+func newWindowRangeKeys(partition Partition, fn parser.AnalyticFunction, view *View, interval bool) (*windowRangeKeys, error) {
+	if fn.AnalyticClause.OrderByClause == nil || len(fn.AnalyticClause.OrderByClause.(parser.OrderByClause).Items) != 1 || view == nil || view.sortValuesInEachRecord == nil {
+		return nil, NewInvalidWindowFrameError(fn, "RANGE offset requires exactly one order by item")
+	}
+
+	keys := &windowRangeKeys{
+		IsNull:      make([]bool, len(partition)),
+		Interval:    interval,
+		NonNullLow:  -1,
+		NonNullHigh: -2,
+		Ascending:   true,
+	}
+	if interval {
+		keys.Times = make([]time.Time, len(partition))
+	} else {
+		keys.Floats = make([]float64, len(partition))
+	}
+
+	for i, idx := range partition {
+		sv := view.sortValuesInEachRecord[idx][0]
+
+		switch sv.Type {
+		case NullType:
+			keys.IsNull[i] = true
+			continue
+		case IntegerType, FloatType:
+			if interval {
+				keys.Times[i] = time.Unix(0, sv.Datetime).In(cmd.GetLocation())
 			} else {
-				idx = current - framePosition.Offset
+				keys.Floats[i] = sv.Float
 			}
-		case parser.FOLLOWING:
-			if framePosition.Unbounded {
-				idx = length - 1
-			} else {
-				idx = current + framePosition.Offset
+		case DatetimeType:
+			if !interval {
+				return nil, NewInvalidWindowFrameError(fn, "RANGE offset for datetime values must be an interval")
+			}
+			keys.Times[i] = time.Unix(0, sv.Datetime).In(cmd.GetLocation())
+		default:
+			if interval {
+				return nil, NewInvalidWindowFrameError(fn, "RANGE offset with an interval requires datetime values to order by")
 			}
+			return nil, NewInvalidWindowFrameError(fn, "RANGE offset requires numeric values to order by")
 		}
 
-		return idx
+		if keys.NonNullLow < 0 {
+			keys.NonNullLow = i
+		}
+		keys.NonNullHigh = i
 	}
 
-	length := len(partition)
+	if 0 <= keys.NonNullLow && 0 < keys.compare(keys.NonNullLow, keys.NonNullHigh) {
+		keys.Ascending = false
+	}
+	return keys, nil
+}
+
+func (keys *windowRangeKeys) compare(i int, j int) int {
+	if keys.Interval {
+		switch {
+		case keys.Times[i].Before(keys.Times[j]):
+			return -1
+		case keys.Times[i].After(keys.Times[j]):
+			return 1
+		}
+		return 0
+	}
 
-	if expr.OrderByClause == nil {
-		return singleFrameSet(partition)
+	switch {
+	case keys.Floats[i] < keys.Floats[j]:
+		return -1
+	case keys.Floats[i] > keys.Floats[j]:
+		return 1
 	}
+	return 0
+}
 
-	frameSet := make([]WindowFrame, 0, length)
+func (keys *windowRangeKeys) compareToBound(i int, floatBound float64, timeBound time.Time) int {
+	c := 0
+	if keys.Interval {
+		switch {
+		case keys.Times[i].Before(timeBound):
+			c = -1
+		case keys.Times[i].After(timeBound):
+			c = 1
+		}
+	} else {
+		switch {
+		case keys.Floats[i] < floatBound:
+			c = -1
+		case keys.Floats[i] > floatBound:
+			c = 1
+		}
+	}
+
+	if !keys.Ascending {
+		c = -c
+	}
+	return c
+}
+
+func (keys *windowRangeKeys) frameIndex(current int, position parser.WindowFramePosition, floatOffset float64, intervalOffset int, isLow bool) int {
+	var floatBound float64
+	var timeBound time.Time
+
+	forward := position.Direction == parser.FOLLOWING
+	if !keys.Ascending {
+		forward = !forward
+	}
+
+	if keys.Interval {
+		if !forward {
+			intervalOffset = -intervalOffset
+		}
+		timeBound = windowIntervalUnits[strings.ToUpper(position.IntervalUnit)](keys.Times[current], intervalOffset)
+	} else {
+		if !forward {
+			floatOffset = -floatOffset
+		}
+		floatBound = keys.Floats[current] + floatOffset
+	}
+
+	n := keys.NonNullHigh - keys.NonNullLow + 1
+	if isLow {
+		return keys.NonNullLow + sort.Search(n, func(i int) bool {
+			return 0 <= keys.compareToBound(keys.NonNullLow+i, floatBound, timeBound)
+		})
+	}
+	return keys.NonNullLow + sort.Search(n, func(i int) bool {
+		return 0 < keys.compareToBound(keys.NonNullLow+i, floatBound, timeBound)
+	}) - 1
+}
+
+func windowFrameOffset(fn parser.AnalyticFunction, unit int, position parser.WindowFramePosition) (int, float64, error) {
+	unitLit := "ROWS"
+	switch unit {
+	case parser.RANGE:
+		unitLit = "RANGE"
+	case parser.GROUPS:
+		unitLit = "GROUPS"
+	}
+
+	if 0 < len(position.IntervalUnit) {
+		if unit != parser.RANGE {
+			return 0, 0, NewInvalidWindowFrameError(fn, fmt.Sprintf("%s offset cannot be an interval", unitLit))
+		}
+		if _, ok := windowIntervalUnits[strings.ToUpper(position.IntervalUnit)]; !ok {
+			return 0, 0, NewInvalidWindowFrameError(fn, fmt.Sprintf("interval unit %s is invalid", position.IntervalUnit))
+		}
+		i := value.ToInteger(position.Offset)
+		if value.IsNull(i) || i.(value.Integer).Raw() < 0 {
+			return 0, 0, NewInvalidWindowFrameError(fn, "interval must be a non-negative integer")
+		}
+		return int(i.(value.Integer).Raw()), 0, nil
+	}
+
+	if unit == parser.RANGE {
+		f := value.ToFloat(position.Offset)
+		if value.IsNull(f) || f.(value.Float).Raw() < 0 {
+			return 0, 0, NewInvalidWindowFrameError(fn, "RANGE offset must be a non-negative number")
+		}
+		return 0, f.(value.Float).Raw(), nil
+	}
+
+	i, ok := position.Offset.(value.Integer)
+	if !ok || i.Raw() < 0 {
+		return 0, 0, NewInvalidWindowFrameError(fn, fmt.Sprintf("%s offset must be a non-negative integer", unitLit))
+	}
+	return int(i.Raw()), 0, nil
+}
+
+func WindowFrameSet(partition Partition, fn parser.AnalyticFunction, view *View) ([]WindowFrame, error) {
+	var singleFrameSet = func(partition Partition) []WindowFrame {
+		indices := make([]int, len(partition))
+		for i, idx := range partition {
+			indices[i] = idx
+		}
+		return []WindowFrame{{Low: 0, High: len(partition) - 1, Records: indices}}
+	}
+
+	expr := fn.AnalyticClause
+	length := len(partition)
 
 	var windowClause parser.WindowingClause
 	if expr.WindowingClause == nil {
+		if expr.OrderByClause == nil {
+			return singleFrameSet(partition), nil
+		}
 		windowClause = parser.WindowingClause{
 			FrameLow: parser.WindowFramePosition{
 				Direction: parser.PRECEDING,
@@ -274,40 +541,140 @@ func WindowFrameSet(partition Partition, expr parser.AnalyticClause) []WindowFra
 		}
 	} else {
 		windowClause = expr.WindowingClause.(parser.WindowingClause)
+		if expr.OrderByClause == nil && windowClause.Exclude.IsEmpty() {
+			return singleFrameSet(partition), nil
+		}
+	}
+
+	unit := windowClause.Unit.Token
+	if unit != parser.RANGE && unit != parser.GROUPS {
+		unit = parser.ROWS
 	}
+
 	frameLow := windowClause.FrameLow.(parser.WindowFramePosition)
+	frameHigh := parser.WindowFramePosition{Direction: parser.CURRENT}
+	if windowClause.FrameHigh != nil {
+		frameHigh = windowClause.FrameHigh.(parser.WindowFramePosition)
+		if frameLow.Unbounded && frameHigh.Unbounded && windowClause.Exclude.IsEmpty() {
+			return singleFrameSet(partition), nil
+		}
+	}
 
-	if windowClause.FrameHigh == nil {
-		for current := 0; current < length; current++ {
-			frameSet = append(frameSet, WindowFrame{
-				Low:     frameIndex(current, length, frameLow),
-				High:    current,
-				Records: []int{partition[current]},
-			})
+	var lowOffset, highOffset int
+	var lowFloatOffset, highFloatOffset float64
+	var err error
+	if !frameLow.Unbounded && frameLow.Direction != parser.CURRENT {
+		if lowOffset, lowFloatOffset, err = windowFrameOffset(fn, unit, frameLow); err != nil {
+			return nil, err
 		}
-	} else {
-		frameHigh := windowClause.FrameHigh.(parser.WindowFramePosition)
-		if frameLow.Direction == parser.PRECEDING && frameLow.Unbounded && frameHigh.Direction == parser.FOLLOWING && frameHigh.Unbounded {
-			return singleFrameSet(partition)
+	}
+	if !frameHigh.Unbounded && frameHigh.Direction != parser.CURRENT {
+		if highOffset, highFloatOffset, err = windowFrameOffset(fn, unit, frameHigh); err != nil {
+			return nil, err
 		}
+	}
+
+	var peers *windowPeerGroups
+	if unit != parser.ROWS || windowClause.Exclude.Token == parser.GROUP || windowClause.Exclude.Token == parser.TIES {
+		peers = newWindowPeerGroups(partition, view)
+	}
 
-		for current := 0; current < length; current++ {
-			frameSet = append(frameSet, WindowFrame{
-				Low:     frameIndex(current, length, frameLow),
-				High:    frameIndex(current, length, frameHigh),
-				Records: []int{partition[current]},
-			})
+	var keys *windowRangeKeys
+	if unit == parser.RANGE {
+		isOffset := func(position parser.WindowFramePosition) bool {
+			return !position.Unbounded && position.Direction != parser.CURRENT
+		}
+		if isOffset(frameLow) || isOffset(frameHigh) {
+			interval := 0 < len(frameLow.IntervalUnit) || 0 < len(frameHigh.IntervalUnit)
+			if keys, err = newWindowRangeKeys(partition, fn, view, interval); err != nil {
+				return nil, err
+			}
 		}
 	}
 
-	return frameSet
+	var frameIndex = func(current int, position parser.WindowFramePosition, offset int, floatOffset float64, isLow bool) int {
+		if position.Unbounded {
+			if position.Direction == parser.PRECEDING {
+				return 0
+			}
+			return length - 1
+		}
+
+		switch unit {
+		case parser.GROUPS:
+			g := peers.Group[current]
+			switch position.Direction {
+			case parser.PRECEDING:
+				g = g - offset
+			case parser.FOLLOWING:
+				g = g + offset
+			}
+
+			switch {
+			case g < 0:
+				if isLow {
+					return 0
+				}
+				return -1
+			case len(peers.Start) <= g:
+				if isLow {
+					return length
+				}
+				return length - 1
+			case isLow:
+				return peers.Start[g]
+			default:
+				return peers.End[g]
+			}
+		case parser.RANGE:
+			if position.Direction == parser.CURRENT || keys.IsNull[current] {
+				if isLow {
+					return peers.Start[peers.Group[current]]
+				}
+				return peers.End[peers.Group[current]]
+			}
+			return keys.frameIndex(current, position, floatOffset, offset, isLow)
+		default:
+			switch position.Direction {
+			case parser.PRECEDING:
+				return current - offset
+			case parser.FOLLOWING:
+				return current + offset
+			}
+			return current
+		}
+	}
+
+	frameSet := make([]WindowFrame, 0, length)
+	for current := 0; current < length; current++ {
+		frame := WindowFrame{
+			Low:     frameIndex(current, frameLow, lowOffset, lowFloatOffset, true),
+			High:    frameIndex(current, frameHigh, highOffset, highFloatOffset, false),
+			Records: []int{partition[current]},
+		}
+
+		switch windowClause.Exclude.Token {
+		case parser.CURRENT:
+			frame.Excluded = []WindowFrameRange{{Low: current, High: current}}
+		case parser.GROUP:
+			g := peers.Group[current]
+			frame.Excluded = []WindowFrameRange{{Low: peers.Start[g], High: peers.End[g]}}
+		case parser.TIES:
+			g := peers.Group[current]
+			frame.Excluded = []WindowFrameRange{{Low: peers.Start[g], High: current - 1}, {Low: current + 1, High: peers.End[g]}}
+		}
+
+		frameSet = append(frameSet, frame)
+	}
+
+	return frameSet, nil
 }
 
 func windowValues(frame WindowFrame, partition Partition, expr parser.AnalyticFunction, filter *Filter, valueCache map[int]value.Primary) ([]value.Primary, error) {
 	values := make([]value.Primary, 0, frame.High-frame.Low+1)
 
 	for i := frame.Low; i <= frame.High; i++ {
-		if i < 0 || len(partition) <= i {
+		if i < 0 || len(partition) <= i || frame.IsExcluded(i) {
 			continue
 		}
 
@@ -586,7 +953,10 @@ func (fn NthValue) Execute(partition Partition, expr parser.AnalyticFunction, fi
 }
 
 func setNthValue(partition Partition, expr parser.AnalyticFunction, filter *Filter, n int) (map[int]value.Primary, error) {
-	frameSet := WindowFrameSet(partition, expr.AnalyticClause)
+	frameSet, err := WindowFrameSet(partition, expr, filter.Records[0].View)
+	if err != nil {
+		return nil, err
+	}
 	list := make(map[int]value.Primary, len(partition))
 
 	valueCache := make(map[int]value.Primary, len(partition))
@@ -596,7 +966,7 @@ func setNthValue(partition Partition, expr parser.AnalyticFunction, filter *Filt
 		count := 0
 
 		for i := frame.Low; i <= frame.High; i++ {
-			if i < 0 || len(partition) <= i {
+			if i < 0 || len(partition) <= i || frame.IsExcluded(i) {
 				continue
 			}
 
@@ -728,17 +1098,9 @@ func (fn AnalyticListAgg) Execute(partition Partition, expr parser.AnalyticFunct
 		separator = s.(value.String).Raw()
 	}
 
-	values := make([]value.Primary, len(partition))
-	for i, idx := range partition {
-		filter.Records[0].RecordIndex = idx
-		val, e := filter.Evaluate(expr.Args[0])
-		if e != nil {
-			return nil, e
-		}
-		values[i] = val
-	}
-	if expr.IsDistinct() {
-		values = Distinguish(values)
+	values, err := withinGroupValues(partition, expr.Args[0], expr, filter)
+	if err != nil {
+		return nil, err
 	}
 
 	val := ListAgg(values, separator)
@@ -758,13 +1120,100 @@ func (fn AnalyticJsonAgg) CheckArgsLen(expr parser.AnalyticFunction) error {
 }
 
 func (fn AnalyticJsonAgg) Execute(partition Partition, expr parser.AnalyticFunction, filter *Filter) (map[int]value.Primary, error) {
+	values, err := withinGroupValues(partition, expr.Args[0], expr, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	val := JsonAgg(values)
+
+	list := make(map[int]value.Primary, len(partition))
+	for _, idx := range partition {
+		list[idx] = val
+	}
+
+	return list, nil
+}
+
+type AnalyticPercentileCont struct{}
+
+func (fn AnalyticPercentileCont) CheckArgsLen(expr parser.AnalyticFunction) error {
+	return CheckArgsLen(expr, []int{1})
+}
+
+func (fn AnalyticPercentileCont) Execute(partition Partition, expr parser.AnalyticFunction, filter *Filter) (map[int]value.Primary, error) {
+	return analyzePercentile(partition, expr, filter, PercentileCont)
+}
+
+type AnalyticPercentileDisc struct{}
+
+func (fn AnalyticPercentileDisc) CheckArgsLen(expr parser.AnalyticFunction) error {
+	return CheckArgsLen(expr, []int{1})
+}
+
+func (fn AnalyticPercentileDisc) Execute(partition Partition, expr parser.AnalyticFunction, filter *Filter) (map[int]value.Primary, error) {
+	return analyzePercentile(partition, expr, filter, PercentileDisc)
+}
+
+func analyzePercentile(partition Partition, expr parser.AnalyticFunction, filter *Filter, fn func([]value.Primary, float64) value.Primary) (map[int]value.Primary, error) {
 	argsFilter := filter.CreateNode()
 	argsFilter.Records = nil
 
+	fraction, err := argsFilter.checkArgsForPercentile(expr, expr.Name, expr.Args, expr.OrderBy)
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := withinGroupValues(partition, expr.OrderBy.(parser.OrderByClause).Items[0].(parser.OrderItem).Value, expr, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	val := fn(values, fraction)
+
+	list := make(map[int]value.Primary, len(partition))
+	for _, idx := range partition {
+		list[idx] = val
+	}
+
+	return list, nil
+}
+
+func withinGroupValues(partition Partition, arg parser.QueryExpression, expr parser.AnalyticFunction, filter *Filter) ([]value.Primary, error) {
+	if expr.OrderBy != nil {
+		items := expr.OrderBy.(parser.OrderByClause).Items
+		directions := make([]int, len(items))
+		nullPositions := make([]int, len(items))
+		for i, item := range items {
+			directions[i], nullPositions[i] = sortOrder(item.(parser.OrderItem))
+		}
+
+		sortValues := make(map[int]SortValues, len(partition))
+		for _, idx := range partition {
+			filter.Records[0].RecordIndex = idx
+			values := make(SortValues, len(items))
+			for i, item := range items {
+				p, e := filter.Evaluate(item.(parser.OrderItem).Value)
+				if e != nil {
+					return nil, e
+				}
+				values[i] = NewSortValue(p)
+			}
+			sortValues[idx] = values
+		}
+
+		sorted := make(Partition, len(partition))
+		copy(sorted, partition)
+		sort.SliceStable(sorted, func(i, j int) bool {
+			return sortValues[sorted[i]].Less(sortValues[sorted[j]], directions, nullPositions)
+		})
+		partition = sorted
+	}
+
 	values := make([]value.Primary, len(partition))
 	for i, idx := range partition {
 		filter.Records[0].RecordIndex = idx
-		val, e := filter.Evaluate(expr.Args[0])
+		val, e := filter.Evaluate(arg)
 		if e != nil {
 			return nil, e
 		}
@@ -773,13 +1222,5 @@ func (fn AnalyticJsonAgg) Execute(partition Partition, expr parser.AnalyticFunct
 	if expr.IsDistinct() {
 		values = Distinguish(values)
 	}
-
-	val := JsonAgg(values)
-
-	list := make(map[int]value.Primary, len(partition))
-	for _, idx := range partition {
-		list[idx] = val
-	}
-
-	return list, nil
+	return values, nil
 }
@@ -560,6 +560,140 @@ var analyzeTests = []struct {
 		},
 		Error: "[L:- C:-] field notexist does not exist",
 	},
+	{
+		Name: "Analyze BinaryAggregateFunction",
+		View: &View{
+			Header: NewHeader("table1", []string{"column1", "column2", "column3"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("a"),
+					value.NewInteger(1),
+					value.NewInteger(2),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("a"),
+					value.NewInteger(2),
+					value.NewInteger(4),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("b"),
+					value.NewInteger(1),
+					value.NewInteger(1),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("b"),
+					value.NewNull(),
+					value.NewInteger(5),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("b"),
+					value.NewInteger(3),
+					value.NewInteger(3),
+				}),
+			},
+			Filter: NewEmptyFilter(),
+		},
+		Function: parser.AnalyticFunction{
+			Name: "covar_samp",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column3"}},
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+			},
+			AnalyticClause: parser.AnalyticClause{
+				PartitionClause: parser.PartitionClause{
+					Values: []parser.QueryExpression{
+						parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+					},
+				},
+			},
+		},
+		PartitionIndices: []int{0},
+		Result: &View{
+			Header: NewHeader("table1", []string{"column1", "column2", "column3"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("a"),
+					value.NewInteger(1),
+					value.NewInteger(2),
+					value.NewInteger(1),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("a"),
+					value.NewInteger(2),
+					value.NewInteger(4),
+					value.NewInteger(1),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("b"),
+					value.NewInteger(1),
+					value.NewInteger(1),
+					value.NewInteger(2),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("b"),
+					value.NewNull(),
+					value.NewInteger(5),
+					value.NewInteger(2),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("b"),
+					value.NewInteger(3),
+					value.NewInteger(3),
+					value.NewInteger(2),
+				}),
+			},
+			Filter: NewEmptyFilter(),
+			sortValuesInEachCell: [][]*SortValue{
+				{NewSortValue(value.NewString("a")), nil, nil},
+				{NewSortValue(value.NewString("a")), nil, nil},
+				{NewSortValue(value.NewString("b")), nil, nil},
+				{NewSortValue(value.NewString("b")), nil, nil},
+				{NewSortValue(value.NewString("b")), nil, nil},
+			},
+		},
+	},
+	{
+		Name: "Analyze BinaryAggregateFunction Argument Length Error",
+		View: &View{
+			Header: NewHeader("table1", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("a"),
+					value.NewInteger(1),
+				}),
+			},
+			Filter: NewEmptyFilter(),
+		},
+		Function: parser.AnalyticFunction{
+			Name: "corr",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+			},
+		},
+		Error: "[L:- C:-] function corr takes exactly 2 arguments",
+	},
+	{
+		Name: "Analyze BinaryAggregateFunction Distinct Error",
+		View: &View{
+			Header: NewHeader("table1", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("a"),
+					value.NewInteger(1),
+				}),
+			},
+			Filter: NewEmptyFilter(),
+		},
+		Function: parser.AnalyticFunction{
+			Name:     "corr",
+			Distinct: parser.Token{Token: parser.DISTINCT, Literal: "distinct"},
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+			},
+		},
+		Error: "[L:- C:-] DISTINCT is not allowed for function corr",
+	},
 	{
 		Name: "Analyze UserDefinedFunction",
 		View: &View{
@@ -1751,6 +1885,246 @@ var firstValueExecuteTests = []analyticFunctionExecuteTests{
 		},
 		Error: "[L:- C:-] field notexist does not exist",
 	},
+	{
+		Name:  "FirstValue Execute Range Offset",
+		Items: Partition{2, 7, 3, 4, 5, 6},
+		SortValues: map[int]SortValues{
+			2: {NewSortValue(value.NewNull())},
+			7: {NewSortValue(value.NewNull())},
+			3: {NewSortValue(value.NewInteger(200))},
+			4: {NewSortValue(value.NewInteger(300))},
+			5: {NewSortValue(value.NewInteger(500))},
+			6: {NewSortValue(value.NewInteger(800))},
+		},
+		Function: parser.AnalyticFunction{
+			Name: "first_value",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+			},
+			AnalyticClause: parser.AnalyticClause{
+				OrderByClause: parser.OrderByClause{
+					Items: []parser.QueryExpression{
+						parser.OrderItem{Value: parser.Identifier{Literal: "column2"}},
+					},
+				},
+				WindowingClause: parser.WindowingClause{
+					Unit: parser.Token{Token: parser.RANGE, Literal: "range"},
+					FrameLow: parser.WindowFramePosition{
+						Direction: parser.PRECEDING,
+						Offset:    value.NewInteger(150),
+					},
+				},
+			},
+		},
+		Result: map[int]value.Primary{
+			2: value.NewNull(),
+			7: value.NewNull(),
+			3: value.NewInteger(200),
+			4: value.NewInteger(200),
+			5: value.NewInteger(500),
+			6: value.NewInteger(800),
+		},
+	},
+	{
+		Name:  "FirstValue Execute Range Interval Offset",
+		Items: Partition{0, 1, 3},
+		SortValues: map[int]SortValues{
+			0: {NewSortValue(value.NewDatetimeFromString("2012-02-01 00:00:00"))},
+			1: {NewSortValue(value.NewDatetimeFromString("2012-02-03 12:00:00"))},
+			3: {NewSortValue(value.NewDatetimeFromString("2012-02-08 00:00:00"))},
+		},
+		Function: parser.AnalyticFunction{
+			Name: "first_value",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+			},
+			AnalyticClause: parser.AnalyticClause{
+				OrderByClause: parser.OrderByClause{
+					Items: []parser.QueryExpression{
+						parser.OrderItem{Value: parser.Identifier{Literal: "column1"}},
+					},
+				},
+				WindowingClause: parser.WindowingClause{
+					Unit: parser.Token{Token: parser.RANGE, Literal: "range"},
+					FrameLow: parser.WindowFramePosition{
+						Direction:    parser.PRECEDING,
+						Offset:       value.NewInteger(5),
+						IntervalUnit: "day",
+					},
+					FrameHigh: parser.WindowFramePosition{
+						Direction: parser.CURRENT,
+					},
+				},
+			},
+		},
+		Result: map[int]value.Primary{
+			0: value.NewInteger(100),
+			1: value.NewInteger(100),
+			3: value.NewInteger(200),
+		},
+	},
+	{
+		Name:  "FirstValue Execute Groups Exclude Current Row",
+		Items: Partition{0, 1, 3, 4},
+		SortValues: map[int]SortValues{
+			0: {NewSortValue(value.NewInteger(100))},
+			1: {NewSortValue(value.NewInteger(200))},
+			3: {NewSortValue(value.NewInteger(200))},
+			4: {NewSortValue(value.NewInteger(300))},
+		},
+		Function: parser.AnalyticFunction{
+			Name: "first_value",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+			},
+			AnalyticClause: parser.AnalyticClause{
+				OrderByClause: parser.OrderByClause{
+					Items: []parser.QueryExpression{
+						parser.OrderItem{Value: parser.Identifier{Literal: "column2"}},
+					},
+				},
+				WindowingClause: parser.WindowingClause{
+					Unit: parser.Token{Token: parser.GROUPS, Literal: "groups"},
+					FrameLow: parser.WindowFramePosition{
+						Direction: parser.PRECEDING,
+						Offset:    value.NewInteger(1),
+					},
+					FrameHigh: parser.WindowFramePosition{
+						Direction: parser.FOLLOWING,
+						Offset:    value.NewInteger(1),
+					},
+					Exclude: parser.Token{Token: parser.CURRENT, Literal: "exclude current row"},
+				},
+			},
+		},
+		Result: map[int]value.Primary{
+			0: value.NewInteger(200),
+			1: value.NewInteger(100),
+			3: value.NewInteger(100),
+			4: value.NewInteger(200),
+		},
+	},
+	{
+		Name:  "FirstValue Execute Rows Exclude Group",
+		Items: Partition{0, 1, 3, 4},
+		SortValues: map[int]SortValues{
+			0: {NewSortValue(value.NewInteger(100))},
+			1: {NewSortValue(value.NewInteger(200))},
+			3: {NewSortValue(value.NewInteger(200))},
+			4: {NewSortValue(value.NewInteger(300))},
+		},
+		Function: parser.AnalyticFunction{
+			Name: "first_value",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+			},
+			AnalyticClause: parser.AnalyticClause{
+				OrderByClause: parser.OrderByClause{
+					Items: []parser.QueryExpression{
+						parser.OrderItem{Value: parser.Identifier{Literal: "column2"}},
+					},
+				},
+				WindowingClause: parser.WindowingClause{
+					Unit: parser.Token{Token: parser.ROWS, Literal: "rows"},
+					FrameLow: parser.WindowFramePosition{
+						Direction: parser.PRECEDING,
+						Unbounded: true,
+					},
+					Exclude: parser.Token{Token: parser.GROUP, Literal: "exclude group"},
+				},
+			},
+		},
+		Result: map[int]value.Primary{
+			0: value.NewNull(),
+			1: value.NewInteger(100),
+			3: value.NewInteger(100),
+			4: value.NewInteger(100),
+		},
+	},
+	{
+		Name:  "FirstValue Execute Range Offset Order Value Error",
+		Items: Partition{0, 1},
+		SortValues: map[int]SortValues{
+			0: {NewSortValue(value.NewString("a"))},
+			1: {NewSortValue(value.NewString("a"))},
+		},
+		Function: parser.AnalyticFunction{
+			Name: "first_value",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+			},
+			AnalyticClause: parser.AnalyticClause{
+				OrderByClause: parser.OrderByClause{
+					Items: []parser.QueryExpression{
+						parser.OrderItem{Value: parser.Identifier{Literal: "column1"}},
+					},
+				},
+				WindowingClause: parser.WindowingClause{
+					Unit: parser.Token{Token: parser.RANGE, Literal: "range"},
+					FrameLow: parser.WindowFramePosition{
+						Direction: parser.PRECEDING,
+						Offset:    value.NewInteger(1),
+					},
+				},
+			},
+		},
+		Error: "[L:- C:-] invalid window frame for function first_value: RANGE offset requires numeric values to order by",
+	},
+	{
+		Name:  "FirstValue Execute Rows Offset Error",
+		Items: Partition{0, 1},
+		Function: parser.AnalyticFunction{
+			Name: "first_value",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+			},
+			AnalyticClause: parser.AnalyticClause{
+				OrderByClause: parser.OrderByClause{
+					Items: []parser.QueryExpression{
+						parser.OrderItem{Value: parser.Identifier{Literal: "column1"}},
+					},
+				},
+				WindowingClause: parser.WindowingClause{
+					Unit: parser.Token{Token: parser.ROWS, Literal: "rows"},
+					FrameLow: parser.WindowFramePosition{
+						Direction: parser.PRECEDING,
+						Offset:    value.NewFloat(1.5),
+					},
+				},
+			},
+		},
+		Error: "[L:- C:-] invalid window frame for function first_value: ROWS offset must be a non-negative integer",
+	},
+	{
+		Name:  "FirstValue Execute Invalid Interval Unit Error",
+		Items: Partition{0, 1},
+		SortValues: map[int]SortValues{
+			0: {NewSortValue(value.NewInteger(1))},
+			1: {NewSortValue(value.NewInteger(2))},
+		},
+		Function: parser.AnalyticFunction{
+			Name: "first_value",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+			},
+			AnalyticClause: parser.AnalyticClause{
+				OrderByClause: parser.OrderByClause{
+					Items: []parser.QueryExpression{
+						parser.OrderItem{Value: parser.Identifier{Literal: "column1"}},
+					},
+				},
+				WindowingClause: parser.WindowingClause{
+					Unit: parser.Token{Token: parser.RANGE, Literal: "range"},
+					FrameLow: parser.WindowFramePosition{
+						Direction:    parser.PRECEDING,
+						Offset:       value.NewInteger(1),
+						IntervalUnit: "fortnight",
+					},
+				},
+			},
+		},
+		Error: "[L:- C:-] invalid window frame for function first_value: interval unit fortnight is invalid",
+	},
 }
 
 func TestFirstValue_Execute(t *testing.T) {
@@ -1867,7 +2241,7 @@ var nthValueExecuteTests = []analyticFunctionExecuteTests{
 				WindowingClause: parser.WindowingClause{
 					FrameLow: parser.WindowFramePosition{
 						Direction: parser.PRECEDING,
-						Offset:    2,
+						Offset:    value.NewInteger(2),
 					},
 				},
 			},
@@ -1938,7 +2312,7 @@ var nthValueExecuteTests = []analyticFunctionExecuteTests{
 					},
 					FrameHigh: parser.WindowFramePosition{
 						Direction: parser.FOLLOWING,
-						Offset:    2,
+						Offset:    value.NewInteger(2),
 					},
 				},
 			},
@@ -2309,6 +2683,33 @@ var analyticListAggExecuteTests = []analyticFunctionExecuteTests{
 			4: value.NewString("100,200,300"),
 		},
 	},
+	{
+		Name:  "AnalyticListAgg Execute With Within Group",
+		Items: Partition{0, 1, 2, 3, 4},
+		Function: parser.AnalyticFunction{
+			Name: "listagg",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+				parser.NewStringValue(","),
+			},
+			WithinGroup: "within group",
+			OrderBy: parser.OrderByClause{
+				Items: []parser.QueryExpression{
+					parser.OrderItem{
+						Value:     parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+						Direction: parser.Token{Token: parser.DESC, Literal: "desc"},
+					},
+				},
+			},
+		},
+		Result: map[int]value.Primary{
+			0: value.NewString("300,200,200,100"),
+			1: value.NewString("300,200,200,100"),
+			2: value.NewString("300,200,200,100"),
+			3: value.NewString("300,200,200,100"),
+			4: value.NewString("300,200,200,100"),
+		},
+	},
 	{
 		Name:  "AnalyticListAgg Execute First Argument Evaluation Error",
 		Items: Partition{0, 1, 2, 3, 4},
@@ -2417,3 +2818,148 @@ var analyticJsonAggExecuteTests = []analyticFunctionExecuteTests{
 func TestAnalyticJsonAgg_Execute(t *testing.T) {
 	testAnalyticFunctionExecute(t, AnalyticJsonAgg{}, analyticJsonAggExecuteTests)
 }
+
+var analyticPercentileContCheckArgsLenTests = []analyticFunctionCheckArgsLenTests{
+	{
+		Name: "PercentileCont CheckArgsLen Too Little Error",
+		Function: parser.AnalyticFunction{
+			Name: "percentile_cont",
+		},
+		Error: "[L:- C:-] function percentile_cont takes exactly 1 argument",
+	},
+}
+
+func TestAnalyticPercentileCont_CheckArgsLen(t *testing.T) {
+	testAnalyticFunctionCheckArgsLenTests(t, AnalyticPercentileCont{}, analyticPercentileContCheckArgsLenTests)
+}
+
+var analyticPercentileContExecuteTests = []analyticFunctionExecuteTests{
+	{
+		Name:  "AnalyticPercentileCont Execute",
+		Items: Partition{0, 1, 2, 3, 4},
+		Function: parser.AnalyticFunction{
+			Name: "percentile_cont",
+			Args: []parser.QueryExpression{
+				parser.NewFloatValue(0.25),
+			},
+			WithinGroup: "within group",
+			OrderBy: parser.OrderByClause{
+				Items: []parser.QueryExpression{
+					parser.OrderItem{Value: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}}},
+				},
+			},
+		},
+		Result: map[int]value.Primary{
+			0: value.NewInteger(175),
+			1: value.NewInteger(175),
+			2: value.NewInteger(175),
+			3: value.NewInteger(175),
+			4: value.NewInteger(175),
+		},
+	},
+	{
+		Name:  "AnalyticPercentileCont Execute Descending Order",
+		Items: Partition{0, 1, 2, 3, 4},
+		Function: parser.AnalyticFunction{
+			Name: "percentile_cont",
+			Args: []parser.QueryExpression{
+				parser.NewFloatValue(0.25),
+			},
+			WithinGroup: "within group",
+			OrderBy: parser.OrderByClause{
+				Items: []parser.QueryExpression{
+					parser.OrderItem{
+						Value:     parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+						Direction: parser.Token{Token: parser.DESC, Literal: "desc"},
+					},
+				},
+			},
+		},
+		Result: map[int]value.Primary{
+			0: value.NewInteger(225),
+			1: value.NewInteger(225),
+			2: value.NewInteger(225),
+			3: value.NewInteger(225),
+			4: value.NewInteger(225),
+		},
+	},
+	{
+		Name:  "AnalyticPercentileCont Execute Within Group Error",
+		Items: Partition{0, 1, 2, 3, 4},
+		Function: parser.AnalyticFunction{
+			Name: "percentile_cont",
+			Args: []parser.QueryExpression{
+				parser.NewFloatValue(0.25),
+			},
+		},
+		Error: "[L:- C:-] WITHIN GROUP clause must have exactly one ordering item for function percentile_cont",
+	},
+	{
+		Name:  "AnalyticPercentileCont Execute Fraction Error",
+		Items: Partition{0, 1, 2, 3, 4},
+		Function: parser.AnalyticFunction{
+			Name: "percentile_cont",
+			Args: []parser.QueryExpression{
+				parser.NewFloatValue(1.5),
+			},
+			WithinGroup: "within group",
+			OrderBy: parser.OrderByClause{
+				Items: []parser.QueryExpression{
+					parser.OrderItem{Value: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}}},
+				},
+			},
+		},
+		Error: "[L:- C:-] the first argument must be a number between 0 and 1 for function percentile_cont",
+	},
+	{
+		Name:  "AnalyticPercentileCont Execute Order Item Evaluation Error",
+		Items: Partition{0, 1, 2, 3, 4},
+		Function: parser.AnalyticFunction{
+			Name: "percentile_cont",
+			Args: []parser.QueryExpression{
+				parser.NewFloatValue(0.25),
+			},
+			WithinGroup: "within group",
+			OrderBy: parser.OrderByClause{
+				Items: []parser.QueryExpression{
+					parser.OrderItem{Value: parser.FieldReference{Column: parser.Identifier{Literal: "notexist"}}},
+				},
+			},
+		},
+		Error: "[L:- C:-] field notexist does not exist",
+	},
+}
+
+func TestAnalyticPercentileCont_Execute(t *testing.T) {
+	testAnalyticFunctionExecute(t, AnalyticPercentileCont{}, analyticPercentileContExecuteTests)
+}
+
+var analyticPercentileDiscExecuteTests = []analyticFunctionExecuteTests{
+	{
+		Name:  "AnalyticPercentileDisc Execute",
+		Items: Partition{0, 1, 2, 3, 4},
+		Function: parser.AnalyticFunction{
+			Name: "percentile_disc",
+			Args: []parser.QueryExpression{
+				parser.NewFloatValue(0.25),
+			},
+			WithinGroup: "within group",
+			OrderBy: parser.OrderByClause{
+				Items: []parser.QueryExpression{
+					parser.OrderItem{Value: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}}},
+				},
+			},
+		},
+		Result: map[int]value.Primary{
+			0: value.NewInteger(100),
+			1: value.NewInteger(100),
+			2: value.NewInteger(100),
+			3: value.NewInteger(100),
+			4: value.NewInteger(100),
+		},
+	},
+}
+
+func TestAnalyticPercentileDisc_Execute(t *testing.T) {
+	testAnalyticFunctionExecute(t, AnalyticPercentileDisc{}, analyticPercentileDiscExecuteTests)
+}
@@ -119,6 +119,9 @@ func Source(expr parser.Source, filter *Filter) ([]parser.Statement, error) {
 	if len(fpath) < 1 {
 		return nil, NewSourceInvalidFilePathError(expr, expr.FilePath)
 	}
+	if err := filter.Session().CheckFileAccess(expr, fpath); err != nil {
+		return nil, err
+	}
 
 	return LoadStatementsFromFile(expr, fpath)
 }
@@ -197,16 +200,24 @@ func SetFlag(expr parser.SetFlag, filter *Filter) error {
 		}
 	}
 
+	if strings.EqualFold(expr.Name, cmd.NoHeaderFlag) {
+		if s := value.ToString(p); !value.IsNull(s) && strings.EqualFold(s.(value.String).Raw(), cmd.DetectAutomatically) {
+			filter.Flags().SetDetectHeader(true)
+			return nil
+		}
+	}
+
 	switch strings.ToUpper(expr.Name) {
-	case cmd.RepositoryFlag, cmd.TimezoneFlag, cmd.DatetimeFormatFlag, cmd.DelimiterFlag, cmd.JsonQueryFlag, cmd.EncodingFlag,
+	case cmd.RepositoryFlag, cmd.TimezoneFlag, cmd.DatetimeFormatFlag, cmd.DelimiterFlag, cmd.JsonQueryFlag, cmd.SheetFlag, cmd.EncodingFlag,
+		cmd.QuoteCharFlag, cmd.EscapeStyleFlag, cmd.CommentPrefixFlag, cmd.OnParseErrorFlag, cmd.RejectFileFlag,
 		cmd.WriteEncodingFlag, cmd.FormatFlag, cmd.WriteDelimiterFlag, cmd.LineBreakFlag, cmd.JsonEscape:
 		p = value.ToString(p)
-	case cmd.NoHeaderFlag, cmd.WithoutNullFlag, cmd.WithoutHeaderFlag, cmd.EncloseAll, cmd.PrettyPrintFlag,
+	case cmd.NoHeaderFlag, cmd.WithoutNullFlag, cmd.LazyQuotesFlag, cmd.WithoutHeaderFlag, cmd.EncloseAll, cmd.PrettyPrintFlag,
 		cmd.EastAsianEncodingFlag, cmd.CountDiacriticalSignFlag, cmd.CountFormatCodeFlag, cmd.ColorFlag, cmd.QuietFlag, cmd.StatsFlag:
 		p = value.ToBoolean(p)
 	case cmd.WaitTimeoutFlag:
 		p = value.ToFloat(p)
-	case cmd.CPUFlag:
+	case cmd.CPUFlag, cmd.SkipLinesFlag:
 		p = value.ToInteger(p)
 	default:
 		return NewInvalidFlagNameError(expr, expr.Name)
@@ -215,7 +226,7 @@ func SetFlag(expr parser.SetFlag, filter *Filter) error {
 		return NewFlagValueNotAllowedFormatError(expr)
 	}
 
-	flags := cmd.GetFlags()
+	flags := filter.Flags()
 
 	switch strings.ToUpper(expr.Name) {
 	case cmd.RepositoryFlag:
@@ -230,12 +241,28 @@ func SetFlag(expr parser.SetFlag, filter *Filter) error {
 		err = flags.SetDelimiter(p.(value.String).Raw())
 	case cmd.JsonQueryFlag:
 		flags.SetJsonQuery(p.(value.String).Raw())
+	case cmd.SheetFlag:
+		flags.SetSheet(p.(value.String).Raw())
 	case cmd.EncodingFlag:
 		err = flags.SetEncoding(p.(value.String).Raw())
 	case cmd.NoHeaderFlag:
 		flags.SetNoHeader(p.(value.Boolean).Raw())
 	case cmd.WithoutNullFlag:
 		flags.SetWithoutNull(p.(value.Boolean).Raw())
+	case cmd.QuoteCharFlag:
+		err = flags.SetQuoteChar(p.(value.String).Raw())
+	case cmd.EscapeStyleFlag:
+		err = flags.SetEscapeStyle(p.(value.String).Raw())
+	case cmd.LazyQuotesFlag:
+		flags.SetLazyQuotes(p.(value.Boolean).Raw())
+	case cmd.CommentPrefixFlag:
+		flags.SetCommentPrefix(p.(value.String).Raw())
+	case cmd.SkipLinesFlag:
+		flags.SetSkipLines(int(p.(value.Integer).Raw()))
+	case cmd.OnParseErrorFlag:
+		err = flags.SetOnParseError(p.(value.String).Raw())
+	case cmd.RejectFileFlag:
+		flags.SetRejectFile(p.(value.String).Raw())
 	case cmd.FormatFlag:
 		err = flags.SetFormat(p.(value.String).Raw(), "")
 	case cmd.WriteEncodingFlag:
@@ -283,9 +310,11 @@ func AddFlagElement(expr parser.AddFlagElement, filter *Filter) error {
 			Value:    expr.Value,
 		}
 		return SetFlag(e, filter)
-	case cmd.RepositoryFlag, cmd.TimezoneFlag, cmd.DelimiterFlag, cmd.JsonQueryFlag, cmd.EncodingFlag,
+	case cmd.RepositoryFlag, cmd.TimezoneFlag, cmd.DelimiterFlag, cmd.JsonQueryFlag, cmd.SheetFlag, cmd.EncodingFlag,
 		cmd.WriteEncodingFlag, cmd.FormatFlag, cmd.WriteDelimiterFlag, cmd.LineBreakFlag, cmd.JsonEscape,
 		cmd.NoHeaderFlag, cmd.WithoutNullFlag, cmd.WithoutHeaderFlag, cmd.EncloseAll, cmd.PrettyPrintFlag,
+		cmd.QuoteCharFlag, cmd.EscapeStyleFlag, cmd.LazyQuotesFlag, cmd.CommentPrefixFlag, cmd.SkipLinesFlag,
+		cmd.OnParseErrorFlag, cmd.RejectFileFlag,
 		cmd.EastAsianEncodingFlag, cmd.CountDiacriticalSignFlag, cmd.CountFormatCodeFlag, cmd.ColorFlag, cmd.QuietFlag, cmd.StatsFlag,
 		cmd.WaitTimeoutFlag,
 		cmd.CPUFlag:
@@ -307,7 +336,7 @@ func RemoveFlagElement(expr parser.RemoveFlagElement, filter *Filter) error {
 
 	switch strings.ToUpper(expr.Name) {
 	case cmd.DatetimeFormatFlag:
-		flags := cmd.GetFlags()
+		flags := filter.Flags()
 
 		if i := value.ToInteger(p); !value.IsNull(i) {
 			idx := int(i.(value.Integer).Raw())
@@ -327,9 +356,11 @@ func RemoveFlagElement(expr parser.RemoveFlagElement, filter *Filter) error {
 		} else {
 			return NewInvalidFlagValueToBeRemovedError(expr)
 		}
-	case cmd.RepositoryFlag, cmd.TimezoneFlag, cmd.DelimiterFlag, cmd.JsonQueryFlag, cmd.EncodingFlag,
+	case cmd.RepositoryFlag, cmd.TimezoneFlag, cmd.DelimiterFlag, cmd.JsonQueryFlag, cmd.SheetFlag, cmd.EncodingFlag,
 		cmd.WriteEncodingFlag, cmd.FormatFlag, cmd.WriteDelimiterFlag, cmd.LineBreakFlag, cmd.JsonEscape,
 		cmd.NoHeaderFlag, cmd.WithoutNullFlag, cmd.WithoutHeaderFlag, cmd.EncloseAll, cmd.PrettyPrintFlag,
+		cmd.QuoteCharFlag, cmd.EscapeStyleFlag, cmd.LazyQuotesFlag, cmd.CommentPrefixFlag, cmd.SkipLinesFlag,
+		cmd.OnParseErrorFlag, cmd.RejectFileFlag,
 		cmd.EastAsianEncodingFlag, cmd.CountDiacriticalSignFlag, cmd.CountFormatCodeFlag, cmd.ColorFlag, cmd.QuietFlag, cmd.StatsFlag,
 		cmd.WaitTimeoutFlag,
 		cmd.CPUFlag:
@@ -342,8 +373,8 @@ func RemoveFlagElement(expr parser.RemoveFlagElement, filter *Filter) error {
 	return nil
 }
 
-func ShowFlag(expr parser.ShowFlag) (string, error) {
-	s, err := showFlag(expr.Name)
+func ShowFlag(expr parser.ShowFlag, filter *Filter) (string, error) {
+	s, err := showFlag(expr.Name, filter.Flags())
 	if err != nil {
 		return s, NewInvalidFlagNameError(expr, expr.Name)
 	}
@@ -352,10 +383,9 @@ func ShowFlag(expr parser.ShowFlag) (string, error) {
 	return palette.Render(cmd.LableEffect, cmd.FlagSymbol(strings.ToUpper(expr.Name)+":")) + " " + s, nil
 }
 
-func showFlag(flag string) (string, error) {
+func showFlag(flag string, flags *cmd.Flags) (string, error) {
 	var s string
 
-	flags := cmd.GetFlags()
 	palette, _ := cmd.GetPalette()
 
 	switch strings.ToUpper(flag) {
@@ -384,6 +414,10 @@ func showFlag(flag string) (string, error) {
 		d := "'" + cmd.EscapeString(string(flags.Delimiter)) + "'"
 		p := fixedlen.DelimiterPositions(flags.DelimiterPositions).String()
 
+		if flags.DetectDelimiter {
+			d = cmd.DetectAutomatically
+		}
+
 		switch flags.SelectImportFormat() {
 		case cmd.CSV, cmd.TSV:
 			s = palette.Render(cmd.StringEffect, d) + palette.Render(cmd.LableEffect, " | ") + palette.Render(cmd.NullEffect, p)
@@ -404,20 +438,101 @@ func showFlag(flag string) (string, error) {
 		default:
 			s = palette.Render(cmd.NullEffect, IgnoredFlagPrefix+q)
 		}
+	case cmd.SheetFlag:
+		if len(flags.Sheet) < 1 {
+			s = palette.Render(cmd.NullEffect, "(not set)")
+		} else {
+			s = palette.Render(cmd.StringEffect, flags.Sheet)
+		}
 	case cmd.EncodingFlag:
-		s = palette.Render(cmd.StringEffect, flags.Encoding.String())
+		if flags.DetectEncoding {
+			s = palette.Render(cmd.StringEffect, cmd.DetectAutomatically)
+		} else {
+			s = palette.Render(cmd.StringEffect, string(flags.Encoding))
+		}
 	case cmd.NoHeaderFlag:
-		s = palette.Render(cmd.BooleanEffect, strconv.FormatBool(flags.NoHeader))
+		if flags.DetectHeader {
+			s = palette.Render(cmd.StringEffect, cmd.DetectAutomatically)
+		} else {
+			s = palette.Render(cmd.BooleanEffect, strconv.FormatBool(flags.NoHeader))
+		}
 	case cmd.WithoutNullFlag:
 		s = palette.Render(cmd.BooleanEffect, strconv.FormatBool(flags.WithoutNull))
+	case cmd.QuoteCharFlag:
+		s = "'" + cmd.EscapeString(string(flags.QuoteChar)) + "'"
+		if flags.DetectQuote {
+			s = cmd.DetectAutomatically
+		}
+		switch flags.SelectImportFormat() {
+		case cmd.CSV, cmd.TSV:
+			s = palette.Render(cmd.StringEffect, s)
+		default:
+			s = palette.Render(cmd.NullEffect, IgnoredFlagPrefix+s)
+		}
+	case cmd.EscapeStyleFlag:
+		s = flags.EscapeStyle.String()
+		switch flags.SelectImportFormat() {
+		case cmd.CSV, cmd.TSV:
+			s = palette.Render(cmd.StringEffect, s)
+		default:
+			s = palette.Render(cmd.NullEffect, IgnoredFlagPrefix+s)
+		}
+	case cmd.LazyQuotesFlag:
+		s = strconv.FormatBool(flags.LazyQuotes)
+		switch flags.SelectImportFormat() {
+		case cmd.CSV, cmd.TSV:
+			s = palette.Render(cmd.BooleanEffect, s)
+		default:
+			s = palette.Render(cmd.NullEffect, IgnoredFlagPrefix+s)
+		}
+	case cmd.CommentPrefixFlag:
+		if len(flags.CommentPrefix) < 1 {
+			s = palette.Render(cmd.NullEffect, "(not set)")
+			break
+		}
+		s = "'" + cmd.EscapeString(flags.CommentPrefix) + "'"
+		switch flags.SelectImportFormat() {
+		case cmd.CSV, cmd.TSV:
+			s = palette.Render(cmd.StringEffect, s)
+		default:
+			s = palette.Render(cmd.NullEffect, IgnoredFlagPrefix+s)
+		}
+	case cmd.SkipLinesFlag:
+		s = strconv.Itoa(flags.SkipLines)
+		switch flags.SelectImportFormat() {
+		case cmd.CSV, cmd.TSV:
+			s = palette.Render(cmd.NumberEffect, s)
+		default:
+			s = palette.Render(cmd.NullEffect, IgnoredFlagPrefix+s)
+		}
+	case cmd.OnParseErrorFlag:
+		s = flags.OnParseError.String()
+		switch flags.SelectImportFormat() {
+		case cmd.CSV, cmd.TSV:
+			s = palette.Render(cmd.StringEffect, s)
+		default:
+			s = palette.Render(cmd.NullEffect, IgnoredFlagPrefix+s)
+		}
+	case cmd.RejectFileFlag:
+		if len(flags.RejectFile) < 1 {
+			s = palette.Render(cmd.NullEffect, "(not set)")
+			break
+		}
+		s = flags.RejectFile
+		switch flags.SelectImportFormat() {
+		case cmd.CSV, cmd.TSV:
+			s = palette.Render(cmd.StringEffect, s)
+		default:
+			s = palette.Render(cmd.NullEffect, IgnoredFlagPrefix+s)
+		}
 	case cmd.FormatFlag:
 		s = palette.Render(cmd.StringEffect, flags.Format.String())
 	case cmd.WriteEncodingFlag:
 		switch flags.Format {
-		case cmd.JSON:
-			s = palette.Render(cmd.NullEffect, IgnoredFlagPrefix+flags.WriteEncoding.String())
+		case cmd.JSON, cmd.JSONL, cmd.PARQUET, cmd.XLSX:
+			s = palette.Render(cmd.NullEffect, IgnoredFlagPrefix+string(flags.WriteEncoding))
 		default:
-			s = palette.Render(cmd.StringEffect, flags.WriteEncoding.String())
+			s = palette.Render(cmd.StringEffect, string(flags.WriteEncoding))
 		}
 	case cmd.WriteDelimiterFlag:
 		d := "'" + cmd.EscapeString(string(flags.WriteDelimiter)) + "'"
@@ -433,7 +548,7 @@ func showFlag(flag string) (string, error) {
 	case cmd.WithoutHeaderFlag:
 		s = strconv.FormatBool(flags.WithoutHeader)
 		switch flags.Format {
-		case cmd.CSV, cmd.TSV, cmd.FIXED, cmd.GFM, cmd.ORG:
+		case cmd.CSV, cmd.TSV, cmd.FIXED, cmd.GFM, cmd.ORG, cmd.XLSX:
 			s = palette.Render(cmd.BooleanEffect, s)
 		default:
 			s = palette.Render(cmd.NullEffect, IgnoredFlagPrefix+s)
@@ -451,7 +566,7 @@ func showFlag(flag string) (string, error) {
 	case cmd.JsonEscape:
 		s = cmd.JsonEscapeTypeToString(flags.JsonEscape)
 		switch flags.Format {
-		case cmd.JSON:
+		case cmd.JSON, cmd.JSONL:
 			s = palette.Render(cmd.StringEffect, s)
 		default:
 			s = palette.Render(cmd.NullEffect, IgnoredFlagPrefix+s)
@@ -510,16 +625,17 @@ func ShowObjects(expr parser.ShowObjects, filter *Filter) (string, error) {
 
 	switch strings.ToUpper(expr.Type.Literal) {
 	case ShowTables:
-		keys := ViewCache.SortedKeys()
+		session := filter.Session()
+		keys := session.ViewCache.SortedKeys()
 
 		if len(keys) < 1 {
 			s = cmd.Warn("No table is loaded")
 		} else {
-			createdFiles, updatedFiles := UncommittedViews.UncommittedFiles()
+			createdFiles, updatedFiles := session.UncommittedViews.UncommittedFiles()
 
 			for _, key := range keys {
-				fields := ViewCache[key].Header.TableColumnNames()
-				info := ViewCache[key].FileInfo
+				fields := session.ViewCache[key].Header.TableColumnNames()
+				info := session.ViewCache[key].FileInfo
 				ufpath := strings.ToUpper(info.Path)
 
 				if _, ok := createdFiles[ufpath]; ok {
@@ -553,7 +669,7 @@ func ShowObjects(expr parser.ShowObjects, filter *Filter) (string, error) {
 		} else {
 			keys := views.SortedKeys()
 
-			updatedViews := UncommittedViews.UncommittedTempViews()
+			updatedViews := filter.Session().UncommittedViews.UncommittedTempViews()
 
 			for _, key := range keys {
 				fields := views[key].Header.TableColumnNames()
@@ -651,7 +767,7 @@ func ShowObjects(expr parser.ShowObjects, filter *Filter) (string, error) {
 	case ShowFlags:
 		for _, flag := range cmd.FlagList {
 			symbol := cmd.FlagSymbol(flag)
-			s, _ := showFlag(flag)
+			s, _ := showFlag(flag, filter.Flags())
 			w.WriteSpaces(24 - len(symbol))
 			w.WriteColorWithoutLineBreak(symbol, cmd.LableEffect)
 			w.WriteColorWithoutLineBreak(":", cmd.LableEffect)
@@ -695,16 +811,18 @@ func ShowObjects(expr parser.ShowObjects, filter *Filter) (string, error) {
 	case ShowRuninfo:
 		for _, ri := range RuntimeInformatinList {
 			label := string(parser.VariableSign) + string(parser.RuntimeInformationSign) + ri
-			p, _ := GetRuntimeInformation(parser.RuntimeInformation{Name: ri})
+			p, _ := GetRuntimeInformation(parser.RuntimeInformation{Name: ri}, filter)
 
 			w.WriteSpaces(19 - len(label))
 			w.WriteColorWithoutLineBreak(label, cmd.LableEffect)
 			w.WriteColorWithoutLineBreak(":", cmd.LableEffect)
 			w.WriteSpaces(1)
-			switch ri {
-			case WorkingDirectory, VersionInformation:
+			switch {
+			case value.IsNull(p):
+				w.WriteColorWithoutLineBreak(p.String(), cmd.NullEffect)
+			case ri == WorkingDirectory || ri == VersionInformation || ri == ErrorMessageInformation || ri == RejectedRowsInformation:
 				w.WriteColorWithoutLineBreak(p.(value.String).Raw(), cmd.StringEffect)
-			case UncommittedInformation:
+			case ri == UncommittedInformation:
 				w.WriteColorWithoutLineBreak(p.(value.Boolean).String(), cmd.BooleanEffect)
 			default:
 				w.WriteColorWithoutLineBreak(p.(value.Integer).String(), cmd.NumberEffect)
@@ -735,6 +853,14 @@ func writeTableAttribute(w *ObjectWriter, info *FileInfo) {
 	case cmd.FIXED:
 		w.WriteColorWithoutLineBreak("Delimiter Positions: ", cmd.LableEffect)
 		w.WriteWithoutLineBreak(info.DelimiterPositions.String())
+	case cmd.XLSX:
+		w.WriteColorWithoutLineBreak("Sheet: ", cmd.LableEffect)
+		w.WriteWithoutLineBreak(info.Sheet)
+		if 0 < len(info.CellRange) {
+			w.WriteSpaces(2)
+			w.WriteColorWithoutLineBreak("Range: ", cmd.LableEffect)
+			w.WriteWithoutLineBreak(info.CellRange)
+		}
 	case cmd.JSON:
 		escapeStr := cmd.JsonEscapeTypeToString(info.JsonEscape)
 		w.WriteColorWithoutLineBreak("Escape: ", cmd.LableEffect)
@@ -752,6 +878,9 @@ func writeTableAttribute(w *ObjectWriter, info *FileInfo) {
 		} else {
 			w.WriteColorWithoutLineBreak(info.JsonQuery, cmd.NullEffect)
 		}
+	case cmd.JSONL:
+		w.WriteColorWithoutLineBreak("Escape: ", cmd.LableEffect)
+		w.WriteWithoutLineBreak(cmd.JsonEscapeTypeToString(info.JsonEscape))
 	}
 
 	switch info.Format {
@@ -765,13 +894,17 @@ func writeTableAttribute(w *ObjectWriter, info *FileInfo) {
 
 	w.WriteColor("Encoding: ", cmd.LableEffect)
 	switch info.Format {
-	case cmd.JSON:
+	case cmd.JSON, cmd.JSONL, cmd.PARQUET, cmd.XLSX:
 		w.WriteColorWithoutLineBreak(text.UTF8.String(), cmd.NullEffect)
 	default:
-		w.WriteWithoutLineBreak(info.Encoding.String())
+		w.WriteWithoutLineBreak(string(info.Encoding))
 	}
 
-	w.WriteSpaces(6 - (cmd.TextWidth(info.Encoding.String())))
+	if l := cmd.TextWidth(string(info.Encoding)); l < 5 {
+		w.WriteSpaces(6 - l)
+	} else {
+		w.WriteSpaces(2)
+	}
 	w.WriteColorWithoutLineBreak("LineBreak: ", cmd.LableEffect)
 	w.WriteWithoutLineBreak(info.LineBreak.String())
 
@@ -780,11 +913,28 @@ func writeTableAttribute(w *ObjectWriter, info *FileInfo) {
 		w.WriteSpaces(6 - (cmd.TextWidth(info.LineBreak.String())))
 		w.WriteColorWithoutLineBreak("Pretty Print: ", cmd.LableEffect)
 		w.WriteWithoutLineBreak(strconv.FormatBool(info.PrettyPrint))
-	case cmd.CSV, cmd.TSV, cmd.FIXED, cmd.GFM, cmd.ORG:
+	case cmd.CSV, cmd.TSV, cmd.FIXED, cmd.GFM, cmd.ORG, cmd.XLSX:
 		w.WriteSpaces(6 - (cmd.TextWidth(info.LineBreak.String())))
 		w.WriteColorWithoutLineBreak("Header: ", cmd.LableEffect)
 		w.WriteWithoutLineBreak(strconv.FormatBool(!info.NoHeader))
 	}
+
+	switch info.Format {
+	case cmd.CSV, cmd.TSV:
+		if !info.Detection.IsEmpty() {
+			w.NewLine()
+			w.WriteColor("Detected: ", cmd.LableEffect)
+			w.WriteWithoutLineBreak(info.Detection.String())
+			w.WriteSpaces(2)
+			w.WriteColorWithoutLineBreak("BOM: ", cmd.LableEffect)
+			w.WriteWithoutLineBreak(strconv.FormatBool(info.BOM))
+		}
+		if !info.Dialect.IsDefault() {
+			w.NewLine()
+			w.WriteColor("Dialect: ", cmd.LableEffect)
+			w.WriteWithoutLineBreak(info.Dialect.String())
+		}
+	}
 }
 
 func writeFields(w *ObjectWriter, fields []string) {
@@ -855,14 +1005,14 @@ func ShowFields(expr parser.ShowFields, filter *Filter) (string, error) {
 	}
 
 	if view.FileInfo.IsTemporary {
-		updatedViews := UncommittedViews.UncommittedTempViews()
+		updatedViews := filter.Session().UncommittedViews.UncommittedTempViews()
 		ufpath := strings.ToUpper(view.FileInfo.Path)
 
 		if _, ok := updatedViews[ufpath]; ok {
 			status = ObjectUpdated
 		}
 	} else {
-		createdViews, updatedView := UncommittedViews.UncommittedFiles()
+		createdViews, updatedView := filter.Session().UncommittedViews.UncommittedFiles()
 		ufpath := strings.ToUpper(view.FileInfo.Path)
 
 		if _, ok := createdViews[ufpath]; ok {
@@ -952,6 +1102,10 @@ func UnsetEnvVar(expr parser.UnsetEnvVar) error {
 }
 
 func Chdir(expr parser.Chdir, filter *Filter) error {
+	if filter.Session().IsSandboxed() {
+		return NewStatementNotAllowedError(expr, "CHDIR")
+	}
+
 	var dirpath string
 	var err error
 
@@ -987,7 +1141,11 @@ func Pwd(expr parser.Pwd) (string, error) {
 	return dirpath, err
 }
 
-func Reload(expr parser.Reload) error {
+func Reload(expr parser.Reload, filter *Filter) error {
+	if filter.Session().IsSandboxed() {
+		return NewStatementNotAllowedError(expr, "RELOAD")
+	}
+
 	switch strings.ToUpper(expr.Type.Literal) {
 	case ReloadConfig:
 		if err := cmd.LoadEnvironment(); err != nil {
@@ -996,7 +1154,7 @@ func Reload(expr parser.Reload) error {
 
 		env, _ := cmd.GetEnvironment()
 
-		flags := cmd.GetFlags()
+		flags := filter.Flags()
 		for _, v := range env.DatetimeFormat {
 			flags.DatetimeFormat = cmd.AppendStrIfNotExist(flags.DatetimeFormat, v)
 		}
@@ -1049,7 +1207,7 @@ func Syntax(expr parser.Syntax, filter *Filter) string {
 	exps := store.Search(keys)
 
 	var p *color.Palette
-	if cmd.GetFlags().Color {
+	if filter.Flags().Color {
 		p, _ = cmd.GetPalette()
 	}
 
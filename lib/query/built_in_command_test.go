@@ -420,6 +420,13 @@ var setFlagTests = []struct {
 			Value: parser.NewStringValue("{}"),
 		},
 	},
+	{
+		Name: "Set Sheet",
+		Expr: parser.SetFlag{
+			Name:  "sheet",
+			Value: parser.NewStringValue("Sheet2"),
+		},
+	},
 	{
 		Name: "Set Encoding",
 		Expr: parser.SetFlag{
@@ -434,6 +441,13 @@ var setFlagTests = []struct {
 			Value: parser.NewTernaryValueFromString("true"),
 		},
 	},
+	{
+		Name: "Set NoHeader Detected Automatically",
+		Expr: parser.SetFlag{
+			Name:  "no_header",
+			Value: parser.NewStringValue("auto"),
+		},
+	},
 	{
 		Name: "Set WithoutNull",
 		Expr: parser.SetFlag{
@@ -441,6 +455,55 @@ var setFlagTests = []struct {
 			Value: parser.NewTernaryValueFromString("true"),
 		},
 	},
+	{
+		Name: "Set QuoteChar",
+		Expr: parser.SetFlag{
+			Name:  "quote_char",
+			Value: parser.NewStringValue("'"),
+		},
+	},
+	{
+		Name: "Set EscapeStyle",
+		Expr: parser.SetFlag{
+			Name:  "escape_style",
+			Value: parser.NewStringValue("backslash"),
+		},
+	},
+	{
+		Name: "Set LazyQuotes",
+		Expr: parser.SetFlag{
+			Name:  "lazy_quotes",
+			Value: parser.NewTernaryValueFromString("true"),
+		},
+	},
+	{
+		Name: "Set CommentPrefix",
+		Expr: parser.SetFlag{
+			Name:  "comment_prefix",
+			Value: parser.NewStringValue("#"),
+		},
+	},
+	{
+		Name: "Set SkipLines",
+		Expr: parser.SetFlag{
+			Name:  "skip_lines",
+			Value: parser.NewIntegerValue(2),
+		},
+	},
+	{
+		Name: "Set OnParseError",
+		Expr: parser.SetFlag{
+			Name:  "on_parse_error",
+			Value: parser.NewStringValue("skip"),
+		},
+	},
+	{
+		Name: "Set RejectFile",
+		Expr: parser.SetFlag{
+			Name:  "reject_file",
+			Value: parser.NewStringValue("rejected.csv"),
+		},
+	},
 	{
 		Name: "Set Format",
 		Expr: parser.SetFlag{
@@ -585,6 +648,14 @@ var setFlagTests = []struct {
 		},
 		Error: "[L:- C:-] 'string' for @@without_null is not allowed",
 	},
+	{
+		Name: "Set SkipLines Value Error",
+		Expr: parser.SetFlag{
+			Name:  "skip_lines",
+			Value: parser.NewStringValue("invalid"),
+		},
+		Error: "[L:- C:-] 'invalid' for @@skip_lines is not allowed",
+	},
 	{
 		Name: "Set CPU Value Error",
 		Expr: parser.SetFlag{
@@ -609,6 +680,22 @@ var setFlagTests = []struct {
 		},
 		Error: "[L:- C:-] line-break must be one of CRLF|LF|CR",
 	},
+	{
+		Name: "Invalid OnParseError Value Error",
+		Expr: parser.SetFlag{
+			Name:  "on_parse_error",
+			Value: parser.NewStringValue("invalid"),
+		},
+		Error: "[L:- C:-] on-parse-error must be one of FAIL|SKIP|PAD",
+	},
+	{
+		Name: "Invalid QuoteChar Value Error",
+		Expr: parser.SetFlag{
+			Name:  "quote_char",
+			Value: parser.NewStringValue("ab"),
+		},
+		Error: "[L:- C:-] quote-char must be one character except line breaks",
+	},
 }
 
 func TestSetFlag(t *testing.T) {
@@ -909,6 +996,19 @@ var showFlagTests = []struct {
 		},
 		Result: "\033[34;1m@@DELIMITER:\033[0m \033[32m'\\t'\033[0m\033[34;1m | \033[0m\033[90mSPACES\033[0m",
 	},
+	{
+		Name: "Show Delimiter Detected Automatically",
+		Expr: parser.ShowFlag{
+			Name: "delimiter",
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Name:  "delimiter",
+				Value: parser.NewStringValue("auto"),
+			},
+		},
+		Result: "\033[34;1m@@DELIMITER:\033[0m \033[32mAUTO\033[0m\033[34;1m | \033[0m\033[90mSPACES\033[0m",
+	},
 	{
 		Name: "Show Delimiter for FIXED",
 		Expr: parser.ShowFlag{
@@ -956,6 +1056,27 @@ var showFlagTests = []struct {
 		SetExprs: []parser.SetFlag{},
 		Result:   "\033[34;1m@@JSON_QUERY:\033[0m \033[90m(ignored) (empty)\033[0m",
 	},
+	{
+		Name: "Show Sheet",
+		Expr: parser.ShowFlag{
+			Name: "sheet",
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Name:  "sheet",
+				Value: parser.NewStringValue("Sheet2"),
+			},
+		},
+		Result: "\033[34;1m@@SHEET:\033[0m \033[32mSheet2\033[0m",
+	},
+	{
+		Name: "Show Sheet Not Set",
+		Expr: parser.ShowFlag{
+			Name: "sheet",
+		},
+		SetExprs: []parser.SetFlag{},
+		Result:   "\033[34;1m@@SHEET:\033[0m \033[90m(not set)\033[0m",
+	},
 	{
 		Name: "Show Encoding",
 		Expr: parser.ShowFlag{
@@ -969,6 +1090,19 @@ var showFlagTests = []struct {
 		},
 		Result: "\033[34;1m@@ENCODING:\033[0m \033[32mSJIS\033[0m",
 	},
+	{
+		Name: "Show Encoding Detected Automatically",
+		Expr: parser.ShowFlag{
+			Name: "encoding",
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Name:  "encoding",
+				Value: parser.NewStringValue("AUTO"),
+			},
+		},
+		Result: "\033[34;1m@@ENCODING:\033[0m \033[32mAUTO\033[0m",
+	},
 	{
 		Name: "Show NoHeader",
 		Expr: parser.ShowFlag{
@@ -982,6 +1116,19 @@ var showFlagTests = []struct {
 		},
 		Result: "\033[34;1m@@NO_HEADER:\033[0m \033[33;1mtrue\033[0m",
 	},
+	{
+		Name: "Show NoHeader Detected Automatically",
+		Expr: parser.ShowFlag{
+			Name: "no_header",
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Name:  "no_header",
+				Value: parser.NewStringValue("auto"),
+			},
+		},
+		Result: "\033[34;1m@@NO_HEADER:\033[0m \033[32mAUTO\033[0m",
+	},
 	{
 		Name: "Show WithoutNull",
 		Expr: parser.ShowFlag{
@@ -995,6 +1142,126 @@ var showFlagTests = []struct {
 		},
 		Result: "\033[34;1m@@WITHOUT_NULL:\033[0m \033[33;1mtrue\033[0m",
 	},
+	{
+		Name: "Show QuoteChar",
+		Expr: parser.ShowFlag{
+			Name: "quote_char",
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Name:  "quote_char",
+				Value: parser.NewStringValue("'"),
+			},
+		},
+		Result: "\033[34;1m@@QUOTE_CHAR:\033[0m \033[32m'\\''\033[0m",
+	},
+	{
+		Name: "Show QuoteChar Ignored",
+		Expr: parser.ShowFlag{
+			Name: "quote_char",
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Name:  "json_query",
+				Value: parser.NewStringValue("{}"),
+			},
+		},
+		Result: "\033[34;1m@@QUOTE_CHAR:\033[0m \033[90m(ignored) '\\\"'\033[0m",
+	},
+	{
+		Name: "Show EscapeStyle",
+		Expr: parser.ShowFlag{
+			Name: "escape_style",
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Name:  "escape_style",
+				Value: parser.NewStringValue("backslash"),
+			},
+		},
+		Result: "\033[34;1m@@ESCAPE_STYLE:\033[0m \033[32mBACKSLASH\033[0m",
+	},
+	{
+		Name: "Show LazyQuotes",
+		Expr: parser.ShowFlag{
+			Name: "lazy_quotes",
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Name:  "lazy_quotes",
+				Value: parser.NewTernaryValueFromString("true"),
+			},
+		},
+		Result: "\033[34;1m@@LAZY_QUOTES:\033[0m \033[33;1mtrue\033[0m",
+	},
+	{
+		Name: "Show CommentPrefix",
+		Expr: parser.ShowFlag{
+			Name: "comment_prefix",
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Name:  "comment_prefix",
+				Value: parser.NewStringValue("#"),
+			},
+		},
+		Result: "\033[34;1m@@COMMENT_PREFIX:\033[0m \033[32m'#'\033[0m",
+	},
+	{
+		Name: "Show CommentPrefix Not Set",
+		Expr: parser.ShowFlag{
+			Name: "comment_prefix",
+		},
+		SetExprs: []parser.SetFlag{},
+		Result:   "\033[34;1m@@COMMENT_PREFIX:\033[0m \033[90m(not set)\033[0m",
+	},
+	{
+		Name: "Show SkipLines",
+		Expr: parser.ShowFlag{
+			Name: "skip_lines",
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Name:  "skip_lines",
+				Value: parser.NewIntegerValue(2),
+			},
+		},
+		Result: "\033[34;1m@@SKIP_LINES:\033[0m \033[35m2\033[0m",
+	},
+	{
+		Name: "Show OnParseError",
+		Expr: parser.ShowFlag{
+			Name: "on_parse_error",
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Name:  "on_parse_error",
+				Value: parser.NewStringValue("pad"),
+			},
+		},
+		Result: "\033[34;1m@@ON_PARSE_ERROR:\033[0m \033[32mPAD\033[0m",
+	},
+	{
+		Name: "Show RejectFile",
+		Expr: parser.ShowFlag{
+			Name: "reject_file",
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Name:  "reject_file",
+				Value: parser.NewStringValue("rejected.csv"),
+			},
+		},
+		Result: "\033[34;1m@@REJECT_FILE:\033[0m \033[32mrejected.csv\033[0m",
+	},
+	{
+		Name: "Show RejectFile Not Set",
+		Expr: parser.ShowFlag{
+			Name: "reject_file",
+		},
+		SetExprs: []parser.SetFlag{},
+		Result:   "\033[34;1m@@REJECT_FILE:\033[0m \033[90m(not set)\033[0m",
+	},
 	{
 		Name: "Show Format",
 		Expr: parser.ShowFlag{
@@ -1406,7 +1673,7 @@ func TestShowFlag(t *testing.T) {
 		for _, expr := range v.SetExprs {
 			SetFlag(expr, filter)
 		}
-		result, err := ShowFlag(v.Expr)
+		result, err := ShowFlag(v.Expr, filter)
 		if err != nil {
 			if len(v.Error) < 1 {
 				t.Errorf("%s: unexpected error %q", v.Name, err)
@@ -1859,9 +2126,17 @@ var showObjectsTests = []struct {
 			"           @@WAIT_TIMEOUT: 15\n" +
 			"              @@DELIMITER: ',' | SPACES\n" +
 			"             @@JSON_QUERY: (ignored) (empty)\n" +
+			"                  @@SHEET: (not set)\n" +
 			"               @@ENCODING: UTF8\n" +
 			"              @@NO_HEADER: false\n" +
 			"           @@WITHOUT_NULL: false\n" +
+			"             @@QUOTE_CHAR: '\\\"'\n" +
+			"           @@ESCAPE_STYLE: DOUBLE\n" +
+			"            @@LAZY_QUOTES: false\n" +
+			"         @@COMMENT_PREFIX: (not set)\n" +
+			"             @@SKIP_LINES: 0\n" +
+			"         @@ON_PARSE_ERROR: FAIL\n" +
+			"            @@REJECT_FILE: (not set)\n" +
 			"                 @@FORMAT: CSV\n" +
 			"         @@WRITE_ENCODING: UTF8\n" +
 			"        @@WRITE_DELIMITER: ',' | SPACES\n" +
@@ -1891,8 +2166,12 @@ var showObjectsTests = []struct {
 			"           @#UPDATED: 0\n" +
 			"     @#UPDATED_VIEWS: 0\n" +
 			"     @#LOADED_TABLES: 0\n" +
+			"     @#REJECTED_ROWS: {}\n" +
 			" @#WORKING_DIRECTORY: " + GetWD() + "\n" +
 			"           @#VERSION: v1.0.0\n" +
+			"        @#ERROR_CODE: NULL\n" +
+			"     @#ERROR_MESSAGE: NULL\n" +
+			"        @#ERROR_LINE: NULL\n" +
 			"\n",
 	},
 	{
@@ -2104,6 +2383,41 @@ var showFieldsTests = []struct {
 			"   2. column2\n" +
 			"\n",
 	},
+	{
+		Name: "ShowFields Detected Attributes",
+		Expr: parser.ShowFields{
+			Type:  parser.Identifier{Literal: "fields"},
+			Table: parser.Identifier{Literal: "show_fields_detected.csv"},
+		},
+		ViewCache: ViewMap{
+			strings.ToUpper(GetTestFilePath("show_fields_detected.csv")): &View{
+				Header: NewHeader("show_fields_detected", []string{"column1", "column2"}),
+				FileInfo: &FileInfo{
+					Path:      GetTestFilePath("show_fields_detected.csv"),
+					Delimiter: ';',
+					Format:    cmd.CSV,
+					Encoding:  text.UTF8,
+					LineBreak: text.LF,
+					NoHeader:  false,
+					BOM:       true,
+					Detection: Detection{Delimiter: true, Encoding: true, Header: true},
+				},
+			},
+		},
+		Expect: "\n" +
+			strings.Repeat(" ", (calcShowFieldsWidth("show_fields_detected.csv", "show_fields_detected.csv", 10)-(10+len("show_fields_detected.csv")))/2) + "Fields in show_fields_detected.csv\n" +
+			strings.Repeat("-", calcShowFieldsWidth("show_fields_detected.csv", "show_fields_detected.csv", 10)) + "\n" +
+			" Type: Table\n" +
+			" Path: " + GetTestFilePath("show_fields_detected.csv") + "\n" +
+			" Format: CSV     Delimiter: ';'   Enclose All: false\n" +
+			" Encoding: UTF8  LineBreak: LF    Header: true\n" +
+			" Detected: Delimiter, Encoding, Header  BOM: true\n" +
+			" Status: Fixed\n" +
+			" Fields:\n" +
+			"   1. column1\n" +
+			"   2. column2\n" +
+			"\n",
+	},
 	{
 		Name: "ShowFields Load Error",
 		Expr: parser.ShowFields{
@@ -1,27 +1,47 @@
 package query
 
 import (
+	"fmt"
+
 	"github.com/mithrandie/csvq/lib/value"
 )
 
-type Cell []value.Primary
+type Cell struct {
+	values   []value.Primary
+	rolledUp bool
+}
 
 func NewCell(val value.Primary) Cell {
-	return []value.Primary{val}
+	return Cell{values: []value.Primary{val}}
 }
 
 func NewGroupCell(values []value.Primary) Cell {
-	return values
+	return Cell{values: values}
+}
+
+func NewRolledUpCell(values []value.Primary) Cell {
+	return Cell{values: values, rolledUp: true}
 }
 
 func (cell Cell) Value() value.Primary {
-	return cell[0]
+	if cell.rolledUp {
+		return value.NewNull()
+	}
+	return cell.values[0]
 }
 
 func (cell Cell) GroupedValue(index int) value.Primary {
-	return cell[index]
+	return cell.values[index]
 }
 
 func (cell Cell) Len() int {
-	return len(cell)
+	return len(cell.values)
+}
+
+func (cell Cell) IsRolledUp() bool {
+	return cell.rolledUp
+}
+
+func (cell Cell) String() string {
+	return fmt.Sprint(cell.values)
 }
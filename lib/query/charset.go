@@ -0,0 +1,243 @@
+package query
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+
+	"github.com/mithrandie/go-text"
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/charmap"
+	"golang.org/x/text/encoding/japanese"
+	"golang.org/x/text/encoding/unicode"
+	"golang.org/x/text/transform"
+)
+
+var (
+	utf8BOM    = []byte{0xEF, 0xBB, 0xBF}
+	utf16LEBOM = []byte{0xFF, 0xFE}
+	utf16BEBOM = []byte{0xFE, 0xFF}
+)
+
+// charset returns the character encoding that is not handled by go-text.
+// For UTF8, UTF8BOM and SJIS, returns nil.
+func charset(enc text.Encoding) encoding.Encoding {
+	switch enc {
+	case cmd.UTF16LE:
+		return unicode.UTF16(unicode.LittleEndian, unicode.IgnoreBOM)
+	case cmd.UTF16BE:
+		return unicode.UTF16(unicode.BigEndian, unicode.IgnoreBOM)
+	case cmd.EUCJP:
+		return japanese.EUCJP
+	case cmd.ISO2022JP:
+		return japanese.ISO2022JP
+	case cmd.LATIN1:
+		return charmap.ISO8859_1
+	case cmd.WINDOWS1252:
+		return charmap.Windows1252
+	}
+	return nil
+}
+
+func isUTF16(enc text.Encoding) bool {
+	return enc == cmd.UTF16LE || enc == cmd.UTF16BE
+}
+
+func hasCharacterEncoding(format cmd.Format) bool {
+	switch format {
+	case cmd.JSON, cmd.JSONL, cmd.PARQUET, cmd.XLSX, cmd.SQLITE:
+		return false
+	}
+	return true
+}
+
+// skipByteOrderMark skips the byte order mark at the beginning of the file.
+// A byte order mark of UTF-16 determines the byte order regardless of the specified one.
+func skipByteOrderMark(fp io.Reader, fileInfo *FileInfo) (io.Reader, error) {
+	if fileInfo.BOM {
+		return fp, nil
+	}
+
+	switch {
+	case fileInfo.Detection.Encoding, fileInfo.Encoding == cmd.UTF8BOM, isUTF16(fileInfo.Encoding):
+	case fileInfo.Encoding == text.UTF8 && !fileInfo.Detection.IsEmpty():
+	default:
+		return fp, nil
+	}
+
+	r := bufio.NewReader(fp)
+	b, err := r.Peek(len(utf8BOM))
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+
+	var bom []byte
+	switch {
+	case bytes.HasPrefix(b, utf8BOM):
+		if fileInfo.Detection.Encoding || fileInfo.Encoding == text.UTF8 || fileInfo.Encoding == cmd.UTF8BOM {
+			bom = utf8BOM
+			if fileInfo.Encoding != cmd.UTF8BOM {
+				fileInfo.Encoding = text.UTF8
+			}
+		}
+	case bytes.HasPrefix(b, utf16LEBOM):
+		if fileInfo.Detection.Encoding || isUTF16(fileInfo.Encoding) {
+			bom = utf16LEBOM
+			fileInfo.Encoding = cmd.UTF16LE
+		}
+	case bytes.HasPrefix(b, utf16BEBOM):
+		if fileInfo.Detection.Encoding || isUTF16(fileInfo.Encoding) {
+			bom = utf16BEBOM
+			fileInfo.Encoding = cmd.UTF16BE
+		}
+	}
+
+	if bom != nil {
+		if _, err = r.Discard(len(bom)); err != nil {
+			return nil, err
+		}
+		fileInfo.BOM = true
+	}
+	return r, nil
+}
+
+// newDecodeReader returns a reader that converts the contents of the file to UTF-8
+// if the encoding of the file is not handled by go-text.
+func newDecodeReader(fp io.Reader, fileInfo *FileInfo) (io.Reader, error) {
+	fp, err := skipByteOrderMark(fp, fileInfo)
+	if err != nil {
+		return nil, err
+	}
+	if e := charset(fileInfo.Encoding); e != nil {
+		return transform.NewReader(fp, e.NewDecoder()), nil
+	}
+	return fp, nil
+}
+
+func outputByteOrderMark(fileInfo *FileInfo) []byte {
+	switch fileInfo.Encoding {
+	case cmd.UTF8BOM:
+		return utf8BOM
+	case cmd.UTF16LE:
+		return utf16LEBOM
+	case cmd.UTF16BE:
+		return utf16BEBOM
+	case text.UTF8:
+		if fileInfo.BOM {
+			return utf8BOM
+		}
+	}
+	return nil
+}
+
+// newEncodeWriter returns a writer that converts the contents from UTF-8
+// if the encoding of the file is not handled by go-text.
+// The byte order mark is written before the first contents.
+// The returned writer must be closed to flush the converted contents.
+func newEncodeWriter(fp io.Writer, fileInfo *FileInfo) io.WriteCloser {
+	if !hasCharacterEncoding(fileInfo.Format) {
+		return nopWriteCloser{fp}
+	}
+
+	if bom := outputByteOrderMark(fileInfo); bom != nil {
+		fp = &bomWriter{w: fp, bom: bom}
+	}
+	if e := charset(fileInfo.Encoding); e != nil {
+		return &encodeWriter{w: transform.NewWriter(fp, e.NewEncoder())}
+	}
+	return nopWriteCloser{fp}
+}
+
+// encodeWriter keeps the first error in writing,
+// because the writers in go-text do not return the errors in flushing buffers.
+type encodeWriter struct {
+	w   io.WriteCloser
+	err error
+}
+
+func (w *encodeWriter) Write(p []byte) (int, error) {
+	if w.err != nil {
+		return 0, w.err
+	}
+	n, err := w.w.Write(p)
+	if err != nil {
+		w.err = err
+	}
+	return n, err
+}
+
+func (w *encodeWriter) Close() error {
+	if err := w.w.Close(); w.err == nil {
+		w.err = err
+	}
+	return w.err
+}
+
+// outputLineBreak returns the line break in the encoding of the file.
+func outputLineBreak(fileInfo *FileInfo) []byte {
+	lb := fileInfo.LineBreak.Value()
+	if hasCharacterEncoding(fileInfo.Format) {
+		if s, err := encodeString(lb, fileInfo.Encoding); err == nil {
+			return []byte(s)
+		}
+	}
+	return []byte(lb)
+}
+
+type bomWriter struct {
+	w   io.Writer
+	bom []byte
+}
+
+func (w *bomWriter) Write(p []byte) (int, error) {
+	if w.bom != nil && 0 < len(p) {
+		if _, err := w.w.Write(w.bom); err != nil {
+			return 0, err
+		}
+		w.bom = nil
+	}
+	return w.w.Write(p)
+}
+
+type nopWriteCloser struct {
+	io.Writer
+}
+
+func (nopWriteCloser) Close() error {
+	return nil
+}
+
+func encodeString(s string, enc text.Encoding) (string, error) {
+	if e := charset(enc); e != nil {
+		return e.NewEncoder().String(s)
+	}
+	return text.Encode(s, enc)
+}
+
+func decodeString(s string, enc text.Encoding) (string, error) {
+	if e := charset(enc); e != nil {
+		return e.NewDecoder().String(s)
+	}
+	return text.Decode(s, enc)
+}
+
+// byteSize returns the byte length of the string in the encoding.
+// If the string cannot be represented in the encoding, then the length in UTF-8 is returned.
+func byteSize(s string, enc text.Encoding) int {
+	if charset(enc) != nil {
+		if b, err := encodeString(s, enc); err == nil {
+			return len(b)
+		}
+		return len(s)
+	}
+	return text.ByteSize(s, enc)
+}
+
+func runeByteSize(r rune, enc text.Encoding) int {
+	if charset(enc) != nil {
+		return byteSize(string(r), enc)
+	}
+	return text.RuneByteSize(r, enc)
+}
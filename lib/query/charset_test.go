@@ -0,0 +1,135 @@
+package query
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+
+	"github.com/mithrandie/go-text"
+)
+
+var newDecodeReaderTests = []struct {
+	Name      string
+	Input     string
+	Encoding  text.Encoding
+	Detection Detection
+	Expect    text.Encoding
+	BOM       bool
+	Content   string
+}{
+	{
+		Name:     "UTF-8 without Byte Order Mark",
+		Input:    "\ufeffa,b\n",
+		Encoding: text.UTF8,
+		Expect:   text.UTF8,
+		Content:  "\ufeffa,b\n",
+	},
+	{
+		Name:     "UTF-8 with Byte Order Mark",
+		Input:    "\ufeffa,b\n",
+		Encoding: cmd.UTF8BOM,
+		Expect:   cmd.UTF8BOM,
+		BOM:      true,
+		Content:  "a,b\n",
+	},
+	{
+		Name:     "UTF-16LE",
+		Input:    "\xff\xfea\x00,\x00\xe5\x65\n\x00",
+		Encoding: cmd.UTF16LE,
+		Expect:   cmd.UTF16LE,
+		BOM:      true,
+		Content:  "a,日\n",
+	},
+	{
+		Name:     "UTF-16 Byte Order Mark Overrides Byte Order",
+		Input:    "\xfe\xff\x00a\x00,\x65\xe5\x00\n",
+		Encoding: cmd.UTF16LE,
+		Expect:   cmd.UTF16BE,
+		BOM:      true,
+		Content:  "a,日\n",
+	},
+	{
+		Name:     "UTF-16BE without Byte Order Mark",
+		Input:    "\x00a\x00,\x65\xe5\x00\n",
+		Encoding: cmd.UTF16BE,
+		Expect:   cmd.UTF16BE,
+		Content:  "a,日\n",
+	},
+	{
+		Name:      "Detect UTF-16 from Byte Order Mark",
+		Input:     "\xff\xfea\x00,\x00b\x00",
+		Encoding:  text.UTF8,
+		Detection: Detection{Encoding: true},
+		Expect:    cmd.UTF16LE,
+		BOM:       true,
+		Content:   "a,b",
+	},
+	{
+		Name:     "EUC-JP",
+		Input:    "a,\xc6\xfc\xcb\xdc\n",
+		Encoding: cmd.EUCJP,
+		Expect:   cmd.EUCJP,
+		Content:  "a,日本\n",
+	},
+	{
+		Name:     "ISO-2022-JP",
+		Input:    "a,\x1b$BF|K\\\x1b(B\n",
+		Encoding: cmd.ISO2022JP,
+		Expect:   cmd.ISO2022JP,
+		Content:  "a,日本\n",
+	},
+	{
+		Name:     "Latin-1",
+		Input:    "caf\xe9,\x80\n",
+		Encoding: cmd.LATIN1,
+		Expect:   cmd.LATIN1,
+		Content:  "café,\u0080\n",
+	},
+	{
+		Name:     "Windows-1252",
+		Input:    "caf\xe9,\x80\n",
+		Encoding: cmd.WINDOWS1252,
+		Expect:   cmd.WINDOWS1252,
+		Content:  "café,€\n",
+	},
+	{
+		Name:     "Shift JIS is Decoded by Readers",
+		Input:    "a,\x93\xfa\x96\x7b\n",
+		Encoding: text.SJIS,
+		Expect:   text.SJIS,
+		Content:  "a,\x93\xfa\x96\x7b\n",
+	},
+}
+
+func TestNewDecodeReader(t *testing.T) {
+	for _, v := range newDecodeReaderTests {
+		fileInfo := &FileInfo{
+			Format:    cmd.CSV,
+			Encoding:  v.Encoding,
+			Detection: v.Detection,
+		}
+
+		r, err := newDecodeReader(strings.NewReader(v.Input), fileInfo)
+		if err != nil {
+			t.Errorf("%s: unexpected error %q", v.Name, err)
+			continue
+		}
+		if fileInfo.Encoding != v.Expect {
+			t.Errorf("%s: encoding = %s, want %s", v.Name, string(fileInfo.Encoding), string(v.Expect))
+		}
+		if fileInfo.BOM != v.BOM {
+			t.Errorf("%s: bom = %t, want %t", v.Name, fileInfo.BOM, v.BOM)
+		}
+
+		b, err := io.ReadAll(r)
+		if err != nil {
+			t.Errorf("%s: unexpected error %q", v.Name, err)
+			continue
+		}
+		if string(b) != v.Content {
+			t.Errorf("%s: content = %q, want %q", v.Name, string(b), v.Content)
+		}
+	}
+}
@@ -78,6 +78,27 @@ func Like(p1 value.Primary, p2 value.Primary) ternary.Value {
 	return ternary.TRUE
 }
 
+func RegExp(p1 value.Primary, p2 value.Primary) (ternary.Value, error) {
+	if value.IsNull(p1) || value.IsNull(p2) {
+		return ternary.UNKNOWN, nil
+	}
+
+	s := value.ToString(p1)
+	if value.IsNull(s) {
+		return ternary.UNKNOWN, nil
+	}
+	pattern := value.ToString(p2)
+	if value.IsNull(pattern) {
+		return ternary.UNKNOWN, nil
+	}
+
+	re, err := RegExps.Compile(pattern.(value.String).Raw(), "")
+	if err != nil {
+		return ternary.FALSE, err
+	}
+	return ternary.ConvertFromBool(re.MatchString(s.(value.String).Raw())), nil
+}
+
 func stringPattern(pattern []rune, position int) (int, int, string, int) {
 	anyRunesMinLen := 0
 	anyRunesMaxLen := 0
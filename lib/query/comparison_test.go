@@ -141,6 +141,60 @@ func TestLike(t *testing.T) {
 	}
 }
 
+var regExpTests = []struct {
+	LHS     value.Primary
+	Pattern value.Primary
+	Result  ternary.Value
+	Error   string
+}{
+	{
+		LHS:     value.NewString("abc123"),
+		Pattern: value.NewString("^[a-z]+[0-9]+$"),
+		Result:  ternary.TRUE,
+	},
+	{
+		LHS:     value.NewString("ABC123"),
+		Pattern: value.NewString("^[a-z]+[0-9]+$"),
+		Result:  ternary.FALSE,
+	},
+	{
+		LHS:     value.NewInteger(123),
+		Pattern: value.NewString("^1"),
+		Result:  ternary.TRUE,
+	},
+	{
+		LHS:     value.NewNull(),
+		Pattern: value.NewString("^a"),
+		Result:  ternary.UNKNOWN,
+	},
+	{
+		LHS:     value.NewString("abc"),
+		Pattern: value.NewString("a("),
+		Error:   "error parsing regexp: missing closing ): `a(`",
+	},
+}
+
+func TestRegExp(t *testing.T) {
+	for _, v := range regExpTests {
+		r, err := RegExp(v.LHS, v.Pattern)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("unexpected error %q for (%s regexp %s)", err, v.LHS, v.Pattern)
+			} else if err.Error() != v.Error {
+				t.Errorf("error %q, want error %q for (%s regexp %s)", err.Error(), v.Error, v.LHS, v.Pattern)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("no error, want error %q for (%s regexp %s)", v.Error, v.LHS, v.Pattern)
+			continue
+		}
+		if r != v.Result {
+			t.Errorf("result = %s, want %s for (%s regexp %s)", r, v.Result, v.LHS, v.Pattern)
+		}
+	}
+}
+
 var inRowValueListTests = []struct {
 	LHS      value.RowValue
 	List     []value.RowValue
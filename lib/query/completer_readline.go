@@ -11,6 +11,7 @@ import (
 	"unicode"
 
 	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/file"
 	"github.com/mithrandie/csvq/lib/parser"
 
 	"github.com/mithrandie/go-text"
@@ -30,8 +31,11 @@ var statementPrefix = []string{
 	"INSERT",
 	"UPDATE",
 	"DELETE",
+	"MERGE",
 	"CREATE",
 	"ALTER",
+	"DROP",
+	"EXPLAIN",
 	"DECLARE",
 	"VAR",
 	"SET",
@@ -77,14 +81,21 @@ var tableObjectCandidates = []string{
 	"CSV()",
 	"FIXED()",
 	"JSON()",
+	"JSONL()",
 	"LTSV()",
+	"PARQUET()",
+	"SQLITE()",
+	"XLSX()",
+	"FILES()",
 	"JSON_TABLE()",
 }
 var tableObjects = []string{
 	cmd.CSV.String(),
 	cmd.FIXED.String(),
 	cmd.JSON.String(),
+	cmd.JSONL.String(),
 	cmd.LTSV.String(),
+	cmd.PARQUET.String(),
 }
 
 type ReadlineListener struct {
@@ -147,21 +158,30 @@ func NewCompleter(filter *Filter) *Completer {
 	sort.Strings(completer.flagList)
 	sort.Strings(completer.runinfoList)
 
-	completer.funcs = make([]string, 0, len(Functions)+2)
+	completer.funcs = make([]string, 0, len(Functions)+5)
 	for k := range Functions {
 		completer.funcs = append(completer.funcs, k)
 	}
 	completer.funcs = append(completer.funcs, "NOW")
 	completer.funcs = append(completer.funcs, "JSON_OBJECT")
+	completer.funcs = append(completer.funcs, "GROUPING")
+	completer.funcs = append(completer.funcs, "FILENAME")
+	completer.funcs = append(completer.funcs, "LINE_NUMBER")
 
-	completer.aggFuncs = make([]string, 0, len(AggregateFunctions)+2)
-	completer.analyticFuncs = make([]string, 0, len(AnalyticFunctions)+len(AggregateFunctions))
+	completer.aggFuncs = make([]string, 0, len(AggregateFunctions)+len(BinaryAggregateFunctions)+4)
+	completer.analyticFuncs = make([]string, 0, len(AnalyticFunctions)+len(AggregateFunctions)+len(BinaryAggregateFunctions))
 	for k := range AggregateFunctions {
 		completer.aggFuncs = append(completer.aggFuncs, k)
 		completer.analyticFuncs = append(completer.analyticFuncs, k)
 	}
+	for k := range BinaryAggregateFunctions {
+		completer.aggFuncs = append(completer.aggFuncs, k)
+		completer.analyticFuncs = append(completer.analyticFuncs, k)
+	}
 	completer.aggFuncs = append(completer.aggFuncs, "LISTAGG")
 	completer.aggFuncs = append(completer.aggFuncs, "JSON_AGG")
+	completer.aggFuncs = append(completer.aggFuncs, "PERCENTILE_CONT")
+	completer.aggFuncs = append(completer.aggFuncs, "PERCENTILE_DISC")
 	for k := range AnalyticFunctions {
 		completer.analyticFuncs = append(completer.analyticFuncs, k)
 	}
@@ -326,6 +346,10 @@ func (c *Completer) Statements(line string, origLine string, index int) readline
 		return c.CreateArgs(line, origLine, index)
 	case parser.ALTER:
 		return c.AlterArgs(line, origLine, index)
+	case parser.DROP:
+		return c.DropArgs(line, origLine, index)
+	case parser.EXPLAIN:
+		return c.ExplainArgs(line, origLine, index)
 	case parser.DECLARE, parser.VAR:
 		return c.DeclareArgs(line, origLine, index)
 	case parser.SET:
@@ -401,6 +425,14 @@ func (c *Completer) TableObjectArgs(line string, origLine string, index int) rea
 	var cands readline.CandidateList
 
 	switch strings.ToUpper(c.tokens[0].Literal) {
+	case cmd.SQLITE.String():
+	case cmd.XLSX.String():
+		switch commaCnt {
+		case 3, 4:
+			if c.tokens[c.lastIdx].Token == ',' {
+				cands = c.candidateList([]string{ternary.TRUE.String(), ternary.FALSE.String()}, false)
+			}
+		}
 	case "LTSV":
 		switch commaCnt {
 		case 0:
@@ -554,7 +586,7 @@ func (c *Completer) FunctionArgs(line string, origLine string, index int) readli
 							if funcName == "FIRST_VALUE" ||
 								funcName == "LAST_VALUE" ||
 								funcName == "NTH_VALUE" ||
-								(funcName != "LISTAGG" && funcName != "JSON_AGG" && InStrSliceWithCaseInsensitive(funcName, c.aggFuncs)) ||
+								(funcName != "LISTAGG" && funcName != "JSON_AGG" && funcName != "PERCENTILE_CONT" && funcName != "PERCENTILE_DISC" && InStrSliceWithCaseInsensitive(funcName, c.aggFuncs)) ||
 								InStrSliceWithCaseInsensitive(funcName, c.userAggFuncs) {
 
 								customList = append(customList, c.candidate("ROWS", true))
@@ -667,6 +699,7 @@ func (c *Completer) WithArgs(line string, origLine string, index int) readline.C
 								"INSERT",
 								"UPDATE",
 								"DELETE",
+								"MERGE",
 							)
 						} else {
 							keywords = append(keywords, "AS")
@@ -876,6 +909,36 @@ func (c *Completer) whereClause(line string, origLine string, index int) readlin
 	return cands
 }
 
+func (c *Completer) ExplainArgs(line string, origLine string, index int) readline.CandidateList {
+	queryStart := 1
+	if queryStart <= c.lastIdx && c.tokens[queryStart].Token == parser.ANALYZE {
+		queryStart++
+	}
+
+	if queryStart <= c.lastIdx {
+		c.tokens = c.tokens[queryStart:]
+		c.SetLastIndex(line)
+
+		switch c.tokens[0].Token {
+		case parser.SELECT:
+			return c.SelectArgs(line, origLine, index)
+		case parser.WITH:
+			return c.WithArgs(line, origLine, index)
+		}
+		return nil
+	}
+
+	var cands readline.CandidateList
+	if queryStart == 1 {
+		cands = append(cands, c.candidate("ANALYZE", true))
+	}
+	cands = append(cands,
+		c.candidate("SELECT", true),
+		c.candidate("WITH", true),
+	)
+	return cands
+}
+
 func (c *Completer) SelectArgs(line string, origLine string, index int) readline.CandidateList {
 	return c.completeArgs(
 		line,
@@ -1231,9 +1294,24 @@ func (c *Completer) CreateArgs(line string, origLine string, index int) readline
 					i == c.lastIdx-1 {
 					return []string{"AS", "SELECT"}, nil, true
 				}
+			case parser.ON:
+				if 1 < len(c.tokens) && c.tokens[1].Token == parser.INDEX {
+					switch {
+					case i == c.lastIdx:
+						return nil, c.SearchAllTablesWithSpace(line, origLine, index), true
+					case i+2 <= c.lastIdx && c.tokens[i+2].Token == '(' && !c.BracketIsEnclosed():
+						return nil, c.identifierList(c.ColumnList(c.tokens[i+1].Literal, c.filter.Flags().Repository), false), true
+					}
+					return nil, nil, true
+				}
+			case parser.INDEX:
+				if i == c.lastIdx-1 {
+					return []string{"ON"}, nil, true
+				}
+				return nil, nil, true
 			case parser.CREATE:
 				if i == c.lastIdx {
-					return []string{"TABLE"}, nil, true
+					return []string{"INDEX", "TABLE"}, nil, true
 				}
 			}
 			return nil, nil, false
@@ -1241,6 +1319,33 @@ func (c *Completer) CreateArgs(line string, origLine string, index int) readline
 	)
 }
 
+func (c *Completer) DropArgs(line string, origLine string, index int) readline.CandidateList {
+	return c.completeArgs(
+		line,
+		origLine,
+		index,
+		func(i int) (keywords []string, customList readline.CandidateList, breakLoop bool) {
+			switch c.tokens[i].Token {
+			case parser.ON:
+				if i == c.lastIdx {
+					return nil, c.SearchAllTables(line, origLine, index), true
+				}
+			case parser.INDEX:
+				if i == c.lastIdx-1 {
+					return []string{"ON"}, nil, true
+				}
+			case parser.DROP:
+				if i == c.lastIdx {
+					return []string{"INDEX"}, nil, true
+				}
+			default:
+				return nil, nil, false
+			}
+			return nil, nil, true
+		},
+	)
+}
+
 func (c *Completer) AlterArgs(line string, origLine string, index int) readline.CandidateList {
 	operations := []string{
 		"ADD",
@@ -1267,7 +1372,7 @@ func (c *Completer) AlterArgs(line string, origLine string, index int) readline.
 
 		var clist readline.CandidateList
 		if 0 < len(tableName) {
-			clist = c.identifierList(c.ColumnList(tableName, cmd.GetFlags().Repository), appendSpace)
+			clist = c.identifierList(c.ColumnList(tableName, c.filter.Flags().Repository), appendSpace)
 		}
 		return clist
 	}
@@ -1477,7 +1582,7 @@ func (c *Completer) SetArgs(line string, origLine string, index int) readline.Ca
 						return nil, c.candidateList(delimiterCandidates, false), true
 					case cmd.EncodingFlag, cmd.WriteEncodingFlag:
 						return nil, c.candidateList(c.encodingList(), false), true
-					case cmd.NoHeaderFlag, cmd.WithoutNullFlag, cmd.WithoutHeaderFlag, cmd.EncloseAll, cmd.PrettyPrintFlag,
+					case cmd.NoHeaderFlag, cmd.WithoutNullFlag, cmd.LazyQuotesFlag, cmd.WithoutHeaderFlag, cmd.EncloseAll, cmd.PrettyPrintFlag,
 						cmd.EastAsianEncodingFlag, cmd.CountDiacriticalSignFlag, cmd.CountFormatCodeFlag,
 						cmd.ColorFlag, cmd.QuietFlag, cmd.StatsFlag:
 						return nil, c.candidateList([]string{ternary.TRUE.String(), ternary.FALSE.String()}, false), true
@@ -1487,6 +1592,10 @@ func (c *Completer) SetArgs(line string, origLine string, index int) readline.Ca
 						return nil, c.candidateList(c.lineBreakList(), false), true
 					case cmd.JsonEscape:
 						return nil, c.candidateList(c.jsonEscapeTypeList(), false), true
+					case cmd.EscapeStyleFlag:
+						return nil, c.candidateList(c.escapeStyleList(), false), true
+					case cmd.OnParseErrorFlag:
+						return nil, c.candidateList(c.parseErrorPolicyList(), false), true
 					}
 				}
 				return nil, c.SearchValues(line, origLine, index), true
@@ -1658,10 +1767,10 @@ func (c *Completer) SearchAllTablesWithSpace(line string, origLine string, index
 }
 
 func (c *Completer) SearchAllTables(line string, origLine string, index int) readline.CandidateList {
-	tableKeys := ViewCache.SortedKeys()
-	files := c.ListFiles(line, []string{cmd.CsvExt, cmd.TsvExt, cmd.FixedExt, cmd.JsonExt, cmd.LtsvExt}, cmd.GetFlags().Repository)
+	tableKeys := c.filter.Session().ViewCache.SortedKeys()
+	files := c.ListFiles(line, []string{cmd.CsvExt, cmd.TsvExt, cmd.FixedExt, cmd.JsonExt, cmd.LtsvExt}, c.filter.Flags().Repository)
 
-	defaultDir := cmd.GetFlags().Repository
+	defaultDir := c.filter.Flags().Repository
 	if len(defaultDir) < 1 {
 		defaultDir, _ = os.Getwd()
 	}
@@ -1669,7 +1778,7 @@ func (c *Completer) SearchAllTables(line string, origLine string, index int) rea
 	items := make([]string, 0, len(tableKeys)+len(files)+len(c.viewList))
 	tablePath := make(map[string]bool)
 	for _, k := range tableKeys {
-		lpath := ViewCache[k].FileInfo.Path
+		lpath := c.filter.Session().ViewCache[k].FileInfo.Path
 		tablePath[lpath] = true
 		if filepath.Dir(lpath) == defaultDir {
 			items = append(items, filepath.Base(lpath))
@@ -1767,6 +1876,7 @@ func (c *Completer) SearchValues(line string, origLine string, index int) readli
 		"IS",
 		"BETWEEN",
 		"LIKE",
+		"REGEXP",
 		"IN",
 		"ANY",
 		"ALL",
@@ -1945,7 +2055,7 @@ func (c *Completer) ListFiles(path string, includeExt []string, repository strin
 				continue
 			}
 
-			if !f.IsDir() && (len(includeExt) < 1 || !InStrSliceWithCaseInsensitive(filepath.Ext(f.Name()), includeExt)) {
+			if !f.IsDir() && (len(includeExt) < 1 || !InStrSliceWithCaseInsensitive(filepath.Ext(file.TrimCompressionExt(f.Name())), includeExt)) {
 				continue
 			}
 
@@ -1980,7 +2090,7 @@ func (c *Completer) AllColumnList() []string {
 		}
 	}
 
-	for _, view := range ViewCache {
+	for _, view := range c.filter.Session().ViewCache {
 		col := c.columnList(view)
 		for _, s := range col {
 			if _, ok := m[s]; !ok {
@@ -2009,14 +2119,14 @@ func (c *Completer) ColumnList(tableName string, repository string) []string {
 	}
 
 	if fpath, err := CreateFilePath(parser.Identifier{Literal: tableName}, repository); err == nil {
-		if view, ok := ViewCache[strings.ToUpper(fpath)]; ok {
+		if view, ok := c.filter.Session().ViewCache[strings.ToUpper(fpath)]; ok {
 			list := c.columnList(view)
 			c.tableColumns[tableName] = list
 			return list
 		}
 	}
 	if fpath, err := SearchFilePathFromAllTypes(parser.Identifier{Literal: tableName}, repository); err == nil {
-		if view, ok := ViewCache[strings.ToUpper(fpath)]; ok {
+		if view, ok := c.filter.Session().ViewCache[strings.ToUpper(fpath)]; ok {
 			list := c.columnList(view)
 			c.tableColumns[tableName] = list
 			return list
@@ -2239,7 +2349,10 @@ func (c *Completer) combineFunction() {
 
 func (c *Completer) isTableObject(token parser.Token) bool {
 	return (token.Token == parser.IDENTIFIER && InStrSliceWithCaseInsensitive(token.Literal, tableObjects)) ||
-		token.Token == parser.JSON_TABLE
+		token.Token == parser.JSON_TABLE ||
+		token.Token == parser.SQLITE ||
+		token.Token == parser.XLSX ||
+		token.Token == parser.FILES
 }
 
 func (c *Completer) isFunction(token parser.Token) bool {
@@ -2349,7 +2462,10 @@ func (c *Completer) environmentVariableList(line string) []string {
 
 func (c *Completer) tableFormatList() []string {
 	list := make([]string, 0, len(cmd.FormatLiteral))
-	for _, v := range cmd.FormatLiteral {
+	for k, v := range cmd.FormatLiteral {
+		if k == cmd.SQLITE {
+			continue
+		}
 		list = append(list, v)
 	}
 	sort.Strings(list)
@@ -2357,9 +2473,9 @@ func (c *Completer) tableFormatList() []string {
 }
 
 func (c *Completer) encodingList() []string {
-	list := make([]string, 0, len(text.EncodingLiteral))
-	for _, v := range text.EncodingLiteral {
-		list = append(list, v)
+	list := make([]string, 0, len(cmd.EncodingList))
+	for _, v := range cmd.EncodingList {
+		list = append(list, string(v))
 	}
 	sort.Strings(list)
 	return list
@@ -2374,6 +2490,24 @@ func (c *Completer) lineBreakList() []string {
 	return list
 }
 
+func (c *Completer) escapeStyleList() []string {
+	list := make([]string, 0, len(cmd.EscapeStyleLiteral))
+	for _, v := range cmd.EscapeStyleLiteral {
+		list = append(list, v)
+	}
+	sort.Strings(list)
+	return list
+}
+
+func (c *Completer) parseErrorPolicyList() []string {
+	list := make([]string, 0, len(cmd.ParseErrorPolicyLiteral))
+	for _, v := range cmd.ParseErrorPolicyLiteral {
+		list = append(list, v)
+	}
+	sort.Strings(list)
+	return list
+}
+
 func (c *Completer) jsonEscapeTypeList() []string {
 	list := make([]string, 0, len(cmd.JsonEscapeTypeLiteral))
 	for _, v := range cmd.JsonEscapeTypeLiteral {
@@ -98,13 +98,13 @@ func TestCompleter_Update(t *testing.T) {
 	if len(c.runinfoList) != len(RuntimeInformatinList) || !strings.HasPrefix(c.runinfoList[0], cmd.RuntimeInformationSign) {
 		t.Error("runtime information are not set correctly")
 	}
-	if len(c.funcs) != len(Functions)+2 {
+	if len(c.funcs) != len(Functions)+5 {
 		t.Error("functions are not set correctly")
 	}
-	if len(c.aggFuncs) != len(AggregateFunctions)+2 {
+	if len(c.aggFuncs) != len(AggregateFunctions)+len(BinaryAggregateFunctions)+4 {
 		t.Error("aggregate functions are not set correctly")
 	}
-	if len(c.analyticFuncs) != len(AnalyticFunctions)+len(AggregateFunctions) {
+	if len(c.analyticFuncs) != len(AnalyticFunctions)+len(AggregateFunctions)+len(BinaryAggregateFunctions) {
 		t.Error("analytic functions are not set correctly")
 	}
 
@@ -118,13 +118,13 @@ func TestCompleter_Update(t *testing.T) {
 	if !reflect.DeepEqual(c.userFuncList, []string{"aggfunc", "scalafunc"}) {
 		t.Error("user defined functions are not set correctly")
 	}
-	if len(c.funcList) != len(Functions)+2+1 || !strings.HasSuffix(c.funcList[0], "()") {
+	if len(c.funcList) != len(Functions)+5+1 || !strings.HasSuffix(c.funcList[0], "()") {
 		t.Error("function list are not set correctly")
 	}
-	if len(c.aggFuncList) != len(AggregateFunctions)+2+1 || !strings.HasSuffix(c.aggFuncList[0], "()") {
+	if len(c.aggFuncList) != len(AggregateFunctions)+len(BinaryAggregateFunctions)+4+1 || !strings.HasSuffix(c.aggFuncList[0], "()") {
 		t.Error("aggregate function list are not set correctly")
 	}
-	if len(c.analyticFuncList) != len(AnalyticFunctions)+len(AggregateFunctions)+1 || !strings.HasSuffix(c.analyticFuncList[0], "() OVER ()") {
+	if len(c.analyticFuncList) != len(AnalyticFunctions)+len(AggregateFunctions)+len(BinaryAggregateFunctions)+1 || !strings.HasSuffix(c.analyticFuncList[0], "() OVER ()") {
 		t.Error("analytic function list are not set correctly")
 	}
 	if !reflect.DeepEqual(c.varList, []string{"@var"}) {
@@ -217,11 +217,14 @@ var completerStatementsTests = []completerTest{
 			{Name: []rune("DECLARE"), AppendSpace: true},
 			{Name: []rune("DELETE"), AppendSpace: true},
 			{Name: []rune("DISPOSE"), AppendSpace: true},
+			{Name: []rune("DROP"), AppendSpace: true},
 			{Name: []rune("ECHO"), AppendSpace: true},
 			{Name: []rune("EXECUTE"), AppendSpace: true},
 			{Name: []rune("EXIT")},
+			{Name: []rune("EXPLAIN"), AppendSpace: true},
 			{Name: []rune("FETCH"), AppendSpace: true},
 			{Name: []rune("INSERT"), AppendSpace: true},
+			{Name: []rune("MERGE"), AppendSpace: true},
 			{Name: []rune("OPEN"), AppendSpace: true},
 			{Name: []rune("PRINT"), AppendSpace: true},
 			{Name: []rune("PRINTF"), AppendSpace: true},
@@ -291,6 +294,7 @@ var completerStatementsTests = []completerTest{
 		OrigLine: "create ",
 		Index:    7,
 		Expect: readline.CandidateList{
+			{Name: []rune("INDEX"), AppendSpace: true},
 			{Name: []rune("TABLE"), AppendSpace: true},
 		},
 	},
@@ -303,6 +307,15 @@ var completerStatementsTests = []completerTest{
 			{Name: []rune("TABLE"), AppendSpace: true},
 		},
 	},
+	{
+		Name:     "Statements DROP",
+		Line:     "",
+		OrigLine: "drop ",
+		Index:    5,
+		Expect: readline.CandidateList{
+			{Name: []rune("INDEX"), AppendSpace: true},
+		},
+	},
 	{
 		Name:     "Statements DECLARE",
 		Line:     "",
@@ -606,8 +619,15 @@ var completerTableObjectArgsTests = []completerTest{
 		OrigLine: "csv(',', filepath, ",
 		Index:    19,
 		Expect: readline.CandidateList{
+			{Name: []rune("EUCJP")},
+			{Name: []rune("ISO2022JP")},
+			{Name: []rune("LATIN1")},
 			{Name: []rune("SJIS")},
+			{Name: []rune("UTF16BE")},
+			{Name: []rune("UTF16LE")},
 			{Name: []rune("UTF8")},
+			{Name: []rune("UTF8BOM")},
+			{Name: []rune("WINDOWS1252")},
 		},
 	},
 	{
@@ -663,8 +683,15 @@ var completerTableObjectArgsTests = []completerTest{
 		OrigLine: "ltsv(filepath, ",
 		Index:    15,
 		Expect: readline.CandidateList{
+			{Name: []rune("EUCJP")},
+			{Name: []rune("ISO2022JP")},
+			{Name: []rune("LATIN1")},
 			{Name: []rune("SJIS")},
+			{Name: []rune("UTF16BE")},
+			{Name: []rune("UTF16LE")},
 			{Name: []rune("UTF8")},
+			{Name: []rune("UTF8BOM")},
+			{Name: []rune("WINDOWS1252")},
 		},
 	},
 	{
@@ -993,6 +1020,7 @@ var completerWithArgsTests = []completerTest{
 		Expect: readline.CandidateList{
 			{Name: []rune("DELETE"), AppendSpace: true},
 			{Name: []rune("INSERT"), AppendSpace: true},
+			{Name: []rune("MERGE"), AppendSpace: true},
 			{Name: []rune("SELECT"), AppendSpace: true},
 			{Name: []rune("UPDATE"), AppendSpace: true},
 		},
@@ -1039,6 +1067,43 @@ func TestCompleter_WithArgs(t *testing.T) {
 	testCompleter(t, completer.WithArgs, completerWithArgsTests)
 }
 
+var completerExplainArgsTests = []completerTest{
+	{
+		Name:     "ExplainArgs",
+		Line:     "",
+		OrigLine: "explain ",
+		Index:    8,
+		Expect: readline.CandidateList{
+			{Name: []rune("ANALYZE"), AppendSpace: true},
+			{Name: []rune("SELECT"), AppendSpace: true},
+			{Name: []rune("WITH"), AppendSpace: true},
+		},
+	},
+	{
+		Name:     "ExplainArgs After ANALYZE",
+		Line:     "",
+		OrigLine: "explain analyze ",
+		Index:    16,
+		Expect: readline.CandidateList{
+			{Name: []rune("SELECT"), AppendSpace: true},
+			{Name: []rune("WITH"), AppendSpace: true},
+		},
+	},
+	{
+		Name:     "ExplainArgs Select Query",
+		Line:     "d",
+		OrigLine: "explain analyze select d",
+		Index:    24,
+		Expect: readline.CandidateList{
+			{Name: []rune("DISTINCT"), AppendSpace: true},
+		},
+	},
+}
+
+func TestCompleter_ExplainArgs(t *testing.T) {
+	testCompleter(t, completer.ExplainArgs, completerExplainArgsTests)
+}
+
 var completerSelectArgsTests = []completerTest{
 	{
 		Name:     "SelectArgs",
@@ -1101,6 +1166,7 @@ var completerSelectArgsTests = []completerTest{
 		Index:    14,
 		Expect: readline.CandidateList{
 			{Name: []rune("RANK() OVER ()")},
+			{Name: []rune("REGEXP"), AppendSpace: true},
 		},
 	},
 	{
@@ -1131,10 +1197,15 @@ var completerSelectArgsTests = []completerTest{
 		Index:    14,
 		Expect: readline.CandidateList{
 			{Name: []rune("CSV()"), AppendSpace: true},
+			{Name: []rune("FILES()"), AppendSpace: true},
 			{Name: []rune("FIXED()"), AppendSpace: true},
 			{Name: []rune("JSON()"), AppendSpace: true},
+			{Name: []rune("JSONL()"), AppendSpace: true},
 			{Name: []rune("JSON_TABLE()"), AppendSpace: true},
 			{Name: []rune("LTSV()"), AppendSpace: true},
+			{Name: []rune("PARQUET()"), AppendSpace: true},
+			{Name: []rune("SQLITE()"), AppendSpace: true},
+			{Name: []rune("XLSX()"), AppendSpace: true},
 			{Name: []rune(filepath.Join(CompletionTestDir, "sub", "table2.csv")), FormatAsIdentifier: true},
 			{Name: []rune("newtable.csv"), FormatAsIdentifier: true},
 			{Name: []rune("tempview"), FormatAsIdentifier: true},
@@ -1186,10 +1257,15 @@ var completerSelectArgsTests = []completerTest{
 		Expect: readline.CandidateList{
 			{Name: []rune("SELECT"), AppendSpace: true},
 			{Name: []rune("CSV()"), AppendSpace: true},
+			{Name: []rune("FILES()"), AppendSpace: true},
 			{Name: []rune("FIXED()"), AppendSpace: true},
 			{Name: []rune("JSON()"), AppendSpace: true},
+			{Name: []rune("JSONL()"), AppendSpace: true},
 			{Name: []rune("JSON_TABLE()"), AppendSpace: true},
 			{Name: []rune("LTSV()"), AppendSpace: true},
+			{Name: []rune("PARQUET()"), AppendSpace: true},
+			{Name: []rune("SQLITE()"), AppendSpace: true},
+			{Name: []rune("XLSX()"), AppendSpace: true},
 			{Name: []rune(filepath.Join(CompletionTestDir, "sub", "table2.csv")), FormatAsIdentifier: true},
 			{Name: []rune("newtable.csv"), FormatAsIdentifier: true},
 			{Name: []rune("tempview"), FormatAsIdentifier: true},
@@ -1655,10 +1731,15 @@ var completerInsertArgsTests = []completerTest{
 		Index:    12,
 		Expect: readline.CandidateList{
 			{Name: []rune("CSV()"), AppendSpace: true},
+			{Name: []rune("FILES()"), AppendSpace: true},
 			{Name: []rune("FIXED()"), AppendSpace: true},
 			{Name: []rune("JSON()"), AppendSpace: true},
+			{Name: []rune("JSONL()"), AppendSpace: true},
 			{Name: []rune("JSON_TABLE()"), AppendSpace: true},
 			{Name: []rune("LTSV()"), AppendSpace: true},
+			{Name: []rune("PARQUET()"), AppendSpace: true},
+			{Name: []rune("SQLITE()"), AppendSpace: true},
+			{Name: []rune("XLSX()"), AppendSpace: true},
 			{Name: []rune(filepath.Join(CompletionTestDir, "sub", "table2.csv")), FormatAsIdentifier: true},
 			{Name: []rune("newtable.csv"), FormatAsIdentifier: true},
 			{Name: []rune("tempview"), FormatAsIdentifier: true},
@@ -1729,10 +1810,15 @@ var completerUpdateArgsTests = []completerTest{
 		Index:    7,
 		Expect: readline.CandidateList{
 			{Name: []rune("CSV()"), AppendSpace: true},
+			{Name: []rune("FILES()"), AppendSpace: true},
 			{Name: []rune("FIXED()"), AppendSpace: true},
 			{Name: []rune("JSON()"), AppendSpace: true},
+			{Name: []rune("JSONL()"), AppendSpace: true},
 			{Name: []rune("JSON_TABLE()"), AppendSpace: true},
 			{Name: []rune("LTSV()"), AppendSpace: true},
+			{Name: []rune("PARQUET()"), AppendSpace: true},
+			{Name: []rune("SQLITE()"), AppendSpace: true},
+			{Name: []rune("XLSX()"), AppendSpace: true},
 			{Name: []rune(filepath.Join(CompletionTestDir, "sub", "table2.csv")), FormatAsIdentifier: true},
 			{Name: []rune("newtable.csv"), FormatAsIdentifier: true},
 			{Name: []rune("tempview"), FormatAsIdentifier: true},
@@ -1814,10 +1900,15 @@ var completerDeleteArgsTests = []completerTest{
 		Index:    12,
 		Expect: readline.CandidateList{
 			{Name: []rune("CSV()"), AppendSpace: true},
+			{Name: []rune("FILES()"), AppendSpace: true},
 			{Name: []rune("FIXED()"), AppendSpace: true},
 			{Name: []rune("JSON()"), AppendSpace: true},
+			{Name: []rune("JSONL()"), AppendSpace: true},
 			{Name: []rune("JSON_TABLE()"), AppendSpace: true},
 			{Name: []rune("LTSV()"), AppendSpace: true},
+			{Name: []rune("PARQUET()"), AppendSpace: true},
+			{Name: []rune("SQLITE()"), AppendSpace: true},
+			{Name: []rune("XLSX()"), AppendSpace: true},
 			{Name: []rune(filepath.Join(CompletionTestDir, "sub", "table2.csv")), FormatAsIdentifier: true},
 			{Name: []rune("newtable.csv"), FormatAsIdentifier: true},
 			{Name: []rune("tempview"), FormatAsIdentifier: true},
@@ -1843,10 +1934,15 @@ var completerDeleteArgsTests = []completerTest{
 		Index:    15,
 		Expect: readline.CandidateList{
 			{Name: []rune("CSV()"), AppendSpace: true},
+			{Name: []rune("FILES()"), AppendSpace: true},
 			{Name: []rune("FIXED()"), AppendSpace: true},
 			{Name: []rune("JSON()"), AppendSpace: true},
+			{Name: []rune("JSONL()"), AppendSpace: true},
 			{Name: []rune("JSON_TABLE()"), AppendSpace: true},
 			{Name: []rune("LTSV()"), AppendSpace: true},
+			{Name: []rune("PARQUET()"), AppendSpace: true},
+			{Name: []rune("SQLITE()"), AppendSpace: true},
+			{Name: []rune("XLSX()"), AppendSpace: true},
 			{Name: []rune(filepath.Join(CompletionTestDir, "sub", "table2.csv")), FormatAsIdentifier: true},
 			{Name: []rune("newtable.csv"), FormatAsIdentifier: true},
 			{Name: []rune("tempview"), FormatAsIdentifier: true},
@@ -1897,9 +1993,45 @@ var completerCreateArgsTests = []completerTest{
 		OrigLine: "create ",
 		Index:    7,
 		Expect: readline.CandidateList{
+			{Name: []rune("INDEX"), AppendSpace: true},
 			{Name: []rune("TABLE"), AppendSpace: true},
 		},
 	},
+	{
+		Name:     "CreateArgs After Index Name",
+		Line:     "",
+		OrigLine: "create index idx ",
+		Index:    17,
+		Expect: readline.CandidateList{
+			{Name: []rune("ON"), AppendSpace: true},
+		},
+	},
+	{
+		Name:     "CreateArgs After ON in Create Index",
+		Line:     "",
+		OrigLine: "create index idx on ",
+		Index:    20,
+		Expect: readline.CandidateList{
+			{Name: []rune(filepath.Join(CompletionTestDir, "sub", "table2.csv")), FormatAsIdentifier: true, AppendSpace: true},
+			{Name: []rune("newtable.csv"), FormatAsIdentifier: true, AppendSpace: true},
+			{Name: []rune("tempview"), FormatAsIdentifier: true, AppendSpace: true},
+			{Name: []rune("."), FormatAsIdentifier: true, AppendSpace: true},
+			{Name: []rune(".."), FormatAsIdentifier: true, AppendSpace: true},
+			{Name: []rune("sub/"), FormatAsIdentifier: true, AppendSpace: true},
+			{Name: []rune("table1.csv"), FormatAsIdentifier: true, AppendSpace: true},
+		},
+	},
+	{
+		Name:     "CreateArgs Columns in Create Index",
+		Line:     "",
+		OrigLine: "create index idx on `newtable.csv` (ncol1, ",
+		Index:    43,
+		Expect: readline.CandidateList{
+			{Name: []rune("ncol1"), FormatAsIdentifier: true},
+			{Name: []rune("ncol2"), FormatAsIdentifier: true},
+			{Name: []rune("ncol3"), FormatAsIdentifier: true},
+		},
+	},
 	{
 		Name:     "CreateArgs After Table Name",
 		Line:     "",
@@ -1953,6 +2085,46 @@ func TestCompleter_CreateArgs(t *testing.T) {
 	testCompleter(t, completer.CreateArgs, completerCreateArgsTests)
 }
 
+var completerDropArgsTests = []completerTest{
+	{
+		Name:     "DropArgs",
+		Line:     "",
+		OrigLine: "drop ",
+		Index:    5,
+		Expect: readline.CandidateList{
+			{Name: []rune("INDEX"), AppendSpace: true},
+		},
+	},
+	{
+		Name:     "DropArgs After Index Name",
+		Line:     "",
+		OrigLine: "drop index idx ",
+		Index:    15,
+		Expect: readline.CandidateList{
+			{Name: []rune("ON"), AppendSpace: true},
+		},
+	},
+	{
+		Name:     "DropArgs After ON",
+		Line:     "",
+		OrigLine: "drop index idx on ",
+		Index:    18,
+		Expect: readline.CandidateList{
+			{Name: []rune(filepath.Join(CompletionTestDir, "sub", "table2.csv")), FormatAsIdentifier: true},
+			{Name: []rune("newtable.csv"), FormatAsIdentifier: true},
+			{Name: []rune("tempview"), FormatAsIdentifier: true},
+			{Name: []rune("."), FormatAsIdentifier: true},
+			{Name: []rune(".."), FormatAsIdentifier: true},
+			{Name: []rune("sub/"), FormatAsIdentifier: true},
+			{Name: []rune("table1.csv"), FormatAsIdentifier: true},
+		},
+	},
+}
+
+func TestCompleter_DropArgs(t *testing.T) {
+	testCompleter(t, completer.DropArgs, completerDropArgsTests)
+}
+
 var completerAlterArgsTests = []completerTest{
 	{
 		Name:     "AlterArgs",
@@ -1970,10 +2142,15 @@ var completerAlterArgsTests = []completerTest{
 		Index:    12,
 		Expect: readline.CandidateList{
 			{Name: []rune("CSV()"), AppendSpace: true},
+			{Name: []rune("FILES()"), AppendSpace: true},
 			{Name: []rune("FIXED()"), AppendSpace: true},
 			{Name: []rune("JSON()"), AppendSpace: true},
+			{Name: []rune("JSONL()"), AppendSpace: true},
 			{Name: []rune("JSON_TABLE()"), AppendSpace: true},
 			{Name: []rune("LTSV()"), AppendSpace: true},
+			{Name: []rune("PARQUET()"), AppendSpace: true},
+			{Name: []rune("SQLITE()"), AppendSpace: true},
+			{Name: []rune("XLSX()"), AppendSpace: true},
 			{Name: []rune(filepath.Join(CompletionTestDir, "sub", "table2.csv")), FormatAsIdentifier: true, AppendSpace: true},
 			{Name: []rune("newtable.csv"), FormatAsIdentifier: true, AppendSpace: true},
 			{Name: []rune("tempview"), FormatAsIdentifier: true, AppendSpace: true},
@@ -2156,10 +2333,13 @@ var completerAlterArgsTests = []completerTest{
 			{Name: []rune("FIXED")},
 			{Name: []rune("GFM")},
 			{Name: []rune("JSON")},
+			{Name: []rune("JSONL")},
 			{Name: []rune("LTSV")},
 			{Name: []rune("ORG")},
+			{Name: []rune("PARQUET")},
 			{Name: []rune("TEXT")},
 			{Name: []rune("TSV")},
+			{Name: []rune("XLSX")},
 		},
 	},
 	{
@@ -2168,8 +2348,15 @@ var completerAlterArgsTests = []completerTest{
 		OrigLine: "alter table `newtable.csv` set encoding to ",
 		Index:    42,
 		Expect: readline.CandidateList{
+			{Name: []rune("EUCJP")},
+			{Name: []rune("ISO2022JP")},
+			{Name: []rune("LATIN1")},
 			{Name: []rune("SJIS")},
+			{Name: []rune("UTF16BE")},
+			{Name: []rune("UTF16LE")},
 			{Name: []rune("UTF8")},
+			{Name: []rune("UTF8BOM")},
+			{Name: []rune("WINDOWS1252")},
 		},
 	},
 	{
@@ -2453,8 +2640,15 @@ var completerSetArgsTests = []completerTest{
 		OrigLine: "set @@encoding to ",
 		Index:    18,
 		Expect: readline.CandidateList{
+			{Name: []rune("EUCJP")},
+			{Name: []rune("ISO2022JP")},
+			{Name: []rune("LATIN1")},
 			{Name: []rune("SJIS")},
+			{Name: []rune("UTF16BE")},
+			{Name: []rune("UTF16LE")},
 			{Name: []rune("UTF8")},
+			{Name: []rune("UTF8BOM")},
+			{Name: []rune("WINDOWS1252")},
 		},
 	},
 	{
@@ -2477,10 +2671,13 @@ var completerSetArgsTests = []completerTest{
 			{Name: []rune("FIXED")},
 			{Name: []rune("GFM")},
 			{Name: []rune("JSON")},
+			{Name: []rune("JSONL")},
 			{Name: []rune("LTSV")},
 			{Name: []rune("ORG")},
+			{Name: []rune("PARQUET")},
 			{Name: []rune("TEXT")},
 			{Name: []rune("TSV")},
+			{Name: []rune("XLSX")},
 		},
 	},
 	{
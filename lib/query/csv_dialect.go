@@ -0,0 +1,676 @@
+package query
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+
+	"github.com/mithrandie/go-text"
+	"github.com/mithrandie/go-text/csv"
+)
+
+// CSVDialect represents the options to read and write CSV that are not supported by go-text.
+// The zero value represents the default dialect.
+type CSVDialect struct {
+	Quote      rune
+	Escape     cmd.EscapeStyle
+	LazyQuotes bool
+	Comment    string
+	SkipLines  int
+}
+
+func NewCSVDialect(flags *cmd.Flags) CSVDialect {
+	d := CSVDialect{
+		Quote:      flags.QuoteChar,
+		Escape:     flags.EscapeStyle,
+		LazyQuotes: flags.LazyQuotes,
+		Comment:    flags.CommentPrefix,
+		SkipLines:  flags.SkipLines,
+	}
+	if d.Quote == '"' {
+		d.Quote = 0
+	}
+	return d
+}
+
+func (d CSVDialect) QuoteChar() rune {
+	if d.Quote == 0 {
+		return '"'
+	}
+	return d.Quote
+}
+
+func (d CSVDialect) IsDefault() bool {
+	return d.QuoteChar() == '"' &&
+		d.Escape == cmd.DoubleQuoteEscape &&
+		!d.LazyQuotes &&
+		len(d.Comment) < 1 &&
+		d.SkipLines < 1
+}
+
+func (d CSVDialect) String() string {
+	list := []string{
+		"Quote: '" + cmd.EscapeString(string(d.QuoteChar())) + "'",
+		"Escape: " + d.Escape.String(),
+	}
+	if d.LazyQuotes {
+		list = append(list, "LazyQuotes")
+	}
+	if 0 < len(d.Comment) {
+		list = append(list, "Comment: '"+cmd.EscapeString(d.Comment)+"'")
+	}
+	if 0 < d.SkipLines {
+		list = append(list, "SkipLines: "+strconv.Itoa(d.SkipLines))
+	}
+	return strings.Join(list, ", ")
+}
+
+// trimSample removes the skipped lines and the comment lines from the sample for detection.
+func (d CSVDialect) trimSample(s string) string {
+	if d.SkipLines < 1 && len(d.Comment) < 1 {
+		return s
+	}
+
+	var buf strings.Builder
+	for i := 0; 0 < len(s); i++ {
+		line := s
+		if idx := strings.IndexAny(s, "\r\n"); -1 < idx {
+			end := idx + 1
+			if s[idx] == '\r' && end < len(s) && s[end] == '\n' {
+				end++
+			}
+			line = s[:end]
+		}
+		s = s[len(line):]
+
+		if i < d.SkipLines || (0 < len(d.Comment) && strings.HasPrefix(line, d.Comment)) {
+			continue
+		}
+		buf.WriteString(line)
+	}
+	return buf.String()
+}
+
+type csvParseError struct {
+	Line    int
+	Column  int
+	Message string
+
+	unterminated bool
+}
+
+func (e *csvParseError) Error() string {
+	return fmt.Sprintf("line %d, column %d: %s", e.Line, e.Column, e.Message)
+}
+
+// csvReader is a CSV reader that supports the CSVDialect.
+// The behavior with the default dialect is the same as the reader of go-text.
+type csvReader struct {
+	Delimiter    rune
+	WithoutNull  bool
+	Encoding     text.Encoding
+	Dialect      CSVDialect
+	OnParseError cmd.ParseErrorPolicy
+
+	reader *bufio.Reader
+	line   int
+	column int
+
+	recordBuf     bytes.Buffer
+	fieldStartPos []int
+	fieldQuoted   []bool
+
+	FieldsPerRecord int
+
+	DetectedLineBreak text.LineBreak
+	EnclosedAll       bool
+
+	// SkippedLines holds the leading lines skipped by the dialect.
+	SkippedLines string
+	skipped      bool
+
+	// Rejected holds the records that are not read due to the OnParseError policy.
+	Rejected   []rejectedRow
+	raw        strings.Builder
+	recordLine int
+}
+
+func newCSVReader(r io.Reader, enc text.Encoding, dialect CSVDialect) *csvReader {
+	return &csvReader{
+		Delimiter:       ',',
+		WithoutNull:     false,
+		Encoding:        enc,
+		Dialect:         dialect,
+		reader:          bufio.NewReader(text.GetTransformDecoder(r, enc)),
+		line:            1,
+		column:          0,
+		FieldsPerRecord: 0,
+		EnclosedAll:     true,
+	}
+}
+
+func (r *csvReader) newError(s string) *csvParseError {
+	return &csvParseError{
+		Line:    r.line,
+		Column:  r.column,
+		Message: s,
+	}
+}
+
+func (r *csvReader) tolerant() bool {
+	return r.OnParseError != cmd.FailOnParseError
+}
+
+func (r *csvReader) ReadHeader() ([]string, error) {
+	record, err := r.parseRecord(true, false)
+	if err != nil {
+		return nil, err
+	}
+
+	header := make([]string, len(record))
+	for i, v := range record {
+		header[i] = string(v)
+	}
+	return header, nil
+}
+
+func (r *csvReader) Read() ([]text.RawText, error) {
+	for {
+		record, err := r.parseRecord(r.WithoutNull, r.tolerant())
+		if err == nil || !r.tolerant() {
+			return record, err
+		}
+
+		perr, ok := err.(*csvParseError)
+		if !ok {
+			return nil, err
+		}
+		if err = r.reject(perr); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// reject discards the record in which the error occurred and keeps its raw text.
+func (r *csvReader) reject(perr *csvParseError) error {
+	raw := r.raw.String()
+
+	if perr.unterminated {
+		// The unterminated quotation consumed the rest of the input,
+		// so only the first line of the record is rejected and the rest is read again.
+		if idx := strings.IndexAny(raw, "\r\n"); -1 < idx {
+			end := idx + 1
+			if raw[idx] == '\r' && end < len(raw) && raw[end] == '\n' {
+				end++
+			}
+			r.reader = bufio.NewReader(strings.NewReader(raw[end:]))
+			r.line = r.recordLine + 1
+			r.column = 0
+			raw = raw[:end]
+		}
+	} else if 0 < len(raw) && raw[len(raw)-1] != '\n' && raw[len(raw)-1] != '\r' {
+		if _, err := r.readLine(); err != nil && err != io.EOF {
+			return err
+		}
+		raw = r.raw.String()
+	}
+
+	r.Rejected = append(r.Rejected, rejectedRow{
+		Line:   r.recordLine,
+		Reason: perr.Message,
+		Text:   strings.TrimRight(raw, "\r\n"),
+	})
+	return nil
+}
+
+func (r *csvReader) ReadAll() ([][]text.RawText, error) {
+	records := make([][]text.RawText, 0)
+
+	for {
+		record, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+// capture keeps the raw text of the current record to reject it.
+func (r *csvReader) capture(s string) {
+	if r.tolerant() {
+		r.raw.WriteString(s)
+	}
+}
+
+// readLine reads a physical line including the line break.
+func (r *csvReader) readLine() (string, error) {
+	var buf strings.Builder
+	for {
+		ch, _, err := r.reader.ReadRune()
+		if err != nil {
+			if err == io.EOF && 0 < buf.Len() {
+				err = nil
+			}
+			r.capture(buf.String())
+			return buf.String(), err
+		}
+
+		buf.WriteRune(ch)
+		switch ch {
+		case '\r':
+			if nxtCh, _, err := r.reader.ReadRune(); err == nil {
+				if nxtCh == '\n' {
+					buf.WriteRune(nxtCh)
+				} else {
+					_ = r.reader.UnreadRune()
+				}
+			}
+			fallthrough
+		case '\n':
+			r.line++
+			r.column = 0
+			r.capture(buf.String())
+			return buf.String(), nil
+		}
+	}
+}
+
+func (r *csvReader) skipLeadingLines() error {
+	var buf strings.Builder
+	for i := 0; i < r.Dialect.SkipLines; i++ {
+		line, err := r.readLine()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			return err
+		}
+		buf.WriteString(line)
+	}
+	r.SkippedLines = buf.String()
+	return nil
+}
+
+func (r *csvReader) skipComment() (bool, error) {
+	prefix := r.Dialect.Comment
+	b, err := r.reader.Peek(len(prefix))
+	if err != nil {
+		if err == io.EOF {
+			return false, nil
+		}
+		return false, err
+	}
+	if string(b) != prefix {
+		return false, nil
+	}
+
+	_, err = r.readLine()
+	if err == io.EOF {
+		err = nil
+	}
+	return true, err
+}
+
+func (r *csvReader) parseRecord(withoutNull bool, tolerant bool) ([]text.RawText, error) {
+	if !r.skipped {
+		if err := r.skipLeadingLines(); err != nil {
+			return nil, err
+		}
+		r.skipped = true
+	}
+
+	r.recordBuf.Reset()
+	r.fieldStartPos = r.fieldStartPos[:0]
+	r.fieldQuoted = r.fieldQuoted[:0]
+
+	fieldIndex := 0
+	fieldPosition := 0
+	for {
+		if !tolerant && 0 < r.FieldsPerRecord && r.FieldsPerRecord <= fieldIndex {
+			return nil, r.newError("wrong number of fields in line")
+		}
+
+		if fieldIndex < 1 && r.recordBuf.Len() < 1 {
+			r.raw.Reset()
+			r.recordLine = r.line
+		}
+
+		if fieldIndex < 1 && r.recordBuf.Len() < 1 && 0 < len(r.Dialect.Comment) {
+			isComment, err := r.skipComment()
+			if err != nil {
+				return nil, err
+			}
+			if isComment {
+				continue
+			}
+		}
+
+		fieldPosition = r.recordBuf.Len()
+		quoted, eol, err := r.parseField()
+
+		if err != nil {
+			if err == io.EOF {
+				if fieldIndex < 1 && r.recordBuf.Len() < 1 {
+					return nil, io.EOF
+				}
+			} else {
+				return nil, err
+			}
+		}
+
+		if eol && fieldIndex < 1 && r.recordBuf.Len() < 1 {
+			continue
+		}
+
+		r.fieldStartPos = append(r.fieldStartPos, fieldPosition)
+		r.fieldQuoted = append(r.fieldQuoted, quoted)
+		fieldIndex++
+
+		if eol {
+			break
+		}
+	}
+
+	padding := 0
+	switch {
+	case r.FieldsPerRecord < 1:
+		r.FieldsPerRecord = fieldIndex
+	case fieldIndex < r.FieldsPerRecord && tolerant && r.OnParseError == cmd.PadOnParseError:
+		padding = r.FieldsPerRecord - fieldIndex
+	case fieldIndex != r.FieldsPerRecord:
+		perr := r.newError("wrong number of fields in line")
+		perr.Line--
+		return nil, perr
+	}
+
+	record := make([]text.RawText, 0, r.FieldsPerRecord)
+	recordStr := make([]byte, r.recordBuf.Len())
+	copy(recordStr, r.recordBuf.Bytes())
+	for i, pos := range r.fieldStartPos {
+		var endPos int
+		if i == len(r.fieldStartPos)-1 {
+			endPos = r.recordBuf.Len()
+		} else {
+			endPos = r.fieldStartPos[i+1]
+		}
+
+		if !withoutNull && pos == endPos && !r.fieldQuoted[i] {
+			record = append(record, nil)
+		} else {
+			record = append(record, recordStr[pos:endPos])
+		}
+	}
+	for i := 0; i < padding; i++ {
+		if withoutNull {
+			record = append(record, text.RawText{})
+		} else {
+			record = append(record, nil)
+		}
+	}
+
+	return record, nil
+}
+
+// readRune reads a rune and converts line breaks to '\n'.
+func (r *csvReader) readRune() (rune, text.LineBreak, error) {
+	ch, _, err := r.reader.ReadRune()
+	r.column++
+	if err != nil {
+		return ch, "", err
+	}
+
+	var lineBreak text.LineBreak
+	switch ch {
+	case '\r':
+		nxtCh, _, _ := r.reader.ReadRune()
+		if nxtCh == '\n' {
+			lineBreak = text.CRLF
+		} else {
+			_ = r.reader.UnreadRune()
+			lineBreak = text.CR
+		}
+		ch = '\n'
+	case '\n':
+		lineBreak = text.LF
+	}
+	if r.tolerant() {
+		if ch == '\n' {
+			r.raw.WriteString(lineBreak.Value())
+		} else {
+			r.raw.WriteRune(ch)
+		}
+	}
+	if ch == '\n' {
+		r.line++
+		r.column = 0
+	}
+	return ch, lineBreak, nil
+}
+
+func (r *csvReader) parseField() (bool, bool, error) {
+	var eof error
+	eol := false
+	startPos := r.recordBuf.Len()
+
+	quote := r.Dialect.QuoteChar()
+	backslash := r.Dialect.Escape == cmd.BackslashEscape
+
+	quoted := false
+	escaped := false
+
+Read:
+	for {
+		ch, lineBreak, err := r.readRune()
+
+		if err != nil {
+			if err == io.EOF {
+				if !escaped && quoted && !r.Dialect.LazyQuotes {
+					perr := r.newError(fmt.Sprintf("extraneous %c in field", quote))
+					perr.unterminated = true
+					return quoted, eol, perr
+				}
+				eol = true
+			}
+			return quoted, eol, err
+		}
+
+		if backslash && ch == '\\' && !(quoted && escaped) {
+			nxtCh, nxtLineBreak, err := r.readRune()
+			if err != nil {
+				if err != io.EOF {
+					return quoted, eol, err
+				}
+				r.column--
+				nxtCh = '\\'
+			}
+			switch {
+			case nxtCh == '\n':
+				r.recordBuf.WriteString(nxtLineBreak.Value())
+			case err == io.EOF:
+				r.recordBuf.WriteRune(nxtCh)
+			default:
+				if !quoted && r.EnclosedAll && unicode.IsLetter(nxtCh) {
+					r.EnclosedAll = false
+				}
+				r.recordBuf.WriteRune(nxtCh)
+			}
+			continue
+		}
+
+		if quoted {
+			if escaped {
+				switch {
+				case ch == quote && !backslash:
+					escaped = false
+					r.recordBuf.WriteRune(ch)
+					continue
+				case ch == r.Delimiter:
+					break Read
+				case ch == '\n':
+					if r.DetectedLineBreak == "" {
+						r.DetectedLineBreak = lineBreak
+					}
+					eol = true
+					break Read
+				case r.Dialect.LazyQuotes:
+					escaped = false
+					r.recordBuf.WriteRune(quote)
+				default:
+					r.column--
+					return quoted, eol, r.newError(fmt.Sprintf("unexpected %c in field", quote))
+				}
+			}
+
+			switch ch {
+			case quote:
+				escaped = true
+			case '\n':
+				r.recordBuf.WriteString(lineBreak.Value())
+			default:
+				r.recordBuf.WriteRune(ch)
+			}
+			continue
+		}
+
+		switch ch {
+		case '\n':
+			if r.DetectedLineBreak == "" {
+				r.DetectedLineBreak = lineBreak
+			}
+			eol = true
+			break Read
+		case r.Delimiter:
+			break Read
+		case quote:
+			if startPos == r.recordBuf.Len() {
+				quoted = true
+			} else {
+				r.recordBuf.WriteRune(ch)
+			}
+		default:
+			if r.EnclosedAll && unicode.IsLetter(ch) {
+				r.EnclosedAll = false
+			}
+			r.recordBuf.WriteRune(ch)
+		}
+	}
+
+	return quoted, eol, eof
+}
+
+type csvRecordWriter interface {
+	Write(record []csv.Field) error
+	Flush() error
+}
+
+// csvDialectWriter is a CSV writer that supports the CSVDialect.
+type csvDialectWriter struct {
+	Delimiter rune
+	Dialect   CSVDialect
+
+	writer    *bufio.Writer
+	lineBreak string
+	appended  bool
+}
+
+func newCSVDialectWriter(w io.Writer, lineBreak text.LineBreak, enc text.Encoding, dialect CSVDialect) *csvDialectWriter {
+	return &csvDialectWriter{
+		Delimiter: ',',
+		Dialect:   dialect,
+		lineBreak: lineBreak.Value(),
+		writer:    bufio.NewWriter(text.GetTransformWriter(w, enc)),
+	}
+}
+
+// WriteLines writes the lines as they are before the records.
+func (e *csvDialectWriter) WriteLines(s string) error {
+	_, err := e.writer.WriteString(s)
+	return err
+}
+
+func (e *csvDialectWriter) Write(record []csv.Field) error {
+	if e.appended {
+		if _, err := e.writer.WriteString(e.lineBreak); err != nil {
+			return err
+		}
+	} else {
+		e.appended = true
+	}
+
+	quote := e.Dialect.QuoteChar()
+	backslash := e.Dialect.Escape == cmd.BackslashEscape
+
+	for i := 0; i < len(record); i++ {
+		if 0 < i {
+			if _, err := e.writer.WriteRune(e.Delimiter); err != nil {
+				return err
+			}
+		}
+
+		if !record[i].Quote && !e.needsQuote(record[i].Contents, i == 0) {
+			if _, err := e.writer.WriteString(record[i].Contents); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if _, err := e.writer.WriteRune(quote); err != nil {
+			return err
+		}
+		for _, r := range record[i].Contents {
+			switch {
+			case r == quote && backslash:
+				if _, err := e.writer.WriteRune('\\'); err != nil {
+					return err
+				}
+			case r == quote:
+				if _, err := e.writer.WriteRune(quote); err != nil {
+					return err
+				}
+			case r == '\\' && backslash:
+				if _, err := e.writer.WriteRune('\\'); err != nil {
+					return err
+				}
+			}
+			if _, err := e.writer.WriteRune(r); err != nil {
+				return err
+			}
+		}
+		if _, err := e.writer.WriteRune(quote); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *csvDialectWriter) Flush() error {
+	return e.writer.Flush()
+}
+
+func (e *csvDialectWriter) needsQuote(s string, first bool) bool {
+	if first && 0 < len(e.Dialect.Comment) && strings.HasPrefix(s, e.Dialect.Comment) {
+		return true
+	}
+
+	quote := e.Dialect.QuoteChar()
+	for _, r := range s {
+		switch {
+		case r == e.Delimiter, r == quote, r == '\r', r == '\n':
+			return true
+		case r == '\\' && e.Dialect.Escape == cmd.BackslashEscape:
+			return true
+		}
+	}
+	return false
+}
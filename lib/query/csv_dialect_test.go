@@ -0,0 +1,198 @@
+package query
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+
+	"github.com/mithrandie/go-text"
+)
+
+var csvReaderTests = []struct {
+	Name         string
+	Input        string
+	Delimiter    rune
+	Dialect      CSVDialect
+	OnParseError cmd.ParseErrorPolicy
+	Result       [][]text.RawText
+	SkippedLines string
+	Rejected     []rejectedRow
+	Error        string
+}{
+	{
+		Name:  "Default Dialect",
+		Input: "a,b\n1,\"x\"\"y\"\n2,\n",
+		Result: [][]text.RawText{
+			{text.RawText("a"), text.RawText("b")},
+			{text.RawText("1"), text.RawText("x\"y")},
+			{text.RawText("2"), nil},
+		},
+	},
+	{
+		Name:    "Quote Character",
+		Input:   "'a,b',c\n'it''s',\"d\"\n",
+		Dialect: CSVDialect{Quote: '\''},
+		Result: [][]text.RawText{
+			{text.RawText("a,b"), text.RawText("c")},
+			{text.RawText("it's"), text.RawText("\"d\"")},
+		},
+	},
+	{
+		Name:      "Backslash Escape",
+		Input:     "\"a\\\"b\";c\\;d\n\"e\\\\\";f\\\ng\n",
+		Delimiter: ';',
+		Dialect:   CSVDialect{Escape: cmd.BackslashEscape},
+		Result: [][]text.RawText{
+			{text.RawText("a\"b"), text.RawText("c;d")},
+			{text.RawText("e\\"), text.RawText("f\ng")},
+		},
+	},
+	{
+		Name:    "Lazy Quotes",
+		Input:   "\"a\"b\",c\nd,\"e,\nf",
+		Dialect: CSVDialect{LazyQuotes: true},
+		Result: [][]text.RawText{
+			{text.RawText("a\"b"), text.RawText("c")},
+			{text.RawText("d"), text.RawText("e,\nf")},
+		},
+	},
+	{
+		Name:    "Comment Lines",
+		Input:   "-- comment\na,b\n--\"c,d\"\n1,2\n-- last",
+		Dialect: CSVDialect{Comment: "--"},
+		Result: [][]text.RawText{
+			{text.RawText("a"), text.RawText("b")},
+			{text.RawText("1"), text.RawText("2")},
+		},
+	},
+	{
+		Name:    "Skip Lines",
+		Input:   "title\r\n\r\na,b\r\n1,2\r\n",
+		Dialect: CSVDialect{SkipLines: 2},
+		Result: [][]text.RawText{
+			{text.RawText("a"), text.RawText("b")},
+			{text.RawText("1"), text.RawText("2")},
+		},
+		SkippedLines: "title\r\n\r\n",
+	},
+	{
+		Name:         "Skip Lines Exceeding File",
+		Input:        "title",
+		Dialect:      CSVDialect{SkipLines: 3},
+		Result:       [][]text.RawText{},
+		SkippedLines: "title",
+	},
+	{
+		Name:    "Extraneous Quote Error",
+		Input:   "a,'b\n",
+		Dialect: CSVDialect{Quote: '\''},
+		Error:   "line 2, column 1: extraneous ' in field",
+	},
+	{
+		Name:    "Unexpected Quote Error",
+		Input:   "'a'b',c\n",
+		Dialect: CSVDialect{Quote: '\''},
+		Error:   "line 1, column 3: unexpected ' in field",
+	},
+	{
+		Name:    "Doubled Quote in Backslash Escape Error",
+		Input:   "\"a\"\"b\"\n",
+		Dialect: CSVDialect{Escape: cmd.BackslashEscape},
+		Error:   "line 1, column 3: unexpected \" in field",
+	},
+	{
+		Name:  "Wrong Number of Fields Error",
+		Input: "a,b\n1,2\n3\n4,5\n",
+		Error: "line 3, column 0: wrong number of fields in line",
+	},
+	{
+		Name:         "Skip Wrong Number of Fields",
+		Input:        "a,b\n1,2\n3\n4,5,6\n7,8\n",
+		OnParseError: cmd.SkipOnParseError,
+		Result: [][]text.RawText{
+			{text.RawText("a"), text.RawText("b")},
+			{text.RawText("1"), text.RawText("2")},
+			{text.RawText("7"), text.RawText("8")},
+		},
+		Rejected: []rejectedRow{
+			{Line: 3, Reason: "wrong number of fields in line", Text: "3"},
+			{Line: 4, Reason: "wrong number of fields in line", Text: "4,5,6"},
+		},
+	},
+	{
+		Name:         "Pad Short Rows",
+		Input:        "a,b,c\n1\n4,5,6,7\n8,9",
+		OnParseError: cmd.PadOnParseError,
+		Result: [][]text.RawText{
+			{text.RawText("a"), text.RawText("b"), text.RawText("c")},
+			{text.RawText("1"), nil, nil},
+			{text.RawText("8"), text.RawText("9"), nil},
+		},
+		Rejected: []rejectedRow{
+			{Line: 3, Reason: "wrong number of fields in line", Text: "4,5,6,7"},
+		},
+	},
+	{
+		Name:         "Skip Broken Quotes",
+		Input:        "a,b\r\n\"x\"y,1\r\n\"m\r\nn\",1,2\r\n3,4\r\n\"z,5\r\n6,7\r\n8\r\n",
+		OnParseError: cmd.SkipOnParseError,
+		Result: [][]text.RawText{
+			{text.RawText("a"), text.RawText("b")},
+			{text.RawText("3"), text.RawText("4")},
+			{text.RawText("6"), text.RawText("7")},
+		},
+		Rejected: []rejectedRow{
+			{Line: 2, Reason: "unexpected \" in field", Text: "\"x\"y,1"},
+			{Line: 3, Reason: "wrong number of fields in line", Text: "\"m\r\nn\",1,2"},
+			{Line: 6, Reason: "extraneous \" in field", Text: "\"z,5"},
+			{Line: 8, Reason: "wrong number of fields in line", Text: "8"},
+		},
+	},
+}
+
+func TestCSVReader_ReadAll(t *testing.T) {
+	for _, v := range csvReaderTests {
+		r := newCSVReader(strings.NewReader(v.Input), text.UTF8, v.Dialect)
+		if v.Delimiter != 0 {
+			r.Delimiter = v.Delimiter
+		}
+		r.OnParseError = v.OnParseError
+
+		result, err := r.ReadAll()
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if !reflect.DeepEqual(result, v.Result) {
+			t.Errorf("%s: result = %q, want %q", v.Name, result, v.Result)
+		}
+		if r.SkippedLines != v.SkippedLines {
+			t.Errorf("%s: skipped lines = %q, want %q", v.Name, r.SkippedLines, v.SkippedLines)
+		}
+		if !reflect.DeepEqual(r.Rejected, v.Rejected) {
+			t.Errorf("%s: rejected = %v, want %v", v.Name, r.Rejected, v.Rejected)
+		}
+	}
+}
+
+func TestCSVDialect_IsDefault(t *testing.T) {
+	if !(CSVDialect{}).IsDefault() {
+		t.Errorf("zero value is not the default dialect")
+	}
+	if !(CSVDialect{Quote: '"'}).IsDefault() {
+		t.Errorf("double quotation mark is not the default quotation character")
+	}
+	if (CSVDialect{SkipLines: 1}).IsDefault() {
+		t.Errorf("dialect skipping lines is treated as the default dialect")
+	}
+}
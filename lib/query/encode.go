@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/file"
 	"github.com/mithrandie/csvq/lib/json"
 	"github.com/mithrandie/csvq/lib/value"
 
@@ -20,33 +21,91 @@ import (
 	"github.com/mithrandie/go-text/ltsv"
 	"github.com/mithrandie/go-text/table"
 	"github.com/mithrandie/ternary"
+	"github.com/parquet-go/parquet-go"
 )
 
-type EmptyResultSetError struct{}
+// EmptyResultSetError is returned when the result set cannot be written in
+// the format. Warning is shown instead of the result set.
+type EmptyResultSetError struct {
+	Warning string
+}
 
 func (e EmptyResultSetError) Error() string {
 	return "empty result set"
 }
 
-func NewEmptyResultSetError() *EmptyResultSetError {
-	return &EmptyResultSetError{}
+func NewEmptyResultSetError(warning string) *EmptyResultSetError {
+	return &EmptyResultSetError{
+		Warning: warning,
+	}
 }
 
-func EncodeView(fp io.Writer, view *View, fileInfo *FileInfo) error {
+func EncodeView(fp io.Writer, view *View, fileInfo *FileInfo, flags *cmd.Flags) error {
 	switch fileInfo.Format {
-	case cmd.FIXED:
-		return encodeFixedLengthFormat(fp, view, fileInfo.DelimiterPositions, fileInfo.LineBreak, fileInfo.NoHeader, fileInfo.Encoding)
 	case cmd.JSON:
-		return encodeJson(fp, view, fileInfo.LineBreak, fileInfo.JsonEscape, fileInfo.PrettyPrint)
-	case cmd.LTSV:
-		return encodeLTSV(fp, view, fileInfo.LineBreak, fileInfo.Encoding)
+		return encodeJson(fp, view, fileInfo.LineBreak, fileInfo.JsonEscape, fileInfo.PrettyPrint, flags.Color)
+	case cmd.PARQUET:
+		return encodeParquet(fp, view)
+	case cmd.XLSX:
+		return encodeXlsx(fp, view, fileInfo)
+	}
+
+	w := newEncodeWriter(fp, fileInfo)
+	if err := encodeTextView(w, view, fileInfo, flags); err != nil {
+		_ = w.Close()
+		return err
+	}
+	return w.Close()
+}
+
+func encodeTextView(fp io.Writer, view *View, fileInfo *FileInfo, flags *cmd.Flags) error {
+	switch fileInfo.Format {
+	case cmd.FIXED:
+		if fileInfo.DelimiterPositions != nil {
+			return encodeStream(fp, view.Header.TableColumnNames(), NewViewIterator(view), fileInfo)
+		}
+		return encodeFixedLengthFormat(fp, view, fileInfo.LineBreak, fileInfo.NoHeader, fileInfo.Encoding)
 	case cmd.GFM, cmd.ORG, cmd.TEXT:
-		return encodeText(fp, view, fileInfo.Format, fileInfo.LineBreak, fileInfo.NoHeader, fileInfo.Encoding)
+		return encodeText(fp, view, fileInfo.Format, fileInfo.LineBreak, fileInfo.NoHeader, fileInfo.Encoding, flags)
+	default: // cmd.CSV, cmd.TSV, cmd.LTSV, cmd.JSONL
+		return encodeStream(fp, view.Header.TableColumnNames(), NewViewIterator(view), fileInfo)
+	}
+}
+
+func encodeViewToFile(fp io.Writer, view *View, fileInfo *FileInfo, flags *cmd.Flags) error {
+	w, err := file.NewCompressWriter(fp, fileInfo.Compression)
+	if err != nil {
+		return err
+	}
+	if err = EncodeView(w, view, fileInfo, flags); err != nil {
+		_ = w.Close()
+		return err
+	}
+	return w.Close()
+}
+
+func EncodeStream(fp io.Writer, header []string, records RecordIterator, fileInfo *FileInfo) error {
+	w := newEncodeWriter(fp, fileInfo)
+	if err := encodeStream(w, header, records, fileInfo); err != nil {
+		_ = w.Close()
+		return err
+	}
+	return w.Close()
+}
+
+func encodeStream(fp io.Writer, header []string, records RecordIterator, fileInfo *FileInfo) error {
+	switch fileInfo.Format {
+	case cmd.FIXED:
+		return encodeFixedLengthFormatWithPositions(fp, header, records, fileInfo.DelimiterPositions, fileInfo.LineBreak, fileInfo.NoHeader, fileInfo.Encoding)
+	case cmd.LTSV:
+		return encodeLTSV(fp, header, records, fileInfo.LineBreak, fileInfo.Encoding)
+	case cmd.JSONL:
+		return encodeJsonLines(fp, header, records, fileInfo.LineBreak, fileInfo.JsonEscape)
 	case cmd.TSV:
 		fileInfo.Delimiter = '\t'
 		fallthrough
 	default: // cmd.CSV
-		return encodeCSV(fp, view, fileInfo.Delimiter, fileInfo.LineBreak, fileInfo.NoHeader, fileInfo.Encoding, fileInfo.EncloseAll)
+		return encodeCSV(fp, header, records, fileInfo.Delimiter, fileInfo.LineBreak, fileInfo.NoHeader, fileInfo.Encoding, fileInfo.EncloseAll, fileInfo.Dialect, fileInfo.SkippedLines)
 	}
 }
 
@@ -63,11 +122,20 @@ func bareValues(view *View) ([]string, [][]value.Primary) {
 	return header, records
 }
 
-func encodeCSV(fp io.Writer, view *View, delimiter rune, lineBreak text.LineBreak, withoutHeader bool, encoding text.Encoding, encloseAll bool) error {
-	header, records := bareValues(view)
-
-	w := csv.NewWriter(fp, lineBreak, encoding)
-	w.Delimiter = delimiter
+func encodeCSV(fp io.Writer, header []string, records RecordIterator, delimiter rune, lineBreak text.LineBreak, withoutHeader bool, encoding text.Encoding, encloseAll bool, dialect CSVDialect, skippedLines string) error {
+	var w csvRecordWriter
+	if dialect.IsDefault() {
+		cw := csv.NewWriter(fp, lineBreak, encoding)
+		cw.Delimiter = delimiter
+		w = cw
+	} else {
+		dw := newCSVDialectWriter(fp, lineBreak, encoding, dialect)
+		dw.Delimiter = delimiter
+		if err := dw.WriteLines(skippedLines); err != nil {
+			return err
+		}
+		w = dw
+	}
 
 	fields := make([]csv.Field, len(header))
 
@@ -80,9 +148,17 @@ func encodeCSV(fp io.Writer, view *View, delimiter rune, lineBreak text.LineBrea
 		}
 	}
 
-	for _, record := range records {
-		for i, v := range record {
-			str, e, _ := ConvertFieldContents(v, false)
+	for {
+		record, err := records.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		for i, cell := range record {
+			str, e, _ := ConvertFieldContents(cell.Value(), false)
 			quote := false
 			if encloseAll && (e == cmd.StringEffect || e == cmd.DatetimeEffect) {
 				quote = true
@@ -97,72 +173,80 @@ func encodeCSV(fp io.Writer, view *View, delimiter rune, lineBreak text.LineBrea
 	return nil
 }
 
-func encodeFixedLengthFormat(fp io.Writer, view *View, positions []int, lineBreak text.LineBreak, withoutHeader bool, encoding text.Encoding) error {
+func encodeFixedLengthFormat(fp io.Writer, view *View, lineBreak text.LineBreak, withoutHeader bool, encoding text.Encoding) error {
 	header, records := bareValues(view)
 
-	if positions == nil {
-		m := fixedlen.NewMeasure()
-		m.Encoding = encoding
+	m := fixedlen.NewMeasure()
+	m.Encoding = encoding
 
-		fieldList := make([][]fixedlen.Field, 0, len(records)+1)
-		if !withoutHeader {
-			fields := make([]fixedlen.Field, 0, len(header))
-			for _, v := range header {
-				fields = append(fields, fixedlen.NewField(v, text.NotAligned))
-			}
-			fieldList = append(fieldList, fields)
-			m.Measure(fields)
+	fieldList := make([][]fixedlen.Field, 0, len(records)+1)
+	if !withoutHeader {
+		fields := make([]fixedlen.Field, 0, len(header))
+		for _, v := range header {
+			fields = append(fields, fixedlen.NewField(v, text.NotAligned))
 		}
+		fieldList = append(fieldList, fields)
+		m.Measure(fields)
+	}
 
-		for _, record := range records {
-			fields := make([]fixedlen.Field, 0, len(record))
-			for _, v := range record {
-				str, _, a := ConvertFieldContents(v, false)
-				fields = append(fields, fixedlen.NewField(str, a))
-			}
-			fieldList = append(fieldList, fields)
-			m.Measure(fields)
+	for _, record := range records {
+		fields := make([]fixedlen.Field, 0, len(record))
+		for _, v := range record {
+			str, _, a := ConvertFieldContents(v, false)
+			fields = append(fields, fixedlen.NewField(str, a))
 		}
+		fieldList = append(fieldList, fields)
+		m.Measure(fields)
+	}
 
-		positions = m.GeneratePositions()
-		w := fixedlen.NewWriter(fp, positions, lineBreak, encoding)
-		w.InsertSpace = true
-		for _, fields := range fieldList {
-			if err := w.Write(fields); err != nil {
-				return err
-			}
+	positions := m.GeneratePositions()
+	w := fixedlen.NewWriter(fp, positions, lineBreak, encoding)
+	w.InsertSpace = true
+	for _, fields := range fieldList {
+		if err := w.Write(fields); err != nil {
+			return err
 		}
-		w.Flush()
+	}
+	w.Flush()
+	return nil
+}
 
-	} else {
-		w := fixedlen.NewWriter(fp, positions, lineBreak, encoding)
+func encodeFixedLengthFormatWithPositions(fp io.Writer, header []string, records RecordIterator, positions []int, lineBreak text.LineBreak, withoutHeader bool, encoding text.Encoding) error {
+	w := fixedlen.NewWriter(fp, positions, lineBreak, encoding)
 
-		fields := make([]fixedlen.Field, len(header))
+	fields := make([]fixedlen.Field, len(header))
 
-		if !withoutHeader {
-			for i, v := range header {
-				fields[i] = fixedlen.NewField(v, text.NotAligned)
-			}
-			if err := w.Write(fields); err != nil {
-				return err
-			}
+	if !withoutHeader {
+		for i, v := range header {
+			fields[i] = fixedlen.NewField(v, text.NotAligned)
+		}
+		if err := w.Write(fields); err != nil {
+			return err
 		}
+	}
 
-		for _, record := range records {
-			for i, v := range record {
-				str, _, a := ConvertFieldContents(v, false)
-				fields[i] = fixedlen.NewField(str, a)
-			}
-			if err := w.Write(fields); err != nil {
-				return err
-			}
+	for {
+		record, err := records.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		for i, cell := range record {
+			str, _, a := ConvertFieldContents(cell.Value(), false)
+			fields[i] = fixedlen.NewField(str, a)
+		}
+		if err := w.Write(fields); err != nil {
+			return err
 		}
-		w.Flush()
 	}
+	w.Flush()
 	return nil
 }
 
-func encodeJson(fp io.Writer, view *View, lineBreak text.LineBreak, escapeType txjson.EscapeType, prettyPrint bool) error {
+func encodeJson(fp io.Writer, view *View, lineBreak text.LineBreak, escapeType txjson.EscapeType, prettyPrint bool, useColor bool) error {
 	header, records := bareValues(view)
 
 	data, err := json.ConvertTableValueToJsonStructure(header, records)
@@ -174,7 +258,7 @@ func encodeJson(fp io.Writer, view *View, lineBreak text.LineBreak, escapeType t
 	e.EscapeType = escapeType
 	e.LineBreak = lineBreak
 	e.PrettyPrint = prettyPrint
-	if prettyPrint && cmd.GetFlags().Color {
+	if prettyPrint && useColor {
 		e.Palette, _ = cmd.GetPalette()
 	}
 
@@ -190,7 +274,51 @@ func encodeJson(fp io.Writer, view *View, lineBreak text.LineBreak, escapeType t
 	return w.Flush()
 }
 
-func encodeText(fp io.Writer, view *View, format cmd.Format, lineBreak text.LineBreak, withoutHeader bool, encoding text.Encoding) error {
+func encodeJsonLines(fp io.Writer, header []string, records RecordIterator, lineBreak text.LineBreak, escapeType txjson.EscapeType) error {
+	pathes, err := json.ParsePathes(header)
+	if err != nil {
+		return errors.New(fmt.Sprintf("encoding to json failed: %s", err.Error()))
+	}
+
+	e := txjson.NewEncoder()
+	e.EscapeType = escapeType
+
+	w := bufio.NewWriter(fp)
+	row := make([]value.Primary, len(header))
+	isFirst := true
+	for {
+		record, err := records.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		for i, cell := range record {
+			row[i] = cell.Value()
+		}
+		data, err := json.ConvertRecordValueToJsonStructure(pathes, row)
+		if err != nil {
+			return errors.New(fmt.Sprintf("encoding to json failed: %s", err.Error()))
+		}
+		if data == nil {
+			data = txjson.NewObject(0)
+		}
+
+		if isFirst {
+			isFirst = false
+		} else if _, err := w.WriteString(lineBreak.Value()); err != nil {
+			return err
+		}
+		if _, err := w.WriteString(e.Encode(data)); err != nil {
+			return err
+		}
+	}
+	return w.Flush()
+}
+
+func encodeText(fp io.Writer, view *View, format cmd.Format, lineBreak text.LineBreak, withoutHeader bool, encoding text.Encoding, flags *cmd.Flags) error {
 	header, records := bareValues(view)
 
 	isPlainTable := false
@@ -203,21 +331,19 @@ func encodeText(fp io.Writer, view *View, format cmd.Format, lineBreak text.Line
 		tableFormat = table.OrgTable
 	default:
 		if len(header) < 1 {
-			LogWarn("Empty Fields", cmd.GetFlags().Quiet)
-			return NewEmptyResultSetError()
+			return NewEmptyResultSetError("Empty Fields")
 		}
 		if len(records) < 1 {
-			LogWarn("Empty RecordSet", cmd.GetFlags().Quiet)
-			return NewEmptyResultSetError()
+			return NewEmptyResultSetError("Empty RecordSet")
 		}
 		isPlainTable = true
 	}
 
 	e := table.NewEncoder(tableFormat, len(records))
 	e.LineBreak = lineBreak
-	e.EastAsianEncoding = cmd.GetFlags().EastAsianEncoding
-	e.CountDiacriticalSign = cmd.GetFlags().CountDiacriticalSign
-	e.CountFormatCode = cmd.GetFlags().CountFormatCode
+	e.EastAsianEncoding = flags.EastAsianEncoding
+	e.CountDiacriticalSign = flags.CountDiacriticalSign
+	e.CountFormatCode = flags.CountFormatCode
 	e.WithoutHeader = withoutHeader
 	e.Encoding = encoding
 
@@ -298,17 +424,24 @@ func encodeText(fp io.Writer, view *View, format cmd.Format, lineBreak text.Line
 	return w.Flush()
 }
 
-func encodeLTSV(fp io.Writer, view *View, lineBreak text.LineBreak, encoding text.Encoding) error {
-	header, records := bareValues(view)
+func encodeLTSV(fp io.Writer, header []string, records RecordIterator, lineBreak text.LineBreak, encoding text.Encoding) error {
 	w, err := ltsv.NewWriter(fp, header, lineBreak, encoding)
 	if err != nil {
 		return err
 	}
 
 	fields := make([]string, len(header))
-	for _, record := range records {
-		for i, v := range record {
-			fields[i], _, _ = ConvertFieldContents(v, false)
+	for {
+		record, err := records.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		for i, cell := range record {
+			fields[i], _, _ = ConvertFieldContents(cell.Value(), false)
 		}
 		if err := w.Write(fields); err != nil {
 			return err
@@ -318,6 +451,42 @@ func encodeLTSV(fp io.Writer, view *View, lineBreak text.LineBreak, encoding tex
 	return nil
 }
 
+func encodeParquet(fp io.Writer, view *View) error {
+	header := view.Header.TableColumnNames()
+	if len(header) < 1 {
+		return errors.New("parquet format requires at least one field")
+	}
+
+	colTypes := make([]parquetColumnType, len(header))
+	nodes := make([]parquet.Node, len(header))
+	names := make(map[string]bool, len(header))
+	for i, name := range header {
+		if names[name] {
+			return errors.New(fmt.Sprintf("field name %s is duplicated in parquet format", name))
+		}
+		names[name] = true
+
+		colTypes[i] = inferParquetColumnType(view, i)
+		nodes[i] = colTypes[i].Node()
+	}
+
+	schema := parquet.NewSchema("csvq", newParquetGroup(header, nodes))
+	w := parquet.NewWriter(fp, schema, parquet.Compression(&parquet.Snappy))
+
+	rows := make([]parquet.Row, 0, view.RecordLen())
+	for _, record := range view.RecordSet {
+		row := make(parquet.Row, len(record))
+		for i, cell := range record {
+			row[i] = colTypes[i].Value(cell.Value(), i)
+		}
+		rows = append(rows, row)
+	}
+	if _, err := w.WriteRows(rows); err != nil {
+		return err
+	}
+	return w.Close()
+}
+
 func ConvertFieldContents(val value.Primary, forTextTable bool) (string, string, text.FieldAlignment) {
 	var s string
 	var effect = cmd.NoEffect
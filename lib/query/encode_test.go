@@ -2,7 +2,10 @@ package query
 
 import (
 	"bytes"
+	"os"
+	"reflect"
 	"testing"
+	"time"
 
 	"github.com/mithrandie/csvq/lib/cmd"
 	"github.com/mithrandie/csvq/lib/value"
@@ -25,6 +28,9 @@ var encodeViewTests = []struct {
 	EncloseAll              bool
 	JsonEscape              json.EscapeType
 	PrettyPrint             bool
+	BOM                     bool
+	Dialect                 CSVDialect
+	SkippedLines            string
 	UseColor                bool
 	Result                  string
 	Error                   string
@@ -192,6 +198,52 @@ var encodeViewTests = []struct {
 			"2.0123,\"2016-02-01T16:00:00.123456-07:00\",\"abcdef\"\n" +
 			"34567890,\" abcdefghijklmnopqrstuvwxyzabcdefg\nhi\"\"jk\n\",",
 	},
+	{
+		Name: "CSV With Byte Order Mark",
+		View: &View{
+			Header: NewHeader("test", []string{"c1", "c2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewInteger(-1), value.NewString("abc")}),
+			},
+		},
+		Format:         cmd.CSV,
+		WriteDelimiter: ';',
+		BOM:            true,
+		Result:         "\ufeffc1;c2\n-1;abc",
+	},
+	{
+		Name: "CSV With Dialect",
+		View: &View{
+			Header: NewHeader("test", []string{"c1", "c2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewString("#1"), value.NewString("it's")}),
+				NewRecord([]value.Primary{value.NewString("2"), value.NewString("a\\b")}),
+				NewRecord([]value.Primary{value.NewString("3"), value.NewString("abc")}),
+			},
+		},
+		Format:       cmd.CSV,
+		Dialect:      CSVDialect{Quote: '\'', Escape: cmd.BackslashEscape, Comment: "#"},
+		SkippedLines: "banner\n",
+		Result: "banner\n" +
+			"c1,c2\n" +
+			"'#1','it\\'s'\n" +
+			"2,'a\\\\b'\n" +
+			"3,abc",
+	},
+	{
+		Name: "CSV With Dialect Doubled Quotes",
+		View: &View{
+			Header: NewHeader("test", []string{"c1", "c2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewString("a|b"), value.NewString("it's")}),
+			},
+		},
+		Format:     cmd.CSV,
+		EncloseAll: true,
+		Dialect:    CSVDialect{Quote: '|', LazyQuotes: true},
+		Result: "|c1|,|c2|\n" +
+			"|a||b|,|it's|",
+	},
 	{
 		Name: "CSV Line Break CRLF",
 		View: &View{
@@ -318,6 +370,21 @@ var encodeViewTests = []struct {
 			"  }\n" +
 			"]",
 	},
+	{
+		Name: "JSON Lines",
+		View: &View{
+			Header: NewHeader("test", []string{"c1", "c2.key"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewInteger(-1), value.NewString("abc\\def")}),
+				NewRecord([]value.Primary{value.NewFloat(2.0123), value.NewNull()}),
+			},
+		},
+		Format:     cmd.JSONL,
+		LineBreak:  text.CRLF,
+		JsonEscape: json.HexDigits,
+		Result: "{\"c1\":-1,\"c2\":{\"key\":\"abc\\u005cdef\"}}\r\n" +
+			"{\"c1\":2.0123,\"c2\":{\"key\":null}}",
+	},
 	{
 		Name: "LTSV",
 		View: &View{
@@ -382,6 +449,17 @@ var encodeViewTests = []struct {
 		Format: cmd.LTSV,
 		Error:  "unpermitted character in field-value: U+0009",
 	},
+	{
+		Name: "Parquet Duplicate Field Name Error",
+		View: &View{
+			Header: NewHeader("test", []string{"c1", "c1"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewInteger(1), value.NewInteger(2)}),
+			},
+		},
+		Format: cmd.PARQUET,
+		Error:  "field name c1 is duplicated in parquet format",
+	},
 	{
 		Name: "CSV Encode Character Code",
 		View: &View{
@@ -402,6 +480,70 @@ var encodeViewTests = []struct {
 			"2.0123,\"2016-02-01T16:00:00.123456-07:00\",\"abcdef\"\n" +
 			"34567890,\" " + string([]byte{0x93, 0xfa, 0x96, 0x7b, 0x8c, 0xea}) + "ghijklmnopqrstuvwxyzabcdefg\nhi\"\"jk\n\",",
 	},
+	{
+		Name: "CSV Encode UTF-16LE",
+		View: &View{
+			Header: NewHeader("test", []string{"c1"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewString("日本")}),
+			},
+		},
+		Format:        cmd.CSV,
+		WriteEncoding: cmd.UTF16LE,
+		Result:        string([]byte{0xff, 0xfe, 'c', 0x00, '1', 0x00, '\n', 0x00, 0xe5, 0x65, 0x2c, 0x67}),
+	},
+	{
+		Name: "CSV Encode UTF-8 with Byte Order Mark",
+		View: &View{
+			Header: NewHeader("test", []string{"c1"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewString("abc")}),
+			},
+		},
+		Format:        cmd.CSV,
+		WriteEncoding: cmd.UTF8BOM,
+		Result:        "\ufeffc1\nabc",
+	},
+	{
+		Name: "LTSV Encode Windows-1252",
+		View: &View{
+			Header: NewHeader("test", []string{"c1"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewString("café €")}),
+			},
+		},
+		Format:        cmd.LTSV,
+		WriteEncoding: cmd.WINDOWS1252,
+		Result:        "c1:caf" + string([]byte{0xe9}) + " " + string([]byte{0x80}),
+	},
+	{
+		Name: "Text Encode EUC-JP",
+		View: &View{
+			Header: NewHeader("test", []string{"c1"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewString("日本")}),
+			},
+		},
+		Format:        cmd.TEXT,
+		WriteEncoding: cmd.EUCJP,
+		Result: "+------+\n" +
+			"|  c1  |\n" +
+			"+------+\n" +
+			"| " + string([]byte{0xc6, 0xfc, 0xcb, 0xdc}) + " |\n" +
+			"+------+",
+	},
+	{
+		Name: "CSV Encode Unsupported Character in Latin-1",
+		View: &View{
+			Header: NewHeader("test", []string{"c1"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewString("日本")}),
+			},
+		},
+		Format:        cmd.CSV,
+		WriteEncoding: cmd.LATIN1,
+		Error:         "encoding: rune not supported by encoding.",
+	},
 }
 
 func TestEncodeView(t *testing.T) {
@@ -429,10 +571,13 @@ func TestEncodeView(t *testing.T) {
 			EncloseAll:         v.EncloseAll,
 			JsonEscape:         v.JsonEscape,
 			PrettyPrint:        v.PrettyPrint,
+			BOM:                v.BOM,
+			Dialect:            v.Dialect,
+			SkippedLines:       v.SkippedLines,
 		}
 
 		buf.Reset()
-		err := EncodeView(buf, v.View, fileInfo)
+		err := EncodeView(buf, v.View, fileInfo, cmd.GetFlags())
 		if err != nil {
 			if len(v.Error) < 1 {
 				t.Errorf("%s: unexpected error %q", v.Name, err)
@@ -452,3 +597,75 @@ func TestEncodeView(t *testing.T) {
 		}
 	}
 }
+
+func TestEncodeView_Parquet(t *testing.T) {
+	view := &View{
+		Header: NewHeader("test", []string{"int", "float", "bool", "datetime", "string", "null"}),
+		RecordSet: []Record{
+			NewRecord([]value.Primary{
+				value.NewInteger(1),
+				value.NewInteger(2),
+				value.NewTernary(ternary.TRUE),
+				value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123456000, GetTestLocation())),
+				value.NewString("str1"),
+				value.NewNull(),
+			}),
+			NewRecord([]value.Primary{
+				value.NewNull(),
+				value.NewFloat(-1.5),
+				value.NewTernary(ternary.UNKNOWN),
+				value.NewNull(),
+				value.NewInteger(2),
+				value.NewNull(),
+			}),
+		},
+	}
+	expect := RecordSet{
+		NewRecord([]value.Primary{
+			value.NewInteger(1),
+			value.NewFloat(2),
+			value.NewBoolean(true),
+			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123456000, GetTestLocation())),
+			value.NewString("str1"),
+			value.NewNull(),
+		}),
+		NewRecord([]value.Primary{
+			value.NewNull(),
+			value.NewFloat(-1.5),
+			value.NewNull(),
+			value.NewNull(),
+			value.NewString("2"),
+			value.NewNull(),
+		}),
+	}
+
+	fpath := GetTestFilePath("encode_parquet.parquet")
+	fp, err := os.Create(fpath)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	defer os.Remove(fpath)
+
+	err = EncodeView(fp, view, &FileInfo{Format: cmd.PARQUET}, cmd.GetFlags())
+	fp.Close()
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+
+	fp, err = os.Open(fpath)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	defer fp.Close()
+
+	result, err := loadViewFromParquetFile(fp, &FileInfo{Path: fpath, Format: cmd.PARQUET}, false)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if !reflect.DeepEqual(result.Header.TableColumnNames(), view.Header.TableColumnNames()) {
+		t.Errorf("header = %q, want %q", result.Header.TableColumnNames(), view.Header.TableColumnNames())
+	}
+	if !reflect.DeepEqual(result.RecordSet, expect) {
+		t.Errorf("records = %s, want %s", result.RecordSet, expect)
+	}
+}
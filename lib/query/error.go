@@ -28,6 +28,8 @@ const (
 	ErrorNotGroupingRecords                   = "function %s cannot aggregate not grouping records"
 	ErrorUndeclaredVariable                   = "variable %s is undeclared"
 	ErrorVariableRedeclared                   = "variable %s is redeclared"
+	ErrorUnboundPlaceholder                   = "value for placeholder %s is not bound"
+	ErrorInvalidRegExp                        = "pattern %s for %s is invalid: %s"
 	ErrorFunctionNotExist                     = "function %s does not exist"
 	ErrorFunctionArgumentsLength              = "function %s takes %s"
 	ErrorFunctionInvalidArgument              = "%s for function %s"
@@ -36,6 +38,10 @@ const (
 	ErrorFunctionRedeclared                   = "function %s is redeclared"
 	ErrorBuiltInFunctionDeclared              = "function %s is a built-in function"
 	ErrorDuplicateParameter                   = "parameter %s is a duplicate"
+	ErrorInvalidWindowFrame                   = "invalid window frame for function %s: %s"
+	ErrorWindowRedefined                      = "window %s is redefined"
+	ErrorUndefinedWindow                      = "window %s is undefined"
+	ErrorWindowNotExtendable                  = "window %s cannot be extended: %s"
 	ErrorSubqueryTooManyRecords               = "subquery returns too many records, should return only one record"
 	ErrorSubqueryTooManyFields                = "subquery returns too many fields, should return only one field"
 	ErrorJsonQueryTooManyRecords              = "json query returns too many records, should return only one record"
@@ -49,6 +55,8 @@ const (
 	ErrorTableObjectArgumentsLength           = "table object %s takes at most %d arguments"
 	ErrorTableObjectJsonArgumentsLength       = "table object %s takes exactly %d arguments"
 	ErrorTableObjectInvalidArgument           = "invalid argument for %s: %s"
+	ErrorInvalidUnpivotColumn                 = "%s is not a column to unpivot"
+	ErrorDuplicatePivotValue                  = "pivot value %s is a duplicate"
 	ErrorCursorRedeclared                     = "cursor %s is redeclared"
 	ErrorUndeclaredCursor                     = "cursor %s is undeclared"
 	ErrorCursorClosed                         = "cursor %s is closed"
@@ -64,6 +72,8 @@ const (
 	ErrorFileUnableToRead                     = "file %s is unable to be read"
 	ErrorFileLockTimeout                      = "file %s: lock wait timeout period exceeded"
 	ErrorFileNameAmbiguous                    = "filename %s is ambiguous"
+	ErrorInvalidFilePattern                   = "file pattern %s is invalid"
+	ErrorMultipleFilesUpdate                  = "table %s is loaded from multiple files and cannot be updated"
 	ErrorDataParsing                          = "data parse error in file %s: %s"
 	ErrorTableFieldLength                     = "select query should return exactly %s for table %s"
 	ErrorTemporaryTableRedeclared             = "view %s is redeclared"
@@ -83,6 +93,8 @@ const (
 	ErrorUpdateFieldNotExist                  = "field %s does not exist in the tables to update"
 	ErrorUpdateValueAmbiguous                 = "value %s to set in the field %s is ambiguous"
 	ErrorDeleteTableNotSpecified              = "tables to delete records are not specified"
+	ErrorMergeTargetAmbiguous                 = "record in %s to merge is matched with multiple records"
+	ErrorSqliteQueryNotUpdatable              = "table %s is a result of a sqlite query and cannot be updated"
 	ErrorShowInvalidObjectType                = "object type %s is invalid"
 	ErrorReplaceValueLength                   = "%s"
 	ErrorSourceInvalidFilePath                = "%s is a invalid file path"
@@ -98,7 +110,15 @@ const (
 	ErrorInvalidTableAttributeName            = "table attribute %s does not exist"
 	ErrorTableAttributeValueNotAllowedFormat  = "%s for %s is not allowed"
 	ErrorInvalidTableAttributeValue           = "%s"
+	ErrorInvalidIndexName                     = "%s is an invalid index name"
+	ErrorIndexAlreadyExists                   = "index %s already exists on %s"
+	ErrorIndexNotExist                        = "index %s does not exist on %s"
+	ErrorIndexNotSupported                    = "index cannot be created on %s: %s"
+	ErrorInvalidColumnType                    = "%s is an unknown column type"
+	ErrorColumnTypeMismatch                   = "value %s for field %s is not a valid %s"
+	ErrorNotNullViolation                     = "field %s does not allow null values"
 	ErrorInvalidEventName                     = "%s is an unknown event"
+	ErrorRaiseOutsideCatch                    = "raise is not allowed outside of a catch block"
 	ErrorInternalRecordIdNotExist             = "internal record id does not exist"
 	ErrorInternalRecordIdEmpty                = "internal record id is empty"
 	ErrorFieldLengthNotMatch                  = "field length does not match"
@@ -107,6 +127,9 @@ const (
 	ErrorUnknownFormatPlaceholder             = "%q is an unknown placeholder"
 	ErrorFormatUnexpectedTermination          = "unexpected termination of format string"
 	ErrorExternalCommand                      = "external command: %s"
+	ErrorExternalCommandNotAllowed            = "external commands are not allowed in this session"
+	ErrorFileAccessNotAllowed                 = "file %s is not accessible in this session"
+	ErrorStatementNotAllowed                  = "%s is not allowed in this session"
 	ErrorInvalidReloadType                    = "%s is an unknown reload type"
 	ErrorLoadConfiguration                    = "configuration loading error: %s"
 )
@@ -133,6 +156,7 @@ type AppError interface {
 	Error() string
 	ErrorMessage() string
 	GetCode() int
+	GetLine() int
 }
 
 type BaseError struct {
@@ -165,6 +189,10 @@ func (e BaseError) GetCode() int {
 	return e.Code
 }
 
+func (e BaseError) GetLine() int {
+	return e.Line
+}
+
 func NewBaseError(expr parser.Expression, message string) *BaseError {
 	return NewBaseErrorWithCode(expr, message, 1)
 }
@@ -367,6 +395,26 @@ func NewVariableRedeclaredError(expr parser.Variable) error {
 	}
 }
 
+type UnboundPlaceholderError struct {
+	*BaseError
+}
+
+func NewUnboundPlaceholderError(expr parser.Placeholder) error {
+	return &UnboundPlaceholderError{
+		NewBaseError(expr, fmt.Sprintf(ErrorUnboundPlaceholder, expr)),
+	}
+}
+
+type InvalidRegExpError struct {
+	*BaseError
+}
+
+func NewInvalidRegExpError(expr parser.RegExp, pattern value.Primary, message string) error {
+	return &InvalidRegExpError{
+		NewBaseError(expr, fmt.Sprintf(ErrorInvalidRegExp, pattern, expr.RegExp, message)),
+	}
+}
+
 type FunctionNotExistError struct {
 	*BaseError
 }
@@ -419,6 +467,46 @@ func NewFunctionInvalidArgumentError(function parser.QueryExpression, funcname s
 	}
 }
 
+type InvalidWindowFrameError struct {
+	*BaseError
+}
+
+func NewInvalidWindowFrameError(function parser.AnalyticFunction, message string) error {
+	return &InvalidWindowFrameError{
+		NewBaseError(function, fmt.Sprintf(ErrorInvalidWindowFrame, function.Name, message)),
+	}
+}
+
+type WindowRedefinedError struct {
+	*BaseError
+}
+
+func NewWindowRedefinedError(window parser.Identifier) error {
+	return &WindowRedefinedError{
+		NewBaseError(window, fmt.Sprintf(ErrorWindowRedefined, window)),
+	}
+}
+
+type UndefinedWindowError struct {
+	*BaseError
+}
+
+func NewUndefinedWindowError(window parser.Identifier) error {
+	return &UndefinedWindowError{
+		NewBaseError(window, fmt.Sprintf(ErrorUndefinedWindow, window)),
+	}
+}
+
+type WindowNotExtendableError struct {
+	*BaseError
+}
+
+func NewWindowNotExtendableError(window parser.Identifier, message string) error {
+	return &WindowNotExtendableError{
+		NewBaseError(window, fmt.Sprintf(ErrorWindowNotExtendable, window, message)),
+	}
+}
+
 type UnpermittedStatementFunctionError struct {
 	*BaseError
 }
@@ -599,6 +687,26 @@ func NewTableObjectInvalidArgumentError(expr parser.TableObject, message string)
 	}
 }
 
+type InvalidUnpivotColumnError struct {
+	*BaseError
+}
+
+func NewInvalidUnpivotColumnError(expr parser.QueryExpression) error {
+	return &InvalidUnpivotColumnError{
+		NewBaseError(expr, fmt.Sprintf(ErrorInvalidUnpivotColumn, expr)),
+	}
+}
+
+type DuplicatePivotValueError struct {
+	*BaseError
+}
+
+func NewDuplicatePivotValueError(field parser.Field) error {
+	return &DuplicatePivotValueError{
+		NewBaseError(field, fmt.Sprintf(ErrorDuplicatePivotValue, field.Object)),
+	}
+}
+
 type CursorRedeclaredError struct {
 	*BaseError
 }
@@ -751,6 +859,26 @@ func NewFileNameAmbiguousError(file parser.Identifier) error {
 	}
 }
 
+type InvalidFilePatternError struct {
+	*BaseError
+}
+
+func NewInvalidFilePatternError(file parser.Identifier) error {
+	return &InvalidFilePatternError{
+		NewBaseError(file, fmt.Sprintf(ErrorInvalidFilePattern, file)),
+	}
+}
+
+type MultipleFilesUpdateError struct {
+	*BaseError
+}
+
+func NewMultipleFilesUpdateError(file parser.Identifier) error {
+	return &MultipleFilesUpdateError{
+		NewBaseError(file, fmt.Sprintf(ErrorMultipleFilesUpdate, file)),
+	}
+}
+
 type DataParsingError struct {
 	*BaseError
 }
@@ -949,6 +1077,26 @@ func NewDeleteTableNotSpecifiedError(query parser.DeleteQuery) error {
 	}
 }
 
+type MergeTargetAmbiguousError struct {
+	*BaseError
+}
+
+func NewMergeTargetAmbiguousError(when parser.MergeWhen, table parser.Identifier) error {
+	return &MergeTargetAmbiguousError{
+		NewBaseError(when, fmt.Sprintf(ErrorMergeTargetAmbiguous, table)),
+	}
+}
+
+type SqliteQueryNotUpdatableError struct {
+	*BaseError
+}
+
+func NewSqliteQueryNotUpdatableError(table parser.Identifier) error {
+	return &SqliteQueryNotUpdatableError{
+		NewBaseError(table, fmt.Sprintf(ErrorSqliteQueryNotUpdatable, table)),
+	}
+}
+
 type ShowInvalidObjectTypeError struct {
 	*BaseError
 }
@@ -1109,6 +1257,16 @@ func NewInvalidEventNameError(expr parser.Identifier) error {
 	}
 }
 
+type RaiseOutsideCatchError struct {
+	*BaseError
+}
+
+func NewRaiseOutsideCatchError(expr parser.Raise) error {
+	return &RaiseOutsideCatchError{
+		NewBaseError(expr, ErrorRaiseOutsideCatch),
+	}
+}
+
 type InternalRecordIdNotExistError struct {
 	*BaseError
 }
@@ -1191,6 +1349,106 @@ func NewExternalCommandError(expr parser.Expression, message string) error {
 	}
 }
 
+type ExternalCommandNotAllowedError struct {
+	*BaseError
+}
+
+func NewExternalCommandNotAllowedError(expr parser.Expression) error {
+	return &ExternalCommandNotAllowedError{
+		NewBaseError(expr, ErrorExternalCommandNotAllowed),
+	}
+}
+
+type FileAccessNotAllowedError struct {
+	*BaseError
+}
+
+func NewFileAccessNotAllowedError(expr parser.Expression, fpath string) error {
+	return &FileAccessNotAllowedError{
+		NewBaseError(expr, fmt.Sprintf(ErrorFileAccessNotAllowed, fpath)),
+	}
+}
+
+type StatementNotAllowedError struct {
+	*BaseError
+}
+
+func NewStatementNotAllowedError(expr parser.Expression, statement string) error {
+	return &StatementNotAllowedError{
+		NewBaseError(expr, fmt.Sprintf(ErrorStatementNotAllowed, statement)),
+	}
+}
+
+type InvalidIndexNameError struct {
+	*BaseError
+}
+
+func NewInvalidIndexNameError(name parser.Identifier) error {
+	return &InvalidIndexNameError{
+		NewBaseError(name, fmt.Sprintf(ErrorInvalidIndexName, name)),
+	}
+}
+
+type IndexAlreadyExistsError struct {
+	*BaseError
+}
+
+func NewIndexAlreadyExistsError(name parser.Identifier, path string) error {
+	return &IndexAlreadyExistsError{
+		NewBaseError(name, fmt.Sprintf(ErrorIndexAlreadyExists, name, path)),
+	}
+}
+
+type IndexNotExistError struct {
+	*BaseError
+}
+
+func NewIndexNotExistError(name parser.Identifier, path string) error {
+	return &IndexNotExistError{
+		NewBaseError(name, fmt.Sprintf(ErrorIndexNotExist, name, path)),
+	}
+}
+
+type IndexNotSupportedError struct {
+	*BaseError
+}
+
+func NewIndexNotSupportedError(table parser.Identifier, path string, message string) error {
+	return &IndexNotSupportedError{
+		NewBaseError(table, fmt.Sprintf(ErrorIndexNotSupported, path, message)),
+	}
+}
+
+type InvalidColumnTypeError struct {
+	*BaseError
+}
+
+func NewInvalidColumnTypeError(columnType parser.Identifier) error {
+	return &InvalidColumnTypeError{
+		NewBaseError(columnType, fmt.Sprintf(ErrorInvalidColumnType, columnType.Literal)),
+	}
+}
+
+type ColumnTypeMismatchError struct {
+	*BaseError
+}
+
+func NewColumnTypeMismatchError(expr parser.Expression, val value.Primary, column string, columnType string) error {
+	return &ColumnTypeMismatchError{
+		NewBaseError(expr, fmt.Sprintf(ErrorColumnTypeMismatch, val, column, columnType)),
+	}
+}
+
+type NotNullViolationError struct {
+	*BaseError
+}
+
+func NewNotNullViolationError(expr parser.Expression, column string) error {
+	return &NotNullViolationError{
+		NewBaseError(expr, fmt.Sprintf(ErrorNotNullViolation, column)),
+	}
+}
+
 type InvalidReloadTypeError struct {
 	*BaseError
 }
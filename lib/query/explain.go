@@ -0,0 +1,678 @@
+package query
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/go-text"
+	txjson "github.com/mithrandie/go-text/json"
+)
+
+// PlanNode represents a stage of a select query.
+// Rows and Elapsed are set only by EXPLAIN ANALYZE.
+type PlanNode struct {
+	Operation string
+	Detail    string
+	Rows      int
+	Elapsed   time.Duration
+	Children  []*PlanNode
+}
+
+// Explain returns the query plan of a select query.
+// If the analyze option is specified, the query is executed to measure each stage.
+func Explain(expr parser.Explain, parentFilter *Filter) (*PlanNode, error) {
+	if !expr.Analyze {
+		return queryPlanner{filter: parentFilter}.selectPlan(expr.Query)
+	}
+
+	filter := parentFilter.CreateNode()
+	filter.plan = &planRecorder{nodes: []*PlanNode{{}}}
+	if _, err := Select(expr.Query, filter); err != nil {
+		return nil, err
+	}
+	return filter.plan.nodes[0].Children[0], nil
+}
+
+type planRecorder struct {
+	nodes     []*PlanNode
+	suspended int
+}
+
+type planStage struct {
+	recorder *planRecorder
+	node     *PlanNode
+	start    time.Time
+}
+
+func (f *Filter) startPlanStage(operation string, detail string) *planStage {
+	if f.plan == nil || 0 < f.plan.suspended {
+		return nil
+	}
+
+	node := &PlanNode{Operation: operation, Detail: detail}
+	parent := f.plan.nodes[len(f.plan.nodes)-1]
+	parent.Children = append(parent.Children, node)
+	f.plan.nodes = append(f.plan.nodes, node)
+
+	return &planStage{
+		recorder: f.plan,
+		node:     node,
+		start:    time.Now(),
+	}
+}
+
+func (f *Filter) describePlanStage(detail string) {
+	if f.plan == nil || 0 < f.plan.suspended {
+		return
+	}
+	f.plan.nodes[len(f.plan.nodes)-1].Detail = detail
+}
+
+func (f *Filter) suspendPlan() {
+	if f.plan != nil {
+		f.plan.suspended++
+	}
+}
+
+func (f *Filter) resumePlan() {
+	if f.plan != nil {
+		f.plan.suspended--
+	}
+}
+
+func (s *planStage) finish(rows int) {
+	if s == nil {
+		return
+	}
+	s.node.Rows = rows
+	s.node.Elapsed = time.Since(s.start)
+	s.recorder.nodes = s.recorder.nodes[:len(s.recorder.nodes)-1]
+}
+
+type queryPlanner struct {
+	filter         *Filter
+	inlineTables   []string
+	recursiveTable string
+}
+
+func (p queryPlanner) selectPlan(query parser.SelectQuery) (*PlanNode, error) {
+	node := &PlanNode{Operation: "Select"}
+
+	if query.WithClause != nil {
+		inlineTables := append(make([]string, 0, len(p.inlineTables)), p.inlineTables...)
+		for _, v := range query.WithClause.(parser.WithClause).InlineTables {
+			inlineTable := v.(parser.InlineTable)
+
+			planner := p
+			planner.inlineTables = inlineTables
+			if inlineTable.IsRecursive() {
+				planner.recursiveTable = inlineTable.Name.Literal
+			}
+			child, err := planner.selectPlan(inlineTable.Query)
+			if err != nil {
+				return nil, err
+			}
+			node.Children = append(node.Children, &PlanNode{
+				Operation: "Inline Table",
+				Detail:    inlineTable.Name.Literal,
+				Children:  []*PlanNode{child},
+			})
+			inlineTables = append(inlineTables, inlineTable.Name.Literal)
+		}
+		p.inlineTables = inlineTables
+	}
+
+	if entity, ok := query.SelectEntity.(parser.SelectEntity); ok {
+		children, err := p.entityPlan(entity)
+		if err != nil {
+			return nil, err
+		}
+		node.Children = append(node.Children, children...)
+	} else {
+		child, err := p.setPlan(query.SelectEntity.(parser.SelectSet))
+		if err != nil {
+			return nil, err
+		}
+		node.Children = append(node.Children, child)
+	}
+
+	if query.OrderByClause != nil {
+		node.Children = append(node.Children, &PlanNode{Operation: "Order By", Detail: listPlanItems(query.OrderByClause.(parser.OrderByClause).Items)})
+	}
+	if query.OffsetClause != nil {
+		node.Children = append(node.Children, &PlanNode{Operation: "Offset", Detail: query.OffsetClause.(parser.OffsetClause).Value.String()})
+	}
+	if query.LimitClause != nil {
+		node.Children = append(node.Children, &PlanNode{Operation: "Limit", Detail: limitPlanDetail(query.LimitClause.(parser.LimitClause))})
+	}
+
+	return node, nil
+}
+
+func (p queryPlanner) setPlan(set parser.SelectSet) (*PlanNode, error) {
+	node := &PlanNode{Operation: setPlanOperation(set)}
+	if 0 < len(p.recursiveTable) {
+		node.Detail = "recursive"
+	}
+
+	for _, expr := range []parser.QueryExpression{set.LHS, set.RHS} {
+		var child *PlanNode
+		var err error
+
+		switch expr.(type) {
+		case parser.Subquery:
+			child, err = p.selectPlan(expr.(parser.Subquery).Query)
+		case parser.SelectEntity:
+			child = &PlanNode{Operation: "Select"}
+			child.Children, err = p.entityPlan(expr.(parser.SelectEntity))
+		default:
+			child, err = p.setPlan(expr.(parser.SelectSet))
+		}
+		if err != nil {
+			return nil, err
+		}
+		node.Children = append(node.Children, child)
+	}
+
+	return node, nil
+}
+
+func (p queryPlanner) entityPlan(entity parser.SelectEntity) ([]*PlanNode, error) {
+	var tables []parser.QueryExpression
+	if entity.FromClause != nil {
+		tables = entity.FromClause.(parser.FromClause).Tables
+	}
+	if tables == nil {
+		var obj parser.QueryExpression
+		if cmd.IsReadableFromPipeOrRedirection() {
+			obj = parser.Stdin{Stdin: "stdin"}
+		} else {
+			obj = parser.Dual{}
+		}
+		tables = []parser.QueryExpression{parser.Table{Object: obj}}
+	}
+
+	var loadNode *PlanNode
+	if 1 < len(tables) {
+		loadNode = &PlanNode{Operation: "Cross Join"}
+		for _, table := range tables {
+			child, err := p.tablePlan(table)
+			if err != nil {
+				return nil, err
+			}
+			loadNode.Children = append(loadNode.Children, child)
+		}
+	} else {
+		var err error
+		if loadNode, err = p.tablePlan(tables[0]); err != nil {
+			return nil, err
+		}
+	}
+
+	if entity.WhereClause != nil && len(tables) == 1 {
+		loadNode.Detail += p.indexPlanDetail(tables[0], entity.WhereClause.(parser.WhereClause).Filter)
+	}
+
+	nodes := []*PlanNode{loadNode}
+
+	if entity.WhereClause != nil {
+		nodes = append(nodes, &PlanNode{Operation: "Where", Detail: entity.WhereClause.(parser.WhereClause).Filter.String()})
+	}
+	if entity.GroupByClause != nil {
+		nodes = append(nodes, &PlanNode{Operation: "Group By", Detail: listPlanItems(entity.GroupByClause.(parser.GroupByClause).Items)})
+	}
+	if entity.HavingClause != nil {
+		nodes = append(nodes, &PlanNode{Operation: "Having", Detail: entity.HavingClause.(parser.HavingClause).Filter.String()})
+	}
+
+	selectClause := entity.SelectClause.(parser.SelectClause)
+	fieldsNode := &PlanNode{Operation: "Fields", Detail: fieldsPlanDetail(selectClause)}
+	analyticFunctions := make([]string, 0)
+	for _, f := range selectClause.Fields {
+		if fn, ok := f.(parser.Field).Object.(parser.AnalyticFunction); ok && !InStrSliceWithCaseInsensitive(fn.String(), analyticFunctions) {
+			analyticFunctions = append(analyticFunctions, fn.String())
+			fieldsNode.Children = append(fieldsNode.Children, &PlanNode{Operation: "Analytic Function", Detail: fn.String()})
+		}
+	}
+	nodes = append(nodes, fieldsNode)
+
+	return nodes, nil
+}
+
+func (p queryPlanner) tablePlan(tableExpr parser.QueryExpression) (*PlanNode, error) {
+	if parentheses, ok := tableExpr.(parser.Parentheses); ok {
+		return p.tablePlan(parentheses.Expr)
+	}
+
+	table := tableExpr.(parser.Table)
+	node := &PlanNode{Operation: tablePlanOperation(table), Detail: tablePlanDetail(table)}
+
+	switch table.Object.(type) {
+	case parser.Stdin:
+		flags := p.filter.Flags()
+		path := table.Object.String()
+		node.Detail = filePlanDetail(path, flags.SelectImportFormat(), p.filter.TempViews[len(p.filter.TempViews)-1].Exists(path))
+	case parser.Identifier:
+		flags := p.filter.Flags()
+		detail, err := p.objectPlanDetail(table.Object.(parser.Identifier), cmd.AutoSelect, flags.Delimiter, flags.Encoding)
+		if err != nil {
+			return nil, err
+		}
+		node.Detail = detail
+	case parser.TableObject:
+		tableObject := table.Object.(parser.TableObject)
+		if strings.EqualFold(tableObject.Type.Literal, cmd.SQLITE.String()) {
+			sqliteTable, err := newSqliteTable(tableObject, p.filter)
+			if err != nil {
+				return nil, err
+			}
+			node.Detail = sqlitePlanDetail(sqliteTable, p.filter.Session().ViewCache.Exists(sqliteTable.Key()))
+			break
+		}
+		if strings.EqualFold(tableObject.Type.Literal, cmd.XLSX.String()) {
+			xlsxTable, err := newXlsxTable(tableObject, p.filter)
+			if err != nil {
+				return nil, err
+			}
+			node.Detail = filePlanDetail(xlsxTable.Key(), cmd.XLSX, p.filter.Session().ViewCache.Exists(xlsxTable.Key()))
+			break
+		}
+		if strings.EqualFold(tableObject.Type.Literal, "FILES") {
+			pattern, err := filesObjectPattern(tableObject, p.filter)
+			if err != nil {
+				return nil, err
+			}
+			flags := p.filter.Flags()
+			detail, err := p.objectPlanDetail(pattern, flags.SelectImportFormat(), flags.Delimiter, flags.Encoding)
+			if err != nil {
+				return nil, err
+			}
+			node.Detail = detail
+			break
+		}
+
+		flags := p.filter.Flags()
+		delimiter := flags.Delimiter
+		encoding := flags.Encoding
+
+		var format cmd.Format
+		switch strings.ToUpper(tableObject.Type.Literal) {
+		case cmd.CSV.String():
+			format = cmd.CSV
+			if tableObject.FormatElement != nil {
+				if felem, err := p.filter.Evaluate(tableObject.FormatElement); err == nil {
+					if s := value.ToString(felem); !value.IsNull(s) {
+						if d := []rune(cmd.UnescapeString(s.(value.String).Raw())); len(d) == 1 {
+							delimiter = d[0]
+						}
+					}
+				}
+			}
+			if delimiter == '\t' {
+				format = cmd.TSV
+			}
+		case cmd.FIXED.String():
+			format = cmd.FIXED
+		case cmd.JSON.String():
+			format = cmd.JSON
+			encoding = text.UTF8
+		case cmd.JSONL.String():
+			format = cmd.JSONL
+			encoding = text.UTF8
+		case cmd.LTSV.String():
+			format = cmd.LTSV
+		case cmd.PARQUET.String():
+			format = cmd.PARQUET
+			encoding = text.UTF8
+		default:
+			return nil, NewTableObjectInvalidObjectError(tableObject, tableObject.Type.Literal)
+		}
+
+		detail, err := p.objectPlanDetail(tableObject.Path, format, delimiter, encoding)
+		if err != nil {
+			return nil, err
+		}
+		node.Detail = detail
+	case parser.Join:
+		join := table.Object.(parser.Join)
+		for _, expr := range []parser.QueryExpression{join.Table, join.JoinTable} {
+			child, err := p.tablePlan(expr)
+			if err != nil {
+				return nil, err
+			}
+			node.Children = append(node.Children, child)
+		}
+
+		if joinPlanType(join) != parser.CROSS {
+			method := "nested loop"
+			if !join.Natural.IsEmpty() || hasEquiJoinCandidate(join.Condition) {
+				method = "hash join"
+			}
+			node.Detail = joinPlanDetail(method, join)
+		}
+	case parser.PivotTable:
+		child, err := p.tablePlan(table.Object.(parser.PivotTable).Table)
+		if err != nil {
+			return nil, err
+		}
+		node.Children = append(node.Children, child)
+	case parser.UnpivotTable:
+		child, err := p.tablePlan(table.Object.(parser.UnpivotTable).Table)
+		if err != nil {
+			return nil, err
+		}
+		node.Children = append(node.Children, child)
+	case parser.Subquery:
+		child, err := p.selectPlan(table.Object.(parser.Subquery).Query)
+		if err != nil {
+			return nil, err
+		}
+		node.Children = append(node.Children, child)
+	}
+
+	return node, nil
+}
+
+func (p queryPlanner) objectPlanDetail(tableIdentifier parser.Identifier, format cmd.Format, delimiter rune, encoding text.Encoding) (string, error) {
+	if 0 < len(p.recursiveTable) && strings.EqualFold(tableIdentifier.Literal, p.recursiveTable) {
+		return "recursive table: " + tableIdentifier.Literal, nil
+	}
+	if InStrSliceWithCaseInsensitive(tableIdentifier.Literal, p.inlineTables) {
+		return "inline table: " + tableIdentifier.Literal, nil
+	}
+	if _, err := p.filter.InlineTables.Get(tableIdentifier); err == nil {
+		return "inline table: " + tableIdentifier.Literal, nil
+	}
+	if p.filter.TempViews.Exists(tableIdentifier.Literal) {
+		return "temporary table: " + tableIdentifier.Literal, nil
+	}
+
+	viewCache := p.filter.Session().ViewCache
+
+	if IsFilePattern(tableIdentifier, p.filter.Flags().Repository) {
+		key, pathes, err := SearchFilePathsByPattern(tableIdentifier, p.filter.Flags().Repository)
+		if err != nil {
+			return "", err
+		}
+		for _, fpath := range pathes {
+			if err = p.filter.Session().CheckFileAccess(tableIdentifier, fpath); err != nil {
+				return "", err
+			}
+		}
+		if viewCache.Exists(key) {
+			return filesPlanDetail(key, viewCache[strings.ToUpper(key)].FileInfo.Format, true, len(pathes)), nil
+		}
+		_, format, err = SearchFilePath(parser.Identifier{BaseExpr: tableIdentifier.BaseExpr, Literal: pathes[0]}, p.filter.Flags(), format)
+		if err != nil {
+			return "", err
+		}
+		return filesPlanDetail(key, format, false, len(pathes)), nil
+	}
+
+	filePath, err := CreateFilePath(tableIdentifier, p.filter.Flags().Repository)
+	if err != nil {
+		return "", err
+	}
+	if !viewCache.Exists(filePath) {
+		fileInfo, err := NewFileInfo(tableIdentifier, p.filter.Flags(), format, delimiter, encoding)
+		if err != nil {
+			return "", err
+		}
+		if err = p.filter.Session().CheckFileAccess(tableIdentifier, fileInfo.Path); err != nil {
+			return "", err
+		}
+		filePath = fileInfo.Path
+		if !viewCache.Exists(filePath) {
+			return filePlanDetail(filePath, fileInfo.Format, false), nil
+		}
+	}
+	return filePlanDetail(filePath, viewCache[strings.ToUpper(filePath)].FileInfo.Format, true), nil
+}
+
+func (p queryPlanner) indexPlanDetail(tableExpr parser.QueryExpression, condition parser.QueryExpression) string {
+	table, tableIdentifier, ok := indexableTable(tableExpr)
+	if !ok ||
+		(0 < len(p.recursiveTable) && strings.EqualFold(tableIdentifier.Literal, p.recursiveTable)) ||
+		InStrSliceWithCaseInsensitive(tableIdentifier.Literal, p.inlineTables) ||
+		p.filter.TempViews.Exists(tableIdentifier.Literal) {
+		return ""
+	}
+	if _, err := p.filter.InlineTables.Get(tableIdentifier); err == nil {
+		return ""
+	}
+
+	selection, err := selectTableIndex(tableIdentifier, table.Name().Literal, condition, p.filter)
+	if err != nil || selection == nil {
+		return ""
+	}
+	return ", index: " + selection.Attributes.Name
+}
+
+func hasEquiJoinCandidate(condition parser.QueryExpression) bool {
+	jc, ok := condition.(parser.JoinCondition)
+	if !ok {
+		return false
+	}
+	if jc.Using != nil {
+		return true
+	}
+
+	isField := func(expr parser.QueryExpression) bool {
+		switch expr.(type) {
+		case parser.FieldReference, parser.ColumnNumber:
+			return true
+		}
+		return false
+	}
+
+	for _, expr := range conjunctiveTerms(jc.On) {
+		if comp, ok := expr.(parser.Comparison); ok && comp.Operator == "=" && isField(comp.LHS) && isField(comp.RHS) {
+			return true
+		}
+	}
+	return false
+}
+
+func tablePlanOperation(table parser.Table) string {
+	switch table.Object.(type) {
+	case parser.Join:
+		join := table.Object.(parser.Join)
+
+		s := make([]string, 0, 4)
+		if !join.Natural.IsEmpty() {
+			s = append(s, "Natural")
+		}
+		switch joinPlanType(join) {
+		case parser.CROSS:
+			s = append(s, "Cross")
+		case parser.INNER:
+			s = append(s, "Inner")
+		default:
+			switch join.Direction.Token {
+			case parser.RIGHT:
+				s = append(s, "Right")
+			case parser.FULL:
+				s = append(s, "Full")
+			default:
+				s = append(s, "Left")
+			}
+			s = append(s, "Outer")
+		}
+		return strings.Join(append(s, "Join"), " ")
+	case parser.PivotTable:
+		return "Pivot"
+	case parser.UnpivotTable:
+		return "Unpivot"
+	case parser.Subquery:
+		return "Subquery"
+	}
+	return "Load"
+}
+
+func tablePlanDetail(table parser.Table) string {
+	switch table.Object.(type) {
+	case parser.Dual:
+		return "dual"
+	case parser.JsonQuery:
+		return "json table: " + table.Name().Literal
+	case parser.Subquery, parser.PivotTable, parser.UnpivotTable:
+		if table.Alias != nil {
+			return table.Alias.(parser.Identifier).Literal
+		}
+	}
+	return ""
+}
+
+func joinPlanType(join parser.Join) int {
+	if join.JoinType.IsEmpty() {
+		if join.Direction.IsEmpty() {
+			return parser.INNER
+		}
+		return parser.OUTER
+	}
+	return join.JoinType.Token
+}
+
+func joinPlanDetail(method string, join parser.Join) string {
+	if join.Condition == nil {
+		return method
+	}
+	return method + " " + join.Condition.String()
+}
+
+func filePlanDetail(path string, format cmd.Format, cached bool) string {
+	cache := "miss"
+	if cached {
+		cache = "hit"
+	}
+	return fmt.Sprintf("path: %s, format: %s, cache: %s", path, format, cache)
+}
+
+func setPlanOperation(set parser.SelectSet) string {
+	var s string
+	switch set.Operator.Token {
+	case parser.UNION:
+		s = "Union"
+	case parser.EXCEPT:
+		s = "Except"
+	default:
+		s = "Intersect"
+	}
+	if !set.All.IsEmpty() {
+		s = s + " All"
+	}
+	return s
+}
+
+func fieldsPlanDetail(clause parser.SelectClause) string {
+	s := listPlanItems(clause.Fields)
+	if clause.IsDistinct() {
+		s = "DISTINCT " + s
+	}
+	return s
+}
+
+func limitPlanDetail(clause parser.LimitClause) string {
+	s := clause.Value.String()
+	if clause.IsPercentage() {
+		s = s + " PERCENT"
+	}
+	if clause.IsWithTies() {
+		s = s + " WITH TIES"
+	}
+	return s
+}
+
+func listPlanItems(items []parser.QueryExpression) string {
+	s := make([]string, len(items))
+	for i, v := range items {
+		s[i] = v.String()
+	}
+	return strings.Join(s, ", ")
+}
+
+// EncodePlan writes the query plan as a nested json structure if the format is JSON,
+// otherwise as a table in the specified format.
+func EncodePlan(fp io.Writer, plan *PlanNode, analyze bool, fileInfo *FileInfo, flags *cmd.Flags) error {
+	if fileInfo.Format != cmd.JSON {
+		return EncodeView(fp, planView(plan, analyze), fileInfo, flags)
+	}
+
+	e := txjson.NewEncoder()
+	e.EscapeType = flags.JsonEscape
+	e.LineBreak = fileInfo.LineBreak
+	e.PrettyPrint = fileInfo.PrettyPrint
+	if fileInfo.PrettyPrint && flags.Color {
+		e.Palette, _ = cmd.GetPalette()
+	}
+
+	s := e.Encode(planJsonStructure(plan, analyze))
+	if e.Palette != nil {
+		e.Palette.Enable()
+	}
+
+	w := bufio.NewWriter(fp)
+	if _, err := w.WriteString(s); err != nil {
+		return err
+	}
+	return w.Flush()
+}
+
+func planView(plan *PlanNode, analyze bool) *View {
+	labels := []string{"Operation", "Detail"}
+	if analyze {
+		labels = append(labels, "Rows", "Time")
+	}
+
+	records := make(RecordSet, 0, 10)
+
+	var appendRecords func(node *PlanNode, depth int)
+	appendRecords = func(node *PlanNode, depth int) {
+		values := []value.Primary{
+			value.NewString(strings.Repeat("  ", depth) + node.Operation),
+			value.NewString(node.Detail),
+		}
+		if analyze {
+			values = append(values,
+				value.NewInteger(int64(node.Rows)),
+				value.NewString(cmd.FormatNumber(node.Elapsed.Seconds(), 6, ".", ",", "")),
+			)
+		}
+		records = append(records, NewRecord(values))
+
+		for _, child := range node.Children {
+			appendRecords(child, depth+1)
+		}
+	}
+	appendRecords(plan, 0)
+
+	view := NewView()
+	view.Header = NewHeader("", labels)
+	view.RecordSet = records
+	return view
+}
+
+func planJsonStructure(node *PlanNode, analyze bool) txjson.Structure {
+	obj := txjson.NewObject(5)
+	obj.Add("operation", txjson.String(node.Operation))
+	obj.Add("detail", txjson.String(node.Detail))
+	if analyze {
+		obj.Add("rows", txjson.Number(node.Rows))
+		obj.Add("time", txjson.Number(node.Elapsed.Seconds()))
+	}
+
+	children := make(txjson.Array, 0, len(node.Children))
+	for _, child := range node.Children {
+		children = append(children, planJsonStructure(child, analyze))
+	}
+	obj.Add("children", children)
+
+	return obj
+}
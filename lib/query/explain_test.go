@@ -0,0 +1,309 @@
+package query
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/parser"
+
+	"github.com/mithrandie/go-text"
+)
+
+var explainTests = []struct {
+	Name   string
+	Query  string
+	Result *PlanNode
+	Error  string
+}{
+	{
+		Name:  "Explain",
+		Query: "explain select t1.column1, count(*) from table1 t1 inner join table2 t2 on t1.column1 = t2.column3 where t1.column1 < 3 group by t1.column1 having count(*) > 0 order by 1 limit 1 offset 1",
+		Result: &PlanNode{
+			Operation: "Select",
+			Children: []*PlanNode{
+				{
+					Operation: "Inner Join",
+					Detail:    "hash join on t1.column1 = t2.column3",
+					Children: []*PlanNode{
+						{Operation: "Load", Detail: "path: " + GetTestFilePath("table1.csv") + ", format: CSV, cache: miss"},
+						{Operation: "Load", Detail: "path: " + GetTestFilePath("table2.csv") + ", format: CSV, cache: miss"},
+					},
+				},
+				{Operation: "Where", Detail: "t1.column1 < 3"},
+				{Operation: "Group By", Detail: "t1.column1"},
+				{Operation: "Having", Detail: "count(*) > 0"},
+				{Operation: "Fields", Detail: "t1.column1, count(*)"},
+				{Operation: "Order By", Detail: "1"},
+				{Operation: "Offset", Detail: "1"},
+				{Operation: "Limit", Detail: "1"},
+			},
+		},
+	},
+	{
+		Name:  "Explain Set Operation and Inline Table",
+		Query: "explain with it as (select 1 as c1) select c1, row_number() over (order by c1) from it union all select * from (select 2, 3) t",
+		Result: &PlanNode{
+			Operation: "Select",
+			Children: []*PlanNode{
+				{
+					Operation: "Inline Table",
+					Detail:    "it",
+					Children: []*PlanNode{
+						{
+							Operation: "Select",
+							Children: []*PlanNode{
+								{Operation: "Load", Detail: "dual"},
+								{Operation: "Fields", Detail: "1 as c1"},
+							},
+						},
+					},
+				},
+				{
+					Operation: "Union All",
+					Children: []*PlanNode{
+						{
+							Operation: "Select",
+							Children: []*PlanNode{
+								{Operation: "Load", Detail: "inline table: it"},
+								{
+									Operation: "Fields",
+									Detail:    "c1, row_number() over (order by c1)",
+									Children: []*PlanNode{
+										{Operation: "Analytic Function", Detail: "row_number() over (order by c1)"},
+									},
+								},
+							},
+						},
+						{
+							Operation: "Select",
+							Children: []*PlanNode{
+								{
+									Operation: "Subquery",
+									Detail:    "t",
+									Children: []*PlanNode{
+										{
+											Operation: "Select",
+											Children: []*PlanNode{
+												{Operation: "Load", Detail: "dual"},
+												{Operation: "Fields", Detail: "2, 3"},
+											},
+										},
+									},
+								},
+								{Operation: "Fields", Detail: "*"},
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Name:  "Explain Nested Loop Join",
+		Query: "explain select * from table1, table2 t2 left join table4 t4 on t2.column3 < t4.column1",
+		Result: &PlanNode{
+			Operation: "Select",
+			Children: []*PlanNode{
+				{
+					Operation: "Cross Join",
+					Children: []*PlanNode{
+						{Operation: "Load", Detail: "path: " + GetTestFilePath("table1.csv") + ", format: CSV, cache: miss"},
+						{
+							Operation: "Left Outer Join",
+							Detail:    "nested loop on t2.column3 < t4.column1",
+							Children: []*PlanNode{
+								{Operation: "Load", Detail: "path: " + GetTestFilePath("table2.csv") + ", format: CSV, cache: miss"},
+								{Operation: "Load", Detail: "path: " + GetTestFilePath("table4.csv") + ", format: CSV, cache: miss"},
+							},
+						},
+					},
+				},
+				{Operation: "Fields", Detail: "*"},
+			},
+		},
+	},
+	{
+		Name:  "Explain File Not Exist Error",
+		Query: "explain select * from notexist",
+		Error: "[L:1 C:23] file notexist does not exist",
+	},
+	{
+		Name:  "Explain Analyze",
+		Query: "explain analyze select t1.column1, count(*) from table1 t1 inner join table2 t2 on t1.column1 = t2.column3 where t1.column1 < 3 group by t1.column1 order by 1 limit 1",
+		Result: &PlanNode{
+			Operation: "Select",
+			Rows:      1,
+			Children: []*PlanNode{
+				{
+					Operation: "Inner Join",
+					Detail:    "hash join on t1.column1 = t2.column3",
+					Rows:      2,
+					Children: []*PlanNode{
+						{Operation: "Load", Detail: "path: " + GetTestFilePath("table1.csv") + ", format: CSV, cache: miss", Rows: 3},
+						{Operation: "Load", Detail: "path: " + GetTestFilePath("table2.csv") + ", format: CSV, cache: miss", Rows: 3},
+					},
+				},
+				{Operation: "Where", Detail: "t1.column1 < 3", Rows: 1},
+				{Operation: "Group By", Detail: "t1.column1", Rows: 1},
+				{Operation: "Fields", Detail: "t1.column1, count(*)", Rows: 1},
+				{Operation: "Order By", Detail: "1", Rows: 1},
+				{Operation: "Limit", Detail: "1", Rows: 1},
+			},
+		},
+	},
+	{
+		Name:  "Explain Analyze Recursive Inline Table",
+		Query: "explain analyze with recursive it (n) as (select 1 union select n + 1 from it where n < 3) select n from it",
+		Result: &PlanNode{
+			Operation: "Select",
+			Rows:      3,
+			Children: []*PlanNode{
+				{
+					Operation: "Inline Table",
+					Detail:    "it",
+					Rows:      3,
+					Children: []*PlanNode{
+						{
+							Operation: "Select",
+							Rows:      3,
+							Children: []*PlanNode{
+								{
+									Operation: "Union",
+									Detail:    "recursive",
+									Rows:      3,
+									Children: []*PlanNode{
+										{
+											Operation: "Select",
+											Rows:      1,
+											Children: []*PlanNode{
+												{Operation: "Load", Detail: "dual", Rows: 1},
+												{Operation: "Fields", Detail: "1", Rows: 1},
+											},
+										},
+										{
+											Operation: "Select",
+											Rows:      1,
+											Children: []*PlanNode{
+												{Operation: "Load", Detail: "recursive table: it", Rows: 1},
+												{Operation: "Where", Detail: "n < 3", Rows: 1},
+												{Operation: "Fields", Detail: "n + 1", Rows: 1},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				{Operation: "Load", Detail: "inline table: it", Rows: 3},
+				{Operation: "Fields", Detail: "n", Rows: 3},
+			},
+		},
+	},
+}
+
+func clearPlanElapsedTime(node *PlanNode) {
+	node.Elapsed = 0
+	for _, child := range node.Children {
+		clearPlanElapsedTime(child)
+	}
+}
+
+func TestExplain(t *testing.T) {
+	tf := cmd.GetFlags()
+	tf.Repository = TestDir
+
+	for _, v := range explainTests {
+		ViewCache.Clean()
+
+		statements, err := parser.Parse(v.Query, "")
+		if err != nil {
+			t.Fatalf("%s: unexpected parsing error %q", v.Name, err)
+		}
+
+		result, err := Explain(statements[0].(parser.Explain), NewEmptyFilter())
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+
+		clearPlanElapsedTime(result)
+		if !reflect.DeepEqual(result, v.Result) {
+			t.Errorf("%s: result = %v, want %v", v.Name, result, v.Result)
+		}
+	}
+}
+
+var encodePlanTests = []struct {
+	Name    string
+	Format  cmd.Format
+	Analyze bool
+	Result  string
+}{
+	{
+		Name:   "Encode Plan",
+		Format: cmd.CSV,
+		Result: "Operation,Detail\n" +
+			"Select,\n" +
+			"  Load,dual\n" +
+			"  Fields,1",
+	},
+	{
+		Name:    "Encode Analyzed Plan",
+		Format:  cmd.TSV,
+		Analyze: true,
+		Result: "Operation\tDetail\tRows\tTime\n" +
+			"Select\t\t1\t0.001500\n" +
+			"  Load\tdual\t1\t0.000000\n" +
+			"  Fields\t1\t1\t0.000020",
+	},
+	{
+		Name:    "Encode Analyzed Plan in JSON",
+		Format:  cmd.JSON,
+		Analyze: true,
+		Result: "{\"operation\":\"Select\",\"detail\":\"\",\"rows\":1,\"time\":0.0015,\"children\":[" +
+			"{\"operation\":\"Load\",\"detail\":\"dual\",\"rows\":1,\"time\":0,\"children\":[]}," +
+			"{\"operation\":\"Fields\",\"detail\":\"1\",\"rows\":1,\"time\":0.00002,\"children\":[]}" +
+			"]}",
+	},
+}
+
+func TestEncodePlan(t *testing.T) {
+	plan := &PlanNode{
+		Operation: "Select",
+		Rows:      1,
+		Elapsed:   1500 * time.Microsecond,
+		Children: []*PlanNode{
+			{Operation: "Load", Detail: "dual", Rows: 1},
+			{Operation: "Fields", Detail: "1", Rows: 1, Elapsed: 20 * time.Microsecond},
+		},
+	}
+
+	for _, v := range encodePlanTests {
+		fileInfo := &FileInfo{
+			Format:    v.Format,
+			Delimiter: ',',
+			LineBreak: text.LF,
+		}
+
+		buf := &bytes.Buffer{}
+		if err := EncodePlan(buf, plan, v.Analyze, fileInfo, cmd.GetFlags()); err != nil {
+			t.Errorf("%s: unexpected error %q", v.Name, err)
+			continue
+		}
+		if buf.String() != v.Result {
+			t.Errorf("%s: result = %q, want %q", v.Name, buf.String(), v.Result)
+		}
+	}
+}
@@ -62,14 +62,28 @@ type FileInfo struct {
 	Format             cmd.Format
 	DelimiterPositions fixedlen.DelimiterPositions
 	JsonQuery          string
+	Sheet              string
+	CellRange          string
 	Encoding           text.Encoding
 	LineBreak          text.LineBreak
 	NoHeader           bool
 	EncloseAll         bool
 	JsonEscape         json.EscapeType
 	PrettyPrint        bool
+	Compression        file.Compression
+	BOM                bool
+	Detection          Detection
+	Dialect            CSVDialect
+	SkippedLines       string
+	OnParseError       cmd.ParseErrorPolicy
+	RejectFile         string
+	RejectedRows       int
+
+	rejected []rejectedRow
 
 	Handler *file.Handler
+	Sqlite  *SqliteTable
+	Schema  *TableSchema
 
 	IsTemporary      bool
 	InitialHeader    Header
@@ -78,12 +92,12 @@ type FileInfo struct {
 
 func NewFileInfo(
 	filename parser.Identifier,
-	repository string,
+	flags *cmd.Flags,
 	format cmd.Format,
 	delimiter rune,
 	encoding text.Encoding,
 ) (*FileInfo, error) {
-	fpath, format, err := SearchFilePath(filename, repository, format)
+	fpath, format, err := SearchFilePath(filename, flags, format)
 	if err != nil {
 		return nil, err
 	}
@@ -91,15 +105,16 @@ func NewFileInfo(
 	switch format {
 	case cmd.TSV:
 		delimiter = '\t'
-	case cmd.JSON:
+	case cmd.JSON, cmd.JSONL, cmd.PARQUET, cmd.XLSX:
 		encoding = text.UTF8
 	}
 
 	return &FileInfo{
-		Path:      fpath,
-		Format:    format,
-		Delimiter: delimiter,
-		Encoding:  encoding,
+		Path:        fpath,
+		Format:      format,
+		Delimiter:   delimiter,
+		Encoding:    encoding,
+		Compression: file.CompressionOf(fpath),
 	}, nil
 }
 
@@ -149,7 +164,7 @@ func (f *FileInfo) SetFormat(s string) error {
 	switch format {
 	case cmd.TSV:
 		delimiter = '\t'
-	case cmd.JSON:
+	case cmd.JSON, cmd.JSONL, cmd.PARQUET, cmd.XLSX:
 		encoding = text.UTF8
 	}
 
@@ -174,10 +189,18 @@ func (f *FileInfo) SetEncoding(s string) error {
 	}
 
 	switch f.Format {
-	case cmd.JSON:
+	case cmd.JSON, cmd.JSONL:
 		if encoding != text.UTF8 {
 			return errors.New("json format is supported only UTF8")
 		}
+	case cmd.PARQUET:
+		if encoding != text.UTF8 {
+			return errors.New("parquet format is supported only UTF8")
+		}
+	case cmd.XLSX:
+		if encoding != text.UTF8 {
+			return errors.New("xlsx format is supported only UTF8")
+		}
 	}
 
 	if f.Encoding == encoding {
@@ -241,6 +264,9 @@ func (f *FileInfo) SetPrettyPrint(b bool) error {
 }
 
 func (f *FileInfo) Close() error {
+	if f.Sqlite != nil {
+		return f.Sqlite.rollback()
+	}
 	if f.Handler == nil {
 		return nil
 	}
@@ -248,6 +274,10 @@ func (f *FileInfo) Close() error {
 }
 
 func (f *FileInfo) CloseWithErrors() error {
+	if f.Sqlite != nil {
+		f.Sqlite.rollback()
+		return nil
+	}
 	if f.Handler == nil {
 		return nil
 	}
@@ -255,28 +285,39 @@ func (f *FileInfo) CloseWithErrors() error {
 }
 
 func (f *FileInfo) Commit() error {
+	if f.Sqlite != nil {
+		return f.Sqlite.commit()
+	}
 	if f.Handler == nil {
 		return nil
 	}
 	return f.Handler.Commit()
 }
 
-func SearchFilePath(filename parser.Identifier, repository string, format cmd.Format) (string, cmd.Format, error) {
+func SearchFilePath(filename parser.Identifier, flags *cmd.Flags, format cmd.Format) (string, cmd.Format, error) {
 	var fpath string
 	var err error
 
+	repository := flags.Repository
+
 	switch format {
 	case cmd.CSV, cmd.TSV:
 		fpath, err = SearchCSVFilePath(filename, repository)
 	case cmd.JSON:
 		fpath, err = SearchJsonFilePath(filename, repository)
+	case cmd.JSONL:
+		fpath, err = SearchJsonlFilePath(filename, repository)
 	case cmd.FIXED:
 		fpath, err = SearchFixedLengthFilePath(filename, repository)
 	case cmd.LTSV:
 		fpath, err = SearchLTSVFilePath(filename, repository)
+	case cmd.PARQUET:
+		fpath, err = SearchParquetFilePath(filename, repository)
+	case cmd.XLSX:
+		fpath, err = SearchXlsxFilePath(filename, repository)
 	default: // AutoSelect
 		if fpath, err = SearchFilePathFromAllTypes(filename, repository); err == nil {
-			switch strings.ToLower(filepath.Ext(fpath)) {
+			switch strings.ToLower(filepath.Ext(file.TrimCompressionExt(fpath))) {
 			case cmd.CsvExt:
 				format = cmd.CSV
 			case cmd.TsvExt:
@@ -285,10 +326,16 @@ func SearchFilePath(filename parser.Identifier, repository string, format cmd.Fo
 				format = cmd.FIXED
 			case cmd.JsonExt:
 				format = cmd.JSON
+			case cmd.JsonlExt:
+				format = cmd.JSONL
 			case cmd.LtsvExt:
 				format = cmd.LTSV
+			case cmd.ParquetExt:
+				format = cmd.PARQUET
+			case cmd.XlsxExt:
+				format = cmd.XLSX
 			default:
-				format = cmd.GetFlags().SelectImportFormat()
+				format = flags.SelectImportFormat()
 			}
 		}
 	}
@@ -304,6 +351,10 @@ func SearchJsonFilePath(filename parser.Identifier, repository string) (string,
 	return SearchFilePathWithExtType(filename, repository, []string{cmd.JsonExt})
 }
 
+func SearchJsonlFilePath(filename parser.Identifier, repository string) (string, error) {
+	return SearchFilePathWithExtType(filename, repository, []string{cmd.JsonlExt})
+}
+
 func SearchFixedLengthFilePath(filename parser.Identifier, repository string) (string, error) {
 	return SearchFilePathWithExtType(filename, repository, []string{cmd.FixedExt})
 }
@@ -312,8 +363,16 @@ func SearchLTSVFilePath(filename parser.Identifier, repository string) (string,
 	return SearchFilePathWithExtType(filename, repository, []string{cmd.LtsvExt})
 }
 
+func SearchParquetFilePath(filename parser.Identifier, repository string) (string, error) {
+	return SearchFilePathWithExtType(filename, repository, []string{cmd.ParquetExt})
+}
+
+func SearchXlsxFilePath(filename parser.Identifier, repository string) (string, error) {
+	return SearchFilePathWithExtType(filename, repository, []string{cmd.XlsxExt})
+}
+
 func SearchFilePathFromAllTypes(filename parser.Identifier, repository string) (string, error) {
-	return SearchFilePathWithExtType(filename, repository, []string{cmd.CsvExt, cmd.TsvExt, cmd.JsonExt, cmd.FixedExt, cmd.LtsvExt})
+	return SearchFilePathWithExtType(filename, repository, []string{cmd.CsvExt, cmd.TsvExt, cmd.JsonExt, cmd.JsonlExt, cmd.FixedExt, cmd.LtsvExt, cmd.ParquetExt, cmd.XlsxExt})
 }
 
 func SearchFilePathWithExtType(filename parser.Identifier, repository string, extTypes []string) (string, error) {
@@ -337,6 +396,16 @@ func SearchFilePathWithExtType(filename parser.Identifier, repository string, ex
 				infoList = append(infoList, i)
 			}
 		}
+		if len(pathes) < 1 {
+			for _, ext := range append([]string{""}, extTypes...) {
+				for _, cext := range file.CompressionExtList {
+					if i, err := os.Stat(fpath + ext + cext); err == nil {
+						pathes = append(pathes, fpath+ext+cext)
+						infoList = append(infoList, i)
+					}
+				}
+			}
+		}
 		switch {
 		case len(pathes) < 1:
 			return fpath, NewFileNotExistError(filename)
@@ -365,8 +434,10 @@ func NewFileInfoForCreate(filename parser.Identifier, repository string, delimit
 		return nil, NewWriteFileError(filename, err.Error())
 	}
 
+	compression := file.CompressionOf(fpath)
+
 	var format cmd.Format
-	switch strings.ToLower(filepath.Ext(fpath)) {
+	switch strings.ToLower(filepath.Ext(file.TrimCompressionExt(fpath))) {
 	case cmd.TsvExt:
 		delimiter = '\t'
 		format = cmd.TSV
@@ -375,21 +446,31 @@ func NewFileInfoForCreate(filename parser.Identifier, repository string, delimit
 	case cmd.JsonExt:
 		encoding = text.UTF8
 		format = cmd.JSON
+	case cmd.JsonlExt:
+		encoding = text.UTF8
+		format = cmd.JSONL
 	case cmd.LtsvExt:
 		format = cmd.LTSV
 	case cmd.GfmExt:
 		format = cmd.GFM
 	case cmd.OrgExt:
 		format = cmd.ORG
+	case cmd.ParquetExt:
+		encoding = text.UTF8
+		format = cmd.PARQUET
+	case cmd.XlsxExt:
+		encoding = text.UTF8
+		format = cmd.XLSX
 	default:
 		format = cmd.CSV
 	}
 
 	return &FileInfo{
-		Path:      fpath,
-		Delimiter: delimiter,
-		Format:    format,
-		Encoding:  encoding,
+		Path:        fpath,
+		Delimiter:   delimiter,
+		Format:      format,
+		Encoding:    encoding,
+		Compression: compression,
 	}, nil
 }
 
@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/file"
 	"github.com/mithrandie/csvq/lib/parser"
 
 	"github.com/mithrandie/go-text"
@@ -16,6 +17,7 @@ var fileInfoTests = []struct {
 	Name       string
 	FilePath   parser.Identifier
 	Repository string
+	JsonQuery  string
 	Format     cmd.Format
 	Delimiter  rune
 	Encoding   text.Encoding
@@ -134,6 +136,120 @@ var fileInfoTests = []struct {
 			Encoding:  text.UTF8,
 		},
 	},
+	{
+		Name:       "Parquet",
+		FilePath:   parser.Identifier{Literal: "table7"},
+		Repository: TestDir,
+		Format:     cmd.PARQUET,
+		Delimiter:  ',',
+		Encoding:   text.SJIS,
+		Result: &FileInfo{
+			Path:      "table7.parquet",
+			Delimiter: ',',
+			Format:    cmd.PARQUET,
+			Encoding:  text.UTF8,
+		},
+	},
+	{
+		Name:       "Parquet with AutoSelect",
+		FilePath:   parser.Identifier{Literal: "table7"},
+		Repository: TestDir,
+		Format:     cmd.AutoSelect,
+		Delimiter:  ',',
+		Encoding:   text.UTF8,
+		Result: &FileInfo{
+			Path:      "table7.parquet",
+			Delimiter: ',',
+			Format:    cmd.PARQUET,
+			Encoding:  text.UTF8,
+		},
+	},
+	{
+		Name:       "Xlsx",
+		FilePath:   parser.Identifier{Literal: "table8"},
+		Repository: TestDir,
+		Format:     cmd.XLSX,
+		Delimiter:  ',',
+		Encoding:   text.SJIS,
+		Result: &FileInfo{
+			Path:      "table8.xlsx",
+			Delimiter: ',',
+			Format:    cmd.XLSX,
+			Encoding:  text.UTF8,
+		},
+	},
+	{
+		Name:       "Xlsx with AutoSelect",
+		FilePath:   parser.Identifier{Literal: "table8"},
+		Repository: TestDir,
+		Format:     cmd.AutoSelect,
+		Delimiter:  ',',
+		Encoding:   text.UTF8,
+		Result: &FileInfo{
+			Path:      "table8.xlsx",
+			Delimiter: ',',
+			Format:    cmd.XLSX,
+			Encoding:  text.UTF8,
+		},
+	},
+	{
+		Name:       "JSON Lines",
+		FilePath:   parser.Identifier{Literal: "table10"},
+		Repository: TestDir,
+		Format:     cmd.JSONL,
+		Delimiter:  ',',
+		Encoding:   text.SJIS,
+		Result: &FileInfo{
+			Path:      "table10.jsonl",
+			Delimiter: ',',
+			Format:    cmd.JSONL,
+			Encoding:  text.UTF8,
+		},
+	},
+	{
+		Name:       "JSON Lines with AutoSelect",
+		FilePath:   parser.Identifier{Literal: "table10"},
+		Repository: TestDir,
+		Format:     cmd.AutoSelect,
+		Delimiter:  ',',
+		Encoding:   text.UTF8,
+		Result: &FileInfo{
+			Path:      "table10.jsonl",
+			Delimiter: ',',
+			Format:    cmd.JSONL,
+			Encoding:  text.UTF8,
+		},
+	},
+	{
+		Name:       "Compressed File with AutoSelect",
+		FilePath:   parser.Identifier{Literal: "table9"},
+		Repository: TestDir,
+		Format:     cmd.AutoSelect,
+		Delimiter:  ',',
+		Encoding:   text.UTF8,
+		Result: &FileInfo{
+			Path:        "table9.csv.gz",
+			Delimiter:   ',',
+			Format:      cmd.CSV,
+			Encoding:    text.UTF8,
+			Compression: file.Gzip,
+		},
+	},
+	{
+		Name:       "Compressed File with Extension",
+		FilePath:   parser.Identifier{Literal: "table9.csv"},
+		Repository: TestDir,
+		Format:     cmd.CSV,
+		Delimiter:  ',',
+		Encoding:   text.UTF8,
+		Result: &FileInfo{
+			Path:        "table9.csv.gz",
+			Delimiter:   ',',
+			Format:      cmd.CSV,
+			Encoding:    text.UTF8,
+			Compression: file.Gzip,
+		},
+	},
 	{
 		Name:       "Fixed-Length",
 		FilePath:   parser.Identifier{Literal: "fixed_length.txt"},
@@ -162,6 +278,21 @@ var fileInfoTests = []struct {
 			Encoding:  text.UTF8,
 		},
 	},
+	{
+		Name:       "Import Format from Flags",
+		FilePath:   parser.Identifier{Literal: "autoselect"},
+		Repository: TestDir,
+		JsonQuery:  "{}",
+		Format:     cmd.AutoSelect,
+		Delimiter:  ',',
+		Encoding:   text.UTF8,
+		Result: &FileInfo{
+			Path:      "autoselect",
+			Delimiter: ',',
+			Format:    cmd.JSON,
+			Encoding:  text.UTF8,
+		},
+	},
 	{
 		Name:       "Not Exist Error",
 		FilePath:   parser.Identifier{Literal: "notexist"},
@@ -193,7 +324,11 @@ var fileInfoTests = []struct {
 
 func TestNewFileInfo(t *testing.T) {
 	for _, v := range fileInfoTests {
-		fileInfo, err := NewFileInfo(v.FilePath, v.Repository, v.Format, v.Delimiter, v.Encoding)
+		flags := cmd.NewFlags()
+		flags.Repository = v.Repository
+		flags.JsonQuery = v.JsonQuery
+
+		fileInfo, err := NewFileInfo(v.FilePath, flags, v.Format, v.Delimiter, v.Encoding)
 		if err != nil {
 			if len(v.Error) < 1 {
 				t.Errorf("%s: unexpected error %q", v.Name, err)
@@ -217,6 +352,9 @@ func TestNewFileInfo(t *testing.T) {
 		if fileInfo.Format != v.Result.Format {
 			t.Errorf("%s: FileInfo.Format = %s, want %s", v.Name, fileInfo.Format, v.Result.Format)
 		}
+		if fileInfo.Compression != v.Result.Compression {
+			t.Errorf("%s: FileInfo.Compression = %s, want %s", v.Name, fileInfo.Compression, v.Result.Compression)
+		}
 	}
 }
 
@@ -313,6 +451,68 @@ var fileInfoForCreateTests = []struct {
 			Encoding:  text.UTF8,
 		},
 	},
+	{
+		Name:      "Parquet",
+		FilePath:  parser.Identifier{Literal: "table1.parquet"},
+		Delimiter: ',',
+		Encoding:  text.SJIS,
+		Result: &FileInfo{
+			Path:      "table1.parquet",
+			Delimiter: ',',
+			Format:    cmd.PARQUET,
+			Encoding:  text.UTF8,
+		},
+	},
+	{
+		Name:      "JSON Lines",
+		FilePath:  parser.Identifier{Literal: "table1.jsonl"},
+		Delimiter: ',',
+		Encoding:  text.SJIS,
+		Result: &FileInfo{
+			Path:      "table1.jsonl",
+			Delimiter: ',',
+			Format:    cmd.JSONL,
+			Encoding:  text.UTF8,
+		},
+	},
+	{
+		Name:      "Xlsx",
+		FilePath:  parser.Identifier{Literal: "table1.xlsx"},
+		Delimiter: ',',
+		Encoding:  text.SJIS,
+		Result: &FileInfo{
+			Path:      "table1.xlsx",
+			Delimiter: ',',
+			Format:    cmd.XLSX,
+			Encoding:  text.UTF8,
+		},
+	},
+	{
+		Name:      "Gzip Compressed TSV",
+		FilePath:  parser.Identifier{Literal: "table1.tsv.gz"},
+		Delimiter: ',',
+		Encoding:  text.UTF8,
+		Result: &FileInfo{
+			Path:        "table1.tsv.gz",
+			Delimiter:   '\t',
+			Format:      cmd.TSV,
+			Encoding:    text.UTF8,
+			Compression: file.Gzip,
+		},
+	},
+	{
+		Name:      "Bzip2 Compressed CSV",
+		FilePath:  parser.Identifier{Literal: "table1.csv.bz2"},
+		Delimiter: ',',
+		Encoding:  text.UTF8,
+		Result: &FileInfo{
+			Path:        "table1.csv.bz2",
+			Delimiter:   ',',
+			Format:      cmd.CSV,
+			Encoding:    text.UTF8,
+			Compression: file.Bzip2,
+		},
+	},
 }
 
 func TestNewFileInfoForCreate(t *testing.T) {
@@ -347,6 +547,9 @@ func TestNewFileInfoForCreate(t *testing.T) {
 		if fileInfo.Format != v.Result.Format {
 			t.Errorf("%s: FileInfo.Format = %s, want %s", v.Name, fileInfo.Format, v.Result.Format)
 		}
+		if fileInfo.Compression != v.Result.Compression {
+			t.Errorf("%s: FileInfo.Compression = %s, want %s", v.Name, fileInfo.Compression, v.Result.Compression)
+		}
 	}
 }
 
@@ -0,0 +1,259 @@
+package query
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/file"
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/go-text"
+	txjson "github.com/mithrandie/go-text/json"
+)
+
+const filePatternMetaChars = "*?["
+
+func IsFilePattern(filename parser.Identifier, repository string) bool {
+	if !strings.ContainsAny(filename.Literal, filePatternMetaChars) {
+		return false
+	}
+	_, err := SearchFilePathFromAllTypes(filename, repository)
+	return err != nil
+}
+
+func SearchFilePathsByPattern(pattern parser.Identifier, repository string) (string, []string, error) {
+	fpattern, err := CreateFilePath(pattern, repository)
+	if err != nil {
+		return fpattern, nil, NewInvalidFilePatternError(pattern)
+	}
+
+	matches, err := filepath.Glob(fpattern)
+	if err != nil {
+		return fpattern, nil, NewInvalidFilePatternError(pattern)
+	}
+
+	pathes := make([]string, 0, len(matches))
+	for _, fpath := range matches {
+		if info, err := os.Stat(fpath); err == nil && info.Mode().IsRegular() {
+			pathes = append(pathes, fpath)
+		}
+	}
+	if len(pathes) < 1 {
+		return fpattern, nil, NewFileNotExistError(pattern)
+	}
+	sort.Strings(pathes)
+	return fpattern, pathes, nil
+}
+
+func filesObjectPattern(tableObject parser.TableObject, filter *Filter) (parser.Identifier, error) {
+	if len(tableObject.Args) < 1 || 2 < len(tableObject.Args) {
+		return parser.Identifier{}, NewTableObjectArgumentsLengthError(tableObject, 2)
+	}
+
+	dir, ok, err := tableObjectStringArgument(tableObject.Args[0], filter)
+	if err != nil {
+		return parser.Identifier{}, err
+	}
+	if !ok || len(dir) < 1 {
+		return parser.Identifier{}, NewTableObjectInvalidArgumentError(tableObject, "directory is not specified")
+	}
+
+	pattern := "*"
+	if 1 < len(tableObject.Args) {
+		p, ok, err := tableObjectStringArgument(tableObject.Args[1], filter)
+		if err != nil {
+			return parser.Identifier{}, err
+		}
+		if ok && 0 < len(p) {
+			pattern = p
+		}
+	}
+
+	return parser.Identifier{BaseExpr: tableObject.BaseExpr, Literal: filepath.Join(dir, pattern)}, nil
+}
+
+func loadFilesObject(tableObject parser.TableObject, tableName parser.Identifier, filter *Filter, useInternalId bool, forUpdate bool) (*View, error) {
+	pattern, err := filesObjectPattern(tableObject, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	flags := filter.Flags()
+	return loadObject(
+		pattern,
+		tableName,
+		filter,
+		useInternalId,
+		forUpdate,
+		flags.SelectImportFormat(),
+		flags.Delimiter,
+		flags.DelimiterPositions,
+		flags.JsonQuery,
+		flags.Encoding,
+		flags.LineBreak,
+		flags.NoHeader,
+		flags.EncloseAll,
+		flags.JsonEscape,
+		flags.WithoutNull,
+		NewDetection(flags),
+		NewCSVDialect(flags),
+	)
+}
+
+func loadMultipleFiles(
+	pattern parser.Identifier,
+	filter *Filter,
+	useInternalId bool,
+	forUpdate bool,
+	importFormat cmd.Format,
+	delimiter rune,
+	delimiterPositions []int,
+	jsonQuery string,
+	encoding text.Encoding,
+	lineBreak text.LineBreak,
+	noHeader bool,
+	encloseAll bool,
+	jsonEscape txjson.EscapeType,
+	withoutNull bool,
+	detection Detection,
+	dialect CSVDialect,
+) (*View, error) {
+	if forUpdate {
+		return nil, NewMultipleFilesUpdateError(pattern)
+	}
+
+	key, pathes, err := SearchFilePathsByPattern(pattern, filter.Flags().Repository)
+	if err != nil {
+		return nil, err
+	}
+	for _, fpath := range pathes {
+		if err = filter.Session().CheckFileAccess(pattern, fpath); err != nil {
+			return nil, err
+		}
+	}
+
+	viewCache := filter.Session().ViewCache
+	isCached := true
+	if !viewCache.Exists(key) {
+		isCached = false
+
+		views := make([]*View, 0, len(pathes))
+		for _, fpath := range pathes {
+			fileInfo, err := NewFileInfo(parser.Identifier{BaseExpr: pattern.BaseExpr, Literal: fpath}, filter.Flags(), importFormat, delimiter, encoding)
+			if err != nil {
+				return nil, err
+			}
+
+			fileInfo.DelimiterPositions = delimiterPositions
+			fileInfo.JsonQuery = strings.TrimSpace(jsonQuery)
+			fileInfo.LineBreak = lineBreak
+			fileInfo.NoHeader = noHeader
+			fileInfo.EncloseAll = encloseAll
+			fileInfo.JsonEscape = jsonEscape
+			fileInfo.Sheet = filter.Flags().Sheet
+			fileInfo.Detection = detection
+			fileInfo.Dialect = dialect
+			fileInfo.OnParseError = filter.Flags().OnParseError
+			fileInfo.RejectFile = filter.Flags().RejectFile
+
+			h, err := file.NewHandlerForRead(fileInfo.Path)
+			if err != nil {
+				if _, ok := err.(*file.TimeoutError); ok {
+					return nil, NewFileLockTimeoutError(pattern, fileInfo.Path)
+				}
+				return nil, NewReadFileError(pattern, err.Error())
+			}
+
+			loadView, err := loadViewFromFile(h.FileForRead(), fileInfo, withoutNull, filter.Flags().CPU)
+			_ = h.Close()
+			if err != nil {
+				return nil, NewDataParsingError(pattern, fileInfo.Path, err.Error())
+			}
+			if err = recordRejectedRows(pattern, fileInfo, filter); err != nil {
+				return nil, err
+			}
+			views = append(views, loadView)
+		}
+
+		viewCache.Set(mergeFileViews(key, views))
+	}
+
+	var view *View
+	pathIdent := parser.Identifier{Literal: key}
+	if useInternalId {
+		view, _ = viewCache.GetWithInternalId(pathIdent, filter.Flags().CPU)
+	} else {
+		view, _ = viewCache.Get(pathIdent)
+	}
+	filter.describePlanStage(filesPlanDetail(view.FileInfo.Path, view.FileInfo.Format, isCached, len(pathes)))
+	return view, nil
+}
+
+func filesPlanDetail(path string, format cmd.Format, cached bool, files int) string {
+	return filePlanDetail(path, format, cached) + ", files: " + strconv.Itoa(files)
+}
+
+// mergeFileViews concatenates the records of views loaded from multiple files.
+// Columns are aligned by their names, and columns that do not exist in a file are filled with nulls.
+func mergeFileViews(key string, views []*View) *View {
+	columns := make([]string, 0, views[0].FieldLen())
+	columnIndices := make(map[string]int, views[0].FieldLen())
+	recordIndices := make([][]int, len(views))
+	recordLen := 0
+
+	for i, v := range views {
+		names := v.Header.TableColumnNames()
+		occurrences := make(map[string]int, len(names))
+		recordIndices[i] = make([]int, len(names))
+
+		for j, name := range names {
+			uname := strings.ToUpper(name)
+			occurrences[uname]++
+			k := uname + ":" + strconv.Itoa(occurrences[uname])
+
+			idx, ok := columnIndices[k]
+			if !ok {
+				idx = len(columns)
+				columnIndices[k] = idx
+				columns = append(columns, name)
+			}
+			recordIndices[i][j] = idx
+		}
+		recordLen += v.RecordLen()
+	}
+
+	tableName := parser.FormatTableName(key)
+	header := NewHeader(tableName, columns)
+	header = append(header, HeaderField{View: tableName, Column: FilenameColumn}, HeaderField{View: tableName, Column: LineNumberColumn})
+
+	records := make(RecordSet, 0, recordLen)
+	for i, v := range views {
+		filename := value.NewString(v.FileInfo.Path)
+		for j, r := range v.RecordSet {
+			record := make(Record, len(columns)+2)
+			for k := range columns {
+				record[k] = NewCell(value.NewNull())
+			}
+			for k, idx := range recordIndices[i] {
+				record[idx] = r[k]
+			}
+			record[len(columns)] = NewCell(filename)
+			record[len(columns)+1] = NewCell(value.NewInteger(int64(j + 1)))
+			records = append(records, record)
+		}
+	}
+
+	fileInfo := *views[0].FileInfo
+	fileInfo.Path = key
+
+	view := NewView()
+	view.Header = header
+	view.RecordSet = records
+	view.FileInfo = &fileInfo
+	return view
+}
@@ -0,0 +1,167 @@
+package query
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+)
+
+var searchFilePathsByPatternTests = []struct {
+	Name    string
+	Pattern parser.Identifier
+	Key     string
+	Result  []string
+	Error   string
+}{
+	{
+		Name:    "SearchFilePathsByPattern",
+		Pattern: parser.Identifier{Literal: "table1*.csv"},
+		Key:     GetTestFilePath("table1*.csv"),
+		Result: []string{
+			GetTestFilePath("table1.csv"),
+			GetTestFilePath("table1b.csv"),
+		},
+	},
+	{
+		Name:    "SearchFilePathsByPattern File Not Exist Error",
+		Pattern: parser.Identifier{Literal: "notexist*.csv"},
+		Error:   "[L:- C:-] file notexist*.csv does not exist",
+	},
+	{
+		Name:    "SearchFilePathsByPattern Invalid Pattern Error",
+		Pattern: parser.Identifier{Literal: "table[.csv"},
+		Error:   "[L:- C:-] file pattern table[.csv is invalid",
+	},
+}
+
+func TestSearchFilePathsByPattern(t *testing.T) {
+	for _, v := range searchFilePathsByPatternTests {
+		key, result, err := SearchFilePathsByPattern(v.Pattern, TestDir)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if key != v.Key {
+			t.Errorf("%s: key = %q, want %q", v.Name, key, v.Key)
+		}
+		if !reflect.DeepEqual(result, v.Result) {
+			t.Errorf("%s: result = %q, want %q", v.Name, result, v.Result)
+		}
+	}
+}
+
+var filePatternQueryTests = []struct {
+	Name    string
+	Query   string
+	Columns []string
+	Result  [][]value.Primary
+	Error   string
+}{
+	{
+		Name:    "Select from Multiple Files",
+		Query:   "SELECT *, FILENAME(), LINE_NUMBER() FROM `table1*.csv`",
+		Columns: []string{"column1", "column2", "column2b", "FILENAME()", "LINE_NUMBER()"},
+		Result: [][]value.Primary{
+			{value.NewString("1"), value.NewString("str1"), value.NewNull(), value.NewString(GetTestFilePath("table1.csv")), value.NewInteger(1)},
+			{value.NewString("2"), value.NewString("str2"), value.NewNull(), value.NewString(GetTestFilePath("table1.csv")), value.NewInteger(2)},
+			{value.NewString("3"), value.NewString("str3"), value.NewNull(), value.NewString(GetTestFilePath("table1.csv")), value.NewInteger(3)},
+			{value.NewString("1"), value.NewNull(), value.NewString("str1b"), value.NewString(GetTestFilePath("table1b.csv")), value.NewInteger(1)},
+			{value.NewString("2"), value.NewNull(), value.NewString("str2b"), value.NewString(GetTestFilePath("table1b.csv")), value.NewInteger(2)},
+			{value.NewString("3"), value.NewNull(), value.NewString("str3b"), value.NewString(GetTestFilePath("table1b.csv")), value.NewInteger(3)},
+			{value.NewString("4"), value.NewNull(), value.NewString("str4b"), value.NewString(GetTestFilePath("table1b.csv")), value.NewInteger(4)},
+		},
+	},
+	{
+		Name:    "Select from Files Table Object",
+		Query:   "SELECT column1, LINE_NUMBER(t) FROM FILES('.', 'table1*.csv') t WHERE FILENAME(t) LIKE '%table1b.csv' AND 2 < column1",
+		Columns: []string{"column1", "LINE_NUMBER(t)"},
+		Result: [][]value.Primary{
+			{value.NewString("3"), value.NewInteger(3)},
+			{value.NewString("4"), value.NewInteger(4)},
+		},
+	},
+	{
+		Name:    "Filename from Single File",
+		Query:   "SELECT FILENAME() FROM table1 WHERE column1 = 1",
+		Columns: []string{"FILENAME()"},
+		Result: [][]value.Primary{
+			{value.NewNull()},
+		},
+	},
+	{
+		Name:  "Update Multiple Files Error",
+		Query: "UPDATE `table1*.csv` SET column1 = 1",
+		Error: "[L:1 C:8] table `table1*.csv` is loaded from multiple files and cannot be updated",
+	},
+	{
+		Name:  "Files Arguments Length Error",
+		Query: "SELECT * FROM FILES('.', '*.csv', 1)",
+		Error: "[L:1 C:15] table object FILES takes at most 2 arguments",
+	},
+	{
+		Name:  "Files Directory Not Specified Error",
+		Query: "SELECT * FROM FILES(NULL)",
+		Error: "[L:1 C:15] invalid argument for FILES: directory is not specified",
+	},
+	{
+		Name:  "Filename Invalid Argument Error",
+		Query: "SELECT FILENAME('t') FROM `table1*.csv`",
+		Error: "[L:1 C:8] the argument must be a table name for function FILENAME",
+	},
+	{
+		Name:  "Filename Ambiguous Error",
+		Query: "SELECT FILENAME() FROM `table1*.csv` t1 CROSS JOIN `table1*.csv` t2",
+		Error: "[L:1 C:8] field FILENAME() is ambiguous",
+	},
+}
+
+func TestFilePatternQuery(t *testing.T) {
+	session := newTestSession()
+	defer session.Close()
+
+	ctx := context.Background()
+
+	for _, v := range filePatternQueryTests {
+		rows, err := session.Query(ctx, v.Query)
+		if err == nil {
+			var result [][]value.Primary
+			for rows.Next() {
+				result = append(result, rows.Values())
+			}
+			err = rows.Err()
+			rows.Close()
+
+			if err == nil {
+				if !reflect.DeepEqual(rows.Columns(), v.Columns) {
+					t.Errorf("%s: columns = %q, want %q", v.Name, rows.Columns(), v.Columns)
+				}
+				if !reflect.DeepEqual(result, v.Result) {
+					t.Errorf("%s: result = %s, want %s", v.Name, result, v.Result)
+				}
+			}
+		}
+
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+		}
+	}
+}
@@ -39,7 +39,12 @@ type Filter struct {
 
 	checkAvailableParallelRoutine bool
 
+	caughtError AppError
+
 	Now time.Time
+
+	session *Session
+	plan    *planRecorder
 }
 
 type ContainsSubstitusion struct{}
@@ -57,6 +62,12 @@ func NewFilter(variableScopes VariableScopes, tempViewScopes TemporaryViewScopes
 	}
 }
 
+func NewFilterForSession(session *Session) *Filter {
+	f := NewEmptyFilter()
+	f.session = session
+	return f
+}
+
 func NewEmptyFilter() *Filter {
 	return NewFilter(
 		VariableScopes{NewVariableMap()},
@@ -103,15 +114,20 @@ func (f *Filter) Merge(filter *Filter) {
 	f.InlineTables = filter.InlineTables
 	f.Aliases = filter.Aliases
 	f.Now = filter.Now
+	f.caughtError = filter.caughtError
+	f.session = filter.session
 }
 
 func (f *Filter) CreateChildScope() *Filter {
-	return NewFilter(
+	child := NewFilter(
 		append(VariableScopes{NewVariableMap()}, f.Variables...),
 		append(TemporaryViewScopes{{}}, f.TempViews...),
 		append(CursorScopes{{}}, f.Cursors...),
 		append(UserDefinedFunctionScopes{{}}, f.Functions...),
 	)
+	child.caughtError = f.caughtError
+	child.session = f.session
+	return child
 }
 
 func (f *Filter) ResetCurrentScope() {
@@ -142,6 +158,9 @@ func (f *Filter) CreateNode() *Filter {
 		RecursiveTable:   f.RecursiveTable,
 		RecursiveTmpView: f.RecursiveTmpView,
 		Now:              f.Now,
+		caughtError:      f.caughtError,
+		session:          f.session,
+		plan:             f.plan,
 	}
 
 	if filter.Now.IsZero() {
@@ -151,6 +170,17 @@ func (f *Filter) CreateNode() *Filter {
 	return filter
 }
 
+func (f *Filter) Session() *Session {
+	if f == nil || f.session == nil {
+		return DefaultSession()
+	}
+	return f.session
+}
+
+func (f *Filter) Flags() *cmd.Flags {
+	return f.Session().Flags
+}
+
 func (f *Filter) LoadInlineTable(clause parser.WithClause) error {
 	return f.InlineTables.Load(clause, f)
 }
@@ -184,6 +214,8 @@ func (f *Filter) Evaluate(expr parser.QueryExpression) (value.Primary, error) {
 		val, err = f.evalBetween(expr.(parser.Between))
 	case parser.Like:
 		val, err = f.evalLike(expr.(parser.Like))
+	case parser.RegExp:
+		val, err = f.evalRegExp(expr.(parser.RegExp))
 	case parser.In:
 		val, err = f.evalIn(expr.(parser.In))
 	case parser.Any:
@@ -211,7 +243,9 @@ func (f *Filter) Evaluate(expr parser.QueryExpression) (value.Primary, error) {
 	case parser.EnvironmentVariable:
 		val = value.NewString(os.Getenv(expr.(parser.EnvironmentVariable).Name))
 	case parser.RuntimeInformation:
-		val, err = GetRuntimeInformation(expr.(parser.RuntimeInformation))
+		val, err = GetRuntimeInformation(expr.(parser.RuntimeInformation), f)
+	case parser.Placeholder:
+		val, err = f.evalPlaceholder(expr.(parser.Placeholder))
 	case parser.VariableSubstitution:
 		if f.checkAvailableParallelRoutine {
 			err = &ContainsSubstitusion{}
@@ -236,7 +270,7 @@ func (f *Filter) EvaluateSequentially(fn func(*Filter, int) error, expr interfac
 		isGrouped := f.Records[0].View.isGrouped
 		f.Records = f.Records[1:]
 
-		gm := NewGoroutineTaskManager(len(recordSet), -1)
+		gm := NewGoroutineTaskManager(len(recordSet), -1, f.Flags().CPU)
 		for i := 0; i < gm.Number; i++ {
 			gm.Add()
 			go func(thIdx int) {
@@ -653,6 +687,26 @@ func (f *Filter) evalLike(expr parser.Like) (value.Primary, error) {
 	return value.NewTernary(t), nil
 }
 
+func (f *Filter) evalRegExp(expr parser.RegExp) (value.Primary, error) {
+	lhs, err := f.Evaluate(expr.LHS)
+	if err != nil {
+		return nil, err
+	}
+	pattern, err := f.Evaluate(expr.Pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := RegExp(lhs, pattern)
+	if err != nil {
+		return nil, NewInvalidRegExpError(expr, pattern, err.Error())
+	}
+	if expr.IsNegated() {
+		t = ternary.Not(t)
+	}
+	return value.NewTernary(t), nil
+}
+
 func (f *Filter) evalExists(expr parser.Exists) (value.Primary, error) {
 	view, err := Select(expr.Query.Query, f)
 	if err != nil {
@@ -688,7 +742,7 @@ func (f *Filter) evalSubqueryForValue(expr parser.Subquery) (value.Primary, erro
 func (f *Filter) evalFunction(expr parser.Function) (value.Primary, error) {
 	name := strings.ToUpper(expr.Name)
 
-	if _, ok := Functions[name]; !ok && name != "NOW" && name != "JSON_OBJECT" {
+	if _, ok := Functions[name]; !ok && name != "NOW" && name != "JSON_OBJECT" && name != "GROUPING" && name != "FILENAME" && name != "LINE_NUMBER" {
 		udfn, err := f.Functions.Get(expr, name)
 		if err != nil {
 			return nil, NewFunctionNotExistError(expr, expr.Name)
@@ -711,6 +765,18 @@ func (f *Filter) evalFunction(expr parser.Function) (value.Primary, error) {
 		return JsonObject(expr, f)
 	}
 
+	if name == "GROUPING" {
+		return Grouping(expr, f)
+	}
+
+	if name == "FILENAME" {
+		return Filename(expr, f)
+	}
+
+	if name == "LINE_NUMBER" {
+		return LineNumber(expr, f)
+	}
+
 	args := make([]value.Primary, len(expr.Args))
 	for i, v := range expr.Args {
 		arg, err := f.Evaluate(v)
@@ -724,6 +790,10 @@ func (f *Filter) evalFunction(expr parser.Function) (value.Primary, error) {
 		return Now(expr, args, f)
 	}
 
+	if name == "CALL" && f.Session().DisallowExternalCommand {
+		return nil, NewExternalCommandNotAllowedError(expr)
+	}
+
 	if fn, ok := Functions[name]; ok {
 		return fn(expr, args)
 	}
@@ -734,6 +804,7 @@ func (f *Filter) evalFunction(expr parser.Function) (value.Primary, error) {
 
 func (f *Filter) evalAggregateFunction(expr parser.AggregateFunction) (value.Primary, error) {
 	var aggfn func([]value.Primary) value.Primary
+	var binfn BinaryAggregateFunction
 	var udfn *UserDefinedFunction
 	var useUserDefined bool
 	var err error
@@ -741,6 +812,8 @@ func (f *Filter) evalAggregateFunction(expr parser.AggregateFunction) (value.Pri
 	uname := strings.ToUpper(expr.Name)
 	if fn, ok := AggregateFunctions[uname]; ok {
 		aggfn = fn
+	} else if fn, ok := BinaryAggregateFunctions[uname]; ok {
+		binfn = fn
 	} else {
 		if udfn, err = f.Functions.Get(expr, uname); err != nil || !udfn.IsAggregate {
 			return nil, NewFunctionNotExistError(expr, expr.Name)
@@ -752,6 +825,13 @@ func (f *Filter) evalAggregateFunction(expr parser.AggregateFunction) (value.Pri
 		if err = udfn.CheckArgsLen(expr, expr.Name, len(expr.Args)-1); err != nil {
 			return nil, err
 		}
+	} else if binfn != nil {
+		if len(expr.Args) != 2 {
+			return nil, NewFunctionArgumentLengthError(expr, expr.Name, []int{2})
+		}
+		if expr.IsDistinct() {
+			return nil, NewFunctionInvalidArgumentError(expr, expr.Name, "DISTINCT is not allowed")
+		}
 	} else {
 		if len(expr.Args) != 1 {
 			return nil, NewFunctionArgumentLengthError(expr, expr.Name, []int{1})
@@ -783,6 +863,14 @@ func (f *Filter) evalAggregateFunction(expr parser.AggregateFunction) (value.Pri
 		return nil, err
 	}
 
+	if binfn != nil {
+		xlist, err := view.ListValuesForAggregateFunctions(expr, expr.Args[1], false, f)
+		if err != nil {
+			return nil, err
+		}
+		return binfn(list, xlist), nil
+	}
+
 	if useUserDefined {
 		argsExprs := expr.Args[1:]
 		args := make([]value.Primary, len(argsExprs))
@@ -801,11 +889,15 @@ func (f *Filter) evalAggregateFunction(expr parser.AggregateFunction) (value.Pri
 
 func (f *Filter) evalListFunction(expr parser.ListFunction) (value.Primary, error) {
 	var separator string
+	var fraction float64
 	var err error
 
-	switch strings.ToUpper(expr.Name) {
+	uname := strings.ToUpper(expr.Name)
+	switch uname {
 	case "JSON_AGG":
 		err = f.checkArgsForJsonAgg(expr)
+	case "PERCENTILE_CONT", "PERCENTILE_DISC":
+		fraction, err = f.checkArgsForPercentile(expr, expr.Name, expr.Args, expr.OrderBy)
 	default: // LISTAGG
 		separator, err = f.checkArgsForListFunction(expr)
 	}
@@ -830,14 +922,23 @@ func (f *Filter) evalListFunction(expr parser.ListFunction) (value.Primary, erro
 		}
 	}
 
-	list, err := view.ListValuesForAggregateFunctions(expr, expr.Args[0], expr.IsDistinct(), f)
+	listExpr := expr.Args[0]
+	if uname == "PERCENTILE_CONT" || uname == "PERCENTILE_DISC" {
+		listExpr = expr.OrderBy.(parser.OrderByClause).Items[0].(parser.OrderItem).Value
+	}
+
+	list, err := view.ListValuesForAggregateFunctions(expr, listExpr, expr.IsDistinct(), f)
 	if err != nil {
 		return nil, err
 	}
 
-	switch strings.ToUpper(expr.Name) {
+	switch uname {
 	case "JSON_AGG":
 		return JsonAgg(list), nil
+	case "PERCENTILE_CONT":
+		return PercentileCont(list, fraction), nil
+	case "PERCENTILE_DISC":
+		return PercentileDisc(list, fraction), nil
 	}
 	return ListAgg(list, separator), nil
 }
@@ -870,6 +971,25 @@ func (f *Filter) checkArgsForJsonAgg(expr parser.ListFunction) error {
 	return nil
 }
 
+func (f *Filter) checkArgsForPercentile(expr parser.QueryExpression, name string, args []parser.QueryExpression, orderBy parser.QueryExpression) (float64, error) {
+	if 1 != len(args) {
+		return 0, NewFunctionArgumentLengthError(expr, name, []int{1})
+	}
+	if orderBy == nil || len(orderBy.(parser.OrderByClause).Items) != 1 {
+		return 0, NewFunctionInvalidArgumentError(expr, name, "WITHIN GROUP clause must have exactly one ordering item")
+	}
+
+	p, err := f.Evaluate(args[0])
+	if err != nil {
+		return 0, NewFunctionInvalidArgumentError(expr, name, "the first argument must be a number between 0 and 1")
+	}
+	fl := value.ToFloat(p)
+	if value.IsNull(fl) || fl.(value.Float).Raw() < 0 || 1 < fl.(value.Float).Raw() {
+		return 0, NewFunctionInvalidArgumentError(expr, name, "the first argument must be a number between 0 and 1")
+	}
+	return fl.(value.Float).Raw(), nil
+}
+
 func (f *Filter) evalCaseExpr(expr parser.CaseExpr) (value.Primary, error) {
 	var val value.Primary
 	var err error
@@ -959,6 +1079,14 @@ func (f *Filter) evalUnaryLogic(expr parser.UnaryLogic) (value.Primary, error) {
 	return value.NewTernary(t), nil
 }
 
+func (f *Filter) evalPlaceholder(expr parser.Placeholder) (value.Primary, error) {
+	val, err := f.Variables.Get(PlaceholderVariable(expr.Ordinal))
+	if err != nil {
+		return nil, NewUnboundPlaceholderError(expr)
+	}
+	return val, nil
+}
+
 func (f *Filter) evalCursorStatus(expr parser.CursorStatus) (value.Primary, error) {
 	var t ternary.Value
 	var err error
@@ -2268,6 +2268,32 @@ var filterEvaluateTests = []struct {
 		},
 		Error: "[L:- C:-] field notexist does not exist",
 	},
+	{
+		Name: "RegExp",
+		Expr: parser.RegExp{
+			LHS:      parser.NewStringValue("abcdefg"),
+			Pattern:  parser.NewStringValue("^a.+g$"),
+			Negation: parser.Token{Token: parser.NOT, Literal: "not"},
+		},
+		Result: value.NewTernary(ternary.FALSE),
+	},
+	{
+		Name: "RegExp LHS Error",
+		Expr: parser.RegExp{
+			LHS:     parser.FieldReference{Column: parser.Identifier{Literal: "notexist"}},
+			Pattern: parser.NewStringValue("^a"),
+		},
+		Error: "[L:- C:-] field notexist does not exist",
+	},
+	{
+		Name: "RegExp Invalid Pattern Error",
+		Expr: parser.RegExp{
+			RegExp:  "regexp",
+			LHS:     parser.NewStringValue("abcdefg"),
+			Pattern: parser.NewStringValue("a("),
+		},
+		Error: "[L:- C:-] pattern \"a(\" for regexp is invalid: error parsing regexp: missing closing ): `a(`",
+	},
 	{
 		Name: "Exists",
 		Filter: &Filter{
@@ -3215,6 +3241,389 @@ var filterEvaluateTests = []struct {
 		},
 		Error: "[L:- C:-] function undefined does not exist",
 	},
+	{
+		Name: "Binary Aggregate Function",
+		Filter: &Filter{
+			Records: []FilterRecord{
+				{
+					View: &View{
+						Header: NewHeaderWithId("table1", []string{"column1", "column2"}),
+						RecordSet: []Record{
+							{
+								NewGroupCell([]value.Primary{
+									value.NewInteger(1),
+									value.NewInteger(2),
+									value.NewInteger(3),
+									value.NewInteger(4),
+								}),
+								NewGroupCell([]value.Primary{
+									value.NewInteger(1),
+									value.NewInteger(2),
+									value.NewInteger(3),
+									value.NewInteger(4),
+								}),
+								NewGroupCell([]value.Primary{
+									value.NewInteger(2),
+									value.NewInteger(4),
+									value.NewInteger(6),
+									value.NewNull(),
+								}),
+							},
+						},
+						Filter:    NewEmptyFilter(),
+						isGrouped: true,
+					},
+					RecordIndex: 0,
+				},
+			},
+		},
+		Expr: parser.AggregateFunction{
+			Name: "regr_slope",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+				parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+			},
+		},
+		Result: value.NewInteger(2),
+	},
+	{
+		Name: "Binary Aggregate Function Argument Length Error",
+		Filter: &Filter{
+			Records: []FilterRecord{
+				{
+					View: &View{
+						Header: NewHeaderWithId("table1", []string{"column1", "column2"}),
+						RecordSet: []Record{
+							{
+								NewGroupCell([]value.Primary{
+									value.NewInteger(1),
+									value.NewInteger(2),
+									value.NewInteger(3),
+									value.NewInteger(4),
+								}),
+								NewGroupCell([]value.Primary{
+									value.NewInteger(1),
+									value.NewInteger(2),
+									value.NewInteger(3),
+									value.NewInteger(4),
+								}),
+								NewGroupCell([]value.Primary{
+									value.NewInteger(2),
+									value.NewInteger(4),
+									value.NewInteger(6),
+									value.NewNull(),
+								}),
+							},
+						},
+						Filter:    NewEmptyFilter(),
+						isGrouped: true,
+					},
+					RecordIndex: 0,
+				},
+			},
+		},
+		Expr: parser.AggregateFunction{
+			Name: "regr_slope",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+			},
+		},
+		Error: "[L:- C:-] function regr_slope takes exactly 2 arguments",
+	},
+	{
+		Name: "Binary Aggregate Function Distinct Error",
+		Filter: &Filter{
+			Records: []FilterRecord{
+				{
+					View: &View{
+						Header: NewHeaderWithId("table1", []string{"column1", "column2"}),
+						RecordSet: []Record{
+							{
+								NewGroupCell([]value.Primary{
+									value.NewInteger(1),
+									value.NewInteger(2),
+									value.NewInteger(3),
+									value.NewInteger(4),
+								}),
+								NewGroupCell([]value.Primary{
+									value.NewInteger(1),
+									value.NewInteger(2),
+									value.NewInteger(3),
+									value.NewInteger(4),
+								}),
+								NewGroupCell([]value.Primary{
+									value.NewInteger(2),
+									value.NewInteger(4),
+									value.NewInteger(6),
+									value.NewNull(),
+								}),
+							},
+						},
+						Filter:    NewEmptyFilter(),
+						isGrouped: true,
+					},
+					RecordIndex: 0,
+				},
+			},
+		},
+		Expr: parser.AggregateFunction{
+			Name:     "regr_slope",
+			Distinct: parser.Token{Token: parser.DISTINCT, Literal: "distinct"},
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+				parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+			},
+		},
+		Error: "[L:- C:-] DISTINCT is not allowed for function regr_slope",
+	},
+	{
+		Name: "Binary Aggregate Function Second Argument Evaluation Error",
+		Filter: &Filter{
+			Records: []FilterRecord{
+				{
+					View: &View{
+						Header: NewHeaderWithId("table1", []string{"column1", "column2"}),
+						RecordSet: []Record{
+							{
+								NewGroupCell([]value.Primary{
+									value.NewInteger(1),
+									value.NewInteger(2),
+									value.NewInteger(3),
+									value.NewInteger(4),
+								}),
+								NewGroupCell([]value.Primary{
+									value.NewInteger(1),
+									value.NewInteger(2),
+									value.NewInteger(3),
+									value.NewInteger(4),
+								}),
+								NewGroupCell([]value.Primary{
+									value.NewInteger(2),
+									value.NewInteger(4),
+									value.NewInteger(6),
+									value.NewNull(),
+								}),
+							},
+						},
+						Filter:    NewEmptyFilter(),
+						isGrouped: true,
+					},
+					RecordIndex: 0,
+				},
+			},
+		},
+		Expr: parser.AggregateFunction{
+			Name: "regr_slope",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+				parser.FieldReference{Column: parser.Identifier{Literal: "notexist"}},
+			},
+		},
+		Error: "[L:- C:-] field notexist does not exist",
+	},
+	{
+		Name: "PercentileCont Function",
+		Filter: &Filter{
+			Records: []FilterRecord{
+				{
+					View: &View{
+						Header: NewHeaderWithId("table1", []string{"column1", "column2"}),
+						RecordSet: []Record{
+							{
+								NewGroupCell([]value.Primary{
+									value.NewInteger(1),
+									value.NewInteger(2),
+									value.NewInteger(3),
+									value.NewInteger(4),
+								}),
+								NewGroupCell([]value.Primary{
+									value.NewInteger(1),
+									value.NewInteger(2),
+									value.NewInteger(3),
+									value.NewInteger(4),
+								}),
+								NewGroupCell([]value.Primary{
+									value.NewInteger(2),
+									value.NewInteger(4),
+									value.NewInteger(6),
+									value.NewNull(),
+								}),
+							},
+						},
+						Filter:    NewEmptyFilter(),
+						isGrouped: true,
+					},
+					RecordIndex: 0,
+				},
+			},
+		},
+		Expr: parser.ListFunction{
+			Name: "percentile_cont",
+			Args: []parser.QueryExpression{
+				parser.NewFloatValue(0.5),
+			},
+			WithinGroup: "within group",
+			OrderBy: parser.OrderByClause{
+				Items: []parser.QueryExpression{
+					parser.OrderItem{
+						Value:     parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+						Direction: parser.Token{Token: parser.DESC, Literal: "desc"},
+					},
+				},
+			},
+		},
+		Result: value.NewFloat(2.5),
+	},
+	{
+		Name: "PercentileDisc Function",
+		Filter: &Filter{
+			Records: []FilterRecord{
+				{
+					View: &View{
+						Header: NewHeaderWithId("table1", []string{"column1", "column2"}),
+						RecordSet: []Record{
+							{
+								NewGroupCell([]value.Primary{
+									value.NewInteger(1),
+									value.NewInteger(2),
+									value.NewInteger(3),
+									value.NewInteger(4),
+								}),
+								NewGroupCell([]value.Primary{
+									value.NewInteger(1),
+									value.NewInteger(2),
+									value.NewInteger(3),
+									value.NewInteger(4),
+								}),
+								NewGroupCell([]value.Primary{
+									value.NewInteger(2),
+									value.NewInteger(4),
+									value.NewInteger(6),
+									value.NewNull(),
+								}),
+							},
+						},
+						Filter:    NewEmptyFilter(),
+						isGrouped: true,
+					},
+					RecordIndex: 0,
+				},
+			},
+		},
+		Expr: parser.ListFunction{
+			Name: "percentile_disc",
+			Args: []parser.QueryExpression{
+				parser.NewFloatValue(0.5),
+			},
+			WithinGroup: "within group",
+			OrderBy: parser.OrderByClause{
+				Items: []parser.QueryExpression{
+					parser.OrderItem{
+						Value:     parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+						Direction: parser.Token{Token: parser.DESC, Literal: "desc"},
+					},
+				},
+			},
+		},
+		Result: value.NewInteger(3),
+	},
+	{
+		Name: "PercentileDisc Function Within Group Error",
+		Filter: &Filter{
+			Records: []FilterRecord{
+				{
+					View: &View{
+						Header: NewHeaderWithId("table1", []string{"column1", "column2"}),
+						RecordSet: []Record{
+							{
+								NewGroupCell([]value.Primary{
+									value.NewInteger(1),
+									value.NewInteger(2),
+									value.NewInteger(3),
+									value.NewInteger(4),
+								}),
+								NewGroupCell([]value.Primary{
+									value.NewInteger(1),
+									value.NewInteger(2),
+									value.NewInteger(3),
+									value.NewInteger(4),
+								}),
+								NewGroupCell([]value.Primary{
+									value.NewInteger(2),
+									value.NewInteger(4),
+									value.NewInteger(6),
+									value.NewNull(),
+								}),
+							},
+						},
+						Filter:    NewEmptyFilter(),
+						isGrouped: true,
+					},
+					RecordIndex: 0,
+				},
+			},
+		},
+		Expr: parser.ListFunction{
+			Name: "percentile_disc",
+			Args: []parser.QueryExpression{
+				parser.NewFloatValue(0.5),
+			},
+		},
+		Error: "[L:- C:-] WITHIN GROUP clause must have exactly one ordering item for function percentile_disc",
+	},
+	{
+		Name: "PercentileDisc Function Fraction Error",
+		Filter: &Filter{
+			Records: []FilterRecord{
+				{
+					View: &View{
+						Header: NewHeaderWithId("table1", []string{"column1", "column2"}),
+						RecordSet: []Record{
+							{
+								NewGroupCell([]value.Primary{
+									value.NewInteger(1),
+									value.NewInteger(2),
+									value.NewInteger(3),
+									value.NewInteger(4),
+								}),
+								NewGroupCell([]value.Primary{
+									value.NewInteger(1),
+									value.NewInteger(2),
+									value.NewInteger(3),
+									value.NewInteger(4),
+								}),
+								NewGroupCell([]value.Primary{
+									value.NewInteger(2),
+									value.NewInteger(4),
+									value.NewInteger(6),
+									value.NewNull(),
+								}),
+							},
+						},
+						Filter:    NewEmptyFilter(),
+						isGrouped: true,
+					},
+					RecordIndex: 0,
+				},
+			},
+		},
+		Expr: parser.ListFunction{
+			Name: "percentile_disc",
+			Args: []parser.QueryExpression{
+				parser.NewStringValue("a"),
+			},
+			WithinGroup: "within group",
+			OrderBy: parser.OrderByClause{
+				Items: []parser.QueryExpression{
+					parser.OrderItem{
+						Value:     parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+						Direction: parser.Token{Token: parser.DESC, Literal: "desc"},
+					},
+				},
+			},
+		},
+		Error: "[L:- C:-] the first argument must be a number between 0 and 1 for function percentile_disc",
+	},
 	{
 		Name: "ListAgg Function",
 		Filter: &Filter{
@@ -3903,6 +4312,32 @@ var filterEvaluateTests = []struct {
 		},
 		Error: "[L:- C:-] variable @undefined is undeclared",
 	},
+	{
+		Name: "Placeholder",
+		Filter: NewFilter(
+			[]VariableMap{
+				GenerateVariableMap(map[string]value.Primary{
+					"?1": value.NewInteger(1),
+				}),
+			},
+			[]ViewMap{{}},
+			[]CursorMap{{}},
+			[]UserDefinedFunctionMap{{}},
+		),
+		Expr: parser.Placeholder{
+			Literal: "?",
+			Ordinal: 1,
+		},
+		Result: value.NewInteger(1),
+	},
+	{
+		Name: "Placeholder Unbound Error",
+		Expr: parser.Placeholder{
+			Literal: "?",
+			Ordinal: 2,
+		},
+		Error: "[L:- C:-] value for placeholder ?2 is not bound",
+	},
 	{
 		Name: "Variable Substitution",
 		Filter: NewFilter(
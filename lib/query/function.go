@@ -11,6 +11,7 @@ import (
 	"hash"
 	"math"
 	"os/exec"
+	"regexp"
 	"strconv"
 	"strings"
 	"time"
@@ -23,116 +24,122 @@ import (
 	"github.com/mithrandie/csvq/lib/value"
 
 	"github.com/mithrandie/go-text"
+	txjson "github.com/mithrandie/go-text/json"
 	"github.com/mithrandie/ternary"
 )
 
 var Functions = map[string]func(parser.Function, []value.Primary) (value.Primary, error){
-	"COALESCE":         Coalesce,
-	"IF":               If,
-	"IFNULL":           Ifnull,
-	"NULLIF":           Nullif,
-	"CEIL":             Ceil,
-	"FLOOR":            Floor,
-	"ROUND":            Round,
-	"ABS":              Abs,
-	"ACOS":             Acos,
-	"ASIN":             Asin,
-	"ATAN":             Atan,
-	"ATAN2":            Atan2,
-	"COS":              Cos,
-	"SIN":              Sin,
-	"TAN":              Tan,
-	"EXP":              Exp,
-	"EXP2":             Exp2,
-	"EXPM1":            Expm1,
-	"LOG":              MathLog,
-	"LOG10":            Log10,
-	"LOG2":             Log2,
-	"LOG1P":            Log1p,
-	"SQRT":             Sqrt,
-	"POW":              Pow,
-	"BIN_TO_DEC":       BinToDec,
-	"OCT_TO_DEC":       OctToDec,
-	"HEX_TO_DEC":       HexToDec,
-	"ENOTATION_TO_DEC": EnotationToDec,
-	"BIN":              Bin,
-	"OCT":              Oct,
-	"HEX":              Hex,
-	"ENOTATION":        Enotation,
-	"NUMBER_FORMAT":    NumberFormat,
-	"RAND":             Rand,
-	"TRIM":             Trim,
-	"LTRIM":            Ltrim,
-	"RTRIM":            Rtrim,
-	"UPPER":            Upper,
-	"LOWER":            Lower,
-	"BASE64_ENCODE":    Base64Encode,
-	"BASE64_DECODE":    Base64Decode,
-	"HEX_ENCODE":       HexEncode,
-	"HEX_DECODE":       HexDecode,
-	"LEN":              Len,
-	"BYTE_LEN":         ByteLen,
-	"WIDTH":            Width,
-	"LPAD":             Lpad,
-	"RPAD":             Rpad,
-	"SUBSTR":           Substr,
-	"INSTR":            Instr,
-	"LIST_ELEM":        ListElem,
-	"REPLACE":          Replace,
-	"FORMAT":           Format,
-	"JSON_VALUE":       JsonValue,
-	"MD5":              Md5,
-	"SHA1":             Sha1,
-	"SHA256":           Sha256,
-	"SHA512":           Sha512,
-	"MD5_HMAC":         Md5Hmac,
-	"SHA1_HMAC":        Sha1Hmac,
-	"SHA256_HMAC":      Sha256Hmac,
-	"SHA512_HMAC":      Sha512Hmac,
-	"DATETIME_FORMAT":  DatetimeFormat,
-	"YEAR":             Year,
-	"MONTH":            Month,
-	"DAY":              Day,
-	"HOUR":             Hour,
-	"MINUTE":           Minute,
-	"SECOND":           Second,
-	"MILLISECOND":      Millisecond,
-	"MICROSECOND":      Microsecond,
-	"NANOSECOND":       Nanosecond,
-	"WEEKDAY":          Weekday,
-	"UNIX_TIME":        UnixTime,
-	"UNIX_NANO_TIME":   UnixNanoTime,
-	"DAY_OF_YEAR":      DayOfYear,
-	"WEEK_OF_YEAR":     WeekOfYear,
-	"ADD_YEAR":         AddYear,
-	"ADD_MONTH":        AddMonth,
-	"ADD_DAY":          AddDay,
-	"ADD_HOUR":         AddHour,
-	"ADD_MINUTE":       AddMinute,
-	"ADD_SECOND":       AddSecond,
-	"ADD_MILLI":        AddMilli,
-	"ADD_MICRO":        AddMicro,
-	"ADD_NANO":         AddNano,
-	"TRUNC_MONTH":      TruncMonth,
-	"TRUNC_DAY":        TruncDay,
-	"TRUNC_TIME":       TruncTime,
-	"TRUNC_HOUR":       TruncTime,
-	"TRUNC_MINUTE":     TruncMinute,
-	"TRUNC_SECOND":     TruncSecond,
-	"TRUNC_MILLI":      TruncMilli,
-	"TRUNC_MICRO":      TruncMicro,
-	"TRUNC_NANO":       TruncNano,
-	"DATE_DIFF":        DateDiff,
-	"TIME_DIFF":        TimeDiff,
-	"TIME_NANO_DIFF":   TimeNanoDiff,
-	"UTC":              UTC,
-	"STRING":           String,
-	"INTEGER":          Integer,
-	"FLOAT":            Float,
-	"BOOLEAN":          Boolean,
-	"TERNARY":          Ternary,
-	"DATETIME":         Datetime,
-	"CALL":             Call,
+	"COALESCE":               Coalesce,
+	"IF":                     If,
+	"IFNULL":                 Ifnull,
+	"NULLIF":                 Nullif,
+	"CEIL":                   Ceil,
+	"FLOOR":                  Floor,
+	"ROUND":                  Round,
+	"ABS":                    Abs,
+	"ACOS":                   Acos,
+	"ASIN":                   Asin,
+	"ATAN":                   Atan,
+	"ATAN2":                  Atan2,
+	"COS":                    Cos,
+	"SIN":                    Sin,
+	"TAN":                    Tan,
+	"EXP":                    Exp,
+	"EXP2":                   Exp2,
+	"EXPM1":                  Expm1,
+	"LOG":                    MathLog,
+	"LOG10":                  Log10,
+	"LOG2":                   Log2,
+	"LOG1P":                  Log1p,
+	"SQRT":                   Sqrt,
+	"POW":                    Pow,
+	"BIN_TO_DEC":             BinToDec,
+	"OCT_TO_DEC":             OctToDec,
+	"HEX_TO_DEC":             HexToDec,
+	"ENOTATION_TO_DEC":       EnotationToDec,
+	"BIN":                    Bin,
+	"OCT":                    Oct,
+	"HEX":                    Hex,
+	"ENOTATION":              Enotation,
+	"NUMBER_FORMAT":          NumberFormat,
+	"RAND":                   Rand,
+	"TRIM":                   Trim,
+	"LTRIM":                  Ltrim,
+	"RTRIM":                  Rtrim,
+	"UPPER":                  Upper,
+	"LOWER":                  Lower,
+	"BASE64_ENCODE":          Base64Encode,
+	"BASE64_DECODE":          Base64Decode,
+	"HEX_ENCODE":             HexEncode,
+	"HEX_DECODE":             HexDecode,
+	"LEN":                    Len,
+	"BYTE_LEN":               ByteLen,
+	"WIDTH":                  Width,
+	"LPAD":                   Lpad,
+	"RPAD":                   Rpad,
+	"SUBSTR":                 Substr,
+	"INSTR":                  Instr,
+	"LIST_ELEM":              ListElem,
+	"REPLACE":                Replace,
+	"REGEXP_MATCH":           RegExpMatch,
+	"REGEXP_FIND":            RegExpFind,
+	"REGEXP_FIND_ALL":        RegExpFindAll,
+	"REGEXP_FIND_SUBMATCHES": RegExpFindSubmatches,
+	"REGEXP_REPLACE":         RegExpReplace,
+	"FORMAT":                 Format,
+	"JSON_VALUE":             JsonValue,
+	"MD5":                    Md5,
+	"SHA1":                   Sha1,
+	"SHA256":                 Sha256,
+	"SHA512":                 Sha512,
+	"MD5_HMAC":               Md5Hmac,
+	"SHA1_HMAC":              Sha1Hmac,
+	"SHA256_HMAC":            Sha256Hmac,
+	"SHA512_HMAC":            Sha512Hmac,
+	"DATETIME_FORMAT":        DatetimeFormat,
+	"YEAR":                   Year,
+	"MONTH":                  Month,
+	"DAY":                    Day,
+	"HOUR":                   Hour,
+	"MINUTE":                 Minute,
+	"SECOND":                 Second,
+	"MILLISECOND":            Millisecond,
+	"MICROSECOND":            Microsecond,
+	"NANOSECOND":             Nanosecond,
+	"WEEKDAY":                Weekday,
+	"UNIX_TIME":              UnixTime,
+	"UNIX_NANO_TIME":         UnixNanoTime,
+	"DAY_OF_YEAR":            DayOfYear,
+	"WEEK_OF_YEAR":           WeekOfYear,
+	"ADD_YEAR":               AddYear,
+	"ADD_MONTH":              AddMonth,
+	"ADD_DAY":                AddDay,
+	"ADD_HOUR":               AddHour,
+	"ADD_MINUTE":             AddMinute,
+	"ADD_SECOND":             AddSecond,
+	"ADD_MILLI":              AddMilli,
+	"ADD_MICRO":              AddMicro,
+	"ADD_NANO":               AddNano,
+	"TRUNC_MONTH":            TruncMonth,
+	"TRUNC_DAY":              TruncDay,
+	"TRUNC_TIME":             TruncTime,
+	"TRUNC_HOUR":             TruncTime,
+	"TRUNC_MINUTE":           TruncMinute,
+	"TRUNC_SECOND":           TruncSecond,
+	"TRUNC_MILLI":            TruncMilli,
+	"TRUNC_MICRO":            TruncMicro,
+	"TRUNC_NANO":             TruncNano,
+	"DATE_DIFF":              DateDiff,
+	"TIME_DIFF":              TimeDiff,
+	"TIME_NANO_DIFF":         TimeNanoDiff,
+	"UTC":                    UTC,
+	"STRING":                 String,
+	"INTEGER":                Integer,
+	"FLOAT":                  Float,
+	"BOOLEAN":                Boolean,
+	"TERNARY":                Ternary,
+	"DATETIME":               Datetime,
+	"CALL":                   Call,
 }
 
 type Direction string
@@ -695,8 +702,8 @@ func execStringsPadding(fn parser.Function, args []value.Primary, direction Dire
 		strLen = utf8.RuneCountInString(str)
 		padstrLen = utf8.RuneCountInString(padstr)
 	case PaddingByteCount:
-		strLen = text.ByteSize(str, enc)
-		padstrLen = text.ByteSize(padstr, enc)
+		strLen = byteSize(str, enc)
+		padstrLen = byteSize(padstr, enc)
 	case PaddingWidth:
 		strLen = cmd.TextWidth(str)
 		padstrLen = cmd.TextWidth(padstr)
@@ -719,7 +726,7 @@ func execStringsPadding(fn parser.Function, args []value.Primary, direction Dire
 		for _, r := range padding {
 			switch padType {
 			case PaddingByteCount:
-				w = text.RuneByteSize(r, enc)
+				w = runeByteSize(r, enc)
 			default:
 				w = cmd.RuneWidth(r)
 			}
@@ -847,7 +854,7 @@ func ByteLen(fn parser.Function, args []value.Primary) (value.Primary, error) {
 		}
 	}
 
-	return value.NewInteger(int64(text.ByteSize(s.(value.String).Raw(), enc))), nil
+	return value.NewInteger(int64(byteSize(s.(value.String).Raw(), enc))), nil
 }
 
 func Width(fn parser.Function, args []value.Primary) (value.Primary, error) {
@@ -986,6 +993,148 @@ func Replace(fn parser.Function, args []value.Primary) (value.Primary, error) {
 	return value.NewString(r), nil
 }
 
+func compileRegExp(fn parser.Function, pattern value.Primary, flags []value.Primary) (*regexp.Regexp, error) {
+	p := value.ToString(pattern)
+	if value.IsNull(p) {
+		return nil, nil
+	}
+
+	f := ""
+	if 0 < len(flags) {
+		if s := value.ToString(flags[0]); !value.IsNull(s) {
+			f = s.(value.String).Raw()
+		}
+	}
+
+	re, err := RegExps.Compile(p.(value.String).Raw(), f)
+	if err != nil {
+		return nil, NewFunctionInvalidArgumentError(fn, fn.Name, err.Error())
+	}
+	return re, nil
+}
+
+func RegExpMatch(fn parser.Function, args []value.Primary) (value.Primary, error) {
+	if len(args) < 2 || 3 < len(args) {
+		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{2, 3})
+	}
+
+	re, err := compileRegExp(fn, args[1], args[2:])
+	if err != nil {
+		return nil, err
+	}
+
+	s := value.ToString(args[0])
+	if value.IsNull(s) || re == nil {
+		return value.NewTernary(ternary.UNKNOWN), nil
+	}
+
+	return value.NewTernary(ternary.ConvertFromBool(re.MatchString(s.(value.String).Raw()))), nil
+}
+
+func RegExpFind(fn parser.Function, args []value.Primary) (value.Primary, error) {
+	if len(args) < 2 || 3 < len(args) {
+		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{2, 3})
+	}
+
+	re, err := compileRegExp(fn, args[1], args[2:])
+	if err != nil {
+		return nil, err
+	}
+
+	s := value.ToString(args[0])
+	if value.IsNull(s) || re == nil {
+		return value.NewNull(), nil
+	}
+
+	loc := re.FindStringIndex(s.(value.String).Raw())
+	if loc == nil {
+		return value.NewNull(), nil
+	}
+	return value.NewString(s.(value.String).Raw()[loc[0]:loc[1]]), nil
+}
+
+func RegExpFindAll(fn parser.Function, args []value.Primary) (value.Primary, error) {
+	if len(args) < 2 || 3 < len(args) {
+		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{2, 3})
+	}
+
+	re, err := compileRegExp(fn, args[1], args[2:])
+	if err != nil {
+		return nil, err
+	}
+
+	s := value.ToString(args[0])
+	if value.IsNull(s) || re == nil {
+		return value.NewNull(), nil
+	}
+
+	matches := re.FindAllString(s.(value.String).Raw(), -1)
+	if matches == nil {
+		return value.NewNull(), nil
+	}
+
+	array := make(txjson.Array, 0, len(matches))
+	for _, m := range matches {
+		array = append(array, txjson.String(m))
+	}
+	return value.NewString(array.Encode()), nil
+}
+
+func RegExpFindSubmatches(fn parser.Function, args []value.Primary) (value.Primary, error) {
+	if len(args) < 2 || 3 < len(args) {
+		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{2, 3})
+	}
+
+	re, err := compileRegExp(fn, args[1], args[2:])
+	if err != nil {
+		return nil, err
+	}
+
+	s := value.ToString(args[0])
+	if value.IsNull(s) || re == nil {
+		return value.NewNull(), nil
+	}
+
+	str := s.(value.String).Raw()
+	loc := re.FindStringSubmatchIndex(str)
+	if loc == nil {
+		return value.NewNull(), nil
+	}
+
+	array := make(txjson.Array, 0, len(loc)/2)
+	for i := 0; i < len(loc); i = i + 2 {
+		if loc[i] < 0 {
+			array = append(array, txjson.Null{})
+		} else {
+			array = append(array, txjson.String(str[loc[i]:loc[i+1]]))
+		}
+	}
+	return value.NewString(array.Encode()), nil
+}
+
+func RegExpReplace(fn parser.Function, args []value.Primary) (value.Primary, error) {
+	if len(args) < 3 || 4 < len(args) {
+		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{3, 4})
+	}
+
+	re, err := compileRegExp(fn, args[1], args[3:])
+	if err != nil {
+		return nil, err
+	}
+
+	s := value.ToString(args[0])
+	if value.IsNull(s) || re == nil {
+		return value.NewNull(), nil
+	}
+
+	repl := value.ToString(args[2])
+	if value.IsNull(repl) {
+		return value.NewNull(), nil
+	}
+
+	return value.NewString(re.ReplaceAllString(s.(value.String).Raw(), repl.(value.String).Raw())), nil
+}
+
 func Format(fn parser.Function, args []value.Primary) (value.Primary, error) {
 	if len(args) < 1 {
 		return nil, NewFunctionArgumentLengthErrorWithCustomArgs(fn, fn.Name, "at least 1 argument")
@@ -1584,3 +1733,85 @@ func JsonObject(fn parser.Function, filter *Filter) (value.Primary, error) {
 	structure, _ := json.ConvertRecordValueToJsonStructure(pathes, record)
 	return value.NewString(structure.Encode()), nil
 }
+
+func Grouping(fn parser.Function, filter *Filter) (value.Primary, error) {
+	if len(fn.Args) < 1 {
+		return nil, NewFunctionArgumentLengthErrorWithCustomArgs(fn, fn.Name, "at least 1 argument")
+	}
+
+	if len(filter.Records) < 1 {
+		return nil, NewUnpermittedStatementFunctionError(fn, fn.Name)
+	}
+
+	view := filter.Records[0].View
+	if !view.isGrouped {
+		return nil, NewNotGroupingRecordsError(fn, fn.Name)
+	}
+
+	record := view.RecordSet[filter.Records[0].RecordIndex]
+
+	var bits int64
+	for _, arg := range fn.Args {
+		switch arg.(type) {
+		case parser.FieldReference, parser.ColumnNumber:
+		default:
+			return nil, NewFieldNotGroupKeyError(arg)
+		}
+
+		idx, err := view.FieldIndex(arg)
+		if err != nil {
+			return nil, err
+		}
+		if !view.Header[idx].IsGroupKey {
+			return nil, NewFieldNotGroupKeyError(arg)
+		}
+
+		bits <<= 1
+		if record[idx].IsRolledUp() {
+			bits |= 1
+		}
+	}
+	return value.NewInteger(bits), nil
+}
+
+func Filename(fn parser.Function, filter *Filter) (value.Primary, error) {
+	return pseudoColumnValue(fn, FilenameColumn, filter)
+}
+
+func LineNumber(fn parser.Function, filter *Filter) (value.Primary, error) {
+	return pseudoColumnValue(fn, LineNumberColumn, filter)
+}
+
+func pseudoColumnValue(fn parser.Function, column string, filter *Filter) (value.Primary, error) {
+	if 1 < len(fn.Args) {
+		return nil, NewFunctionArgumentLengthErrorWithCustomArgs(fn, fn.Name, "at most 1 argument")
+	}
+
+	if len(filter.Records) < 1 {
+		return nil, NewUnpermittedStatementFunctionError(fn, fn.Name)
+	}
+
+	fieldRef := parser.FieldReference{BaseExpr: fn.BaseExpr, Column: parser.Identifier{Literal: column}}
+	if 0 < len(fn.Args) {
+		ref, ok := fn.Args[0].(parser.FieldReference)
+		if !ok || 0 < len(ref.View.Literal) {
+			return nil, NewFunctionInvalidArgumentError(fn, fn.Name, "the argument must be a table name")
+		}
+		fieldRef.View = ref.Column
+	}
+
+	for _, r := range filter.Records {
+		idx, err := r.View.FieldIndex(fieldRef)
+		if err != nil {
+			if _, ok := err.(*FieldAmbiguousError); ok {
+				return nil, NewFieldAmbiguousError(fn)
+			}
+			continue
+		}
+		if r.View.isGrouped && !r.View.Header[idx].IsGroupKey {
+			return nil, NewFieldNotGroupKeyError(fn)
+		}
+		return r.View.RecordSet[r.RecordIndex][idx].Value(), nil
+	}
+	return value.NewNull(), nil
+}
@@ -1365,6 +1365,17 @@ var byteLenTests = []functionTest{
 		},
 		Result: value.NewInteger(9),
 	},
+	{
+		Name: "ByteLen UTF-16",
+		Function: parser.Function{
+			Name: "byte_len",
+		},
+		Args: []value.Primary{
+			value.NewString("abc日本語"),
+			value.NewString("utf16le"),
+		},
+		Result: value.NewInteger(12),
+	},
 	{
 		Name: "ByteLen Null",
 		Function: parser.Function{
@@ -1392,7 +1403,7 @@ var byteLenTests = []functionTest{
 			value.NewString("abc日本語"),
 			value.NewString("invalid"),
 		},
-		Error: "[L:- C:-] encoding must be one of UTF8|SJIS for function byte_len",
+		Error: "[L:- C:-] encoding must be one of UTF8|UTF8BOM|UTF16LE|UTF16BE|SJIS|EUCJP|ISO2022JP|LATIN1|WINDOWS1252 for function byte_len",
 	},
 }
 
@@ -1491,7 +1502,7 @@ var lpadTests = []functionTest{
 			value.NewString("byte"),
 			value.NewString("invalid"),
 		},
-		Error: "[L:- C:-] encoding must be one of UTF8|SJIS for function lpad",
+		Error: "[L:- C:-] encoding must be one of UTF8|UTF8BOM|UTF16LE|UTF16BE|SJIS|EUCJP|ISO2022JP|LATIN1|WINDOWS1252 for function lpad",
 	},
 	{
 		Name: "Lpad by Width",
@@ -1895,6 +1906,319 @@ func TestReplace(t *testing.T) {
 	testFunction(t, Replace, replaceTests)
 }
 
+var regExpMatchTests = []functionTest{
+	{
+		Name: "RegExpMatch",
+		Function: parser.Function{
+			Name: "regexp_match",
+		},
+		Args: []value.Primary{
+			value.NewString("abc123"),
+			value.NewString(`^[a-z]+\d+$`),
+		},
+		Result: value.NewTernary(ternary.TRUE),
+	},
+	{
+		Name: "RegExpMatch Not Matched",
+		Function: parser.Function{
+			Name: "regexp_match",
+		},
+		Args: []value.Primary{
+			value.NewString("ABC123"),
+			value.NewString(`^[a-z]+\d+$`),
+		},
+		Result: value.NewTernary(ternary.FALSE),
+	},
+	{
+		Name: "RegExpMatch with Flags",
+		Function: parser.Function{
+			Name: "regexp_match",
+		},
+		Args: []value.Primary{
+			value.NewString("ABC123"),
+			value.NewString(`^[a-z]+\d+$`),
+			value.NewString("i"),
+		},
+		Result: value.NewTernary(ternary.TRUE),
+	},
+	{
+		Name: "RegExpMatch String is Null",
+		Function: parser.Function{
+			Name: "regexp_match",
+		},
+		Args: []value.Primary{
+			value.NewNull(),
+			value.NewString("abc"),
+		},
+		Result: value.NewTernary(ternary.UNKNOWN),
+	},
+	{
+		Name: "RegExpMatch Pattern is Null",
+		Function: parser.Function{
+			Name: "regexp_match",
+		},
+		Args: []value.Primary{
+			value.NewString("abc"),
+			value.NewNull(),
+		},
+		Result: value.NewTernary(ternary.UNKNOWN),
+	},
+	{
+		Name: "RegExpMatch Arguments Error",
+		Function: parser.Function{
+			Name: "regexp_match",
+		},
+		Args: []value.Primary{
+			value.NewString("abc"),
+		},
+		Error: "[L:- C:-] function regexp_match takes 2 or 3 arguments",
+	},
+	{
+		Name: "RegExpMatch Invalid Pattern Error",
+		Function: parser.Function{
+			Name: "regexp_match",
+		},
+		Args: []value.Primary{
+			value.NewString("abc"),
+			value.NewString("a("),
+		},
+		Error: "[L:- C:-] error parsing regexp: missing closing ): `a(` for function regexp_match",
+	},
+	{
+		Name: "RegExpMatch Invalid Flags Error",
+		Function: parser.Function{
+			Name: "regexp_match",
+		},
+		Args: []value.Primary{
+			value.NewString("abc"),
+			value.NewString("a"),
+			value.NewString("x"),
+		},
+		Error: "[L:- C:-] flags must consist of the characters i, m, s and U for function regexp_match",
+	},
+	{
+		Name: "RegExpMatch Integer Flags Error",
+		Function: parser.Function{
+			Name: "regexp_match",
+		},
+		Args: []value.Primary{
+			value.NewString("aaa"),
+			value.NewString("a"),
+			value.NewInteger(2),
+		},
+		Error: "[L:- C:-] flags must consist of the characters i, m, s and U for function regexp_match",
+	},
+}
+
+func TestRegExpMatch(t *testing.T) {
+	testFunction(t, RegExpMatch, regExpMatchTests)
+}
+
+var regExpFindTests = []functionTest{
+	{
+		Name: "RegExpFind",
+		Function: parser.Function{
+			Name: "regexp_find",
+		},
+		Args: []value.Primary{
+			value.NewString("id: 123, code: 456"),
+			value.NewString(`\d+`),
+		},
+		Result: value.NewString("123"),
+	},
+	{
+		Name: "RegExpFind Not Matched",
+		Function: parser.Function{
+			Name: "regexp_find",
+		},
+		Args: []value.Primary{
+			value.NewString("id: abc"),
+			value.NewString(`\d+`),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "RegExpFind String is Null",
+		Function: parser.Function{
+			Name: "regexp_find",
+		},
+		Args: []value.Primary{
+			value.NewNull(),
+			value.NewString(`\d+`),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "RegExpFind Arguments Error",
+		Function: parser.Function{
+			Name: "regexp_find",
+		},
+		Args: []value.Primary{
+			value.NewString("abc"),
+		},
+		Error: "[L:- C:-] function regexp_find takes 2 or 3 arguments",
+	},
+}
+
+func TestRegExpFind(t *testing.T) {
+	testFunction(t, RegExpFind, regExpFindTests)
+}
+
+var regExpFindAllTests = []functionTest{
+	{
+		Name: "RegExpFindAll",
+		Function: parser.Function{
+			Name: "regexp_find_all",
+		},
+		Args: []value.Primary{
+			value.NewString("id: 123, code: 456"),
+			value.NewString(`\d+`),
+		},
+		Result: value.NewString(`["123","456"]`),
+	},
+	{
+		Name: "RegExpFindAll Not Matched",
+		Function: parser.Function{
+			Name: "regexp_find_all",
+		},
+		Args: []value.Primary{
+			value.NewString("id: abc"),
+			value.NewString(`\d+`),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "RegExpFindAll String is Null",
+		Function: parser.Function{
+			Name: "regexp_find_all",
+		},
+		Args: []value.Primary{
+			value.NewNull(),
+			value.NewString(`\d+`),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "RegExpFindAll Arguments Error",
+		Function: parser.Function{
+			Name: "regexp_find_all",
+		},
+		Args: []value.Primary{
+			value.NewString("abc"),
+		},
+		Error: "[L:- C:-] function regexp_find_all takes 2 or 3 arguments",
+	},
+}
+
+func TestRegExpFindAll(t *testing.T) {
+	testFunction(t, RegExpFindAll, regExpFindAllTests)
+}
+
+var regExpFindSubmatchesTests = []functionTest{
+	{
+		Name: "RegExpFindSubmatches",
+		Function: parser.Function{
+			Name: "regexp_find_submatches",
+		},
+		Args: []value.Primary{
+			value.NewString("user=foo id=12"),
+			value.NewString(`user=(\w+)(?: name=(\w+))? id=(\d+)`),
+		},
+		Result: value.NewString(`["user=foo id=12","foo",null,"12"]`),
+	},
+	{
+		Name: "RegExpFindSubmatches Not Matched",
+		Function: parser.Function{
+			Name: "regexp_find_submatches",
+		},
+		Args: []value.Primary{
+			value.NewString("id: abc"),
+			value.NewString(`(\d+)`),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "RegExpFindSubmatches String is Null",
+		Function: parser.Function{
+			Name: "regexp_find_submatches",
+		},
+		Args: []value.Primary{
+			value.NewNull(),
+			value.NewString(`(\d+)`),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "RegExpFindSubmatches Arguments Error",
+		Function: parser.Function{
+			Name: "regexp_find_submatches",
+		},
+		Args: []value.Primary{
+			value.NewString("abc"),
+		},
+		Error: "[L:- C:-] function regexp_find_submatches takes 2 or 3 arguments",
+	},
+}
+
+func TestRegExpFindSubmatches(t *testing.T) {
+	testFunction(t, RegExpFindSubmatches, regExpFindSubmatchesTests)
+}
+
+var regExpReplaceTests = []functionTest{
+	{
+		Name: "RegExpReplace",
+		Function: parser.Function{
+			Name: "regexp_replace",
+		},
+		Args: []value.Primary{
+			value.NewString("2012-02-03"),
+			value.NewString(`(\d+)-(\d+)-(\d+)`),
+			value.NewString("$3/$2/$1"),
+		},
+		Result: value.NewString("03/02/2012"),
+	},
+	{
+		Name: "RegExpReplace with Flags",
+		Function: parser.Function{
+			Name: "regexp_replace",
+		},
+		Args: []value.Primary{
+			value.NewString("aBc ABC"),
+			value.NewString("b"),
+			value.NewString("x"),
+			value.NewString("i"),
+		},
+		Result: value.NewString("axc AxC"),
+	},
+	{
+		Name: "RegExpReplace Replacement is Null",
+		Function: parser.Function{
+			Name: "regexp_replace",
+		},
+		Args: []value.Primary{
+			value.NewString("abc"),
+			value.NewString("b"),
+			value.NewNull(),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "RegExpReplace Arguments Error",
+		Function: parser.Function{
+			Name: "regexp_replace",
+		},
+		Args: []value.Primary{
+			value.NewString("abc"),
+			value.NewString("b"),
+		},
+		Error: "[L:- C:-] function regexp_replace takes 3 or 4 arguments",
+	},
+}
+
+func TestRegExpReplace(t *testing.T) {
+	testFunction(t, RegExpReplace, regExpReplaceTests)
+}
+
 var formatTests = []functionTest{
 	{
 		Name: "Format",
@@ -3448,3 +3772,138 @@ func TestJsonObject(t *testing.T) {
 		}
 	}
 }
+
+var groupingTests = []struct {
+	Name     string
+	Function parser.Function
+	Filter   *Filter
+	Result   value.Primary
+	Error    string
+}{
+	{
+		Name: "Grouping",
+		Function: parser.Function{
+			Name: "grouping",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+			},
+		},
+		Filter: &Filter{
+			Records: []FilterRecord{
+				{
+					View: &View{
+						Header: []HeaderField{
+							{View: "table1", Column: "column1", Number: 1, IsFromTable: true, IsGroupKey: true},
+							{View: "table1", Column: "column2", Number: 2, IsFromTable: true, IsGroupKey: true},
+						},
+						RecordSet: []Record{
+							{
+								NewGroupCell([]value.Primary{value.NewInteger(1)}),
+								NewGroupCell([]value.Primary{value.NewInteger(2)}),
+							},
+							{
+								NewGroupCell([]value.Primary{value.NewInteger(1), value.NewInteger(1)}),
+								NewRolledUpCell([]value.Primary{value.NewInteger(2), value.NewInteger(3)}),
+							},
+						},
+						isGrouped: true,
+					},
+					RecordIndex: 1,
+				},
+			},
+		},
+		Result: value.NewInteger(1),
+	},
+	{
+		Name: "Grouping Arguments Error",
+		Function: parser.Function{
+			Name: "grouping",
+		},
+		Filter: &Filter{},
+		Error:  "[L:- C:-] function grouping takes at least 1 argument",
+	},
+	{
+		Name: "Grouping Unpermitted Statement Error",
+		Function: parser.Function{
+			Name: "grouping",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+			},
+		},
+		Filter: &Filter{},
+		Error:  "[L:- C:-] function grouping cannot be used as a statement",
+	},
+	{
+		Name: "Grouping Not Grouping Records Error",
+		Function: parser.Function{
+			Name: "grouping",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+			},
+		},
+		Filter: &Filter{
+			Records: []FilterRecord{
+				{
+					View: &View{
+						Header: NewHeader("table1", []string{"column1"}),
+						RecordSet: []Record{
+							NewRecord([]value.Primary{value.NewInteger(1)}),
+						},
+					},
+				},
+			},
+		},
+		Error: "[L:- C:-] function grouping cannot aggregate not grouping records",
+	},
+	{
+		Name: "Grouping Field Not Group Key Error",
+		Function: parser.Function{
+			Name: "grouping",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+			},
+		},
+		Filter: &Filter{
+			Records: []FilterRecord{
+				{
+					View: &View{
+						Header: []HeaderField{
+							{View: "table1", Column: "column1", Number: 1, IsFromTable: true, IsGroupKey: true},
+							{View: "table1", Column: "column2", Number: 2, IsFromTable: true},
+						},
+						RecordSet: []Record{
+							{
+								NewGroupCell([]value.Primary{value.NewInteger(1)}),
+								NewGroupCell([]value.Primary{value.NewInteger(2)}),
+							},
+						},
+						isGrouped: true,
+					},
+				},
+			},
+		},
+		Error: "[L:- C:-] field column2 is not a group key",
+	},
+}
+
+func TestGrouping(t *testing.T) {
+	for _, v := range groupingTests {
+		result, err := Grouping(v.Function, v.Filter)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if !reflect.DeepEqual(result, v.Result) {
+			t.Errorf("%s: result = %s, want %s", v.Name, result, v.Result)
+		}
+	}
+}
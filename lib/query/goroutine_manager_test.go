@@ -69,7 +69,7 @@ func TestGoroutineManager_AssignRoutineNumber(t *testing.T) {
 		gm.Count = v.PresetCount
 		gm.MinimumRequiredPerCore = v.DefaultMinimumRequiredPerCore
 
-		result := gm.AssignRoutineNumber(v.RecordLen, v.MinimumRequired)
+		result := gm.AssignRoutineNumber(v.RecordLen, v.MinimumRequired, v.PresetCPU)
 		if result != v.Expect {
 			t.Errorf("%s: result = %d, want %d", v.Name, result, v.Expect)
 		}
@@ -7,7 +7,11 @@ import (
 	"github.com/mithrandie/csvq/lib/parser"
 )
 
-const InternalIdColumn = "@__internal_id"
+const (
+	InternalIdColumn = "@__internal_id"
+	FilenameColumn   = "@__filename"
+	LineNumberColumn = "@__line_number"
+)
 
 type HeaderField struct {
 	View         string
@@ -0,0 +1,981 @@
+package query
+
+import (
+	"bufio"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/file"
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/go-text"
+	"github.com/mithrandie/go-text/csv"
+	"github.com/mithrandie/ternary"
+)
+
+const IndexFileExt = ".idx"
+
+type indexAttributes struct {
+	Name       string
+	Columns    []string
+	Header     []string
+	FileSize   int64
+	ModTime    int64
+	Delimiter  rune
+	Encoding   text.Encoding
+	NoHeader   bool
+	LineBreak  text.LineBreak
+	EncloseAll bool
+	NumericLen int
+}
+
+func (attr indexAttributes) isValidFor(fileInfo *FileInfo, stat os.FileInfo) bool {
+	return attr.FileSize == stat.Size() &&
+		attr.ModTime == stat.ModTime().UnixNano() &&
+		attr.Delimiter == fileInfo.Delimiter &&
+		attr.Encoding == fileInfo.Encoding &&
+		attr.NoHeader == fileInfo.NoHeader
+}
+
+type indexEntry struct {
+	Keys   []string
+	Nulls  []bool
+	Number float64
+	Offset int64
+	Length int64
+}
+
+func (e indexEntry) key(i int, withoutNull bool) value.Primary {
+	if e.Nulls != nil && e.Nulls[i] {
+		if withoutNull {
+			return value.NewString("")
+		}
+		return value.NewNull()
+	}
+	return value.NewString(e.Keys[i])
+}
+
+type tableIndex struct {
+	Attributes indexAttributes
+	Entries    []indexEntry
+}
+
+func (index *tableIndex) search(predicates []indexPredicate, withoutNull bool) []indexEntry {
+	candidates := index.Entries
+
+	if low, high, ok := numericSearchRange(predicates); ok {
+		numeric := index.Entries[:index.Attributes.NumericLen]
+		begin := sort.Search(len(numeric), func(i int) bool { return low <= numeric[i].Number })
+		end := sort.Search(len(numeric), func(i int) bool { return high < numeric[i].Number })
+		if end < begin {
+			end = begin
+		}
+
+		candidates = make([]indexEntry, 0, end-begin+len(index.Entries)-len(numeric))
+		candidates = append(candidates, numeric[begin:end]...)
+		candidates = append(candidates, index.Entries[len(numeric):]...)
+	}
+
+	entries := make([]indexEntry, 0, len(candidates))
+	for _, entry := range candidates {
+		matched := true
+		for _, p := range predicates {
+			if !p.match(entry.key(p.Column, withoutNull)) {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			entries = append(entries, entry)
+		}
+	}
+
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Offset < entries[j].Offset })
+	return entries
+}
+
+type indexPredicate struct {
+	Column   int
+	Operator string
+	Values   []value.Primary
+}
+
+func (p indexPredicate) match(key value.Primary) bool {
+	if value.IsNull(key) {
+		return false
+	}
+
+	switch p.Operator {
+	case "BETWEEN":
+		return value.GreaterOrEqual(key, p.Values[0]) == ternary.TRUE && value.LessOrEqual(key, p.Values[1]) == ternary.TRUE
+	case "IN":
+		for _, v := range p.Values {
+			if value.Equal(key, v) == ternary.TRUE {
+				return true
+			}
+		}
+		return false
+	default:
+		return value.Compare(key, p.Values[0], p.Operator) == ternary.TRUE
+	}
+}
+
+func numericSearchRange(predicates []indexPredicate) (float64, float64, bool) {
+	low := math.Inf(-1)
+	high := math.Inf(1)
+	ok := false
+
+	for _, p := range predicates {
+		if p.Column != 0 {
+			continue
+		}
+
+		floats := make([]float64, 0, len(p.Values))
+		for _, v := range p.Values {
+			f := value.ToFloat(v)
+			if value.IsNull(f) || math.IsNaN(f.(value.Float).Raw()) {
+				break
+			}
+			floats = append(floats, f.(value.Float).Raw())
+		}
+		if len(floats) < len(p.Values) {
+			continue
+		}
+
+		switch p.Operator {
+		case "=":
+			low, high = math.Max(low, floats[0]), math.Min(high, floats[0])
+		case ">", ">=":
+			low = math.Max(low, floats[0])
+		case "<", "<=":
+			high = math.Min(high, floats[0])
+		case "BETWEEN":
+			low, high = math.Max(low, floats[0]), math.Min(high, floats[1])
+		case "IN":
+			sort.Float64s(floats)
+			low, high = math.Max(low, floats[0]), math.Min(high, floats[len(floats)-1])
+		default:
+			continue
+		}
+		ok = true
+	}
+	return low, high, ok
+}
+
+type indexFile struct {
+	Name string
+	Path string
+}
+
+func isValidIndexName(name string) bool {
+	return 0 < len(name) && !strings.ContainsAny(name, "./\\"+string(os.PathSeparator))
+}
+
+func indexFilePath(tablePath string, name string) string {
+	return tablePath + "." + name + IndexFileExt
+}
+
+func tableIndexFiles(tablePath string) []indexFile {
+	entries, err := os.ReadDir(filepath.Dir(tablePath))
+	if err != nil {
+		return nil
+	}
+
+	prefix := filepath.Base(tablePath) + "."
+	files := make([]indexFile, 0)
+	for _, e := range entries {
+		fname := e.Name()
+		if e.IsDir() || !strings.HasPrefix(fname, prefix) || !strings.HasSuffix(fname, IndexFileExt) || len(fname) <= len(prefix)+len(IndexFileExt) {
+			continue
+		}
+		name := fname[len(prefix) : len(fname)-len(IndexFileExt)]
+		if isValidIndexName(name) {
+			files = append(files, indexFile{Name: name, Path: filepath.Join(filepath.Dir(tablePath), fname)})
+		}
+	}
+	return files
+}
+
+func searchIndexFile(tablePath string, name string) (indexFile, bool) {
+	for _, f := range tableIndexFiles(tablePath) {
+		if strings.EqualFold(f.Name, name) {
+			return f, true
+		}
+	}
+	return indexFile{}, false
+}
+
+func checkIndexableFile(fileInfo *FileInfo) error {
+	switch {
+	case fileInfo.Format != cmd.CSV && fileInfo.Format != cmd.TSV:
+		return errors.New(fmt.Sprintf("format %s is not supported", fileInfo.Format))
+	case fileInfo.Encoding != text.UTF8 && fileInfo.Encoding != text.SJIS:
+		return errors.New(fmt.Sprintf("encoding %s is not supported", string(fileInfo.Encoding)))
+	case 0x80 <= fileInfo.Delimiter || fileInfo.Delimiter == '"' || fileInfo.Delimiter == '\r' || fileInfo.Delimiter == '\n':
+		return errors.New(fmt.Sprintf("delimiter %q is not supported", fileInfo.Delimiter))
+	case fileInfo.Compression != file.NoCompression:
+		return errors.New("compressed files are not supported")
+	case !fileInfo.Dialect.IsDefault():
+		return errors.New("csv dialect options are not supported")
+	}
+	return nil
+}
+
+func readIndexFile(path string, withEntries bool) (*tableIndex, error) {
+	h, err := file.NewHandlerForRead(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = h.Close()
+	}()
+
+	dec := gob.NewDecoder(bufio.NewReader(h.FileForRead()))
+	index := &tableIndex{}
+	if err = dec.Decode(&index.Attributes); err != nil {
+		return nil, err
+	}
+	if withEntries {
+		if err = dec.Decode(&index.Entries); err != nil && err != io.EOF {
+			return nil, err
+		}
+	}
+	return index, nil
+}
+
+func writeIndexFile(path string, index *tableIndex) error {
+	var h *file.Handler
+	var err error
+
+	if file.Exists(path) {
+		h, err = file.NewHandlerForUpdate(path)
+	} else {
+		h, err = file.NewHandlerForCreate(path)
+	}
+	if err != nil {
+		return err
+	}
+
+	w := bufio.NewWriter(h.FileForUpdate())
+	enc := gob.NewEncoder(w)
+	if err = enc.Encode(index.Attributes); err == nil {
+		if err = enc.Encode(index.Entries); err == nil {
+			err = w.Flush()
+		}
+	}
+	if err != nil {
+		_ = h.Close()
+		return err
+	}
+	return h.Commit()
+}
+
+type recordPosition struct {
+	Offset int64
+	Length int64
+}
+
+func isSJISLeadByte(b byte) bool {
+	return (0x81 <= b && b <= 0x9f) || (0xe0 <= b && b <= 0xfc)
+}
+
+func isSJISTrailByte(b byte) bool {
+	return (0x40 <= b && b <= 0x7e) || (0x80 <= b && b <= 0xfc)
+}
+
+// scanRecordPositions returns the byte ranges of the records in a csv file
+// without line breaks. Records are recognized in the same way as csv.Reader.
+func scanRecordPositions(r io.Reader, delimiter byte, encoding text.Encoding) ([]recordPosition, error) {
+	br := bufio.NewReader(r)
+	positions := make([]recordPosition, 0, 1000)
+
+	var pos int64
+	var start int64
+	fieldIndex := 0
+	fieldStarted := false
+	hasContent := false
+	quoted := false
+	escaped := false
+
+	endRecord := func(end int64) {
+		if 0 < fieldIndex || hasContent {
+			positions = append(positions, recordPosition{Offset: start, Length: end - start})
+		}
+		start = pos
+		fieldIndex = 0
+		fieldStarted = false
+		hasContent = false
+		quoted = false
+		escaped = false
+	}
+
+	for {
+		b, err := br.ReadByte()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, err
+		}
+		pos++
+
+		if encoding == text.SJIS && isSJISLeadByte(b) {
+			if next, err := br.Peek(1); err == nil && isSJISTrailByte(next[0]) {
+				_, _ = br.ReadByte()
+				pos++
+			}
+			if escaped {
+				return nil, errors.New(fmt.Sprintf("unexpected \" in field at offset %d", pos))
+			}
+			hasContent = true
+			fieldStarted = true
+			continue
+		}
+
+		if b == '\r' || b == '\n' {
+			end := pos - 1
+			if b == '\r' {
+				if next, err := br.Peek(1); err == nil && next[0] == '\n' {
+					_, _ = br.ReadByte()
+					pos++
+				}
+			}
+			if quoted && !escaped {
+				hasContent = true
+				continue
+			}
+			endRecord(end)
+			continue
+		}
+
+		if quoted {
+			if escaped {
+				switch b {
+				case '"':
+					escaped = false
+					hasContent = true
+				case delimiter:
+					quoted = false
+					escaped = false
+					fieldIndex++
+					fieldStarted = false
+				default:
+					return nil, errors.New(fmt.Sprintf("unexpected \" in field at offset %d", pos))
+				}
+				continue
+			}
+
+			if b == '"' {
+				escaped = true
+			} else {
+				hasContent = true
+			}
+			continue
+		}
+
+		switch b {
+		case delimiter:
+			fieldIndex++
+			fieldStarted = false
+		case '"':
+			if fieldStarted {
+				hasContent = true
+			} else {
+				quoted = true
+			}
+		default:
+			hasContent = true
+			fieldStarted = true
+		}
+	}
+
+	if quoted && !escaped {
+		return nil, errors.New("extraneous \" in field")
+	}
+	endRecord(pos)
+	return positions, nil
+}
+
+func noHeaderLabels(fieldLen int) []string {
+	header := make([]string, fieldLen)
+	for i := 0; i < fieldLen; i++ {
+		header[i] = "c" + strconv.Itoa(i+1)
+	}
+	return header
+}
+
+func readIndexHeader(fp io.Reader, fileInfo *FileInfo) ([]string, error) {
+	reader := csv.NewReader(fp, fileInfo.Encoding)
+	reader.Delimiter = fileInfo.Delimiter
+
+	if !fileInfo.NoHeader {
+		header, err := reader.ReadHeader()
+		if err != nil && err != io.EOF {
+			return nil, err
+		}
+		return header, nil
+	}
+
+	record, err := reader.Read()
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+	return noHeaderLabels(len(record)), nil
+}
+
+func indexColumnIndices(header []string, columns []string) ([]int, error) {
+	indices := make([]int, len(columns))
+	for i, c := range columns {
+		idx := -1
+		for j, h := range header {
+			if strings.EqualFold(h, c) {
+				idx = j
+				break
+			}
+		}
+		if idx < 0 {
+			return nil, errors.New(fmt.Sprintf("field %s does not exist", c))
+		}
+		indices[i] = idx
+	}
+	return indices, nil
+}
+
+func buildTableIndex(fp *os.File, fileInfo *FileInfo, name string, columns []string) (*tableIndex, error) {
+	stat, err := fp.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = fp.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+	positions, err := scanRecordPositions(fp, byte(fileInfo.Delimiter), fileInfo.Encoding)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = fp.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+	reader := csv.NewReader(fp, fileInfo.Encoding)
+	reader.Delimiter = fileInfo.Delimiter
+
+	var header []string
+	if !fileInfo.NoHeader {
+		header, err = reader.ReadHeader()
+		if err != nil && err != io.EOF {
+			return nil, err
+		}
+		if 0 < len(positions) {
+			positions = positions[1:]
+		}
+	}
+
+	var indices []int
+	entries := make([]indexEntry, 0, len(positions))
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(positions) <= len(entries) {
+			return nil, errors.New("failed to locate records")
+		}
+
+		if indices == nil {
+			if header == nil {
+				header = noHeaderLabels(len(record))
+			}
+			if indices, err = indexColumnIndices(header, columns); err != nil {
+				return nil, err
+			}
+		}
+
+		entry := indexEntry{
+			Keys:   make([]string, len(indices)),
+			Offset: positions[len(entries)].Offset,
+			Length: positions[len(entries)].Length,
+		}
+		for i, idx := range indices {
+			if record[idx] == nil {
+				if entry.Nulls == nil {
+					entry.Nulls = make([]bool, len(indices))
+				}
+				entry.Nulls[i] = true
+			} else {
+				entry.Keys[i] = string(record[idx])
+			}
+		}
+		entries = append(entries, entry)
+	}
+	if len(entries) != len(positions) {
+		return nil, errors.New("failed to locate records")
+	}
+	if indices == nil {
+		if _, err = indexColumnIndices(header, columns); err != nil {
+			return nil, err
+		}
+	}
+
+	lineBreak := fileInfo.LineBreak
+	if reader.DetectedLineBreak != "" {
+		lineBreak = reader.DetectedLineBreak
+	}
+
+	return &tableIndex{
+		Attributes: indexAttributes{
+			Name:       name,
+			Columns:    columns,
+			Header:     header,
+			FileSize:   stat.Size(),
+			ModTime:    stat.ModTime().UnixNano(),
+			Delimiter:  fileInfo.Delimiter,
+			Encoding:   fileInfo.Encoding,
+			NoHeader:   fileInfo.NoHeader,
+			LineBreak:  lineBreak,
+			EncloseAll: reader.EnclosedAll,
+			NumericLen: sortIndexEntries(entries),
+		},
+		Entries: entries,
+	}, nil
+}
+
+// sortIndexEntries moves the entries whose first key can be converted to a number
+// to the front in ascending order, and returns the number of them.
+func sortIndexEntries(entries []indexEntry) int {
+	numericLen := 0
+	for i := range entries {
+		if entries[i].Nulls != nil && entries[i].Nulls[0] {
+			continue
+		}
+		f := value.ToFloat(value.NewString(entries[i].Keys[0]))
+		if value.IsNull(f) || math.IsNaN(f.(value.Float).Raw()) {
+			continue
+		}
+		entries[i].Number = f.(value.Float).Raw()
+		entries[numericLen], entries[i] = entries[i], entries[numericLen]
+		numericLen++
+	}
+
+	numeric := entries[:numericLen]
+	sort.SliceStable(numeric, func(i, j int) bool { return numeric[i].Number < numeric[j].Number })
+	return numericLen
+}
+
+func readIndexedRecords(fp *os.File, fileInfo *FileInfo, entries []indexEntry, fieldLen int, withoutNull bool) (RecordSet, error) {
+	readers := make([]io.Reader, 0, len(entries)*2)
+	for _, e := range entries {
+		readers = append(readers, io.NewSectionReader(fp, e.Offset, e.Length), strings.NewReader("\n"))
+	}
+
+	reader := csv.NewReader(io.MultiReader(readers...), fileInfo.Encoding)
+	reader.Delimiter = fileInfo.Delimiter
+	reader.WithoutNull = withoutNull
+	reader.FieldsPerRecord = fieldLen
+
+	records, err := readRecordSet(reader)
+	if err != nil {
+		return nil, err
+	}
+	if len(records) != len(entries) {
+		return nil, errors.New("index does not match the file")
+	}
+	return records, nil
+}
+
+func indexConditionTerms(condition parser.QueryExpression) []parser.QueryExpression {
+	switch expr := condition.(type) {
+	case parser.Parentheses:
+		return indexConditionTerms(expr.Expr)
+	case parser.Logic:
+		if expr.Operator.Token == parser.AND {
+			return append(indexConditionTerms(expr.LHS), indexConditionTerms(expr.RHS)...)
+		}
+	}
+	return []parser.QueryExpression{condition}
+}
+
+func isIndexConstant(expr parser.QueryExpression) bool {
+	switch e := expr.(type) {
+	case parser.PrimitiveType, parser.Variable, parser.Placeholder:
+		return true
+	case parser.Parentheses:
+		return isIndexConstant(e.Expr)
+	case parser.UnaryArithmetic:
+		return isIndexConstant(e.Operand)
+	}
+	return false
+}
+
+func indexColumn(expr parser.QueryExpression, columns []string, tableName string) int {
+	if p, ok := expr.(parser.Parentheses); ok {
+		return indexColumn(p.Expr, columns, tableName)
+	}
+
+	ref, ok := expr.(parser.FieldReference)
+	if !ok || (0 < len(ref.View.Literal) && !strings.EqualFold(ref.View.Literal, tableName)) {
+		return -1
+	}
+	for i, c := range columns {
+		if strings.EqualFold(c, ref.Column.Literal) {
+			return i
+		}
+	}
+	return -1
+}
+
+func indexPredicates(terms []parser.QueryExpression, columns []string, tableName string, filter *Filter) ([]indexPredicate, error) {
+	predicates := make([]indexPredicate, 0, len(terms))
+
+	evaluate := func(exprs ...parser.QueryExpression) ([]value.Primary, bool, error) {
+		values := make([]value.Primary, len(exprs))
+		for i, e := range exprs {
+			if !isIndexConstant(e) {
+				return nil, false, nil
+			}
+			p, err := filter.Evaluate(e)
+			if err != nil {
+				return nil, false, err
+			}
+			values[i] = p
+		}
+		return values, true, nil
+	}
+
+	for _, term := range terms {
+		var column int
+		var operator string
+		var exprs []parser.QueryExpression
+
+		switch t := term.(type) {
+		case parser.Comparison:
+			operator = t.Operator
+			if column = indexColumn(t.LHS, columns, tableName); -1 < column {
+				exprs = []parser.QueryExpression{t.RHS}
+			} else if column = indexColumn(t.RHS, columns, tableName); -1 < column {
+				exprs = []parser.QueryExpression{t.LHS}
+				switch operator {
+				case "<":
+					operator = ">"
+				case "<=":
+					operator = ">="
+				case ">":
+					operator = "<"
+				case ">=":
+					operator = "<="
+				}
+			}
+		case parser.Between:
+			if t.Negation.IsEmpty() {
+				operator = "BETWEEN"
+				if column = indexColumn(t.LHS, columns, tableName); -1 < column {
+					exprs = []parser.QueryExpression{t.Low, t.High}
+				}
+			}
+		case parser.In:
+			if !t.IsNegated() {
+				operator = "IN"
+				if column = indexColumn(t.LHS, columns, tableName); -1 < column {
+					if rv, ok := t.Values.(parser.RowValue); ok {
+						if list, ok := rv.Value.(parser.ValueList); ok {
+							exprs = list.Values
+						}
+					}
+				}
+			}
+		}
+		if len(exprs) < 1 {
+			continue
+		}
+
+		values, ok, err := evaluate(exprs...)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			predicates = append(predicates, indexPredicate{Column: column, Operator: operator, Values: values})
+		}
+	}
+	return predicates, nil
+}
+
+type indexSelection struct {
+	File       indexFile
+	FileInfo   *FileInfo
+	Attributes indexAttributes
+	Predicates []indexPredicate
+}
+
+func indexableTable(tableExpr parser.QueryExpression) (parser.Table, parser.Identifier, bool) {
+	if parentheses, ok := tableExpr.(parser.Parentheses); ok {
+		return indexableTable(parentheses.Expr)
+	}
+	if table, ok := tableExpr.(parser.Table); ok {
+		if ident, ok := table.Object.(parser.Identifier); ok {
+			return table, ident, true
+		}
+	}
+	return parser.Table{}, parser.Identifier{}, false
+}
+
+// selectTableIndex returns an index of the file that can be used to find records
+// satisfying the condition, or nil if there is no such index.
+func selectTableIndex(tableIdentifier parser.Identifier, tableName string, condition parser.QueryExpression, filter *Filter) (*indexSelection, error) {
+	flags := filter.Flags()
+	fileInfo, err := NewFileInfo(tableIdentifier, flags, cmd.AutoSelect, flags.Delimiter, flags.Encoding)
+	if err != nil || filter.Session().CheckFileAccess(tableIdentifier, fileInfo.Path) != nil {
+		return nil, nil
+	}
+	fileInfo.LineBreak = flags.LineBreak
+	fileInfo.NoHeader = flags.NoHeader
+	fileInfo.EncloseAll = flags.EncloseAll
+	fileInfo.JsonEscape = flags.JsonEscape
+	fileInfo.Dialect = NewCSVDialect(flags)
+
+	if filter.Session().ViewCache.Exists(fileInfo.Path) || checkIndexableFile(fileInfo) != nil {
+		return nil, nil
+	}
+
+	files := tableIndexFiles(fileInfo.Path)
+	if len(files) < 1 {
+		return nil, nil
+	}
+	stat, err := os.Stat(fileInfo.Path)
+	if err != nil {
+		return nil, nil
+	}
+
+	terms := indexConditionTerms(condition)
+	var selected *indexSelection
+	for _, f := range files {
+		index, err := readIndexFile(f.Path, false)
+		if err != nil || !index.Attributes.isValidFor(fileInfo, stat) {
+			continue
+		}
+
+		predicates, err := indexPredicates(terms, index.Attributes.Columns, tableName, filter)
+		if err != nil {
+			return nil, err
+		}
+		if len(predicates) < 1 {
+			continue
+		}
+
+		selection := &indexSelection{File: f, FileInfo: fileInfo, Attributes: index.Attributes, Predicates: predicates}
+		for _, p := range predicates {
+			if p.Column == 0 {
+				return selection, nil
+			}
+		}
+		if selected == nil {
+			selected = selection
+		}
+	}
+	return selected, nil
+}
+
+// loadViewByIndex loads only the records that can satisfy the condition by using an index.
+// Nil is returned if the table has no available index.
+func loadViewByIndex(tableExpr parser.QueryExpression, condition parser.QueryExpression, filter *Filter) (*View, error) {
+	table, tableIdentifier, ok := indexableTable(tableExpr)
+	if !ok {
+		return nil, nil
+	}
+	if filter.RecursiveTable != nil && strings.EqualFold(tableIdentifier.Literal, filter.RecursiveTable.Name.Literal) {
+		return nil, nil
+	}
+	if _, err := filter.InlineTables.Get(tableIdentifier); err == nil {
+		return nil, nil
+	}
+	if filter.TempViews.Exists(tableIdentifier.Literal) {
+		return nil, nil
+	}
+
+	selection, err := selectTableIndex(tableIdentifier, table.Name().Literal, condition, filter)
+	if err != nil || selection == nil {
+		return nil, nil
+	}
+	fileInfo := selection.FileInfo
+
+	h, err := file.NewHandlerForRead(fileInfo.Path)
+	if err != nil {
+		if _, ok := err.(*file.TimeoutError); ok {
+			return nil, NewFileLockTimeoutError(tableIdentifier, fileInfo.Path)
+		}
+		return nil, NewReadFileError(tableIdentifier, err.Error())
+	}
+	defer func() {
+		_ = h.Close()
+	}()
+	fp := h.FileForRead()
+
+	stat, err := fp.Stat()
+	if err != nil || !selection.Attributes.isValidFor(fileInfo, stat) {
+		return nil, nil
+	}
+	index, err := readIndexFile(selection.File.Path, true)
+	if err != nil || !index.Attributes.isValidFor(fileInfo, stat) {
+		return nil, nil
+	}
+
+	stage := filter.startPlanStage(tablePlanOperation(table), filePlanDetail(fileInfo.Path, fileInfo.Format, false)+", index: "+index.Attributes.Name)
+
+	entries := index.search(selection.Predicates, filter.Flags().WithoutNull)
+	records, err := readIndexedRecords(fp, fileInfo, entries, len(index.Attributes.Header), filter.Flags().WithoutNull)
+	if err != nil {
+		return nil, NewDataParsingError(tableIdentifier, fileInfo.Path, err.Error())
+	}
+
+	fileInfo.LineBreak = index.Attributes.LineBreak
+	fileInfo.EncloseAll = index.Attributes.EncloseAll
+
+	view := NewView()
+	view.Header = NewHeader(parser.FormatTableName(fileInfo.Path), index.Attributes.Header)
+	view.RecordSet = records
+	view.FileInfo = fileInfo
+
+	if err = loadTableSchema(fileInfo, view.Header, view.RecordSet); err != nil {
+		return nil, NewDataParsingError(tableIdentifier, fileInfo.Path, err.Error())
+	}
+
+	if err = filter.Aliases.Add(table.Name(), fileInfo.Path); err != nil {
+		return nil, err
+	}
+	if !strings.EqualFold(parser.FormatTableName(fileInfo.Path), table.Name().Literal) {
+		view.Header.Update(table.Name().Literal, nil)
+	}
+
+	stage.finish(view.RecordLen())
+	return view, nil
+}
+
+func CreateIndex(query parser.CreateIndex, filter *Filter) (string, error) {
+	if !isValidIndexName(query.Name.Literal) {
+		return "", NewInvalidIndexNameError(query.Name)
+	}
+
+	flags := filter.Flags()
+	fileInfo, err := NewFileInfo(query.Table, flags, cmd.AutoSelect, flags.Delimiter, flags.Encoding)
+	if err != nil {
+		return "", err
+	}
+	if err = filter.Session().CheckFileAccess(query.Table, fileInfo.Path); err != nil {
+		return "", err
+	}
+	fileInfo.NoHeader = flags.NoHeader
+	fileInfo.LineBreak = flags.LineBreak
+	fileInfo.Dialect = NewCSVDialect(flags)
+
+	if err = checkIndexableFile(fileInfo); err != nil {
+		return "", NewIndexNotSupportedError(query.Table, fileInfo.Path, err.Error())
+	}
+	if _, ok := searchIndexFile(fileInfo.Path, query.Name.Literal); ok {
+		return "", NewIndexAlreadyExistsError(query.Name, fileInfo.Path)
+	}
+
+	h, err := file.NewHandlerForRead(fileInfo.Path)
+	if err != nil {
+		if _, ok := err.(*file.TimeoutError); ok {
+			return "", NewFileLockTimeoutError(query.Table, fileInfo.Path)
+		}
+		return "", NewReadFileError(query.Table, err.Error())
+	}
+	defer func() {
+		_ = h.Close()
+	}()
+	fp := h.FileForRead()
+
+	header, err := readIndexHeader(fp, fileInfo)
+	if err != nil {
+		return "", NewDataParsingError(query.Table, fileInfo.Path, err.Error())
+	}
+	columns := make([]string, len(query.Columns))
+	for i, c := range query.Columns {
+		ident := c.(parser.Identifier)
+		indices, err := indexColumnIndices(header, []string{ident.Literal})
+		if err != nil {
+			return "", NewFieldNotExistError(ident)
+		}
+		columns[i] = header[indices[0]]
+	}
+
+	index, err := buildTableIndex(fp, fileInfo, query.Name.Literal, columns)
+	if err != nil {
+		return "", NewDataParsingError(query.Table, fileInfo.Path, err.Error())
+	}
+	if err = writeIndexFile(indexFilePath(fileInfo.Path, query.Name.Literal), index); err != nil {
+		return "", NewWriteFileError(query.Name, err.Error())
+	}
+	return fileInfo.Path, nil
+}
+
+func DropIndex(query parser.DropIndex, filter *Filter) (string, error) {
+	flags := filter.Flags()
+	fileInfo, err := NewFileInfo(query.Table, flags, cmd.AutoSelect, flags.Delimiter, flags.Encoding)
+	if err != nil {
+		return "", err
+	}
+	if err = filter.Session().CheckFileAccess(query.Table, fileInfo.Path); err != nil {
+		return "", err
+	}
+
+	f, ok := searchIndexFile(fileInfo.Path, query.Name.Literal)
+	if !ok {
+		return "", NewIndexNotExistError(query.Name, fileInfo.Path)
+	}
+	if err = os.Remove(f.Path); err != nil {
+		return "", NewWriteFileError(query.Name, err.Error())
+	}
+	return fileInfo.Path, nil
+}
+
+func rebuildTableIndex(fileInfo *FileInfo, f indexFile) error {
+	index, err := readIndexFile(f.Path, false)
+	if err != nil {
+		return err
+	}
+	if err = checkIndexableFile(fileInfo); err != nil {
+		return err
+	}
+
+	h, err := file.NewHandlerForRead(fileInfo.Path)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = h.Close()
+	}()
+
+	index, err = buildTableIndex(h.FileForRead(), fileInfo, index.Attributes.Name, index.Attributes.Columns)
+	if err != nil {
+		return err
+	}
+	return writeIndexFile(f.Path, index)
+}
+
+// RebuildIndexes rebuilds the indexes of a rewritten file.
+// Indexes that cannot be rebuilt are removed.
+func RebuildIndexes(fileInfo *FileInfo, session *Session) {
+	for _, f := range tableIndexFiles(fileInfo.Path) {
+		if err := rebuildTableIndex(fileInfo, f); err != nil {
+			_ = os.Remove(f.Path)
+			session.LogNotice(fmt.Sprintf("Commit: index %q on %q is dropped: %s", f.Name, fileInfo.Path, err.Error()), session.Flags.Quiet)
+			continue
+		}
+		session.LogNotice(fmt.Sprintf("Commit: index %q on %q is rebuilt.", f.Name, fileInfo.Path), session.Flags.Quiet)
+	}
+}
@@ -0,0 +1,393 @@
+package query
+
+import (
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/parser"
+
+	"github.com/mithrandie/go-text"
+)
+
+var scanRecordPositionsTests = []struct {
+	Name      string
+	Input     string
+	Delimiter byte
+	Encoding  text.Encoding
+	Result    []recordPosition
+	Error     string
+}{
+	{
+		Name:      "ScanRecordPositions",
+		Input:     "a,b\n1,2\r\n3,4\r5,6",
+		Delimiter: ',',
+		Encoding:  text.UTF8,
+		Result:    []recordPosition{{0, 3}, {4, 3}, {9, 3}, {13, 3}},
+	},
+	{
+		Name:      "ScanRecordPositions Quoted Fields",
+		Input:     "\"a\nb\",c\n\"x\"\"y\",z\n",
+		Delimiter: ',',
+		Encoding:  text.UTF8,
+		Result:    []recordPosition{{0, 7}, {8, 8}},
+	},
+	{
+		Name:      "ScanRecordPositions Empty Lines",
+		Input:     "a\n\n\"\"\nb\n",
+		Delimiter: ',',
+		Encoding:  text.UTF8,
+		Result:    []recordPosition{{0, 1}, {6, 1}},
+	},
+	{
+		Name:      "ScanRecordPositions Quotation Mark in Unquoted Field",
+		Input:     "a\"b,c\n",
+		Delimiter: ',',
+		Encoding:  text.UTF8,
+		Result:    []recordPosition{{0, 5}},
+	},
+	{
+		Name:      "ScanRecordPositions SJIS",
+		Input:     "\x95\x7c|a\nb|c",
+		Delimiter: '|',
+		Encoding:  text.SJIS,
+		Result:    []recordPosition{{0, 4}, {5, 3}},
+	},
+	{
+		Name:      "ScanRecordPositions Unexpected Quotation Mark Error",
+		Input:     "\"a\"b\n",
+		Delimiter: ',',
+		Encoding:  text.UTF8,
+		Error:     "unexpected \" in field at offset 4",
+	},
+	{
+		Name:      "ScanRecordPositions Extraneous Quotation Mark Error",
+		Input:     "\"a\n",
+		Delimiter: ',',
+		Encoding:  text.UTF8,
+		Error:     "extraneous \" in field",
+	},
+}
+
+func TestScanRecordPositions(t *testing.T) {
+	for _, v := range scanRecordPositionsTests {
+		result, err := scanRecordPositions(strings.NewReader(v.Input), v.Delimiter, v.Encoding)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if !reflect.DeepEqual(result, v.Result) {
+			t.Errorf("%s: result = %v, want %v", v.Name, result, v.Result)
+		}
+	}
+}
+
+var indexStatementTests = []struct {
+	Name   string
+	Query  string
+	Result string
+	Error  string
+}{
+	{
+		Name:   "Create Index",
+		Query:  "create index idx_id on index_table (id)",
+		Result: GetTestFilePath("index_table.csv"),
+	},
+	{
+		Name:   "Create Index with Multiple Columns",
+		Query:  "create index idx_name on `index_table.csv` (NAME, note)",
+		Result: GetTestFilePath("index_table.csv"),
+	},
+	{
+		Name:  "Create Index Already Exists Error",
+		Query: "create index IDX_ID on index_table (name)",
+		Error: "[L:1 C:14] index IDX_ID already exists on " + GetTestFilePath("index_table.csv"),
+	},
+	{
+		Name:  "Create Index Field Not Exist Error",
+		Query: "create index idx_notexist on index_table (notexist)",
+		Error: "[L:1 C:43] field notexist does not exist",
+	},
+	{
+		Name:  "Create Index Invalid Name Error",
+		Query: "create index `idx.1` on index_table (id)",
+		Error: "[L:1 C:14] `idx.1` is an invalid index name",
+	},
+	{
+		Name:  "Create Index Not Supported Error",
+		Query: "create index idx on `table.json` (item1)",
+		Error: "[L:1 C:21] index cannot be created on " + GetTestFilePath("table.json") + ": format JSON is not supported",
+	},
+	{
+		Name:  "Create Index File Not Exist Error",
+		Query: "create index idx on notexist (id)",
+		Error: "[L:1 C:21] file notexist does not exist",
+	},
+	{
+		Name:   "Drop Index",
+		Query:  "drop index idx_name on index_table",
+		Result: GetTestFilePath("index_table.csv"),
+	},
+	{
+		Name:  "Drop Index Not Exist Error",
+		Query: "drop index idx_name on index_table",
+		Error: "[L:1 C:12] index idx_name does not exist on " + GetTestFilePath("index_table.csv"),
+	},
+}
+
+func TestCreateIndexAndDropIndex(t *testing.T) {
+	tf := cmd.GetFlags()
+	tf.Repository = TestDir
+	defer removeTestIndexFiles()
+
+	for _, v := range indexStatementTests {
+		statements, err := parser.Parse(v.Query, "")
+		if err != nil {
+			t.Fatalf("%s: unexpected parsing error %q", v.Name, err)
+		}
+
+		var result string
+		switch stmt := statements[0].(type) {
+		case parser.CreateIndex:
+			result, err = CreateIndex(stmt, NewEmptyFilter())
+		case parser.DropIndex:
+			result, err = DropIndex(stmt, NewEmptyFilter())
+		}
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if result != v.Result {
+			t.Errorf("%s: result = %q, want %q", v.Name, result, v.Result)
+		}
+	}
+
+	files := tableIndexFiles(GetTestFilePath("index_table.csv"))
+	expect := []indexFile{{Name: "idx_id", Path: GetTestFilePath("index_table.csv.idx_id.idx")}}
+	if !reflect.DeepEqual(files, expect) {
+		t.Errorf("index files = %v, want %v", files, expect)
+	}
+}
+
+var selectWithIndexTests = []struct {
+	Name     string
+	Query    string
+	Index    string
+	LoadRows int
+}{
+	{
+		Name:     "Select with Index Equality",
+		Query:    "select * from index_table where id = 3",
+		Index:    "idx_id",
+		LoadRows: 2,
+	},
+	{
+		Name:     "Select with Index Range",
+		Query:    "select * from index_table where id > 2 and id <= 10",
+		Index:    "idx_id",
+		LoadRows: 4,
+	},
+	{
+		Name:     "Select with Index Reversed Comparison",
+		Query:    "select * from index_table where 2 < id and name like 'c%'",
+		Index:    "idx_id",
+		LoadRows: 4,
+	},
+	{
+		Name:     "Select with Index In",
+		Query:    "select name from index_table t where t.id in (1, 10)",
+		Index:    "idx_id",
+		LoadRows: 2,
+	},
+	{
+		Name:     "Select with Index Between",
+		Query:    "select * from index_table where id between 1 and 2",
+		Index:    "idx_id",
+		LoadRows: 2,
+	},
+	{
+		Name:     "Select with Index String",
+		Query:    "select * from index_table where id = 'abc'",
+		Index:    "idx_id",
+		LoadRows: 1,
+	},
+	{
+		Name:     "Select with Index on Second Column",
+		Query:    "select * from index_table where note = 'x'",
+		Index:    "idx_name",
+		LoadRows: 1,
+	},
+	{
+		Name:     "Select without Index for Disjunction",
+		Query:    "select * from index_table where id = 3 or name = 'fig'",
+		LoadRows: 8,
+	},
+	{
+		Name:     "Select without Index for Not Supported Operator",
+		Query:    "select * from index_table where id like '3'",
+		LoadRows: 8,
+	},
+}
+
+func removeTestIndexFiles() {
+	for _, f := range tableIndexFiles(GetTestFilePath("index_table.csv")) {
+		_ = os.Remove(f.Path)
+	}
+}
+
+func loadPlanNode(node *PlanNode) *PlanNode {
+	if node.Operation == "Load" {
+		return node
+	}
+	for _, child := range node.Children {
+		if n := loadPlanNode(child); n != nil {
+			return n
+		}
+	}
+	return nil
+}
+
+func selectForIndexTest(query string) (RecordSet, *PlanNode, error) {
+	ViewCache.Clean()
+	statements, err := parser.Parse(query, "")
+	if err != nil {
+		return nil, nil, err
+	}
+	view, err := Select(statements[0].(parser.SelectQuery), NewEmptyFilter())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ViewCache.Clean()
+	statements, _ = parser.Parse("explain analyze "+query, "")
+	plan, err := Explain(statements[0].(parser.Explain), NewEmptyFilter())
+	if err != nil {
+		return nil, nil, err
+	}
+	return view.RecordSet, loadPlanNode(plan), nil
+}
+
+func TestSelectWithIndex(t *testing.T) {
+	tf := cmd.GetFlags()
+	tf.Repository = TestDir
+	defer removeTestIndexFiles()
+
+	expects := make([]RecordSet, len(selectWithIndexTests))
+	for i, v := range selectWithIndexTests {
+		records, _, err := selectForIndexTest(v.Query)
+		if err != nil {
+			t.Fatalf("%s: unexpected error %q", v.Name, err)
+		}
+		expects[i] = records
+	}
+
+	for _, q := range []string{
+		"create index idx_id on index_table (id)",
+		"create index idx_name on index_table (name, note)",
+	} {
+		statements, _ := parser.Parse(q, "")
+		if _, err := CreateIndex(statements[0].(parser.CreateIndex), NewEmptyFilter()); err != nil {
+			t.Fatalf("unexpected error %q", err)
+		}
+	}
+
+	for i, v := range selectWithIndexTests {
+		records, node, err := selectForIndexTest(v.Query)
+		if err != nil {
+			t.Errorf("%s: unexpected error %q", v.Name, err)
+			continue
+		}
+
+		detail := "path: " + GetTestFilePath("index_table.csv") + ", format: CSV, cache: miss"
+		if 0 < len(v.Index) {
+			detail += ", index: " + v.Index
+		}
+		if node.Detail != detail {
+			t.Errorf("%s: load detail = %q, want %q", v.Name, node.Detail, detail)
+		}
+		if node.Rows != v.LoadRows {
+			t.Errorf("%s: loaded rows = %d, want %d", v.Name, node.Rows, v.LoadRows)
+		}
+		if !reflect.DeepEqual(records, expects[i]) {
+			t.Errorf("%s: result = %v, want %v", v.Name, records, expects[i])
+		}
+	}
+
+	ViewCache.Clean()
+	statements, _ := parser.Parse("explain select * from index_table where id = 3", "")
+	plan, err := Explain(statements[0].(parser.Explain), NewEmptyFilter())
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if detail := loadPlanNode(plan).Detail; !strings.HasSuffix(detail, ", index: idx_id") {
+		t.Errorf("static plan detail = %q, want to use index idx_id", detail)
+	}
+}
+
+func TestRebuildIndexes(t *testing.T) {
+	tf := cmd.GetFlags()
+	tf.Repository = TestDir
+	defer removeTestIndexFiles()
+
+	path := GetTestFilePath("index_table.csv")
+	original, _ := os.ReadFile(path)
+	defer func() {
+		_ = os.WriteFile(path, original, 0644)
+	}()
+
+	statements, _ := parser.Parse("create index idx_id on index_table (id)", "")
+	if _, err := CreateIndex(statements[0].(parser.CreateIndex), NewEmptyFilter()); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+
+	query := "select * from index_table where id = 3"
+
+	if err := os.WriteFile(path, append(original, []byte("3,lemon,v\n")...), 0644); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	_, node, err := selectForIndexTest(query)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if strings.Contains(node.Detail, "index:") || node.Rows != 9 {
+		t.Errorf("stale index: load detail = %q, rows = %d, want to load all 9 rows without index", node.Detail, node.Rows)
+	}
+
+	session := NewSession(nil)
+	session.Flags.Quiet = true
+
+	fileInfo := &FileInfo{Path: path, Format: cmd.CSV, Delimiter: ',', Encoding: text.UTF8}
+	RebuildIndexes(fileInfo, session)
+
+	records, node, err := selectForIndexTest(query)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if !strings.HasSuffix(node.Detail, ", index: idx_id") || node.Rows != 3 || len(records) != 3 {
+		t.Errorf("rebuilt index: load detail = %q, rows = %d, results = %d, want to load 3 rows with index idx_id", node.Detail, node.Rows, len(records))
+	}
+
+	fileInfo.Format = cmd.JSON
+	RebuildIndexes(fileInfo, session)
+	if files := tableIndexFiles(path); 0 < len(files) {
+		t.Errorf("index files = %v, want to be removed", files)
+	}
+}
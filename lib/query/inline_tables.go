@@ -45,10 +45,12 @@ func (it InlineTableMap) Set(inlineTable parser.InlineTable, parentFilter *Filte
 	if inlineTable.IsRecursive() {
 		filter.RecursiveTable = &inlineTable
 	}
+	stage := filter.startPlanStage("Inline Table", inlineTable.Name.Literal)
 	view, err := Select(inlineTable.Query, filter)
 	if err != nil {
 		return err
 	}
+	stage.finish(view.RecordLen())
 
 	err = view.Header.Update(inlineTable.Name.Literal, inlineTable.Fields)
 	if err != nil {
@@ -48,10 +48,7 @@ func WriteToStdout(s string) error {
 }
 
 func WriteToStdoutWithLineBreak(s string) error {
-	if 0 < len(s) && s[len(s)-1] != '\n' {
-		s = s + "\n"
-	}
-	return WriteToStdout(s)
+	return WriteToStdout(withLineBreak(s))
 }
 
 func WriteToStderr(s string) error {
@@ -64,8 +61,12 @@ func WriteToStderr(s string) error {
 }
 
 func WriteToStderrWithLineBreak(s string) error {
+	return WriteToStderr(withLineBreak(s))
+}
+
+func withLineBreak(s string) string {
 	if 0 < len(s) && s[len(s)-1] != '\n' {
 		s = s + "\n"
 	}
-	return WriteToStderr(s)
+	return s
 }
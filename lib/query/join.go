@@ -1,9 +1,12 @@
 package query
 
 import (
+	"bytes"
 	"math"
 
 	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+
 	"github.com/mithrandie/ternary"
 )
 
@@ -16,7 +19,7 @@ func ParseJoinCondition(join parser.Join, view *View, joinView *View) (parser.Qu
 
 	if !join.Natural.IsEmpty() {
 		for _, field := range view.Header {
-			if field.Column == InternalIdColumn {
+			if field.Column == InternalIdColumn || field.Column == FilenameColumn || field.Column == LineNumberColumn {
 				continue
 			}
 			ref := parser.FieldReference{BaseExpr: parser.NewBaseExpr(join.Natural), Column: parser.Identifier{Literal: field.Column}}
@@ -105,7 +108,7 @@ func CrossJoin(view *View, joinView *View) {
 	mergedHeader := MergeHeader(view.Header, joinView.Header)
 	records := make(RecordSet, view.RecordLen()*joinView.RecordLen())
 
-	NewGoroutineTaskManager(view.RecordLen(), CalcMinimumRequired(view.RecordLen(), joinView.RecordLen(), MinimumRequiredPerCPUCore)).Run(func(index int) {
+	NewGoroutineTaskManager(view.RecordLen(), CalcMinimumRequired(view.RecordLen(), joinView.RecordLen(), MinimumRequiredPerCPUCore), view.cpu()).Run(func(index int) {
 		start := index * joinView.RecordLen()
 		for i := 0; i < joinView.RecordLen(); i++ {
 			records[start+i] = append(view.RecordSet[index], joinView.RecordSet[i]...)
@@ -125,7 +128,26 @@ func InnerJoin(view *View, joinView *View, condition parser.QueryExpression, par
 
 	mergedHeader := MergeHeader(view.Header, joinView.Header)
 
-	gm := NewGoroutineTaskManager(view.RecordLen(), CalcMinimumRequired(view.RecordLen(), joinView.RecordLen(), MinimumRequiredPerCPUCore))
+	if viewKeys, joinViewKeys := EquiJoinKeys(condition, view, joinView); viewKeys != nil {
+		matches, err := hashJoinMatches(view, joinView, viewKeys, joinViewKeys, mergedHeader, condition, false, parentFilter)
+		if err != nil {
+			return err
+		}
+
+		records := make(RecordSet, 0, view.RecordLen())
+		for i, joinIndices := range matches {
+			for _, j := range joinIndices {
+				records = append(records, append(view.RecordSet[i], joinView.RecordSet[j]...))
+			}
+		}
+
+		view.Header = mergedHeader
+		view.RecordSet = records
+		view.FileInfo = nil
+		return nil
+	}
+
+	gm := NewGoroutineTaskManager(view.RecordLen(), CalcMinimumRequired(view.RecordLen(), joinView.RecordLen(), MinimumRequiredPerCPUCore), parentFilter.Flags().CPU)
 	recordsList := make([]RecordSet, gm.Number)
 	for i := 0; i < gm.Number; i++ {
 		gm.Add()
@@ -192,7 +214,55 @@ func OuterJoin(view *View, joinView *View, condition parser.QueryExpression, dir
 	viewEmptyRecord := NewEmptyRecord(view.FieldLen())
 	joinViewEmptyRecord := NewEmptyRecord(joinView.FieldLen())
 
-	gm := NewGoroutineTaskManager(view.RecordLen(), CalcMinimumRequired(view.RecordLen(), joinView.RecordLen(), MinimumRequiredPerCPUCore))
+	if viewKeys, joinViewKeys := EquiJoinKeys(condition, view, joinView); viewKeys != nil {
+		matches, err := hashJoinMatches(view, joinView, viewKeys, joinViewKeys, mergedHeader, condition, direction == parser.RIGHT, parentFilter)
+		if err != nil {
+			return err
+		}
+
+		records := make(RecordSet, 0, view.RecordLen())
+		joinViewMatches := make([]bool, joinView.RecordLen())
+		for i, joinIndices := range matches {
+			if len(joinIndices) < 1 {
+				switch direction {
+				case parser.RIGHT:
+					records = append(records, append(joinViewEmptyRecord, view.RecordSet[i]...))
+				default:
+					records = append(records, append(view.RecordSet[i], joinViewEmptyRecord...))
+				}
+				continue
+			}
+
+			for _, j := range joinIndices {
+				joinViewMatches[j] = true
+				switch direction {
+				case parser.RIGHT:
+					records = append(records, append(joinView.RecordSet[j], view.RecordSet[i]...))
+				default:
+					records = append(records, append(view.RecordSet[i], joinView.RecordSet[j]...))
+				}
+			}
+		}
+
+		if direction == parser.FULL {
+			for j, match := range joinViewMatches {
+				if !match {
+					records = append(records, append(viewEmptyRecord, joinView.RecordSet[j]...))
+				}
+			}
+		}
+
+		if direction == parser.RIGHT {
+			view, joinView = joinView, view
+		}
+
+		view.Header = mergedHeader
+		view.RecordSet = records
+		view.FileInfo = nil
+		return nil
+	}
+
+	gm := NewGoroutineTaskManager(view.RecordLen(), CalcMinimumRequired(view.RecordLen(), joinView.RecordLen(), MinimumRequiredPerCPUCore), parentFilter.Flags().CPU)
 
 	recordsList := make([]RecordSet, gm.Number)
 	joinViewMatchesList := make([][]bool, gm.Number)
@@ -294,6 +364,187 @@ func OuterJoin(view *View, joinView *View, condition parser.QueryExpression, dir
 	return nil
 }
 
+// EquiJoinKeys returns the indices of the fields compared by equal operators
+// in the conjunctive terms of the join condition. The first indices refer to
+// fields in view, and the second ones refer to fields in joinView.
+// Nil is returned if the condition has no such comparison.
+func EquiJoinKeys(condition parser.QueryExpression, view *View, joinView *View) ([]int, []int) {
+	var viewKeys []int
+	var joinViewKeys []int
+
+	for _, expr := range conjunctiveTerms(condition) {
+		comp, ok := expr.(parser.Comparison)
+		if !ok || comp.Operator != "=" {
+			continue
+		}
+
+		lhsViewIdx, lhsJoinViewIdx := joinFieldIndex(comp.LHS, view, joinView)
+		rhsViewIdx, rhsJoinViewIdx := joinFieldIndex(comp.RHS, view, joinView)
+
+		switch {
+		case -1 < lhsViewIdx && -1 < rhsJoinViewIdx:
+			viewKeys = append(viewKeys, lhsViewIdx)
+			joinViewKeys = append(joinViewKeys, rhsJoinViewIdx)
+		case -1 < lhsJoinViewIdx && -1 < rhsViewIdx:
+			viewKeys = append(viewKeys, rhsViewIdx)
+			joinViewKeys = append(joinViewKeys, lhsJoinViewIdx)
+		}
+	}
+
+	return viewKeys, joinViewKeys
+}
+
+func conjunctiveTerms(expr parser.QueryExpression) []parser.QueryExpression {
+	switch expr.(type) {
+	case parser.Parentheses:
+		return conjunctiveTerms(expr.(parser.Parentheses).Expr)
+	case parser.Logic:
+		logic := expr.(parser.Logic)
+		if logic.Operator.Token == parser.AND {
+			return append(conjunctiveTerms(logic.LHS), conjunctiveTerms(logic.RHS)...)
+		}
+	}
+	return []parser.QueryExpression{expr}
+}
+
+func joinFieldIndex(expr parser.QueryExpression, view *View, joinView *View) (int, int) {
+	switch expr.(type) {
+	case parser.FieldReference, parser.ColumnNumber:
+	default:
+		return -1, -1
+	}
+
+	viewIdx, viewErr := view.FieldIndex(expr)
+	joinViewIdx, joinViewErr := joinView.FieldIndex(expr)
+
+	if viewErr == nil && joinViewErr != nil {
+		if _, ok := joinViewErr.(*FieldNotExistError); ok {
+			return viewIdx, -1
+		}
+	} else if viewErr != nil && joinViewErr == nil {
+		if _, ok := viewErr.(*FieldNotExistError); ok {
+			return -1, joinViewIdx
+		}
+	}
+	return -1, -1
+}
+
+// hashJoinMatches returns the indices of the records in joinView that satisfy
+// the condition for each record in view.
+// A hash table is built on the smaller view, and the other one probes it.
+// The condition is evaluated only for records that have the same keys.
+func hashJoinMatches(view *View, joinView *View, viewKeys []int, joinViewKeys []int, mergedHeader Header, condition parser.QueryExpression, reverse bool, parentFilter *Filter) ([][]int, error) {
+	buildOnView := view.RecordLen() < joinView.RecordLen()
+
+	build, probe := joinView, view
+	buildKeys, probeKeys := joinViewKeys, viewKeys
+	if buildOnView {
+		build, probe = view, joinView
+		buildKeys, probeKeys = viewKeys, joinViewKeys
+	}
+
+	table := make(map[string][]int, build.RecordLen())
+	buf := &bytes.Buffer{}
+	for i, record := range build.RecordSet {
+		if key, ok := serializeJoinKey(buf, record, buildKeys); ok {
+			table[key] = append(table[key], i)
+		}
+	}
+
+	matches := make([][]int, view.RecordLen())
+
+	gm := NewGoroutineTaskManager(probe.RecordLen(), -1, parentFilter.Flags().CPU)
+	pairsList := make([][][2]int, gm.Number)
+	for i := 0; i < gm.Number; i++ {
+		gm.Add()
+		go func(thIdx int) {
+			start, end := gm.RecordRange(thIdx)
+			filter := NewFilterForRecord(
+				&View{
+					Header:    mergedHeader,
+					RecordSet: make(RecordSet, 1),
+				},
+				0,
+				parentFilter,
+			)
+			buf := &bytes.Buffer{}
+			var pairs [][2]int
+
+		ProbeLoop:
+			for p := start; p < end; p++ {
+				key, ok := serializeJoinKey(buf, probe.RecordSet[p], probeKeys)
+				if !ok {
+					continue
+				}
+
+				for _, b := range table[key] {
+					if gm.HasError() {
+						break ProbeLoop
+					}
+
+					i, j := p, b
+					if buildOnView {
+						i, j = b, p
+					}
+
+					var mergedRecord Record
+					if reverse {
+						mergedRecord = append(joinView.RecordSet[j], view.RecordSet[i]...)
+					} else {
+						mergedRecord = append(view.RecordSet[i], joinView.RecordSet[j]...)
+					}
+					filter.Records[0].View.RecordSet[0] = mergedRecord
+
+					primary, e := filter.Evaluate(condition)
+					if e != nil {
+						gm.SetError(e)
+						break ProbeLoop
+					}
+					if primary.Ternary() != ternary.TRUE {
+						continue
+					}
+
+					if buildOnView {
+						pairs = append(pairs, [2]int{i, j})
+					} else {
+						matches[i] = append(matches[i], j)
+					}
+				}
+			}
+
+			pairsList[thIdx] = pairs
+			gm.Done()
+		}(i)
+	}
+	gm.Wait()
+
+	if gm.HasError() {
+		return nil, gm.Err()
+	}
+
+	for _, pairs := range pairsList {
+		for _, pair := range pairs {
+			matches[pair[0]] = append(matches[pair[0]], pair[1])
+		}
+	}
+	return matches, nil
+}
+
+func serializeJoinKey(buf *bytes.Buffer, record Record, indices []int) (string, bool) {
+	buf.Reset()
+	for i, idx := range indices {
+		val := record[idx].Value()
+		if value.IsNull(val) {
+			return "", false
+		}
+		if 0 < i {
+			buf.WriteString(":")
+		}
+		SerializeKey(buf, val)
+	}
+	return buf.String(), true
+}
+
 func CalcMinimumRequired(i1 int, i2 int, defaultMinimumRequired int) int {
 	if i1 < 1 || i2 < 1 {
 		return defaultMinimumRequired
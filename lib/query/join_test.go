@@ -228,6 +228,125 @@ func TestParseJoinCondition(t *testing.T) {
 	}
 }
 
+var equiJoinKeysTests = []struct {
+	Name         string
+	Condition    parser.QueryExpression
+	ViewKeys     []int
+	JoinViewKeys []int
+}{
+	{
+		Name: "EquiJoinKeys",
+		Condition: parser.Comparison{
+			LHS:      parser.FieldReference{View: parser.Identifier{Literal: "table1"}, Column: parser.Identifier{Literal: "column2"}},
+			RHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column3"}},
+			Operator: "=",
+		},
+		ViewKeys:     []int{2},
+		JoinViewKeys: []int{2},
+	},
+	{
+		Name: "EquiJoinKeys Multiple Keys in Parentheses",
+		Condition: parser.Parentheses{
+			Expr: parser.Logic{
+				LHS: parser.Comparison{
+					LHS:      parser.FieldReference{View: parser.Identifier{Literal: "table2"}, Column: parser.Identifier{Literal: "column1"}},
+					RHS:      parser.FieldReference{View: parser.Identifier{Literal: "table1"}, Column: parser.Identifier{Literal: "column1"}},
+					Operator: "=",
+				},
+				RHS: parser.Comparison{
+					LHS:      parser.ColumnNumber{View: parser.Identifier{Literal: "table1"}, Number: value.NewInteger(2)},
+					RHS:      parser.FieldReference{View: parser.Identifier{Literal: "table2"}, Column: parser.Identifier{Literal: "column3"}},
+					Operator: "=",
+				},
+				Operator: parser.Token{Token: parser.AND, Literal: "and"},
+			},
+		},
+		ViewKeys:     []int{1, 2},
+		JoinViewKeys: []int{1, 2},
+	},
+	{
+		Name: "EquiJoinKeys Ignore Non-Equal Comparison",
+		Condition: parser.Logic{
+			LHS: parser.Comparison{
+				LHS:      parser.FieldReference{View: parser.Identifier{Literal: "table1"}, Column: parser.Identifier{Literal: "column1"}},
+				RHS:      parser.FieldReference{View: parser.Identifier{Literal: "table2"}, Column: parser.Identifier{Literal: "column1"}},
+				Operator: "=",
+			},
+			RHS: parser.Comparison{
+				LHS:      parser.FieldReference{View: parser.Identifier{Literal: "table1"}, Column: parser.Identifier{Literal: "column2"}},
+				RHS:      parser.FieldReference{View: parser.Identifier{Literal: "table2"}, Column: parser.Identifier{Literal: "column3"}},
+				Operator: "<",
+			},
+			Operator: parser.Token{Token: parser.AND, Literal: "and"},
+		},
+		ViewKeys:     []int{1},
+		JoinViewKeys: []int{1},
+	},
+	{
+		Name: "EquiJoinKeys Disjunction",
+		Condition: parser.Logic{
+			LHS: parser.Comparison{
+				LHS:      parser.FieldReference{View: parser.Identifier{Literal: "table1"}, Column: parser.Identifier{Literal: "column1"}},
+				RHS:      parser.FieldReference{View: parser.Identifier{Literal: "table2"}, Column: parser.Identifier{Literal: "column1"}},
+				Operator: "=",
+			},
+			RHS: parser.Comparison{
+				LHS:      parser.FieldReference{View: parser.Identifier{Literal: "table1"}, Column: parser.Identifier{Literal: "column2"}},
+				RHS:      parser.FieldReference{View: parser.Identifier{Literal: "table2"}, Column: parser.Identifier{Literal: "column3"}},
+				Operator: "=",
+			},
+			Operator: parser.Token{Token: parser.OR, Literal: "or"},
+		},
+		ViewKeys:     nil,
+		JoinViewKeys: nil,
+	},
+	{
+		Name: "EquiJoinKeys Ambiguous Field",
+		Condition: parser.Comparison{
+			LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+			RHS:      parser.FieldReference{View: parser.Identifier{Literal: "table2"}, Column: parser.Identifier{Literal: "column1"}},
+			Operator: "=",
+		},
+		ViewKeys:     nil,
+		JoinViewKeys: nil,
+	},
+	{
+		Name: "EquiJoinKeys Fields in the Same View",
+		Condition: parser.Comparison{
+			LHS:      parser.FieldReference{View: parser.Identifier{Literal: "table1"}, Column: parser.Identifier{Literal: "column1"}},
+			RHS:      parser.FieldReference{View: parser.Identifier{Literal: "table1"}, Column: parser.Identifier{Literal: "column2"}},
+			Operator: "=",
+		},
+		ViewKeys:     nil,
+		JoinViewKeys: nil,
+	},
+	{
+		Name: "EquiJoinKeys Comparison with Value",
+		Condition: parser.Comparison{
+			LHS:      parser.FieldReference{View: parser.Identifier{Literal: "table1"}, Column: parser.Identifier{Literal: "column1"}},
+			RHS:      parser.NewIntegerValue(1),
+			Operator: "=",
+		},
+		ViewKeys:     nil,
+		JoinViewKeys: nil,
+	},
+}
+
+func TestEquiJoinKeys(t *testing.T) {
+	view := &View{Header: NewHeaderWithId("table1", []string{"column1", "column2"})}
+	joinView := &View{Header: NewHeaderWithId("table2", []string{"column1", "column3"})}
+
+	for _, v := range equiJoinKeysTests {
+		viewKeys, joinViewKeys := EquiJoinKeys(v.Condition, view, joinView)
+		if !reflect.DeepEqual(viewKeys, v.ViewKeys) {
+			t.Errorf("%s: view keys = %v, want %v", v.Name, viewKeys, v.ViewKeys)
+		}
+		if !reflect.DeepEqual(joinViewKeys, v.JoinViewKeys) {
+			t.Errorf("%s: join view keys = %v, want %v", v.Name, joinViewKeys, v.JoinViewKeys)
+		}
+	}
+}
+
 func TestCrossJoin(t *testing.T) {
 	view := &View{
 		Header: NewHeaderWithId("table1", []string{"column1", "column2"}),
@@ -562,6 +681,89 @@ var innerJoinTests = []struct {
 		},
 		Error: "[L:- C:-] field table2.notexist does not exist",
 	},
+	{
+		Name: "Inner Join with Hash Table on Smaller View",
+		CPU:  2,
+		View: &View{
+			Header: NewHeaderWithId("table1", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecordWithId(1, []value.Primary{
+					value.NewInteger(1),
+					value.NewString("str1"),
+				}),
+				NewRecordWithId(2, []value.Primary{
+					value.NewNull(),
+					value.NewString("str2"),
+				}),
+				NewRecordWithId(3, []value.Primary{
+					value.NewInteger(2),
+					value.NewString("str3"),
+				}),
+			},
+		},
+		JoinView: &View{
+			Header: NewHeaderWithId("table2", []string{"column1", "column3"}),
+			RecordSet: []Record{
+				NewRecordWithId(1, []value.Primary{
+					value.NewInteger(2),
+					value.NewString("str22"),
+				}),
+				NewRecordWithId(2, []value.Primary{
+					value.NewInteger(1),
+					value.NewString("str11"),
+				}),
+				NewRecordWithId(3, []value.Primary{
+					value.NewNull(),
+					value.NewString("str33"),
+				}),
+				NewRecordWithId(4, []value.Primary{
+					value.NewString("1"),
+					value.NewString("str44"),
+				}),
+			},
+		},
+		Condition: parser.Logic{
+			LHS: parser.Comparison{
+				LHS:      parser.FieldReference{View: parser.Identifier{Literal: "table2"}, Column: parser.Identifier{Literal: "column1"}},
+				RHS:      parser.FieldReference{View: parser.Identifier{Literal: "table1"}, Column: parser.Identifier{Literal: "column1"}},
+				Operator: "=",
+			},
+			RHS: parser.Comparison{
+				LHS:      parser.FieldReference{View: parser.Identifier{Literal: "table2"}, Column: parser.Identifier{Literal: "column3"}},
+				RHS:      parser.NewStringValue("str11"),
+				Operator: "<>",
+			},
+			Operator: parser.Token{Token: parser.AND, Literal: "and"},
+		},
+		Result: &View{
+			Header: []HeaderField{
+				{View: "table1", Column: InternalIdColumn},
+				{View: "table1", Column: "column1", Number: 1, IsFromTable: true},
+				{View: "table1", Column: "column2", Number: 2, IsFromTable: true},
+				{View: "table2", Column: InternalIdColumn},
+				{View: "table2", Column: "column1", Number: 1, IsFromTable: true},
+				{View: "table2", Column: "column3", Number: 2, IsFromTable: true},
+			},
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewInteger(1),
+					value.NewInteger(1),
+					value.NewString("str1"),
+					value.NewInteger(4),
+					value.NewString("1"),
+					value.NewString("str44"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewInteger(3),
+					value.NewInteger(2),
+					value.NewString("str3"),
+					value.NewInteger(1),
+					value.NewInteger(2),
+					value.NewString("str22"),
+				}),
+			},
+		},
+	},
 }
 
 func TestInnerJoin(t *testing.T) {
@@ -974,6 +1176,105 @@ var outerJoinTests = []struct {
 			},
 		},
 	},
+	{
+		Name: "Full Outer Join with Hash Table on Smaller View",
+		View: &View{
+			Header: NewHeaderWithId("table1", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecordWithId(1, []value.Primary{
+					value.NewInteger(1),
+					value.NewString("str1"),
+				}),
+				NewRecordWithId(2, []value.Primary{
+					value.NewNull(),
+					value.NewString("str2"),
+				}),
+				NewRecordWithId(3, []value.Primary{
+					value.NewInteger(2),
+					value.NewString("str3"),
+				}),
+			},
+		},
+		JoinView: &View{
+			Header: NewHeaderWithId("table2", []string{"column1", "column3"}),
+			RecordSet: []Record{
+				NewRecordWithId(1, []value.Primary{
+					value.NewInteger(2),
+					value.NewString("str22"),
+				}),
+				NewRecordWithId(2, []value.Primary{
+					value.NewInteger(1),
+					value.NewString("str11"),
+				}),
+				NewRecordWithId(3, []value.Primary{
+					value.NewNull(),
+					value.NewString("str33"),
+				}),
+				NewRecordWithId(4, []value.Primary{
+					value.NewString("1"),
+					value.NewString("str44"),
+				}),
+			},
+		},
+		Condition: parser.Comparison{
+			LHS:      parser.FieldReference{View: parser.Identifier{Literal: "table1"}, Column: parser.Identifier{Literal: "column1"}},
+			RHS:      parser.FieldReference{View: parser.Identifier{Literal: "table2"}, Column: parser.Identifier{Literal: "column1"}},
+			Operator: "=",
+		},
+		Direction: parser.FULL,
+		Result: &View{
+			Header: []HeaderField{
+				{View: "table1", Column: InternalIdColumn},
+				{View: "table1", Column: "column1", Number: 1, IsFromTable: true},
+				{View: "table1", Column: "column2", Number: 2, IsFromTable: true},
+				{View: "table2", Column: InternalIdColumn},
+				{View: "table2", Column: "column1", Number: 1, IsFromTable: true},
+				{View: "table2", Column: "column3", Number: 2, IsFromTable: true},
+			},
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewInteger(1),
+					value.NewInteger(1),
+					value.NewString("str1"),
+					value.NewInteger(2),
+					value.NewInteger(1),
+					value.NewString("str11"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewInteger(1),
+					value.NewInteger(1),
+					value.NewString("str1"),
+					value.NewInteger(4),
+					value.NewString("1"),
+					value.NewString("str44"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewInteger(2),
+					value.NewNull(),
+					value.NewString("str2"),
+					value.NewNull(),
+					value.NewNull(),
+					value.NewNull(),
+				}),
+				NewRecord([]value.Primary{
+					value.NewInteger(3),
+					value.NewInteger(2),
+					value.NewString("str3"),
+					value.NewInteger(1),
+					value.NewInteger(2),
+					value.NewString("str22"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewNull(),
+					value.NewNull(),
+					value.NewNull(),
+					value.NewInteger(3),
+					value.NewNull(),
+					value.NewString("str33"),
+				}),
+			},
+		},
+	},
 }
 
 func TestOuterJoin(t *testing.T) {
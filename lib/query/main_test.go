@@ -75,7 +75,9 @@ func setup() {
 	copyfile(filepath.Join(TestDir, "table2.csv"), filepath.Join(TestDataDir, "table2.csv"))
 	copyfile(filepath.Join(TestDir, "table4.csv"), filepath.Join(TestDataDir, "table4.csv"))
 	copyfile(filepath.Join(TestDir, "table5.csv"), filepath.Join(TestDataDir, "table5.csv"))
+	copyfile(filepath.Join(TestDir, "table_dialect.csv"), filepath.Join(TestDataDir, "table_dialect.csv"))
 	copyfile(filepath.Join(TestDir, "group_table.csv"), filepath.Join(TestDataDir, "group_table.csv"))
+	copyfile(filepath.Join(TestDir, "index_table.csv"), filepath.Join(TestDataDir, "index_table.csv"))
 	copyfile(filepath.Join(TestDir, "insert_query.csv"), filepath.Join(TestDataDir, "table1.csv"))
 	copyfile(filepath.Join(TestDir, "update_query.csv"), filepath.Join(TestDataDir, "table1.csv"))
 	copyfile(filepath.Join(TestDir, "delete_query.csv"), filepath.Join(TestDataDir, "table1.csv"))
@@ -96,6 +98,12 @@ func setup() {
 
 	copyfile(filepath.Join(TestDir, "fixed_length.txt"), filepath.Join(TestDataDir, "fixed_length.txt"))
 
+	copyfile(filepath.Join(TestDir, "table7.parquet"), filepath.Join(TestDataDir, "table7.parquet"))
+
+	copyfile(filepath.Join(TestDir, "table8.xlsx"), filepath.Join(TestDataDir, "table8.xlsx"))
+	copyfile(filepath.Join(TestDir, "table9.csv.gz"), filepath.Join(TestDataDir, "table9.csv.gz"))
+	copyfile(filepath.Join(TestDir, "table10.jsonl"), filepath.Join(TestDataDir, "table10.jsonl"))
+
 	copyfile(filepath.Join(TestDir, "autoselect"), filepath.Join(TestDataDir, "autoselect"))
 
 	copyfile(filepath.Join(TestDir, "source.sql"), filepath.Join(filepath.Join(GetWD(), "..", "..", "testdata"), "source.sql"))
@@ -143,9 +151,17 @@ func initFlag(flags *cmd.Flags) {
 	flags.WaitTimeout = 15
 	flags.Delimiter = ','
 	flags.JsonQuery = ""
+	flags.Sheet = ""
 	flags.Encoding = text.UTF8
 	flags.NoHeader = false
 	flags.WithoutNull = false
+	flags.QuoteChar = '"'
+	flags.EscapeStyle = cmd.DoubleQuoteEscape
+	flags.LazyQuotes = false
+	flags.CommentPrefix = ""
+	flags.SkipLines = 0
+	flags.OnParseError = cmd.FailOnParseError
+	flags.RejectFile = ""
 	flags.Format = cmd.TEXT
 	flags.WriteEncoding = text.UTF8
 	flags.WriteDelimiter = ','
@@ -161,6 +177,9 @@ func initFlag(flags *cmd.Flags) {
 	flags.Quiet = false
 	flags.CPU = cpu
 	flags.Stats = false
+	flags.DetectDelimiter = false
+	flags.DetectEncoding = false
+	flags.DetectHeader = false
 	flags.DelimitAutomatically = false
 	flags.DelimiterPositions = nil
 	flags.WriteDelimiterPositions = nil
@@ -0,0 +1,305 @@
+package query
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/ternary"
+	"github.com/parquet-go/parquet-go"
+	"github.com/parquet-go/parquet-go/deprecated"
+	"github.com/parquet-go/parquet-go/format"
+)
+
+const julianDayOfUnixEpoch = 2440588
+
+type parquetColumn struct {
+	Name string
+	Node parquet.Node
+}
+
+func parquetLeafColumns(node parquet.Node, path []string) ([]parquetColumn, error) {
+	columns := make([]parquetColumn, 0, len(node.Fields()))
+	for _, field := range node.Fields() {
+		fieldPath := append(append(make([]string, 0, len(path)+1), path...), field.Name())
+		if field.Repeated() {
+			return nil, errors.New(fmt.Sprintf("repeated field %s is not supported", strings.Join(fieldPath, ".")))
+		}
+
+		if field.Leaf() {
+			columns = append(columns, parquetColumn{Name: strings.Join(fieldPath, "."), Node: field})
+			continue
+		}
+
+		children, err := parquetLeafColumns(field, fieldPath)
+		if err != nil {
+			return nil, err
+		}
+		columns = append(columns, children...)
+	}
+	return columns, nil
+}
+
+func convertParquetValue(v parquet.Value, node parquet.Node) value.Primary {
+	if v.IsNull() {
+		return value.NewNull()
+	}
+
+	lt := node.Type().LogicalType()
+	if lt == nil {
+		lt = &format.LogicalType{}
+	}
+
+	switch v.Kind() {
+	case parquet.Boolean:
+		return value.NewBoolean(v.Boolean())
+	case parquet.Int32:
+		i := int64(v.Int32())
+		switch {
+		case lt.Date != nil:
+			t := time.Unix(i*86400, 0).UTC()
+			return value.NewDatetime(time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, cmd.GetLocation()))
+		case lt.Time != nil:
+			return value.NewString(formatParquetTime(i, time.Millisecond))
+		case lt.Decimal != nil:
+			return value.NewFloat(float64(i) / math.Pow10(int(lt.Decimal.Scale)))
+		case lt.Integer != nil && !lt.Integer.IsSigned:
+			return value.NewInteger(int64(uint32(v.Int32())))
+		}
+		return value.NewInteger(i)
+	case parquet.Int64:
+		i := v.Int64()
+		switch {
+		case lt.Timestamp != nil:
+			return value.NewDatetime(parquetTimestamp(i, timeUnitDuration(lt.Timestamp.Unit), lt.Timestamp.IsAdjustedToUTC))
+		case lt.Time != nil:
+			return value.NewString(formatParquetTime(i, timeUnitDuration(lt.Time.Unit)))
+		case lt.Decimal != nil:
+			return value.NewFloat(float64(i) / math.Pow10(int(lt.Decimal.Scale)))
+		case lt.Integer != nil && !lt.Integer.IsSigned && i < 0:
+			return value.NewFloat(float64(uint64(i)))
+		}
+		return value.NewInteger(i)
+	case parquet.Int96:
+		return value.NewDatetime(int96ToTime(v.Int96()))
+	case parquet.Float:
+		return value.NewFloat(float64(v.Float()))
+	case parquet.Double:
+		return value.NewFloat(v.Double())
+	default: // parquet.ByteArray, parquet.FixedLenByteArray
+		b := v.ByteArray()
+		switch {
+		case lt.Decimal != nil:
+			return value.NewFloat(decimalBytesToFloat(b, int(lt.Decimal.Scale)))
+		case lt.UUID != nil && len(b) == 16:
+			return value.NewString(formatUUID(b))
+		}
+		return value.NewString(string(b))
+	}
+}
+
+func timeUnitDuration(unit format.TimeUnit) time.Duration {
+	switch {
+	case unit.Millis != nil:
+		return time.Millisecond
+	case unit.Micros != nil:
+		return time.Microsecond
+	}
+	return time.Nanosecond
+}
+
+func parquetTimestamp(i int64, unit time.Duration, isAdjustedToUTC bool) time.Time {
+	perSec := int64(time.Second / unit)
+	sec := i / perSec
+	nsec := (i % perSec) * int64(unit)
+	if nsec < 0 {
+		sec--
+		nsec += int64(time.Second)
+	}
+
+	t := time.Unix(sec, nsec)
+	if isAdjustedToUTC {
+		return t.In(cmd.GetLocation())
+	}
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), cmd.GetLocation())
+}
+
+func int96ToTime(i deprecated.Int96) time.Time {
+	nsec := int64(i[1])<<32 | int64(i[0])
+	days := int64(i[2]) - julianDayOfUnixEpoch
+	return time.Unix(days*86400, nsec).In(cmd.GetLocation())
+}
+
+func formatParquetTime(i int64, unit time.Duration) string {
+	return time.Unix(0, i*int64(unit)).UTC().Format("15:04:05.999999999")
+}
+
+func decimalBytesToFloat(b []byte, scale int) float64 {
+	unscaled := new(big.Int).SetBytes(b)
+	if 0 < len(b) && b[0]&0x80 != 0 {
+		unscaled.Sub(unscaled, new(big.Int).Lsh(big.NewInt(1), uint(len(b)*8)))
+	}
+	f, _ := new(big.Float).Quo(
+		new(big.Float).SetInt(unscaled),
+		new(big.Float).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(scale)), nil)),
+	).Float64()
+	return f
+}
+
+func formatUUID(b []byte) string {
+	s := hex.EncodeToString(b)
+	return s[0:8] + "-" + s[8:12] + "-" + s[12:16] + "-" + s[16:20] + "-" + s[20:]
+}
+
+type parquetColumnType int
+
+const (
+	parquetNullColumn parquetColumnType = iota
+	parquetIntegerColumn
+	parquetFloatColumn
+	parquetBooleanColumn
+	parquetDatetimeColumn
+	parquetStringColumn
+)
+
+func inferParquetColumnType(view *View, fieldIdx int) parquetColumnType {
+	colType := parquetNullColumn
+
+	for _, record := range view.RecordSet {
+		var t parquetColumnType
+
+		switch v := record[fieldIdx].Value().(type) {
+		case value.Null:
+			continue
+		case value.Integer:
+			t = parquetIntegerColumn
+		case value.Float:
+			t = parquetFloatColumn
+		case value.Boolean:
+			t = parquetBooleanColumn
+		case value.Ternary:
+			if v.Ternary() == ternary.UNKNOWN {
+				continue
+			}
+			t = parquetBooleanColumn
+		case value.Datetime:
+			t = parquetDatetimeColumn
+		default:
+			return parquetStringColumn
+		}
+
+		switch {
+		case colType == parquetNullColumn || colType == t:
+			colType = t
+		case (colType == parquetIntegerColumn && t == parquetFloatColumn) || (colType == parquetFloatColumn && t == parquetIntegerColumn):
+			colType = parquetFloatColumn
+		default:
+			return parquetStringColumn
+		}
+	}
+
+	return colType
+}
+
+func (t parquetColumnType) Node() parquet.Node {
+	var node parquet.Node
+	switch t {
+	case parquetIntegerColumn:
+		node = parquet.Int(64)
+	case parquetFloatColumn:
+		node = parquet.Leaf(parquet.DoubleType)
+	case parquetBooleanColumn:
+		node = parquet.Leaf(parquet.BooleanType)
+	case parquetDatetimeColumn:
+		node = parquet.Timestamp(parquet.Microsecond)
+	default:
+		node = parquet.String()
+	}
+	return parquet.Optional(node)
+}
+
+func (t parquetColumnType) Value(p value.Primary, columnIdx int) parquet.Value {
+	var v parquet.Value
+
+	switch t {
+	case parquetIntegerColumn:
+		if i, ok := p.(value.Integer); ok {
+			v = parquet.Int64Value(i.Raw())
+		}
+	case parquetFloatColumn:
+		switch p.(type) {
+		case value.Integer:
+			v = parquet.DoubleValue(float64(p.(value.Integer).Raw()))
+		case value.Float:
+			v = parquet.DoubleValue(p.(value.Float).Raw())
+		}
+	case parquetBooleanColumn:
+		switch p.(type) {
+		case value.Boolean:
+			v = parquet.BooleanValue(p.(value.Boolean).Raw())
+		case value.Ternary:
+			if p.(value.Ternary).Ternary() != ternary.UNKNOWN {
+				v = parquet.BooleanValue(p.(value.Ternary).Ternary().ParseBool())
+			}
+		}
+	case parquetDatetimeColumn:
+		if dt, ok := p.(value.Datetime); ok {
+			v = parquet.Int64Value(dt.Raw().UnixMicro())
+		}
+	default:
+		if !value.IsNull(p) {
+			s, _, _ := ConvertFieldContents(p, false)
+			v = parquet.ByteArrayValue([]byte(s))
+		}
+	}
+
+	if v.IsNull() {
+		return v.Level(0, 0, columnIdx)
+	}
+	return v.Level(0, 1, columnIdx)
+}
+
+// parquetGroup is a group node that keeps the order of its fields.
+// parquet.Group sorts its fields by name.
+type parquetGroup struct {
+	parquet.Group
+	fields []parquet.Field
+}
+
+func newParquetGroup(names []string, nodes []parquet.Node) *parquetGroup {
+	g := &parquetGroup{
+		Group:  make(parquet.Group, len(names)),
+		fields: make([]parquet.Field, len(names)),
+	}
+	for i, name := range names {
+		g.Group[name] = nodes[i]
+		g.fields[i] = &parquetField{Node: nodes[i], name: name}
+	}
+	return g
+}
+
+func (g *parquetGroup) Fields() []parquet.Field {
+	return g.fields
+}
+
+type parquetField struct {
+	parquet.Node
+	name string
+}
+
+func (f *parquetField) Name() string {
+	return f.name
+}
+
+func (f *parquetField) Value(base reflect.Value) reflect.Value {
+	return base.MapIndex(reflect.ValueOf(f.name))
+}
@@ -0,0 +1,368 @@
+package query
+
+import (
+	"bytes"
+	"sort"
+	"strings"
+
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/ternary"
+)
+
+func Pivot(view *View, expr parser.PivotTable, filter *Filter) (*View, error) {
+	keyIdx, err := view.FieldIndex(expr.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	referenced := map[int]bool{keyIdx: true}
+	for _, agg := range expr.Aggregates {
+		for _, ref := range referencedFields(agg.(parser.Field).Object) {
+			idx, err := view.FieldIndex(ref)
+			if err != nil {
+				return nil, err
+			}
+			referenced[idx] = true
+		}
+	}
+
+	groupIndices := make([]int, 0, view.FieldLen())
+	for i := range view.Header {
+		if !referenced[i] {
+			groupIndices = append(groupIndices, i)
+		}
+	}
+
+	keyBuf := new(bytes.Buffer)
+	serializeKey := func(p value.Primary) string {
+		keyBuf.Reset()
+		SerializeKey(keyBuf, p)
+		return keyBuf.String()
+	}
+
+	var pivotValues []value.Primary
+	var pivotNames []string
+	if expr.IsDynamic() {
+		pivotValues = distinctPivotValues(view, keyIdx)
+		pivotNames = make([]string, len(pivotValues))
+		for i, p := range pivotValues {
+			pivotNames[i] = pivotValueName(p)
+		}
+	} else {
+		pivotValues = make([]value.Primary, len(expr.Values))
+		pivotNames = make([]string, len(expr.Values))
+		keys := make(map[string]bool, len(expr.Values))
+		names := make(map[string]bool, len(expr.Values))
+		for i, v := range expr.Values {
+			field := v.(parser.Field)
+			p, err := filter.Evaluate(field.Object)
+			if err != nil {
+				return nil, err
+			}
+			if !value.IsNull(p) {
+				key := serializeKey(p)
+				if keys[key] {
+					return nil, NewDuplicatePivotValueError(field)
+				}
+				keys[key] = true
+			}
+
+			pivotValues[i] = p
+			if field.Alias != nil {
+				pivotNames[i] = field.Alias.(parser.Identifier).Literal
+			} else {
+				pivotNames[i] = pivotValueName(p)
+			}
+
+			name := strings.ToUpper(pivotNames[i])
+			if names[name] {
+				if field.Alias != nil {
+					return nil, NewDuplicateFieldNameError(field.Alias.(parser.Identifier))
+				}
+				return nil, NewDuplicatePivotValueError(field)
+			}
+			names[name] = true
+		}
+	}
+
+	pivotIndices := make(map[string]int, len(pivotValues))
+	for i, p := range pivotValues {
+		if value.IsNull(p) {
+			continue
+		}
+		pivotIndices[serializeKey(p)] = i
+	}
+
+	header := make(Header, 0, len(groupIndices)+len(pivotNames)*len(expr.Aggregates))
+	for _, idx := range groupIndices {
+		hfield := view.Header[idx]
+		hfield.Aliases = nil
+		hfield.IsFromTable = true
+		hfield.IsJoinColumn = false
+		hfield.IsGroupKey = false
+		header = append(header, hfield)
+	}
+	for _, name := range pivotNames {
+		for _, agg := range expr.Aggregates {
+			field := agg.(parser.Field)
+			column := name
+			if field.Alias != nil {
+				column = name + "_" + field.Alias.(parser.Identifier).Literal
+			} else if 1 < len(expr.Aggregates) {
+				column = name + "_" + parser.FormatFieldIdentifier(field.Object)
+			}
+			header = append(header, HeaderField{Column: column, IsFromTable: true})
+		}
+	}
+	for i := range header {
+		header[i].Number = i + 1
+	}
+
+	groups := make(map[string][]int)
+	groupKeys := make([]string, 0)
+	groupValues := make([]value.Primary, len(groupIndices))
+	for i, record := range view.RecordSet {
+		for j, idx := range groupIndices {
+			groupValues[j] = record[idx].Value()
+		}
+		keyBuf.Reset()
+		SerializeComparisonKeys(keyBuf, groupValues)
+		key := keyBuf.String()
+
+		if _, ok := groups[key]; ok {
+			groups[key] = append(groups[key], i)
+		} else {
+			groups[key] = []int{i}
+			groupKeys = append(groupKeys, key)
+		}
+	}
+
+	records := make(RecordSet, len(groupKeys))
+	for i, groupKey := range groupKeys {
+		indices := groups[groupKey]
+
+		pivotRecords := make([][]int, len(pivotValues))
+		for _, idx := range indices {
+			key := view.RecordSet[idx][keyIdx].Value()
+			if value.IsNull(key) {
+				continue
+			}
+			if pIdx, ok := pivotIndices[serializeKey(key)]; ok {
+				pivotRecords[pIdx] = append(pivotRecords[pIdx], idx)
+			}
+		}
+
+		record := make(Record, 0, header.Len())
+		for _, idx := range groupIndices {
+			record = append(record, NewCell(view.RecordSet[indices[0]][idx].Value()))
+		}
+
+		for _, recordIndices := range pivotRecords {
+			groupRecord := make(Record, view.FieldLen())
+			for j := 0; j < view.FieldLen(); j++ {
+				primaries := make([]value.Primary, len(recordIndices))
+				for k, idx := range recordIndices {
+					primaries[k] = view.RecordSet[idx][j].Value()
+				}
+				groupRecord[j] = NewGroupCell(primaries)
+			}
+
+			groupView := &View{
+				Header:    view.Header,
+				RecordSet: RecordSet{groupRecord},
+				Filter:    filter,
+				isGrouped: true,
+			}
+			aggFilter := NewFilterForRecord(groupView, 0, filter)
+			for _, agg := range expr.Aggregates {
+				p, err := aggFilter.Evaluate(agg.(parser.Field).Object)
+				if err != nil {
+					return nil, err
+				}
+				record = append(record, NewCell(p))
+			}
+		}
+
+		records[i] = record
+	}
+
+	pivotView := NewView()
+	pivotView.Header = header
+	pivotView.RecordSet = records
+	return pivotView, nil
+}
+
+func Unpivot(view *View, expr parser.UnpivotTable, filter *Filter) (*View, error) {
+	columnIndices := make([]int, len(expr.Columns))
+	labels := make([]value.Primary, len(expr.Columns))
+	for i, v := range expr.Columns {
+		field := v.(parser.Field)
+		switch field.Object.(type) {
+		case parser.FieldReference, parser.ColumnNumber:
+		default:
+			return nil, NewInvalidUnpivotColumnError(field.Object)
+		}
+
+		idx, err := view.FieldIndex(field.Object)
+		if err != nil {
+			return nil, err
+		}
+		columnIndices[i] = idx
+
+		if field.Alias != nil {
+			labels[i] = value.NewString(field.Alias.(parser.Identifier).Literal)
+		} else {
+			labels[i] = value.NewString(view.Header[idx].Column)
+		}
+	}
+
+	remainIndices := make([]int, 0, view.FieldLen())
+	for i := range view.Header {
+		if !InIntSlice(i, columnIndices) {
+			remainIndices = append(remainIndices, i)
+		}
+	}
+
+	header := make(Header, 0, len(remainIndices)+2)
+	for _, idx := range remainIndices {
+		hfield := view.Header[idx]
+		hfield.Aliases = nil
+		hfield.IsFromTable = true
+		hfield.IsJoinColumn = false
+		hfield.IsGroupKey = false
+		header = append(header, hfield)
+	}
+	header = append(header, HeaderField{Column: expr.Key.Literal, IsFromTable: true})
+	header = append(header, HeaderField{Column: expr.Value.Literal, IsFromTable: true})
+	for i := range header {
+		header[i].Number = i + 1
+	}
+
+	records := make(RecordSet, 0, view.RecordLen()*len(columnIndices))
+	for _, src := range view.RecordSet {
+		for i, idx := range columnIndices {
+			val := src[idx].Value()
+			if value.IsNull(val) && !expr.IncludeNulls() {
+				continue
+			}
+
+			record := make(Record, 0, header.Len())
+			for _, ridx := range remainIndices {
+				record = append(record, src[ridx])
+			}
+			record = append(record, NewCell(labels[i]), NewCell(val))
+			records = append(records, record)
+		}
+	}
+
+	unpivotView := NewView()
+	unpivotView.Header = header
+	unpivotView.RecordSet = records
+	return unpivotView, nil
+}
+
+func distinctPivotValues(view *View, keyIdx int) []value.Primary {
+	keyBuf := new(bytes.Buffer)
+	keys := make(map[string]bool)
+	values := make([]value.Primary, 0)
+	sortValues := make([]*SortValue, 0)
+	for _, record := range view.RecordSet {
+		p := record[keyIdx].Value()
+		if value.IsNull(p) {
+			continue
+		}
+
+		keyBuf.Reset()
+		SerializeKey(keyBuf, p)
+		key := keyBuf.String()
+		if !keys[key] {
+			keys[key] = true
+			values = append(values, p)
+			sortValues = append(sortValues, NewSortValue(p))
+		}
+	}
+
+	indices := make([]int, len(values))
+	for i := range indices {
+		indices[i] = i
+	}
+	sort.SliceStable(indices, func(i, j int) bool {
+		return sortValues[indices[i]].Less(sortValues[indices[j]]) == ternary.TRUE
+	})
+
+	sorted := make([]value.Primary, len(values))
+	for i, idx := range indices {
+		sorted[i] = values[idx]
+	}
+	return sorted
+}
+
+func pivotValueName(p value.Primary) string {
+	if s, ok := p.(value.String); ok {
+		return s.Raw()
+	}
+	return p.String()
+}
+
+func referencedFields(expr parser.QueryExpression) []parser.QueryExpression {
+	var list []parser.QueryExpression
+
+	switch expr.(type) {
+	case parser.FieldReference, parser.ColumnNumber:
+		list = append(list, expr)
+	case parser.Parentheses:
+		list = append(list, referencedFields(expr.(parser.Parentheses).Expr)...)
+	case parser.Arithmetic:
+		list = append(list, referencedFields(expr.(parser.Arithmetic).LHS)...)
+		list = append(list, referencedFields(expr.(parser.Arithmetic).RHS)...)
+	case parser.UnaryArithmetic:
+		list = append(list, referencedFields(expr.(parser.UnaryArithmetic).Operand)...)
+	case parser.Concat:
+		for _, item := range expr.(parser.Concat).Items {
+			list = append(list, referencedFields(item)...)
+		}
+	case parser.Comparison:
+		list = append(list, referencedFields(expr.(parser.Comparison).LHS)...)
+		list = append(list, referencedFields(expr.(parser.Comparison).RHS)...)
+	case parser.Logic:
+		list = append(list, referencedFields(expr.(parser.Logic).LHS)...)
+		list = append(list, referencedFields(expr.(parser.Logic).RHS)...)
+	case parser.UnaryLogic:
+		list = append(list, referencedFields(expr.(parser.UnaryLogic).Operand)...)
+	case parser.Function:
+		for _, arg := range expr.(parser.Function).Args {
+			list = append(list, referencedFields(arg)...)
+		}
+	case parser.AggregateFunction:
+		for _, arg := range expr.(parser.AggregateFunction).Args {
+			list = append(list, referencedFields(arg)...)
+		}
+	case parser.ListFunction:
+		listFunction := expr.(parser.ListFunction)
+		for _, arg := range listFunction.Args {
+			list = append(list, referencedFields(arg)...)
+		}
+		if listFunction.OrderBy != nil {
+			for _, item := range listFunction.OrderBy.(parser.OrderByClause).Items {
+				list = append(list, referencedFields(item.(parser.OrderItem).Value)...)
+			}
+		}
+	case parser.CaseExpr:
+		caseExpr := expr.(parser.CaseExpr)
+		if caseExpr.Value != nil {
+			list = append(list, referencedFields(caseExpr.Value)...)
+		}
+		for _, v := range caseExpr.When {
+			when := v.(parser.CaseExprWhen)
+			list = append(list, referencedFields(when.Condition)...)
+			list = append(list, referencedFields(when.Result)...)
+		}
+		if caseExpr.Else != nil {
+			list = append(list, referencedFields(caseExpr.Else.(parser.CaseExprElse).Result)...)
+		}
+	}
+
+	return list
+}
@@ -0,0 +1,277 @@
+package query
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+)
+
+var pivotTestView = &View{
+	Header: NewHeader("table1", []string{"column1", "column2", "column3"}),
+	RecordSet: []Record{
+		NewRecord([]value.Primary{
+			value.NewString("x"),
+			value.NewString("b"),
+			value.NewInteger(1),
+		}),
+		NewRecord([]value.Primary{
+			value.NewString("x"),
+			value.NewString("a"),
+			value.NewInteger(2),
+		}),
+		NewRecord([]value.Primary{
+			value.NewString("y"),
+			value.NewString("a"),
+			value.NewInteger(3),
+		}),
+		NewRecord([]value.Primary{
+			value.NewString("x"),
+			value.NewString("a"),
+			value.NewInteger(4),
+		}),
+		NewRecord([]value.Primary{
+			value.NewString("y"),
+			value.NewNull(),
+			value.NewInteger(5),
+		}),
+	},
+}
+
+var pivotTests = []struct {
+	Name   string
+	Expr   parser.PivotTable
+	Result *View
+	Error  string
+}{
+	{
+		Name: "Pivot",
+		Expr: parser.PivotTable{
+			Aggregates: []parser.QueryExpression{
+				parser.Field{Object: parser.AggregateFunction{Name: "sum", Args: []parser.QueryExpression{parser.FieldReference{Column: parser.Identifier{Literal: "column3"}}}}},
+			},
+			Key: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+			Values: []parser.QueryExpression{
+				parser.Field{Object: parser.NewStringValue("a")},
+				parser.Field{Object: parser.NewStringValue("b"), Alias: parser.Identifier{Literal: "bb"}},
+			},
+		},
+		Result: &View{
+			Header: []HeaderField{
+				{View: "table1", Column: "column1", Number: 1, IsFromTable: true},
+				{Column: "a", Number: 2, IsFromTable: true},
+				{Column: "bb", Number: 3, IsFromTable: true},
+			},
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("x"),
+					value.NewInteger(6),
+					value.NewInteger(1),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("y"),
+					value.NewInteger(3),
+					value.NewNull(),
+				}),
+			},
+		},
+	},
+	{
+		Name: "Pivot Dynamic with Multiple Aggregates",
+		Expr: parser.PivotTable{
+			Aggregates: []parser.QueryExpression{
+				parser.Field{Object: parser.AggregateFunction{Name: "max", Args: []parser.QueryExpression{parser.FieldReference{Column: parser.Identifier{Literal: "column3"}}}}},
+				parser.Field{Object: parser.AggregateFunction{Name: "count", Args: []parser.QueryExpression{parser.AllColumns{}}}, Alias: parser.Identifier{Literal: "cnt"}},
+			},
+			Key: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+			Any: "any",
+		},
+		Result: &View{
+			Header: []HeaderField{
+				{View: "table1", Column: "column1", Number: 1, IsFromTable: true},
+				{Column: "a_max(column3)", Number: 2, IsFromTable: true},
+				{Column: "a_cnt", Number: 3, IsFromTable: true},
+				{Column: "b_max(column3)", Number: 4, IsFromTable: true},
+				{Column: "b_cnt", Number: 5, IsFromTable: true},
+			},
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("x"),
+					value.NewInteger(4),
+					value.NewInteger(2),
+					value.NewInteger(1),
+					value.NewInteger(1),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("y"),
+					value.NewInteger(3),
+					value.NewInteger(1),
+					value.NewNull(),
+					value.NewInteger(0),
+				}),
+			},
+		},
+	},
+	{
+		Name: "Pivot Key Not Exist Error",
+		Expr: parser.PivotTable{
+			Aggregates: []parser.QueryExpression{
+				parser.Field{Object: parser.AggregateFunction{Name: "sum", Args: []parser.QueryExpression{parser.FieldReference{Column: parser.Identifier{Literal: "column3"}}}}},
+			},
+			Key: parser.FieldReference{Column: parser.Identifier{Literal: "notexist"}},
+			Any: "any",
+		},
+		Error: "[L:- C:-] field notexist does not exist",
+	},
+	{
+		Name: "Pivot Duplicate Value Error",
+		Expr: parser.PivotTable{
+			Aggregates: []parser.QueryExpression{
+				parser.Field{Object: parser.AggregateFunction{Name: "sum", Args: []parser.QueryExpression{parser.FieldReference{Column: parser.Identifier{Literal: "column3"}}}}},
+			},
+			Key: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+			Values: []parser.QueryExpression{
+				parser.Field{Object: parser.NewStringValue("a")},
+				parser.Field{Object: parser.NewStringValue("a"), Alias: parser.Identifier{Literal: "aa"}},
+			},
+		},
+		Error: "[L:- C:-] pivot value 'a' is a duplicate",
+	},
+	{
+		Name: "Pivot Duplicate Column Name Error",
+		Expr: parser.PivotTable{
+			Aggregates: []parser.QueryExpression{
+				parser.Field{Object: parser.AggregateFunction{Name: "sum", Args: []parser.QueryExpression{parser.FieldReference{Column: parser.Identifier{Literal: "column3"}}}}},
+			},
+			Key: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+			Values: []parser.QueryExpression{
+				parser.Field{Object: parser.NewStringValue("a")},
+				parser.Field{Object: parser.NewStringValue("b"), Alias: parser.Identifier{Literal: "A"}},
+			},
+		},
+		Error: "[L:- C:-] field name A is a duplicate",
+	},
+}
+
+func TestPivot(t *testing.T) {
+	for _, v := range pivotTests {
+		result, err := Pivot(pivotTestView, v.Expr, NewEmptyFilter())
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if !reflect.DeepEqual(result.Header, v.Result.Header) {
+			t.Errorf("%s: header = %v, want %v", v.Name, result.Header, v.Result.Header)
+		}
+		if !reflect.DeepEqual(result.RecordSet, v.Result.RecordSet) {
+			t.Errorf("%s: records = %s, want %s", v.Name, result.RecordSet, v.Result.RecordSet)
+		}
+	}
+}
+
+var unpivotTests = []struct {
+	Name   string
+	Expr   parser.UnpivotTable
+	Result *View
+	Error  string
+}{
+	{
+		Name: "Unpivot",
+		Expr: parser.UnpivotTable{
+			Value: parser.Identifier{Literal: "val"},
+			Key:   parser.Identifier{Literal: "col"},
+			Columns: []parser.QueryExpression{
+				parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}}},
+				parser.Field{Object: parser.ColumnNumber{View: parser.Identifier{Literal: "table1"}, Number: value.NewInteger(3)}, Alias: parser.Identifier{Literal: "c3"}},
+			},
+		},
+		Result: &View{
+			Header: []HeaderField{
+				{View: "table1", Column: "column1", Number: 1, IsFromTable: true},
+				{Column: "col", Number: 2, IsFromTable: true},
+				{Column: "val", Number: 3, IsFromTable: true},
+			},
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewString("x"), value.NewString("column2"), value.NewString("b")}),
+				NewRecord([]value.Primary{value.NewString("x"), value.NewString("c3"), value.NewInteger(1)}),
+				NewRecord([]value.Primary{value.NewString("x"), value.NewString("column2"), value.NewString("a")}),
+				NewRecord([]value.Primary{value.NewString("x"), value.NewString("c3"), value.NewInteger(2)}),
+				NewRecord([]value.Primary{value.NewString("y"), value.NewString("column2"), value.NewString("a")}),
+				NewRecord([]value.Primary{value.NewString("y"), value.NewString("c3"), value.NewInteger(3)}),
+				NewRecord([]value.Primary{value.NewString("x"), value.NewString("column2"), value.NewString("a")}),
+				NewRecord([]value.Primary{value.NewString("x"), value.NewString("c3"), value.NewInteger(4)}),
+				NewRecord([]value.Primary{value.NewString("y"), value.NewString("c3"), value.NewInteger(5)}),
+			},
+		},
+	},
+	{
+		Name: "Unpivot Include Nulls",
+		Expr: parser.UnpivotTable{
+			Nulls: parser.Token{Token: parser.INCLUDE, Literal: "include nulls"},
+			Value: parser.Identifier{Literal: "val"},
+			Key:   parser.Identifier{Literal: "col"},
+			Columns: []parser.QueryExpression{
+				parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}}},
+			},
+		},
+		Result: &View{
+			Header: []HeaderField{
+				{View: "table1", Column: "column1", Number: 1, IsFromTable: true},
+				{View: "table1", Column: "column3", Number: 2, IsFromTable: true},
+				{Column: "col", Number: 3, IsFromTable: true},
+				{Column: "val", Number: 4, IsFromTable: true},
+			},
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewString("x"), value.NewInteger(1), value.NewString("column2"), value.NewString("b")}),
+				NewRecord([]value.Primary{value.NewString("x"), value.NewInteger(2), value.NewString("column2"), value.NewString("a")}),
+				NewRecord([]value.Primary{value.NewString("y"), value.NewInteger(3), value.NewString("column2"), value.NewString("a")}),
+				NewRecord([]value.Primary{value.NewString("x"), value.NewInteger(4), value.NewString("column2"), value.NewString("a")}),
+				NewRecord([]value.Primary{value.NewString("y"), value.NewInteger(5), value.NewString("column2"), value.NewNull()}),
+			},
+		},
+	},
+	{
+		Name: "Unpivot Invalid Column Error",
+		Expr: parser.UnpivotTable{
+			Value: parser.Identifier{Literal: "val"},
+			Key:   parser.Identifier{Literal: "col"},
+			Columns: []parser.QueryExpression{
+				parser.Field{Object: parser.NewIntegerValue(1)},
+			},
+		},
+		Error: "[L:- C:-] 1 is not a column to unpivot",
+	},
+}
+
+func TestUnpivot(t *testing.T) {
+	for _, v := range unpivotTests {
+		result, err := Unpivot(pivotTestView, v.Expr, NewEmptyFilter())
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if !reflect.DeepEqual(result.Header, v.Result.Header) {
+			t.Errorf("%s: header = %v, want %v", v.Name, result.Header, v.Result.Header)
+		}
+		if !reflect.DeepEqual(result.RecordSet, v.Result.RecordSet) {
+			t.Errorf("%s: records = %s, want %s", v.Name, result.RecordSet, v.Result.RecordSet)
+		}
+	}
+}
@@ -2,7 +2,6 @@ package query
 
 import (
 	"fmt"
-	"io"
 	"os/exec"
 	"strings"
 	"time"
@@ -30,6 +29,7 @@ const (
 var Version string
 var ViewCache = make(ViewMap, 10)
 var UncommittedViews = NewUncommittedViewMap()
+var RejectedRows = make(RejectedRowMap)
 
 var Formatter = NewStringFormatter()
 
@@ -61,6 +61,7 @@ func ReleaseResourcesWithErrors() error {
 type Procedure struct {
 	Filter           *Filter
 	ReturnVal        value.Primary
+	AffectedRows     int
 	MeasurementStart time.Time
 }
 
@@ -98,7 +99,8 @@ func (proc *Procedure) Execute(statements []parser.Statement) (StatementFlow, er
 }
 
 func (proc *Procedure) ExecuteStatement(stmt parser.Statement) (StatementFlow, error) {
-	flags := cmd.GetFlags()
+	session := proc.Filter.Session()
+	flags := session.Flags
 	flow := Terminate
 
 	var err error
@@ -113,8 +115,8 @@ func (proc *Procedure) ExecuteStatement(stmt parser.Statement) (StatementFlow, e
 	case parser.RemoveFlagElement:
 		err = RemoveFlagElement(stmt.(parser.RemoveFlagElement), proc.Filter)
 	case parser.ShowFlag:
-		if printstr, err = ShowFlag(stmt.(parser.ShowFlag)); err == nil {
-			Log(printstr, false)
+		if printstr, err = ShowFlag(stmt.(parser.ShowFlag), proc.Filter); err == nil {
+			session.Log(printstr, false)
 		}
 	case parser.VariableDeclaration:
 		err = proc.Filter.Variables.Declare(stmt.(parser.VariableDeclaration), proc.Filter)
@@ -152,38 +154,76 @@ func (proc *Procedure) ExecuteStatement(stmt parser.Statement) (StatementFlow, e
 			proc.MeasurementStart = time.Now()
 		}
 
-		view, e := Select(stmt.(parser.SelectQuery), proc.Filter)
-		if e == nil {
-			fileInfo := &FileInfo{
-				Format:             flags.Format,
-				Delimiter:          flags.WriteDelimiter,
-				DelimiterPositions: flags.WriteDelimiterPositions,
-				Encoding:           flags.WriteEncoding,
-				LineBreak:          flags.LineBreak,
-				NoHeader:           flags.WithoutHeader,
-				EncloseAll:         flags.EncloseAll,
-				PrettyPrint:        flags.PrettyPrint,
-			}
+		fileInfo := &FileInfo{
+			Format:             flags.Format,
+			Delimiter:          flags.WriteDelimiter,
+			DelimiterPositions: flags.WriteDelimiterPositions,
+			Encoding:           flags.WriteEncoding,
+			LineBreak:          flags.LineBreak,
+			NoHeader:           flags.WithoutHeader,
+			EncloseAll:         flags.EncloseAll,
+			PrettyPrint:        flags.PrettyPrint,
+			Sheet:              flags.Sheet,
+			Dialect:            NewCSVDialect(flags),
+		}
+
+		writer := session.resultWriter()
 
-			var writer io.Writer
-			if OutFile != nil {
-				writer = OutFile
+		var stream *SelectStream
+		if CanEncodeStream(fileInfo) {
+			stream, err = NewSelectStream(stmt.(parser.SelectQuery), proc.Filter)
+		}
+
+		if err == nil {
+			if stream != nil {
+				err = EncodeStream(writer, stream.Labels, stream, fileInfo)
+				if e := stream.Close(); err == nil {
+					err = e
+				}
 			} else {
-				writer = Stdout
+				var view *View
+				if view, err = Select(stmt.(parser.SelectQuery), proc.Filter); err == nil {
+					err = EncodeView(writer, view, fileInfo, flags)
+				}
 			}
-			err = EncodeView(writer, view, fileInfo)
+
 			if err == nil {
-				writer.Write([]byte(cmd.GetFlags().LineBreak.Value()))
-			} else if _, ok := err.(*EmptyResultSetError); ok {
+				if fileInfo.Format != cmd.PARQUET && fileInfo.Format != cmd.XLSX {
+					writer.Write(outputLineBreak(fileInfo))
+				}
+			} else if e, ok := err.(*EmptyResultSetError); ok {
+				session.LogWarn(e.Warning, flags.Quiet)
 				err = nil
 			}
-		} else {
-			err = e
 		}
 
 		if flags.Stats {
 			proc.showExecutionTime()
 		}
+	case parser.Explain:
+		explain := stmt.(parser.Explain)
+
+		fileInfo := &FileInfo{
+			Format:             flags.Format,
+			Delimiter:          flags.WriteDelimiter,
+			DelimiterPositions: flags.WriteDelimiterPositions,
+			Encoding:           flags.WriteEncoding,
+			LineBreak:          flags.LineBreak,
+			NoHeader:           flags.WithoutHeader,
+			EncloseAll:         flags.EncloseAll,
+			PrettyPrint:        flags.PrettyPrint,
+			Sheet:              flags.Sheet,
+			Dialect:            NewCSVDialect(flags),
+		}
+
+		writer := session.resultWriter()
+
+		var plan *PlanNode
+		if plan, err = Explain(explain, proc.Filter); err == nil {
+			if err = EncodePlan(writer, plan, explain.Analyze, fileInfo, flags); err == nil && fileInfo.Format != cmd.PARQUET && fileInfo.Format != cmd.XLSX {
+				writer.Write(outputLineBreak(fileInfo))
+			}
+		}
 	case parser.InsertQuery:
 		if flags.Stats {
 			proc.MeasurementStart = time.Now()
@@ -191,10 +231,11 @@ func (proc *Procedure) ExecuteStatement(stmt parser.Statement) (StatementFlow, e
 
 		fileInfo, cnt, e := Insert(stmt.(parser.InsertQuery), proc.Filter)
 		if e == nil {
+			proc.AffectedRows += cnt
 			if 0 < cnt {
-				UncommittedViews.SetForUpdatedView(fileInfo)
+				proc.Filter.Session().UncommittedViews.SetForUpdatedView(fileInfo)
 			}
-			Log(fmt.Sprintf("%s inserted on %q.", FormatCount(cnt, "record"), fileInfo.Path), flags.Quiet)
+			session.Log(fmt.Sprintf("%s inserted on %q.", FormatCount(cnt, "record"), fileInfo.Path), flags.Quiet)
 		} else {
 			err = e
 		}
@@ -210,10 +251,11 @@ func (proc *Procedure) ExecuteStatement(stmt parser.Statement) (StatementFlow, e
 		infos, cnts, e := Update(stmt.(parser.UpdateQuery), proc.Filter)
 		if e == nil {
 			for i, info := range infos {
+				proc.AffectedRows += cnts[i]
 				if 0 < cnts[i] {
-					UncommittedViews.SetForUpdatedView(info)
+					proc.Filter.Session().UncommittedViews.SetForUpdatedView(info)
 				}
-				Log(fmt.Sprintf("%s updated on %q.", FormatCount(cnts[i], "record"), info.Path), flags.Quiet)
+				session.Log(fmt.Sprintf("%s updated on %q.", FormatCount(cnts[i], "record"), info.Path), flags.Quiet)
 			}
 		} else {
 			err = e
@@ -230,47 +272,67 @@ func (proc *Procedure) ExecuteStatement(stmt parser.Statement) (StatementFlow, e
 		infos, cnts, e := Delete(stmt.(parser.DeleteQuery), proc.Filter)
 		if e == nil {
 			for i, info := range infos {
+				proc.AffectedRows += cnts[i]
 				if 0 < cnts[i] {
-					UncommittedViews.SetForUpdatedView(info)
+					proc.Filter.Session().UncommittedViews.SetForUpdatedView(info)
 				}
-				Log(fmt.Sprintf("%s deleted on %q.", FormatCount(cnts[i], "record"), info.Path), flags.Quiet)
+				session.Log(fmt.Sprintf("%s deleted on %q.", FormatCount(cnts[i], "record"), info.Path), flags.Quiet)
 			}
 		} else {
 			err = e
 		}
 
+		if flags.Stats {
+			proc.showExecutionTime()
+		}
+	case parser.MergeQuery:
+		if flags.Stats {
+			proc.MeasurementStart = time.Now()
+		}
+
+		info, inserted, updated, deleted, e := Merge(stmt.(parser.MergeQuery), proc.Filter)
+		if e == nil {
+			proc.AffectedRows += inserted + updated + deleted
+			if 0 < inserted+updated+deleted {
+				proc.Filter.Session().UncommittedViews.SetForUpdatedView(info)
+			}
+			session.Log(fmt.Sprintf("%s inserted, %s updated, %s deleted on %q.", FormatCount(inserted, "record"), FormatCount(updated, "record"), FormatCount(deleted, "record"), info.Path), flags.Quiet)
+		} else {
+			err = e
+		}
+
 		if flags.Stats {
 			proc.showExecutionTime()
 		}
 	case parser.CreateTable:
 		info, e := CreateTable(stmt.(parser.CreateTable), proc.Filter)
 		if e == nil {
-			UncommittedViews.SetForCreatedView(info)
-			Log(fmt.Sprintf("file %q is created.", info.Path), flags.Quiet)
+			proc.Filter.Session().UncommittedViews.SetForCreatedView(info)
+			session.Log(fmt.Sprintf("file %q is created.", info.Path), flags.Quiet)
 		} else {
 			err = e
 		}
 	case parser.AddColumns:
 		info, cnt, e := AddColumns(stmt.(parser.AddColumns), proc.Filter)
 		if e == nil {
-			UncommittedViews.SetForUpdatedView(info)
-			Log(fmt.Sprintf("%s added on %q.", FormatCount(cnt, "field"), info.Path), flags.Quiet)
+			proc.Filter.Session().UncommittedViews.SetForUpdatedView(info)
+			session.Log(fmt.Sprintf("%s added on %q.", FormatCount(cnt, "field"), info.Path), flags.Quiet)
 		} else {
 			err = e
 		}
 	case parser.DropColumns:
 		info, cnt, e := DropColumns(stmt.(parser.DropColumns), proc.Filter)
 		if e == nil {
-			UncommittedViews.SetForUpdatedView(info)
-			Log(fmt.Sprintf("%s dropped on %q.", FormatCount(cnt, "field"), info.Path), flags.Quiet)
+			proc.Filter.Session().UncommittedViews.SetForUpdatedView(info)
+			session.Log(fmt.Sprintf("%s dropped on %q.", FormatCount(cnt, "field"), info.Path), flags.Quiet)
 		} else {
 			err = e
 		}
 	case parser.RenameColumn:
 		info, e := RenameColumn(stmt.(parser.RenameColumn), proc.Filter)
 		if e == nil {
-			UncommittedViews.SetForUpdatedView(info)
-			Log(fmt.Sprintf("%s renamed on %q.", FormatCount(1, "field"), info.Path), flags.Quiet)
+			proc.Filter.Session().UncommittedViews.SetForUpdatedView(info)
+			session.Log(fmt.Sprintf("%s renamed on %q.", FormatCount(1, "field"), info.Path), flags.Quiet)
 		} else {
 			err = e
 		}
@@ -278,15 +340,31 @@ func (proc *Procedure) ExecuteStatement(stmt parser.Statement) (StatementFlow, e
 		expr := stmt.(parser.SetTableAttribute)
 		info, log, e := SetTableAttribute(expr, proc.Filter)
 		if e == nil {
-			UncommittedViews.SetForUpdatedView(info)
-			Log(log, flags.Quiet)
+			proc.Filter.Session().UncommittedViews.SetForUpdatedView(info)
+			session.Log(log, flags.Quiet)
 		} else {
 			if unchanged, ok := e.(*TableAttributeUnchangedError); ok {
-				Log(fmt.Sprintf("Table attributes of %s remain unchanged.", unchanged.Path), flags.Quiet)
+				session.Log(fmt.Sprintf("Table attributes of %s remain unchanged.", unchanged.Path), flags.Quiet)
 			} else {
 				err = e
 			}
 		}
+	case parser.CreateIndex:
+		expr := stmt.(parser.CreateIndex)
+		path, e := CreateIndex(expr, proc.Filter)
+		if e == nil {
+			session.Log(fmt.Sprintf("index %q is created on %q.", expr.Name.Literal, path), flags.Quiet)
+		} else {
+			err = e
+		}
+	case parser.DropIndex:
+		expr := stmt.(parser.DropIndex)
+		path, e := DropIndex(expr, proc.Filter)
+		if e == nil {
+			session.Log(fmt.Sprintf("index %q is dropped on %q.", expr.Name.Literal, path), flags.Quiet)
+		} else {
+			err = e
+		}
 	case parser.TransactionControl:
 		switch stmt.(parser.TransactionControl).Token {
 		case parser.COMMIT:
@@ -327,17 +405,19 @@ func (proc *Procedure) ExecuteStatement(stmt parser.Statement) (StatementFlow, e
 		flow, err = proc.While(stmt.(parser.While))
 	case parser.WhileInCursor:
 		flow, err = proc.WhileInCursor(stmt.(parser.WhileInCursor))
+	case parser.TryCatch:
+		flow, err = proc.TryCatch(stmt.(parser.TryCatch))
 	case parser.Echo:
 		if printstr, err = Echo(stmt.(parser.Echo), proc.Filter); err == nil {
-			Log(printstr, false)
+			session.Log(printstr, false)
 		}
 	case parser.Print:
 		if printstr, err = Print(stmt.(parser.Print), proc.Filter); err == nil {
-			Log(printstr, false)
+			session.Log(printstr, false)
 		}
 	case parser.Printf:
 		if printstr, err = Printf(stmt.(parser.Printf), proc.Filter); err == nil {
-			Log(printstr, false)
+			session.Log(printstr, false)
 		}
 	case parser.Source:
 		var externalStatements []parser.Statement
@@ -355,21 +435,21 @@ func (proc *Procedure) ExecuteStatement(stmt parser.Statement) (StatementFlow, e
 		var dirpath string
 		dirpath, err = Pwd(stmt.(parser.Pwd))
 		if err == nil {
-			Log(dirpath, false)
+			session.Log(dirpath, false)
 		}
 	case parser.Reload:
-		err = Reload(stmt.(parser.Reload))
+		err = Reload(stmt.(parser.Reload), proc.Filter)
 	case parser.ShowObjects:
 		if printstr, err = ShowObjects(stmt.(parser.ShowObjects), proc.Filter); err == nil {
-			Log(printstr, false)
+			session.Log(printstr, false)
 		}
 	case parser.ShowFields:
 		if printstr, err = ShowFields(stmt.(parser.ShowFields), proc.Filter); err == nil {
-			Log(printstr, false)
+			session.Log(printstr, false)
 		}
 	case parser.Syntax:
 		printstr = Syntax(stmt.(parser.Syntax), proc.Filter)
-		Log(printstr, false)
+		session.Log(printstr, false)
 	case parser.Trigger:
 		trigger := stmt.(parser.Trigger)
 		switch strings.ToUpper(trigger.Event.Literal) {
@@ -393,6 +473,12 @@ func (proc *Procedure) ExecuteStatement(stmt parser.Statement) (StatementFlow, e
 		default:
 			err = NewInvalidEventNameError(trigger.Event)
 		}
+	case parser.Raise:
+		if proc.Filter.caughtError == nil {
+			err = NewRaiseOutsideCatchError(stmt.(parser.Raise))
+		} else {
+			err = proc.Filter.caughtError
+		}
 	case parser.ExternalCommand:
 		err = proc.ExecExternalCommand(stmt.(parser.ExternalCommand))
 	default:
@@ -537,7 +623,36 @@ func (proc *Procedure) WhileInCursor(stmt parser.WhileInCursor) (StatementFlow,
 	return Terminate, nil
 }
 
+func (proc *Procedure) TryCatch(stmt parser.TryCatch) (StatementFlow, error) {
+	childProc := proc.NewChildProcedure()
+	flow, err := childProc.Execute(stmt.TryStatements)
+	proc.AffectedRows += childProc.AffectedRows
+
+	if err != nil {
+		appErr, ok := err.(AppError)
+		if !ok {
+			return flow, err
+		}
+
+		// Statements change views only when they succeed, so the failed statement
+		// has left no changes in the view cache and the uncommitted views.
+		childProc = proc.NewChildProcedure()
+		childProc.Filter.caughtError = appErr
+		flow, err = childProc.Execute(stmt.CatchStatements)
+		proc.AffectedRows += childProc.AffectedRows
+	}
+
+	if flow == Return {
+		proc.ReturnVal = childProc.ReturnVal
+	}
+	return flow, err
+}
+
 func (proc *Procedure) ExecExternalCommand(stmt parser.ExternalCommand) error {
+	if proc.Filter.Session().DisallowExternalCommand {
+		return NewExternalCommandNotAllowedError(stmt)
+	}
+
 	splitter := new(excmd.ArgsSplitter).Init(stmt.Command)
 	var argStrs = make([]string, 0, 8)
 	for splitter.Scan() {
@@ -568,8 +683,8 @@ func (proc *Procedure) ExecExternalCommand(stmt parser.ExternalCommand) error {
 
 	c := exec.Command(args[0], args[1:]...)
 	c.Stdin = Stdin
-	c.Stdout = Stdout
-	c.Stderr = Stderr
+	c.Stdout = proc.Filter.Session().stdout()
+	c.Stderr = proc.Filter.Session().stderr()
 
 	err = c.Run()
 	if err != nil {
@@ -582,5 +697,5 @@ func (proc *Procedure) showExecutionTime() {
 	palette, _ := cmd.GetPalette()
 	exectime := cmd.FormatNumber(time.Since(proc.MeasurementStart).Seconds(), 6, ".", ",", "")
 	stats := fmt.Sprintf(palette.Render(cmd.LableEffect, "Query Execution Time: ")+"%s seconds", exectime)
-	Log(stats, false)
+	proc.Filter.Session().Log(stats, false)
 }
@@ -884,6 +884,46 @@ func TestProcedure_ExecuteStatement(t *testing.T) {
 	OutFile = nil
 }
 
+func TestProcedure_ExecuteStatement_CSVDialect(t *testing.T) {
+	initCmdFlag()
+	tf := cmd.GetFlags()
+	tf.Format = cmd.CSV
+	tf.EncloseAll = true
+	_ = tf.SetQuoteChar("'")
+	_ = tf.SetEscapeStyle("BACKSLASH")
+	defer initCmdFlag()
+
+	proc := NewProcedure()
+
+	oldStdout := Stdout
+	r, w, _ := os.Pipe()
+	Stdout = w
+
+	_, err := proc.ExecuteStatement(parser.SelectQuery{
+		SelectEntity: parser.SelectEntity{
+			SelectClause: parser.SelectClause{
+				Fields: []parser.QueryExpression{
+					parser.Field{Object: parser.NewStringValue("it's"), Alias: parser.Identifier{Literal: "c1"}},
+					parser.Field{Object: parser.NewIntegerValueFromString("1"), Alias: parser.Identifier{Literal: "c2"}},
+				},
+			},
+		},
+	})
+
+	w.Close()
+	Stdout = oldStdout
+
+	log, _ := ioutil.ReadAll(r)
+
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	expect := "'c1','c2'\n'it\\'s',1\n"
+	if string(log) != expect {
+		t.Errorf("logs = %q, want %q", string(log), expect)
+	}
+}
+
 var procedureIfStmtTests = []struct {
 	Name       string
 	Stmt       parser.If
@@ -1661,6 +1701,131 @@ func TestProcedure_WhileInCursor(t *testing.T) {
 	}
 }
 
+var procedureTryCatchTests = []struct {
+	Name       string
+	Query      string
+	ResultFlow StatementFlow
+	Result     string
+	Error      string
+	ErrorCode  int
+}{
+	{
+		Name:       "TryCatch Statement",
+		Query:      "try print 1; catch print 2; end try",
+		ResultFlow: Terminate,
+		Result:     "1\n",
+	},
+	{
+		Name: "TryCatch Statement Catch Error",
+		Query: "try print 1; trigger error 300 'user error'; print 2; \n" +
+			"catch print @#error_code; print @#error_message; print @#error_line; end try",
+		ResultFlow: Terminate,
+		Result:     "1\n300\n\"user error\"\n1\n",
+	},
+	{
+		Name:       "TryCatch Statement Runtime Information Outside Catch",
+		Query:      "try try trigger error 'user error'; catch print @#error_message; end try; print @#error_message; catch end try",
+		ResultFlow: Terminate,
+		Result:     "\"user error\"\nNULL\n",
+	},
+	{
+		Name:       "TryCatch Statement Raise",
+		Query:      "try trigger error 300 'user error'; catch print 'caught'; raise; end try",
+		ResultFlow: Error,
+		Result:     "\"caught\"\n",
+		Error:      "[L:1 C:5] user error",
+		ErrorCode:  300,
+	},
+	{
+		Name:       "TryCatch Statement Nested Raise",
+		Query:      "try try trigger error 'inner'; catch raise; end try; catch print @#error_message; end try",
+		ResultFlow: Terminate,
+		Result:     "\"inner\"\n",
+	},
+	{
+		Name:       "TryCatch Statement Raise Outside Catch",
+		Query:      "try raise; catch print @#error_message; end try",
+		ResultFlow: Terminate,
+		Result:     "\"raise is not allowed outside of a catch block\"\n",
+	},
+	{
+		Name:       "TryCatch Statement Error in Catch",
+		Query:      "try trigger error 'try'; catch trigger error 200 'catch'; end try",
+		ResultFlow: Error,
+		Error:      "[L:1 C:32] catch",
+		ErrorCode:  200,
+	},
+	{
+		Name:       "TryCatch Statement Flow",
+		Query:      "try exit; catch end try",
+		ResultFlow: Exit,
+	},
+	{
+		Name: "TryCatch Statement Uncommitted Views",
+		Query: "try update table1 set column2 = 'updated' where column1 = 2; \n" +
+			"update table1 set column2 = notexist; \n" +
+			"catch print @#error_message; print @#updated; \n" +
+			"print (select listagg(column2, ',') within group (order by column1) from table1); end try",
+		ResultFlow: Terminate,
+		Result:     "\"field notexist does not exist\"\n1\n\"str1,updated,str3\"\n",
+	},
+}
+
+func TestProcedure_TryCatch(t *testing.T) {
+	tf := cmd.GetFlags()
+	tf.Repository = TestDir
+	tf.SetQuiet(true)
+	defer func() {
+		tf.SetQuiet(false)
+	}()
+
+	proc := NewProcedure()
+
+	for _, v := range procedureTryCatchTests {
+		ViewCache.Clean()
+		UncommittedViews.Clean()
+
+		statements, err := parser.Parse(v.Query, "")
+		if err != nil {
+			t.Fatalf("%s: unexpected parsing error %q", v.Name, err)
+		}
+
+		oldStdout := Stdout
+		r, w, _ := os.Pipe()
+		Stdout = w
+
+		flow, err := proc.TryCatch(statements[0].(parser.TryCatch))
+
+		w.Close()
+		Stdout = oldStdout
+
+		log, _ := ioutil.ReadAll(r)
+
+		if flow != v.ResultFlow {
+			t.Errorf("%s: result flow = %q, want %q", v.Name, flow, v.ResultFlow)
+		}
+		if string(log) != v.Result {
+			t.Errorf("%s: result = %q, want %q", v.Name, string(log), v.Result)
+		}
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			} else if err.(AppError).GetCode() != v.ErrorCode {
+				t.Errorf("%s: error code %d, want error code %d", v.Name, err.(AppError).GetCode(), v.ErrorCode)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+		}
+	}
+
+	ViewCache.Clean()
+	UncommittedViews.Clean()
+}
+
 var procedureExecExternalCommand = []struct {
 	Name  string
 	Stmt  parser.ExternalCommand
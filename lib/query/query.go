@@ -9,6 +9,8 @@ import (
 	"github.com/mithrandie/csvq/lib/file"
 	"github.com/mithrandie/csvq/lib/parser"
 	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/ternary"
 )
 
 func FetchCursor(name parser.Identifier, fetchPosition parser.FetchPosition, vars []parser.Variable, filter *Filter) (bool, error) {
@@ -99,6 +101,7 @@ func DeclareView(expr parser.ViewDeclaration, filter *Filter) error {
 
 func Select(query parser.SelectQuery, parentFilter *Filter) (*View, error) {
 	filter := parentFilter.CreateNode()
+	stage := filter.startPlanStage("Select", "")
 
 	if query.WithClause != nil {
 		if err := filter.LoadInlineTable(query.WithClause.(parser.WithClause)); err != nil {
@@ -112,24 +115,34 @@ func Select(query parser.SelectQuery, parentFilter *Filter) (*View, error) {
 	}
 
 	if query.OrderByClause != nil {
-		if err := view.OrderBy(query.OrderByClause.(parser.OrderByClause)); err != nil {
+		orderByClause := query.OrderByClause.(parser.OrderByClause)
+		s := filter.startPlanStage("Order By", listPlanItems(orderByClause.Items))
+		if err := view.OrderBy(orderByClause); err != nil {
 			return nil, err
 		}
+		s.finish(view.RecordLen())
 	}
 
 	if query.OffsetClause != nil {
-		if err := view.Offset(query.OffsetClause.(parser.OffsetClause)); err != nil {
+		offsetClause := query.OffsetClause.(parser.OffsetClause)
+		s := filter.startPlanStage("Offset", offsetClause.Value.String())
+		if err := view.Offset(offsetClause); err != nil {
 			return nil, err
 		}
+		s.finish(view.RecordLen())
 	}
 
 	if query.LimitClause != nil {
-		if err := view.Limit(query.LimitClause.(parser.LimitClause)); err != nil {
+		limitClause := query.LimitClause.(parser.LimitClause)
+		s := filter.startPlanStage("Limit", limitPlanDetail(limitClause))
+		if err := view.Limit(limitClause); err != nil {
 			return nil, err
 		}
+		s.finish(view.RecordLen())
 	}
 
 	view.Fix()
+	stage.finish(view.RecordLen())
 
 	return view, nil
 }
@@ -144,32 +157,52 @@ func selectEntity(expr parser.QueryExpression, filter *Filter) (*View, error) {
 		entity.FromClause = parser.FromClause{}
 	}
 	view := NewView()
+	if entity.WhereClause != nil {
+		view.indexCondition = entity.WhereClause.(parser.WhereClause).Filter
+	}
+	if entity.WindowClause != nil {
+		if err := view.DefineWindows(entity.WindowClause.(parser.WindowClause)); err != nil {
+			return nil, err
+		}
+	}
 	err := view.Load(entity.FromClause.(parser.FromClause), filter)
 	if err != nil {
 		return nil, err
 	}
 
 	if entity.WhereClause != nil {
-		if err := view.Where(entity.WhereClause.(parser.WhereClause)); err != nil {
+		whereClause := entity.WhereClause.(parser.WhereClause)
+		s := filter.startPlanStage("Where", whereClause.Filter.String())
+		if err := view.Where(whereClause); err != nil {
 			return nil, err
 		}
+		s.finish(view.RecordLen())
 	}
 
 	if entity.GroupByClause != nil {
-		if err := view.GroupBy(entity.GroupByClause.(parser.GroupByClause)); err != nil {
+		groupByClause := entity.GroupByClause.(parser.GroupByClause)
+		s := filter.startPlanStage("Group By", listPlanItems(groupByClause.Items))
+		if err := view.GroupBy(groupByClause); err != nil {
 			return nil, err
 		}
+		s.finish(view.RecordLen())
 	}
 
 	if entity.HavingClause != nil {
-		if err := view.Having(entity.HavingClause.(parser.HavingClause)); err != nil {
+		havingClause := entity.HavingClause.(parser.HavingClause)
+		s := filter.startPlanStage("Having", havingClause.Filter.String())
+		if err := view.Having(havingClause); err != nil {
 			return nil, err
 		}
+		s.finish(view.RecordLen())
 	}
 
-	if err := view.Select(entity.SelectClause.(parser.SelectClause)); err != nil {
+	selectClause := entity.SelectClause.(parser.SelectClause)
+	s := filter.startPlanStage("Fields", fieldsPlanDetail(selectClause))
+	if err := view.Select(selectClause); err != nil {
 		return nil, err
 	}
+	s.finish(view.RecordLen())
 
 	return view, nil
 }
@@ -179,15 +212,28 @@ func selectSetEntity(expr parser.QueryExpression, filter *Filter) (*View, error)
 		return Select(subquery.Query, filter)
 	}
 
+	var stage *planStage
+	if _, ok := expr.(parser.SelectEntity); ok {
+		stage = filter.startPlanStage("Select", "")
+	}
+
 	view, err := selectEntity(expr, filter)
 	if err != nil {
 		return nil, err
 	}
 	view.Fix()
+
+	stage.finish(view.RecordLen())
 	return view, nil
 }
 
 func selectSet(set parser.SelectSet, filter *Filter) (*View, error) {
+	var detail string
+	if filter.RecursiveTable != nil {
+		detail = "recursive"
+	}
+	stage := filter.startPlanStage(setPlanOperation(set), detail)
+
 	lview, err := selectSetEntity(set.LHS, filter)
 	if err != nil {
 		return nil, err
@@ -221,21 +267,28 @@ func selectSet(set parser.SelectSet, filter *Filter) (*View, error) {
 
 	lview.SelectAllColumns()
 
+	stage.finish(lview.RecordLen())
 	return lview, nil
 }
 
 func selectSetForRecursion(view *View, set parser.SelectSet, filter *Filter) error {
 	tmpViewName := strings.ToUpper(filter.RecursiveTable.Name.Literal)
 
-	if filter.RecursiveTmpView == nil {
+	isFirst := filter.RecursiveTmpView == nil
+	if isFirst {
 		err := view.Header.Update(tmpViewName, filter.RecursiveTable.Fields)
 		if err != nil {
 			return err
 		}
 		filter.RecursiveTmpView = view
+	} else {
+		filter.suspendPlan()
 	}
 
 	rview, err := selectSetEntity(set.RHS, filter.CreateNode())
+	if !isFirst {
+		filter.resumePlan()
+	}
 	if err != nil {
 		return err
 	}
@@ -283,6 +336,9 @@ func Insert(query parser.InsertQuery, parentFilter *Filter) (*FileInfo, int, err
 	if err != nil {
 		return nil, insertRecords, err
 	}
+	if view.FileInfo.Sqlite != nil && view.FileInfo.Sqlite.IsQuery() {
+		return nil, insertRecords, NewSqliteQueryNotUpdatableError(query.Table.Name())
+	}
 
 	fields := query.Fields
 	if fields == nil {
@@ -305,7 +361,7 @@ func Insert(query parser.InsertQuery, parentFilter *Filter) (*FileInfo, int, err
 	if view.FileInfo.IsTemporary {
 		filter.TempViews.Replace(view)
 	} else {
-		ViewCache.Replace(view)
+		filter.Session().ViewCache.Replace(view)
 	}
 
 	return view.FileInfo, insertRecords, nil
@@ -339,6 +395,7 @@ func Update(query parser.UpdateQuery, parentFilter *Filter) ([]*FileInfo, []int,
 	}
 
 	viewsToUpdate := make(map[string]*View)
+	columnsToUpdate := make(map[string][]ColumnSchema)
 	updatedCount := make(map[string]int)
 	for _, v := range query.Tables {
 		table := v.(parser.Table)
@@ -351,9 +408,13 @@ func Update(query parser.UpdateQuery, parentFilter *Filter) ([]*FileInfo, []int,
 		if filter.TempViews.Exists(fpath) {
 			viewsToUpdate[viewKey], _ = filter.TempViews.Get(parser.Identifier{Literal: fpath})
 		} else {
-			viewsToUpdate[viewKey], _ = ViewCache.Get(parser.Identifier{Literal: fpath})
+			viewsToUpdate[viewKey], _ = filter.Session().ViewCache.Get(parser.Identifier{Literal: fpath})
+		}
+		if sqliteTable := viewsToUpdate[viewKey].FileInfo.Sqlite; sqliteTable != nil && sqliteTable.IsQuery() {
+			return nil, nil, NewSqliteQueryNotUpdatableError(table.Name())
 		}
 		viewsToUpdate[viewKey].Header.Update(table.Name().Literal, nil)
+		columnsToUpdate[viewKey] = viewsToUpdate[viewKey].schemaColumns()
 	}
 
 	updatesList := make(map[string]map[int][]int)
@@ -403,6 +464,11 @@ func Update(query parser.UpdateQuery, parentFilter *Filter) ([]*FileInfo, []int,
 				return nil, nil, NewUpdateValueAmbiguousError(uset.Field, uset.Value)
 			}
 			updatesList[viewref][internalId] = append(updatesList[viewref][internalId], fieldIdx)
+			if columns := columnsToUpdate[viewref]; columns != nil {
+				if val, err = columns[fieldIdx].convertCell(uset.Field, val); err != nil {
+					return nil, nil, err
+				}
+			}
 			viewsToUpdate[viewref].RecordSet[internalId][fieldIdx] = NewCell(val)
 		}
 	}
@@ -415,7 +481,7 @@ func Update(query parser.UpdateQuery, parentFilter *Filter) ([]*FileInfo, []int,
 		if v.FileInfo.IsTemporary {
 			filter.TempViews.Replace(v)
 		} else {
-			ViewCache.Replace(v)
+			filter.Session().ViewCache.Replace(v)
 		}
 
 		fileInfos = append(fileInfos, v.FileInfo)
@@ -440,10 +506,14 @@ func Delete(query parser.DeleteQuery, parentFilter *Filter) ([]*FileInfo, []int,
 			return nil, nil, NewDeleteTableNotSpecifiedError(query)
 		}
 		table := fromClause.Tables[0].(parser.Table)
-		if _, ok := table.Object.(parser.Identifier); !ok {
-			if _, ok := table.Object.(parser.Stdin); !ok {
+		switch table.Object.(type) {
+		case parser.Identifier, parser.Stdin:
+		case parser.TableObject:
+			if !strings.EqualFold(table.Object.(parser.TableObject).Type.Literal, cmd.SQLITE.String()) {
 				return nil, nil, NewDeleteTableNotSpecifiedError(query)
 			}
+		default:
+			return nil, nil, NewDeleteTableNotSpecifiedError(query)
 		}
 		query.Tables = fromClause.Tables
 	}
@@ -475,7 +545,10 @@ func Delete(query parser.DeleteQuery, parentFilter *Filter) ([]*FileInfo, []int,
 		if filter.TempViews.Exists(fpath) {
 			viewsToDelete[viewKey], _ = filter.TempViews.Get(parser.Identifier{Literal: fpath})
 		} else {
-			viewsToDelete[viewKey], _ = ViewCache.Get(parser.Identifier{Literal: fpath})
+			viewsToDelete[viewKey], _ = filter.Session().ViewCache.Get(parser.Identifier{Literal: fpath})
+		}
+		if sqliteTable := viewsToDelete[viewKey].FileInfo.Sqlite; sqliteTable != nil && sqliteTable.IsQuery() {
+			return nil, nil, NewSqliteQueryNotUpdatableError(table.Name())
 		}
 		viewsToDelete[viewKey].Header.Update(table.Name().Literal, nil)
 		deletedIndices[viewKey] = make(map[int]bool)
@@ -496,20 +569,14 @@ func Delete(query parser.DeleteQuery, parentFilter *Filter) ([]*FileInfo, []int,
 	fileInfos := make([]*FileInfo, 0)
 	deletedCounts := make([]int, 0)
 	for k, v := range viewsToDelete {
-		records := make(RecordSet, 0, v.RecordLen()-len(deletedIndices[k]))
-		for i, record := range v.RecordSet {
-			if !deletedIndices[k][i] {
-				records = append(records, record)
-			}
-		}
-		v.RecordSet = records
+		v.removeRecords(deletedIndices[k])
 
 		v.RestoreHeaderReferences()
 
 		if v.FileInfo.IsTemporary {
 			filter.TempViews.Replace(v)
 		} else {
-			ViewCache.Replace(v)
+			filter.Session().ViewCache.Replace(v)
 		}
 
 		fileInfos = append(fileInfos, v.FileInfo)
@@ -519,17 +586,176 @@ func Delete(query parser.DeleteQuery, parentFilter *Filter) ([]*FileInfo, []int,
 	return fileInfos, deletedCounts, nil
 }
 
+func Merge(query parser.MergeQuery, parentFilter *Filter) (*FileInfo, int, int, int, error) {
+	filter := parentFilter.CreateNode()
+
+	if query.WithClause != nil {
+		if err := filter.LoadInlineTable(query.WithClause.(parser.WithClause)); err != nil {
+			return nil, 0, 0, 0, err
+		}
+	}
+
+	fromClause := parser.FromClause{
+		Tables: []parser.QueryExpression{
+			parser.Table{
+				Object: parser.Join{
+					Table:     query.Table,
+					JoinTable: query.Source,
+					JoinType:  parser.Token{Token: parser.OUTER, Literal: parser.TokenLiteral(parser.OUTER)},
+					Direction: parser.Token{Token: parser.RIGHT, Literal: parser.TokenLiteral(parser.RIGHT)},
+					Condition: parser.JoinCondition{On: query.On},
+				},
+			},
+		},
+	}
+
+	view := NewView()
+	view.ForUpdate = true
+	view.UseInternalId = true
+	err := view.Load(fromClause, filter)
+	if err != nil {
+		return nil, 0, 0, 0, err
+	}
+
+	targetName := query.Table.Name()
+	fpath, err := filter.Aliases.Get(targetName)
+	if err != nil {
+		return nil, 0, 0, 0, err
+	}
+
+	var target *View
+	if filter.TempViews.Exists(fpath) {
+		target, _ = filter.TempViews.Get(parser.Identifier{Literal: fpath})
+	} else {
+		target, _ = filter.Session().ViewCache.Get(parser.Identifier{Literal: fpath})
+	}
+	if sqliteTable := target.FileInfo.Sqlite; sqliteTable != nil && sqliteTable.IsQuery() {
+		return nil, 0, 0, 0, NewSqliteQueryNotUpdatableError(targetName)
+	}
+	target.Header.Update(targetName.Literal, nil)
+	targetRef := strings.ToUpper(targetName.Literal)
+
+	mergedIndices := make(map[int]bool)
+	deletedIndices := make(map[int]bool)
+	var updatedCount int
+	insertFields := make([][]parser.QueryExpression, 0)
+	insertValues := make([][]value.Primary, 0)
+	insertExprs := make([]parser.QueryExpression, 0)
+	columns := target.schemaColumns()
+
+	filterForLoop := NewFilterForSequentialEvaluation(view, filter)
+	for i := range view.RecordSet {
+		filterForLoop.Records[0].RecordIndex = i
+
+		internalId, err := view.InternalRecordId(targetRef, i)
+		matched := err == nil
+
+		for _, when := range query.WhenList {
+			if when.Matched != matched {
+				continue
+			}
+
+			if when.Condition != nil {
+				p, err := filterForLoop.Evaluate(when.Condition)
+				if err != nil {
+					return nil, 0, 0, 0, err
+				}
+				if p.Ternary() != ternary.TRUE {
+					continue
+				}
+			}
+
+			if !matched {
+				fields := when.Fields
+				if fields == nil {
+					fields = target.Header.TableColumns()
+				}
+				rv := when.Values.(parser.RowValue)
+				values, err := filterForLoop.evalRowValue(rv)
+				if err != nil {
+					return nil, 0, 0, 0, err
+				}
+				if len(fields) != len(values) {
+					return nil, 0, 0, 0, NewInsertRowValueLengthError(rv, len(fields))
+				}
+				insertFields = append(insertFields, fields)
+				insertValues = append(insertValues, values)
+				insertExprs = append(insertExprs, rv)
+				break
+			}
+
+			if mergedIndices[internalId] {
+				return nil, 0, 0, 0, NewMergeTargetAmbiguousError(when, targetName)
+			}
+			mergedIndices[internalId] = true
+
+			if when.Operation.Token == parser.DELETE {
+				deletedIndices[internalId] = true
+				break
+			}
+
+			updatedFields := make([]int, 0, len(when.SetList))
+			for _, uset := range when.SetList {
+				val, err := filterForLoop.Evaluate(uset.Value)
+				if err != nil {
+					return nil, 0, 0, 0, err
+				}
+
+				fieldIdx, err := target.FieldIndex(uset.Field)
+				if err != nil {
+					return nil, 0, 0, 0, NewUpdateFieldNotExistError(uset.Field)
+				}
+				if InIntSlice(fieldIdx, updatedFields) {
+					return nil, 0, 0, 0, NewUpdateValueAmbiguousError(uset.Field, uset.Value)
+				}
+				updatedFields = append(updatedFields, fieldIdx)
+				if columns != nil {
+					if val, err = columns[fieldIdx].convertCell(uset.Field, val); err != nil {
+						return nil, 0, 0, 0, err
+					}
+				}
+				target.RecordSet[internalId][fieldIdx] = NewCell(val)
+			}
+			updatedCount++
+			break
+		}
+	}
+
+	if 0 < len(deletedIndices) {
+		target.removeRecords(deletedIndices)
+	}
+
+	for i := range insertValues {
+		if _, err := target.insert(insertFields[i], insertValues[i:i+1], insertExprs[i:i+1]); err != nil {
+			return nil, 0, 0, 0, err
+		}
+	}
+
+	target.RestoreHeaderReferences()
+
+	if target.FileInfo.IsTemporary {
+		filter.TempViews.Replace(target)
+	} else {
+		filter.Session().ViewCache.Replace(target)
+	}
+
+	return target.FileInfo, len(insertValues), updatedCount, len(deletedIndices), nil
+}
+
 func CreateTable(query parser.CreateTable, parentFilter *Filter) (*FileInfo, error) {
 	filter := parentFilter.CreateNode()
 
 	var view *View
 	var err error
 
-	flags := cmd.GetFlags()
+	flags := filter.Flags()
 	fileInfo, err := NewFileInfoForCreate(query.Table, flags.Repository, flags.WriteDelimiter, flags.WriteEncoding)
 	if err != nil {
 		return nil, err
 	}
+	if err = filter.Session().CheckFileAccess(query.Table, fileInfo.Path); err != nil {
+		return nil, err
+	}
 	h, err := file.NewHandlerForCreate(fileInfo.Path)
 	if err != nil {
 		return nil, NewFileAlreadyExistError(query.Table)
@@ -540,6 +766,14 @@ func CreateTable(query parser.CreateTable, parentFilter *Filter) (*FileInfo, err
 	fileInfo.EncloseAll = flags.EncloseAll
 	fileInfo.NoHeader = flags.WithoutHeader
 	fileInfo.PrettyPrint = flags.PrettyPrint
+	fileInfo.Dialect = NewCSVDialect(flags)
+
+	identifiers, schema, err := createTableColumns(query.Fields)
+	if err != nil {
+		fileInfo.Close()
+		return nil, err
+	}
+	fileInfo.Schema = schema
 
 	if query.Query != nil {
 		view, err = Select(query.Query.(parser.SelectQuery), filter)
@@ -548,7 +782,7 @@ func CreateTable(query parser.CreateTable, parentFilter *Filter) (*FileInfo, err
 			return nil, err
 		}
 
-		if err = view.Header.Update(parser.FormatTableName(fileInfo.Path), query.Fields); err != nil {
+		if err = view.Header.Update(parser.FormatTableName(fileInfo.Path), identifiers); err != nil {
 			fileInfo.Close()
 			if _, ok := err.(*FieldLengthNotMatchError); ok {
 				return nil, NewTableFieldLengthError(query.Query.(parser.SelectQuery), query.Table, len(query.Fields))
@@ -556,8 +790,8 @@ func CreateTable(query parser.CreateTable, parentFilter *Filter) (*FileInfo, err
 			return nil, err
 		}
 	} else {
-		fields := make([]string, len(query.Fields))
-		for i, v := range query.Fields {
+		fields := make([]string, len(identifiers))
+		for i, v := range identifiers {
 			f, _ := v.(parser.Identifier)
 			if InStrSliceWithCaseInsensitive(f.Literal, fields) {
 				fileInfo.Close()
@@ -575,7 +809,22 @@ func CreateTable(query parser.CreateTable, parentFilter *Filter) (*FileInfo, err
 	view.FileInfo = fileInfo
 	view.ForUpdate = true
 
-	ViewCache.Set(view)
+	if columns := view.schemaColumns(); columns != nil {
+		for i, record := range view.RecordSet {
+			converted := make(Record, len(record))
+			for j, col := range columns {
+				val, err := col.convertCell(searchSelectClause(query.Query.(parser.SelectQuery)), record[j].Value())
+				if err != nil {
+					fileInfo.Close()
+					return nil, err
+				}
+				converted[j] = NewCell(val)
+			}
+			view.RecordSet[i] = converted
+		}
+	}
+
+	filter.Session().ViewCache.Set(view)
 
 	return view.FileInfo, nil
 }
@@ -619,12 +868,16 @@ func AddColumns(query parser.AddColumns, parentFilter *Filter) (*FileInfo, int,
 	columnNames := view.Header.TableColumnNames()
 	fields := make([]string, len(query.Columns))
 	defaults := make([]parser.QueryExpression, len(query.Columns))
+	columns := make([]ColumnSchema, len(query.Columns))
 	for i, coldef := range query.Columns {
 		if InStrSliceWithCaseInsensitive(coldef.Column.Literal, columnNames) || InStrSliceWithCaseInsensitive(coldef.Column.Literal, fields) {
 			return nil, 0, NewDuplicateFieldNameError(coldef.Column)
 		}
 		fields[i] = coldef.Column.Literal
 		defaults[i] = coldef.Value
+		if columns[i], err = NewColumnSchema(coldef.Column, coldef.Type, coldef.NotNull); err != nil {
+			return nil, 0, err
+		}
 	}
 	newFieldLen := view.FieldLen() + len(query.Columns)
 
@@ -663,13 +916,21 @@ func AddColumns(query parser.AddColumns, parentFilter *Filter) (*FileInfo, int,
 		}
 
 		for i, v := range defaults {
+			var expr parser.Expression = query.Columns[i].Column
 			if v == nil {
 				v = parser.NewNullValue()
+			} else if v.HasParseInfo() {
+				expr = v
+			} else {
+				expr = query.Columns[i]
 			}
 			val, e := f.Evaluate(v)
 			if e != nil {
 				return e
 			}
+			if val, e = columns[i].convertCell(expr, val); e != nil {
+				return e
+			}
 			record[i+insertPos] = NewCell(val)
 		}
 		records[rIdx] = record
@@ -683,10 +944,18 @@ func AddColumns(query parser.AddColumns, parentFilter *Filter) (*FileInfo, int,
 	view.RecordSet = records
 	view.Filter = nil
 
+	if !view.FileInfo.IsTemporary {
+		for _, col := range columns {
+			if col.IsConstrained() {
+				view.FileInfo.Schema = view.FileInfo.Schema.Set(col)
+			}
+		}
+	}
+
 	if view.FileInfo.IsTemporary {
 		filter.TempViews.Replace(view)
 	} else {
-		ViewCache.Replace(view)
+		filter.Session().ViewCache.Replace(view)
 	}
 
 	return view.FileInfo, len(fields), nil
@@ -711,6 +980,12 @@ func DropColumns(query parser.DropColumns, parentFilter *Filter) (*FileInfo, int
 		dropIndices[i] = idx
 	}
 
+	if !view.FileInfo.Schema.IsEmpty() {
+		for _, idx := range dropIndices {
+			view.FileInfo.Schema = view.FileInfo.Schema.Remove(view.Header[idx].Column)
+		}
+	}
+
 	view.selectFields = []int{}
 	for i := 0; i < view.FieldLen(); i++ {
 		if view.Header[i].IsFromTable && !InIntSlice(i, dropIndices) {
@@ -723,7 +998,7 @@ func DropColumns(query parser.DropColumns, parentFilter *Filter) (*FileInfo, int
 	if view.FileInfo.IsTemporary {
 		filter.TempViews.Replace(view)
 	} else {
-		ViewCache.Replace(view)
+		filter.Session().ViewCache.Replace(view)
 	}
 
 	return view.FileInfo, len(dropIndices), nil
@@ -750,13 +1025,14 @@ func RenameColumn(query parser.RenameColumn, parentFilter *Filter) (*FileInfo, e
 		return nil, err
 	}
 
+	view.FileInfo.Schema = view.FileInfo.Schema.Rename(view.Header[idx].Column, query.New.Literal)
 	view.Header[idx].Column = query.New.Literal
 	view.Filter = nil
 
 	if view.FileInfo.IsTemporary {
 		filter.TempViews.Replace(view)
 	} else {
-		ViewCache.Replace(view)
+		filter.Session().ViewCache.Replace(view)
 	}
 
 	return view.FileInfo, nil
@@ -842,44 +1118,55 @@ func SetTableAttribute(query parser.SetTableAttribute, parentFilter *Filter) (*F
 	w.Title2Effect = cmd.IdentifierEffect
 	log = "\n" + w.String() + "\n"
 
-	ViewCache.Replace(view)
+	filter.Session().ViewCache.Replace(view)
 
 	return view.FileInfo, log, nil
 }
 
 func Commit(expr parser.Expression, filter *Filter) error {
-	createdFiles, updatedFiles := UncommittedViews.UncommittedFiles()
+	session := filter.Session()
+	createdFiles, updatedFiles := session.UncommittedViews.UncommittedFiles()
 
 	createFileInfo := make([]*FileInfo, 0, len(createdFiles))
 	updateFileInfo := make([]*FileInfo, 0, len(updatedFiles))
 
 	if 0 < len(createdFiles) {
 		for _, fileinfo := range createdFiles {
-			view, _ := ViewCache.Get(parser.Identifier{Literal: fileinfo.Path})
+			view, _ := session.ViewCache.Get(parser.Identifier{Literal: fileinfo.Path})
 
 			fp := view.FileInfo.Handler.FileForUpdate()
 			fp.Truncate(0)
 			fp.Seek(0, io.SeekStart)
 
-			err := EncodeView(fp, view, fileinfo)
+			err := encodeViewToFile(fp, view, fileinfo, session.Flags)
 			if err != nil {
 				return NewCommitError(expr, err.Error())
 			}
+			view.FileInfo.Schema = tableSchemaFor(view.FileInfo.Schema, view.Header)
 			createFileInfo = append(createFileInfo, view.FileInfo)
 		}
 	}
 
 	if 0 < len(updatedFiles) {
 		for _, fileinfo := range updatedFiles {
-			view, _ := ViewCache.Get(parser.Identifier{Literal: fileinfo.Path})
+			view, _ := session.ViewCache.Get(parser.Identifier{Literal: fileinfo.Path})
+
+			if view.FileInfo.Sqlite != nil {
+				if err := view.FileInfo.Sqlite.write(view); err != nil {
+					return NewCommitError(expr, err.Error())
+				}
+				updateFileInfo = append(updateFileInfo, view.FileInfo)
+				continue
+			}
 
 			fp := view.FileInfo.Handler.FileForUpdate()
 			fp.Truncate(0)
 			fp.Seek(0, io.SeekStart)
 
-			if err := EncodeView(fp, view, fileinfo); err != nil {
+			if err := encodeViewToFile(fp, view, fileinfo, session.Flags); err != nil {
 				return NewCommitError(expr, err.Error())
 			}
+			view.FileInfo.Schema = tableSchemaFor(view.FileInfo.Schema, view.Header)
 
 			updateFileInfo = append(updateFileInfo, view.FileInfo)
 		}
@@ -889,45 +1176,57 @@ func Commit(expr parser.Expression, filter *Filter) error {
 		if err := f.Commit(); err != nil {
 			return NewCommitError(expr, err.Error())
 		}
-		UncommittedViews.Unset(f)
-		LogNotice(fmt.Sprintf("Commit: file %q is created.", f.Path), cmd.GetFlags().Quiet)
+		session.UncommittedViews.Unset(f)
+		session.LogNotice(fmt.Sprintf("Commit: file %q is created.", f.Path), session.Flags.Quiet)
+		if err := writeSchemaFile(f.Path, f.Schema); err != nil {
+			return NewCommitError(expr, err.Error())
+		}
 	}
 	for _, f := range updateFileInfo {
 		if err := f.Commit(); err != nil {
 			return NewCommitError(expr, err.Error())
 		}
-		UncommittedViews.Unset(f)
-		LogNotice(fmt.Sprintf("Commit: file %q is updated.", f.Path), cmd.GetFlags().Quiet)
+		session.UncommittedViews.Unset(f)
+		if f.Sqlite != nil {
+			session.LogNotice(fmt.Sprintf("Commit: table %q in %q is updated.", f.Sqlite.Name, f.Sqlite.Path), session.Flags.Quiet)
+			continue
+		}
+		session.LogNotice(fmt.Sprintf("Commit: file %q is updated.", f.Path), session.Flags.Quiet)
+		if err := writeSchemaFile(f.Path, f.Schema); err != nil {
+			return NewCommitError(expr, err.Error())
+		}
+		RebuildIndexes(f, session)
 	}
 
-	filter.TempViews.Store(UncommittedViews.UncommittedTempViews())
-	UncommittedViews.Clean()
-	if err := ReleaseResources(); err != nil {
+	filter.TempViews.Store(session.UncommittedViews.UncommittedTempViews(), session)
+	session.UncommittedViews.Clean()
+	if err := session.ReleaseResources(); err != nil {
 		return NewCommitError(expr, err.Error())
 	}
 	return nil
 }
 
 func Rollback(expr parser.Expression, filter *Filter) error {
-	createdFiles, updatedFiles := UncommittedViews.UncommittedFiles()
+	session := filter.Session()
+	createdFiles, updatedFiles := session.UncommittedViews.UncommittedFiles()
 
 	if 0 < len(createdFiles) {
 		for _, fileinfo := range createdFiles {
-			LogNotice(fmt.Sprintf("Rollback: file %q is deleted.", fileinfo.Path), cmd.GetFlags().Quiet)
+			session.LogNotice(fmt.Sprintf("Rollback: file %q is deleted.", fileinfo.Path), session.Flags.Quiet)
 		}
 	}
 
 	if 0 < len(updatedFiles) {
 		for _, fileinfo := range updatedFiles {
-			LogNotice(fmt.Sprintf("Rollback: file %q is restored.", fileinfo.Path), cmd.GetFlags().Quiet)
+			session.LogNotice(fmt.Sprintf("Rollback: file %q is restored.", fileinfo.Path), session.Flags.Quiet)
 		}
 	}
 
 	if filter != nil {
-		filter.TempViews.Restore(UncommittedViews.UncommittedTempViews())
+		filter.TempViews.Restore(session.UncommittedViews.UncommittedTempViews(), session)
 	}
-	UncommittedViews.Clean()
-	if err := ReleaseResources(); err != nil {
+	session.UncommittedViews.Clean()
+	if err := session.ReleaseResources(); err != nil {
 		return NewRollbackError(expr, err.Error())
 	}
 	return nil
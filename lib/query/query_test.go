@@ -1,6 +1,7 @@
 package query
 
 import (
+	"context"
 	"fmt"
 	"io/ioutil"
 	"os"
@@ -2350,6 +2351,295 @@ func TestDelete(t *testing.T) {
 	ReleaseResources()
 }
 
+func mergeTestFieldReference(view string, column string) parser.FieldReference {
+	return parser.FieldReference{View: parser.Identifier{Literal: view}, Column: parser.Identifier{Literal: column}}
+}
+
+var mergeTests = []struct {
+	Name        string
+	Query       parser.MergeQuery
+	ResultFile  *FileInfo
+	InsertCount int
+	UpdateCount int
+	DeleteCount int
+	Result      *View
+	Error       string
+}{
+	{
+		Name: "Merge Query",
+		Query: parser.MergeQuery{
+			Table:  parser.Table{Object: parser.Identifier{Literal: "table1"}},
+			Source: parser.Table{Object: parser.Identifier{Literal: "table2"}},
+			On: parser.Comparison{
+				LHS:      mergeTestFieldReference("table1", "column1"),
+				RHS:      mergeTestFieldReference("table2", "column3"),
+				Operator: "=",
+			},
+			WhenList: []parser.MergeWhen{
+				{
+					Matched: true,
+					Condition: parser.Comparison{
+						LHS:      mergeTestFieldReference("table2", "column3"),
+						RHS:      parser.NewIntegerValueFromString("3"),
+						Operator: "=",
+					},
+					Operation: parser.Token{Token: parser.DELETE, Literal: "delete"},
+				},
+				{
+					Matched:   true,
+					Operation: parser.Token{Token: parser.UPDATE, Literal: "update"},
+					SetList: []parser.UpdateSet{
+						{
+							Field: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+							Value: mergeTestFieldReference("table2", "column4"),
+						},
+					},
+				},
+				{
+					Operation: parser.Token{Token: parser.INSERT, Literal: "insert"},
+					Values: parser.RowValue{
+						Value: parser.ValueList{
+							Values: []parser.QueryExpression{
+								mergeTestFieldReference("table2", "column3"),
+								mergeTestFieldReference("table2", "column4"),
+							},
+						},
+					},
+				},
+			},
+		},
+		ResultFile: &FileInfo{
+			Path:      GetTestFilePath("table1.csv"),
+			Delimiter: ',',
+			NoHeader:  false,
+			Encoding:  text.UTF8,
+			LineBreak: text.LF,
+		},
+		InsertCount: 1,
+		UpdateCount: 1,
+		DeleteCount: 1,
+		Result: &View{
+			Header: NewHeader("table1", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("1"),
+					value.NewString("str1"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("2"),
+					value.NewString("str22"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("4"),
+					value.NewString("str44"),
+				}),
+			},
+		},
+	},
+	{
+		Name: "Merge Query For Temporary View",
+		Query: parser.MergeQuery{
+			Table:  parser.Table{Object: parser.Identifier{Literal: "tmpview"}, Alias: parser.Identifier{Literal: "t"}},
+			Source: parser.Table{Object: parser.Identifier{Literal: "table2"}},
+			On: parser.Comparison{
+				LHS:      mergeTestFieldReference("t", "column1"),
+				RHS:      mergeTestFieldReference("table2", "column3"),
+				Operator: "=",
+			},
+			WhenList: []parser.MergeWhen{
+				{
+					Operation: parser.Token{Token: parser.INSERT, Literal: "insert"},
+					Fields: []parser.QueryExpression{
+						parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+					},
+					Values: parser.RowValue{
+						Value: parser.ValueList{
+							Values: []parser.QueryExpression{
+								mergeTestFieldReference("table2", "column3"),
+							},
+						},
+					},
+				},
+			},
+		},
+		ResultFile: &FileInfo{
+			Path:        "tmpview",
+			Delimiter:   ',',
+			IsTemporary: true,
+		},
+		InsertCount: 2,
+		Result: &View{
+			Header: NewHeader("tmpview", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("1"),
+					value.NewString("str1"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("2"),
+					value.NewString("str2"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("3"),
+					value.NewNull(),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("4"),
+					value.NewNull(),
+				}),
+			},
+		},
+	},
+	{
+		Name: "Merge Query Target Ambiguous Error",
+		Query: parser.MergeQuery{
+			Table:  parser.Table{Object: parser.Identifier{Literal: "tmpview"}},
+			Source: parser.Table{Object: parser.Identifier{Literal: "table2"}},
+			On: parser.Comparison{
+				LHS:      mergeTestFieldReference("tmpview", "column1"),
+				RHS:      mergeTestFieldReference("table2", "column3"),
+				Operator: "<",
+			},
+			WhenList: []parser.MergeWhen{
+				{
+					Matched:   true,
+					Operation: parser.Token{Token: parser.DELETE, Literal: "delete"},
+				},
+			},
+		},
+		Error: "[L:- C:-] record in tmpview to merge is matched with multiple records",
+	},
+	{
+		Name: "Merge Query Update Field Not Exist Error",
+		Query: parser.MergeQuery{
+			Table:  parser.Table{Object: parser.Identifier{Literal: "tmpview"}},
+			Source: parser.Table{Object: parser.Identifier{Literal: "table2"}},
+			On: parser.Comparison{
+				LHS:      mergeTestFieldReference("tmpview", "column1"),
+				RHS:      mergeTestFieldReference("table2", "column3"),
+				Operator: "=",
+			},
+			WhenList: []parser.MergeWhen{
+				{
+					Matched:   true,
+					Operation: parser.Token{Token: parser.UPDATE, Literal: "update"},
+					SetList: []parser.UpdateSet{
+						{
+							Field: mergeTestFieldReference("table2", "column4"),
+							Value: parser.NewStringValue("update"),
+						},
+					},
+				},
+			},
+		},
+		Error: "[L:- C:-] field table2.column4 does not exist in the tables to update",
+	},
+	{
+		Name: "Merge Query Insert Row Value Length Error",
+		Query: parser.MergeQuery{
+			Table:  parser.Table{Object: parser.Identifier{Literal: "tmpview"}},
+			Source: parser.Table{Object: parser.Identifier{Literal: "table2"}},
+			On: parser.Comparison{
+				LHS:      mergeTestFieldReference("tmpview", "column1"),
+				RHS:      mergeTestFieldReference("table2", "column3"),
+				Operator: "=",
+			},
+			WhenList: []parser.MergeWhen{
+				{
+					Operation: parser.Token{Token: parser.INSERT, Literal: "insert"},
+					Values: parser.RowValue{
+						Value: parser.ValueList{
+							Values: []parser.QueryExpression{
+								mergeTestFieldReference("table2", "column3"),
+							},
+						},
+					},
+				},
+			},
+		},
+		Error: "[L:- C:-] row value should contain exactly 2 values",
+	},
+}
+
+func TestMerge(t *testing.T) {
+	tf := cmd.GetFlags()
+	tf.Repository = TestDir
+	tf.Quiet = false
+
+	for _, v := range mergeTests {
+		ReleaseResources()
+
+		filter := NewEmptyFilter()
+		filter.TempViews = TemporaryViewScopes{
+			ViewMap{
+				"TMPVIEW": &View{
+					Header: NewHeader("tmpview", []string{"column1", "column2"}),
+					RecordSet: []Record{
+						NewRecord([]value.Primary{
+							value.NewString("1"),
+							value.NewString("str1"),
+						}),
+						NewRecord([]value.Primary{
+							value.NewString("2"),
+							value.NewString("str2"),
+						}),
+					},
+					FileInfo: &FileInfo{
+						Path:        "tmpview",
+						Delimiter:   ',',
+						IsTemporary: true,
+					},
+				},
+			},
+		}
+
+		file, inserted, updated, deleted, err := Merge(v.Query, filter)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+
+		for _, v2 := range ViewCache {
+			if v2.FileInfo.Handler != nil {
+				if v2.FileInfo.Path != v2.FileInfo.Handler.Path() {
+					t.Errorf("file pointer = %q, want %q for %q", v2.FileInfo.Handler.Path(), v2.FileInfo.Path, v.Name)
+				}
+				v2.FileInfo.Close()
+				v2.FileInfo.Handler = nil
+			}
+		}
+
+		if !reflect.DeepEqual(file, v.ResultFile) {
+			t.Errorf("%s: fileinfo = %v, want %v", v.Name, file, v.ResultFile)
+		}
+		if inserted != v.InsertCount || updated != v.UpdateCount || deleted != v.DeleteCount {
+			t.Errorf("%s: counts = %d, %d, %d, want %d, %d, %d", v.Name, inserted, updated, deleted, v.InsertCount, v.UpdateCount, v.DeleteCount)
+		}
+
+		var result *View
+		if v.ResultFile.IsTemporary {
+			result, _ = filter.TempViews.Get(parser.Identifier{Literal: v.ResultFile.Path})
+		} else {
+			result, _ = ViewCache.Get(parser.Identifier{Literal: v.ResultFile.Path})
+		}
+		if !reflect.DeepEqual(result.Header, v.Result.Header) {
+			t.Errorf("%s: header = %v, want %v", v.Name, result.Header, v.Result.Header)
+		}
+		if !reflect.DeepEqual(result.RecordSet, v.Result.RecordSet) {
+			t.Errorf("%s: records = %s, want %s", v.Name, result.RecordSet, v.Result.RecordSet)
+		}
+	}
+	ReleaseResources()
+}
+
 var createTableTests = []struct {
 	Name       string
 	Query      parser.CreateTable
@@ -2434,6 +2724,101 @@ var createTableTests = []struct {
 			},
 		},
 	},
+	{
+		Name: "Create Table With Column Types",
+		Query: parser.CreateTable{
+			Table: parser.Identifier{Literal: "create_table_1.csv"},
+			Fields: []parser.QueryExpression{
+				parser.ColumnDefinition{
+					Column:  parser.Identifier{Literal: "column1"},
+					Type:    parser.Identifier{Literal: "integer"},
+					NotNull: true,
+				},
+				parser.Identifier{Literal: "column2"},
+			},
+			Query: parser.SelectQuery{
+				SelectEntity: parser.SelectEntity{
+					SelectClause: parser.SelectClause{
+						Fields: []parser.QueryExpression{
+							parser.Field{Object: parser.NewStringValue("1")},
+							parser.Field{Object: parser.NewStringValue("2")},
+						},
+					},
+				},
+			},
+		},
+		ResultFile: &FileInfo{
+			Path:      GetTestFilePath("create_table_1.csv"),
+			Delimiter: ',',
+			NoHeader:  false,
+			Encoding:  text.UTF8,
+			LineBreak: text.LF,
+			Schema: &TableSchema{
+				Columns: []ColumnSchema{
+					{Name: "column1", Type: ColumnTypeInteger, NotNull: true},
+				},
+			},
+		},
+		ViewCache: ViewMap{
+			strings.ToUpper(GetTestFilePath("create_table_1.csv")): &View{
+				FileInfo: &FileInfo{
+					Path:      GetTestFilePath("create_table_1.csv"),
+					Delimiter: ',',
+					NoHeader:  false,
+					Encoding:  text.UTF8,
+					LineBreak: text.LF,
+					Schema: &TableSchema{
+						Columns: []ColumnSchema{
+							{Name: "column1", Type: ColumnTypeInteger, NotNull: true},
+						},
+					},
+				},
+				Header: NewHeader("create_table_1", []string{"column1", "column2"}),
+				RecordSet: RecordSet{
+					NewRecord([]value.Primary{
+						value.NewInteger(1),
+						value.NewString("2"),
+					}),
+				},
+				ForUpdate: true,
+			},
+		},
+	},
+	{
+		Name: "Create Table Invalid Column Type Error",
+		Query: parser.CreateTable{
+			Table: parser.Identifier{Literal: "create_table_1.csv"},
+			Fields: []parser.QueryExpression{
+				parser.ColumnDefinition{
+					Column: parser.Identifier{Literal: "column1"},
+					Type:   parser.Identifier{Literal: "widget"},
+				},
+			},
+		},
+		Error: "[L:- C:-] widget is an unknown column type",
+	},
+	{
+		Name: "Create Table Column Type Mismatch Error",
+		Query: parser.CreateTable{
+			Table: parser.Identifier{Literal: "create_table_1.csv"},
+			Fields: []parser.QueryExpression{
+				parser.ColumnDefinition{
+					Column: parser.Identifier{Literal: "column1"},
+					Type:   parser.Identifier{Literal: "float"},
+				},
+			},
+			Query: parser.SelectQuery{
+				SelectEntity: parser.SelectEntity{
+					SelectClause: parser.SelectClause{
+						Fields: []parser.QueryExpression{
+							parser.Field{Object: parser.NewStringValue("12,5")},
+						},
+					},
+				},
+			},
+		},
+		Error: "[L:- C:-] value \"12,5\" for field column1 is not a valid FLOAT",
+	},
 	{
 		Name: "Create Table File Already Exist Error",
 		Query: parser.CreateTable{
@@ -2925,6 +3310,21 @@ var addColumnsTests = []struct {
 		},
 		Error: "[L:- C:-] field notexist does not exist",
 	},
+	{
+		Name: "Add Fields Default Value Type Mismatch Error",
+		Query: parser.AddColumns{
+			Table: parser.Identifier{Literal: "table1.csv"},
+			Columns: []parser.ColumnDefault{
+				{
+					BaseExpr: parser.NewBaseExpr(parser.Token{Line: 1, Char: 39}),
+					Column:   parser.Identifier{Literal: "column3"},
+					Type:     parser.Identifier{Literal: "integer"},
+					Value:    parser.NewStringValue("str"),
+				},
+			},
+		},
+		Error: "[L:1 C:39] value \"str\" for field column3 is not a valid INTEGER",
+	},
 }
 
 func TestAddColumns(t *testing.T) {
@@ -3497,7 +3897,7 @@ var setTableAttributeTests = []struct {
 			Attribute: parser.Identifier{Literal: "format"},
 			Value:     parser.NewStringValue("invalid"),
 		},
-		Error: "[L:- C:-] format must be one of CSV|TSV|FIXED|JSON|LTSV|GFM|ORG|TEXT",
+		Error: "[L:- C:-] format must be one of CSV|TSV|FIXED|JSON|JSONL|LTSV|GFM|ORG|TEXT|PARQUET|XLSX",
 	},
 	{
 		Name: "Set Encoding to SJIS",
@@ -3536,7 +3936,7 @@ var setTableAttributeTests = []struct {
 			Attribute: parser.Identifier{Literal: "encoding"},
 			Value:     parser.NewStringValue("invalid"),
 		},
-		Error: "[L:- C:-] encoding must be one of UTF8|SJIS",
+		Error: "[L:- C:-] encoding must be one of UTF8|UTF8BOM|UTF16LE|UTF16BE|SJIS|EUCJP|ISO2022JP|LATIN1|WINDOWS1252",
 	},
 	{
 		Name: "Set Encoding Error in JSON Format",
@@ -3835,6 +4235,53 @@ func TestCommit(t *testing.T) {
 	}
 }
 
+func TestCommit_CompressedFile(t *testing.T) {
+	path := GetTestFilePath("compressed_file.csv.gz")
+	defer func() {
+		_ = os.Remove(path)
+	}()
+
+	session := newTestSession()
+	defer session.Close()
+
+	ctx := context.Background()
+
+	if _, err := session.Exec(ctx, "CREATE TABLE `compressed_file.csv.gz` (column1, column2); INSERT INTO `compressed_file.csv.gz` VALUES (1, 'str1'), (2, 'str2')"); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if err := session.Commit(ctx); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if _, err := session.Exec(ctx, "UPDATE compressed_file SET column2 = 'updated' WHERE column1 = 2"); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if err := session.Commit(ctx); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+
+	fp, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	defer func() {
+		_ = fp.Close()
+	}()
+	r, err := file.NewDecompressReader(fp, file.Gzip)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	result, err := ioutil.ReadAll(r)
+	_ = r.Close()
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+
+	expect := "column1,column2\n1,str1\n2,updated"
+	if string(result) != expect {
+		t.Errorf("result = %q, want %q", string(result), expect)
+	}
+}
+
 func TestRollback(t *testing.T) {
 	cmd.GetFlags().SetQuiet(false)
 
@@ -0,0 +1,54 @@
+package query
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// regExpCacheSize is the maximum number of compiled patterns held in a
+// RegExpMap. An arbitrary pattern is evicted when the map is full.
+const regExpCacheSize = 256
+
+const regExpFlags = "imsU"
+
+var RegExps = RegExpMap{}
+
+var regExpMtx = &sync.RWMutex{}
+
+type RegExpMap map[string]*regexp.Regexp
+
+func (m RegExpMap) Compile(pattern string, flags string) (*regexp.Regexp, error) {
+	if 0 < len(flags) {
+		for _, r := range flags {
+			if !strings.ContainsRune(regExpFlags, r) {
+				return nil, errors.New("flags must consist of the characters i, m, s and U")
+			}
+		}
+		pattern = "(?" + flags + ")" + pattern
+	}
+
+	regExpMtx.RLock()
+	re, ok := m[pattern]
+	regExpMtx.RUnlock()
+	if ok {
+		return re, nil
+	}
+
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	regExpMtx.Lock()
+	if regExpCacheSize <= len(m) {
+		for k := range m {
+			delete(m, k)
+			break
+		}
+	}
+	m[pattern] = re
+	regExpMtx.Unlock()
+	return re, nil
+}
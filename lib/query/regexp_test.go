@@ -0,0 +1,34 @@
+package query
+
+import (
+	"strconv"
+	"testing"
+)
+
+func TestRegExpMap_Compile(t *testing.T) {
+	m := RegExpMap{}
+
+	re, err := m.Compile("^a", "im")
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if re.String() != "(?im)^a" {
+		t.Errorf("pattern = %q, want %q", re.String(), "(?im)^a")
+	}
+
+	expectErr := "flags must consist of the characters i, m, s and U"
+	for _, flags := range []string{"x", "2", "i)|(?s"} {
+		if _, err := m.Compile("a", flags); err == nil || err.Error() != expectErr {
+			t.Errorf("error = %v, want error %q for %q", err, expectErr, flags)
+		}
+	}
+
+	for i := 0; i < regExpCacheSize*2; i++ {
+		if _, err := m.Compile(strconv.Itoa(i), ""); err != nil {
+			t.Fatalf("unexpected error %q", err)
+		}
+	}
+	if regExpCacheSize < len(m) {
+		t.Errorf("cached patterns = %d, want at most %d", len(m), regExpCacheSize)
+	}
+}
@@ -0,0 +1,107 @@
+package query
+
+import (
+	"bytes"
+	"os"
+	"strconv"
+	"sync"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/parser"
+
+	"github.com/mithrandie/go-text"
+	"github.com/mithrandie/go-text/csv"
+)
+
+var rejectFileHeader = []string{"file", "line", "reason", "record"}
+
+var rejectFileMutex = &sync.Mutex{}
+
+type rejectedRow struct {
+	Line   int
+	Reason string
+	Text   string
+}
+
+// RejectedRowMap holds the number of rows rejected in loading each file
+// with the ON_PARSE_ERROR policy other than FAIL.
+type RejectedRowMap map[string]int
+
+func (m RejectedRowMap) Set(fileInfo *FileInfo) {
+	if fileInfo.OnParseError == cmd.FailOnParseError {
+		delete(m, fileInfo.Path)
+		return
+	}
+	m[fileInfo.Path] = fileInfo.RejectedRows
+}
+
+// recordRejectedRows writes the rows rejected in loading the file to the reject file
+// and keeps the number of them in the session.
+func recordRejectedRows(expr parser.QueryExpression, fileInfo *FileInfo, filter *Filter) error {
+	rows := fileInfo.rejected
+	fileInfo.rejected = nil
+
+	filter.Session().RejectedRows.Set(fileInfo)
+	if 0 < len(fileInfo.RejectFile) && 0 < len(rows) {
+		if err := filter.Session().CheckFileAccess(expr, fileInfo.RejectFile); err != nil {
+			return err
+		}
+	}
+	if err := writeRejectedRows(fileInfo, rows); err != nil {
+		return NewWriteFileError(expr, err.Error())
+	}
+	return nil
+}
+
+// writeRejectedRows appends the rejected rows to the reject file in CSV format.
+func writeRejectedRows(fileInfo *FileInfo, rows []rejectedRow) error {
+	if len(fileInfo.RejectFile) < 1 || len(rows) < 1 {
+		return nil
+	}
+
+	rejectFileMutex.Lock()
+	defer rejectFileMutex.Unlock()
+
+	fp, err := os.OpenFile(fileInfo.RejectFile, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0664)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = fp.Close()
+	}()
+
+	stat, err := fp.Stat()
+	if err != nil {
+		return err
+	}
+
+	buf := &bytes.Buffer{}
+	w := csv.NewWriter(buf, text.LF, text.UTF8)
+	if stat.Size() < 1 {
+		fields := make([]csv.Field, len(rejectFileHeader))
+		for i, v := range rejectFileHeader {
+			fields[i] = csv.NewField(v, false)
+		}
+		if err = w.Write(fields); err != nil {
+			return err
+		}
+	}
+	for _, row := range rows {
+		fields := []csv.Field{
+			csv.NewField(fileInfo.Path, true),
+			csv.NewField(strconv.Itoa(row.Line), false),
+			csv.NewField(row.Reason, true),
+			csv.NewField(row.Text, true),
+		}
+		if err = w.Write(fields); err != nil {
+			return err
+		}
+	}
+	if err = w.Flush(); err != nil {
+		return err
+	}
+	buf.WriteString(text.LF.Value())
+
+	_, err = fp.Write(buf.Bytes())
+	return err
+}
@@ -0,0 +1,91 @@
+package query
+
+import (
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/parser"
+)
+
+func TestRecordRejectedRows(t *testing.T) {
+	defer func() {
+		RejectedRows = make(RejectedRowMap)
+	}()
+
+	rejectFile := GetTestFilePath("rejected.csv")
+	_ = os.Remove(rejectFile)
+
+	filter := NewEmptyFilter()
+	expr := parser.Identifier{Literal: "table1"}
+
+	fileInfo := &FileInfo{
+		Path:         "/path/to/table1.csv",
+		OnParseError: cmd.SkipOnParseError,
+		RejectFile:   rejectFile,
+		RejectedRows: 2,
+		rejected: []rejectedRow{
+			{Line: 3, Reason: "wrong number of fields in line", Text: "3"},
+			{Line: 5, Reason: "unexpected \" in field", Text: "\"a\"b,\"c"},
+		},
+	}
+	if err := recordRejectedRows(expr, fileInfo, filter); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+
+	fileInfo2 := &FileInfo{
+		Path:         "/path/to/table2.csv",
+		OnParseError: cmd.PadOnParseError,
+		RejectFile:   rejectFile,
+		RejectedRows: 1,
+		rejected: []rejectedRow{
+			{Line: 2, Reason: "wrong number of fields in line", Text: "1,2,3"},
+		},
+	}
+	if err := recordRejectedRows(expr, fileInfo2, filter); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+
+	expect := "file,line,reason,record\n" +
+		"\"/path/to/table1.csv\",3,\"wrong number of fields in line\",\"3\"\n" +
+		"\"/path/to/table1.csv\",5,\"unexpected \"\" in field\",\"\"\"a\"\"b,\"\"c\"\n" +
+		"\"/path/to/table2.csv\",2,\"wrong number of fields in line\",\"1,2,3\"\n"
+	b, err := os.ReadFile(rejectFile)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if string(b) != expect {
+		t.Errorf("reject file = %q, want %q", string(b), expect)
+	}
+
+	if fileInfo.rejected != nil {
+		t.Errorf("rejected rows are not released")
+	}
+
+	expectCounts := RejectedRowMap{
+		"/path/to/table1.csv": 2,
+		"/path/to/table2.csv": 1,
+	}
+	if !reflect.DeepEqual(RejectedRows, expectCounts) {
+		t.Errorf("rejected rows = %v, want %v", RejectedRows, expectCounts)
+	}
+
+	fileInfo.OnParseError = cmd.FailOnParseError
+	fileInfo.RejectedRows = 0
+	if err := recordRejectedRows(expr, fileInfo, filter); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	expectCounts = RejectedRowMap{
+		"/path/to/table2.csv": 1,
+	}
+	if !reflect.DeepEqual(RejectedRows, expectCounts) {
+		t.Errorf("rejected rows = %v, want %v", RejectedRows, expectCounts)
+	}
+
+	fileInfo2.RejectFile = GetTestFilePath("notexist/rejected.csv")
+	fileInfo2.rejected = []rejectedRow{{Line: 2, Reason: "wrong number of fields in line", Text: "1,2,3"}}
+	if err := recordRejectedRows(expr, fileInfo2, filter); err == nil {
+		t.Errorf("no error, want error for writing to a nonexistent directory")
+	}
+}
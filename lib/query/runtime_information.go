@@ -1,6 +1,7 @@
 package query
 
 import (
+	"encoding/json"
 	"os"
 	"strings"
 
@@ -14,8 +15,12 @@ const (
 	UpdatedInformation      = "UPDATED"
 	UpdatedViewsInformation = "UPDATED_VIEWS"
 	LoadedTablesInformation = "LOADED_TABLES"
+	RejectedRowsInformation = "REJECTED_ROWS"
 	WorkingDirectory        = "WORKING_DIRECTORY"
 	VersionInformation      = "VERSION"
+	ErrorCodeInformation    = "ERROR_CODE"
+	ErrorMessageInformation = "ERROR_MESSAGE"
+	ErrorLineInformation    = "ERROR_LINE"
 )
 
 var RuntimeInformatinList = []string{
@@ -24,24 +29,35 @@ var RuntimeInformatinList = []string{
 	UpdatedInformation,
 	UpdatedViewsInformation,
 	LoadedTablesInformation,
+	RejectedRowsInformation,
 	WorkingDirectory,
 	VersionInformation,
+	ErrorCodeInformation,
+	ErrorMessageInformation,
+	ErrorLineInformation,
 }
 
-func GetRuntimeInformation(expr parser.RuntimeInformation) (value.Primary, error) {
+func GetRuntimeInformation(expr parser.RuntimeInformation, filter *Filter) (value.Primary, error) {
 	var p value.Primary
+	session := filter.Session()
 
 	switch strings.ToUpper(expr.Name) {
 	case UncommittedInformation:
-		p = value.NewBoolean(!UncommittedViews.IsEmpty())
+		p = value.NewBoolean(!session.UncommittedViews.IsEmpty())
 	case CreatedInformation:
-		p = value.NewInteger(int64(UncommittedViews.CountCreatedTables()))
+		p = value.NewInteger(int64(session.UncommittedViews.CountCreatedTables()))
 	case UpdatedInformation:
-		p = value.NewInteger(int64(UncommittedViews.CountUpdatedTables()))
+		p = value.NewInteger(int64(session.UncommittedViews.CountUpdatedTables()))
 	case UpdatedViewsInformation:
-		p = value.NewInteger(int64(UncommittedViews.CountUpdatedViews()))
+		p = value.NewInteger(int64(session.UncommittedViews.CountUpdatedViews()))
 	case LoadedTablesInformation:
-		p = value.NewInteger(int64(len(ViewCache)))
+		p = value.NewInteger(int64(len(session.ViewCache)))
+	case RejectedRowsInformation:
+		b, err := json.Marshal(session.RejectedRows)
+		if err != nil {
+			return p, err
+		}
+		p = value.NewString(string(b))
 	case WorkingDirectory:
 		wd, err := os.Getwd()
 		if err != nil {
@@ -50,6 +66,21 @@ func GetRuntimeInformation(expr parser.RuntimeInformation) (value.Primary, error
 		p = value.NewString(wd)
 	case VersionInformation:
 		p = value.NewString(Version)
+	case ErrorCodeInformation:
+		p = value.NewNull()
+		if filter.caughtError != nil {
+			p = value.NewInteger(int64(filter.caughtError.GetCode()))
+		}
+	case ErrorMessageInformation:
+		p = value.NewNull()
+		if filter.caughtError != nil {
+			p = value.NewString(filter.caughtError.ErrorMessage())
+		}
+	case ErrorLineInformation:
+		p = value.NewNull()
+		if filter.caughtError != nil && 0 < filter.caughtError.GetLine() {
+			p = value.NewInteger(int64(filter.caughtError.GetLine()))
+		}
 	default:
 		return p, NewInvalidRuntimeInformationError(expr)
 	}
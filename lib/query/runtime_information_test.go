@@ -33,6 +33,10 @@ var getRuntimeInformationTests = []struct {
 		Input:  parser.RuntimeInformation{Name: "loaded_tables"},
 		Expect: value.NewInteger(4),
 	},
+	{
+		Input:  parser.RuntimeInformation{Name: "rejected_rows"},
+		Expect: value.NewString("{\"/path/to/table1.csv\":0,\"/path/to/table2.csv\":3}"),
+	},
 	{
 		Input:  parser.RuntimeInformation{Name: "working_directory"},
 		Expect: value.NewString(GetWD()),
@@ -66,9 +70,16 @@ func TestGetRuntimeInformation(t *testing.T) {
 			"VIEW1":  {IsTemporary: true},
 		},
 	}
+	RejectedRows = RejectedRowMap{
+		"/path/to/table2.csv": 3,
+		"/path/to/table1.csv": 0,
+	}
+	defer func() {
+		RejectedRows = make(RejectedRowMap)
+	}()
 
 	for _, v := range getRuntimeInformationTests {
-		result, err := GetRuntimeInformation(v.Input)
+		result, err := GetRuntimeInformation(v.Input, NewEmptyFilter())
 
 		if err != nil {
 			if v.Error == "" {
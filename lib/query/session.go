@@ -0,0 +1,394 @@
+package query
+
+import (
+	"context"
+	"io"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+)
+
+// Session holds the state that a sequence of statements shares: flags, loaded
+// tables and uncommitted changes. Sessions created by NewSession are isolated
+// from each other and can run concurrently in one process.
+//
+// The timezone, the wait timeout for file locks, the datetime formats used to
+// convert strings and the string width settings used in functions are still
+// process-wide.
+type Session struct {
+	Flags            *cmd.Flags
+	ViewCache        ViewMap
+	UncommittedViews *UncommittedViewMap
+	RejectedRows     RejectedRowMap
+
+	// Stdout and Stderr receive the outputs of statements such as PRINT and
+	// SELECT, and the notices of commits and rollbacks. If they are nil, the
+	// outputs are written to the package-level Stdout and Stderr.
+	Stdout io.Writer
+	Stderr io.Writer
+
+	// DisallowExternalCommand prevents statements from running external
+	// commands and the CALL function.
+	DisallowExternalCommand bool
+
+	// RootDirectory confines the files that statements can read and write to
+	// the directory and its subdirectories if it is not empty.
+	RootDirectory string
+
+	proc      *Procedure
+	mtx       *sync.Mutex
+	isDefault bool
+}
+
+// DefaultSession returns the session that refers to the package-level
+// ViewCache, UncommittedViews, RejectedRows and cmd.GetFlags().
+func DefaultSession() *Session {
+	return &Session{
+		Flags:            cmd.GetFlags(),
+		ViewCache:        ViewCache,
+		UncommittedViews: UncommittedViews,
+		RejectedRows:     RejectedRows,
+		isDefault:        true,
+	}
+}
+
+func NewSession(flags *cmd.Flags) *Session {
+	if flags == nil {
+		flags = cmd.NewFlags()
+	}
+
+	session := &Session{
+		Flags:            flags,
+		ViewCache:        make(ViewMap, 10),
+		UncommittedViews: NewUncommittedViewMap(),
+		RejectedRows:     make(RejectedRowMap),
+		mtx:              &sync.Mutex{},
+	}
+	session.proc = &Procedure{
+		Filter: NewFilterForSession(session),
+	}
+	return session
+}
+
+// IsSandboxed returns true if statements of the session are restricted, in
+// which case statements that affect the whole process are not allowed.
+func (s *Session) IsSandboxed() bool {
+	return s.DisallowExternalCommand || 0 < len(s.RootDirectory)
+}
+
+func (s *Session) WriteToStdout(str string) error {
+	if s.Stdout == nil {
+		return WriteToStdout(str)
+	}
+	_, err := s.Stdout.Write([]byte(str))
+	return err
+}
+
+func (s *Session) WriteToStderr(str string) error {
+	if s.Stderr == nil {
+		return WriteToStderr(str)
+	}
+	_, err := s.Stderr.Write([]byte(str))
+	return err
+}
+
+func (s *Session) Log(log string, quiet bool) {
+	if !quiet {
+		_ = s.WriteToStdout(withLineBreak(log))
+	}
+}
+
+func (s *Session) LogNotice(log string, quiet bool) {
+	if !quiet {
+		_ = s.WriteToStdout(withLineBreak(cmd.Notice(log)))
+	}
+}
+
+func (s *Session) LogWarn(log string, quiet bool) {
+	if !quiet {
+		_ = s.WriteToStdout(withLineBreak(cmd.Warn(log)))
+	}
+}
+
+func (s *Session) LogError(log string) {
+	_ = s.WriteToStderr(withLineBreak(cmd.Error(log)))
+}
+
+// resultWriter returns the writer for the result sets of select queries.
+func (s *Session) resultWriter() io.Writer {
+	if s.Stdout != nil {
+		return s.Stdout
+	}
+	if OutFile != nil {
+		return OutFile
+	}
+	return Stdout
+}
+
+func (s *Session) stdout() io.Writer {
+	if s.Stdout != nil {
+		return s.Stdout
+	}
+	return Stdout
+}
+
+func (s *Session) stderr() io.Writer {
+	if s.Stderr != nil {
+		return s.Stderr
+	}
+	return Stderr
+}
+
+func (s *Session) ReleaseResources() error {
+	if s.isDefault {
+		return ReleaseResources()
+	}
+	return s.ViewCache.Clean()
+}
+
+func (s *Session) Procedure() *Procedure {
+	return s.proc
+}
+
+// CheckFileAccess returns an error if the file is outside of the root directory.
+func (s *Session) CheckFileAccess(expr parser.Expression, fpath string) error {
+	if len(s.RootDirectory) < 1 || isInDirectory(fpath, s.RootDirectory) {
+		return nil
+	}
+	return NewFileAccessNotAllowedError(expr, fpath)
+}
+
+func isInDirectory(fpath string, dir string) bool {
+	rel, err := filepath.Rel(resolveFilePath(dir), resolveFilePath(fpath))
+	if err != nil {
+		return false
+	}
+	return rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+// resolveFilePath returns the absolute path with symbolic links evaluated.
+// Files that do not exist yet are resolved by their directories.
+func resolveFilePath(fpath string) string {
+	if abs, err := filepath.Abs(fpath); err == nil {
+		fpath = abs
+	}
+	if resolved, err := filepath.EvalSymlinks(fpath); err == nil {
+		return resolved
+	}
+	if dir, err := filepath.EvalSymlinks(filepath.Dir(fpath)); err == nil {
+		return filepath.Join(dir, filepath.Base(fpath))
+	}
+	return fpath
+}
+
+// Parameter is a value bound to a placeholder or a variable in statements.
+// Ordinal is used for the placeholder "?" when Name is empty.
+type Parameter struct {
+	Name    string
+	Ordinal int
+	Value   value.Primary
+}
+
+func (s *Session) Exec(ctx context.Context, sql string) (int, error) {
+	statements, err := parser.Parse(sql, "")
+	if err != nil {
+		return 0, NewSyntaxError(err.(*parser.SyntaxError))
+	}
+	return s.ExecStatements(ctx, statements, nil)
+}
+
+// ExecStatements executes the statements and returns the number of records
+// affected by insert, update and delete queries.
+func (s *Session) ExecStatements(ctx context.Context, statements []parser.Statement, params []Parameter) (int, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	rows, affected, err := s.execute(ctx, statements, params)
+	if err != nil {
+		return affected, err
+	}
+	return affected, rows.Close()
+}
+
+func (s *Session) Query(ctx context.Context, sql string) (*Rows, error) {
+	statements, err := parser.Parse(sql, "")
+	if err != nil {
+		return nil, NewSyntaxError(err.(*parser.SyntaxError))
+	}
+	return s.QueryStatements(ctx, statements, nil)
+}
+
+// QueryStatements executes the statements and returns the result set of the
+// last statement if it is a select query. Results of the other select queries
+// are discarded.
+func (s *Session) QueryStatements(ctx context.Context, statements []parser.Statement, params []Parameter) (*Rows, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	rows, _, err := s.execute(ctx, statements, params)
+	return rows, err
+}
+
+func (s *Session) execute(ctx context.Context, statements []parser.Statement, params []Parameter) (*Rows, int, error) {
+	proc := s.proc
+	if 0 < len(params) {
+		proc = s.proc.NewChildProcedure()
+		for _, p := range params {
+			variable := PlaceholderVariable(p.Ordinal)
+			if 0 < len(p.Name) {
+				variable = parser.Variable{Name: p.Name}
+			}
+			if err := proc.Filter.Variables[0].Add(variable, p.Value); err != nil {
+				return nil, 0, err
+			}
+		}
+	}
+
+	affected := 0
+	for i, stmt := range statements {
+		if err := ctx.Err(); err != nil {
+			return nil, affected, err
+		}
+
+		if selectQuery, ok := stmt.(parser.SelectQuery); ok {
+			rows, err := s.query(ctx, selectQuery, proc.Filter)
+			if err != nil {
+				return nil, affected, err
+			}
+			if i == len(statements)-1 {
+				return rows, affected, nil
+			}
+			if err := rows.Close(); err != nil {
+				return nil, affected, err
+			}
+			continue
+		}
+
+		proc.AffectedRows = 0
+		flow, err := proc.ExecuteStatement(stmt)
+		affected += proc.AffectedRows
+		if err != nil {
+			return nil, affected, err
+		}
+		if flow == Exit {
+			break
+		}
+	}
+
+	return &Rows{ctx: ctx}, affected, nil
+}
+
+func (s *Session) query(ctx context.Context, query parser.SelectQuery, filter *Filter) (*Rows, error) {
+	stream, err := NewSelectStream(query, filter)
+	if err != nil {
+		return nil, err
+	}
+	if stream != nil {
+		return &Rows{
+			ctx:     ctx,
+			columns: stream.Labels,
+			records: stream,
+			closer:  stream.Close,
+		}, nil
+	}
+
+	view, err := Select(query, filter)
+	if err != nil {
+		return nil, err
+	}
+	return &Rows{
+		ctx:     ctx,
+		columns: view.Header.TableColumnNames(),
+		records: NewViewIterator(view),
+	}, nil
+}
+
+func (s *Session) Commit(ctx context.Context) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return Commit(nil, s.proc.Filter)
+}
+
+func (s *Session) Rollback(ctx context.Context) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return Rollback(nil, s.proc.Filter)
+}
+
+// Close discards uncommitted changes and releases the tables loaded in the session.
+func (s *Session) Close() error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	return Rollback(nil, s.proc.Filter)
+}
+
+type Rows struct {
+	ctx     context.Context
+	columns []string
+	records RecordIterator
+	closer  func() error
+
+	current []value.Primary
+	err     error
+}
+
+func (r *Rows) Columns() []string {
+	return r.columns
+}
+
+func (r *Rows) Next() bool {
+	if r.records == nil || r.err != nil {
+		return false
+	}
+	if err := r.ctx.Err(); err != nil {
+		r.err = err
+		return false
+	}
+
+	record, err := r.records.Next()
+	if err != nil {
+		if err != io.EOF {
+			r.err = err
+		}
+		r.current = nil
+		return false
+	}
+
+	values := make([]value.Primary, len(record))
+	for i, cell := range record {
+		values[i] = cell.Value()
+	}
+	r.current = values
+	return true
+}
+
+func (r *Rows) Values() []value.Primary {
+	return r.current
+}
+
+func (r *Rows) Err() error {
+	return r.err
+}
+
+func (r *Rows) Close() error {
+	r.records = nil
+	if r.closer != nil {
+		closer := r.closer
+		r.closer = nil
+		return closer()
+	}
+	return nil
+}
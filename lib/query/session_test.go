@@ -0,0 +1,385 @@
+package query
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/file"
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+)
+
+func newTestSession() *Session {
+	flags := cmd.NewFlags()
+	flags.Repository = TestDir
+	flags.Quiet = true
+	return NewSession(flags)
+}
+
+var sessionQueryTests = []struct {
+	Name    string
+	Query   string
+	Columns []string
+	Result  [][]value.Primary
+	Error   string
+}{
+	{
+		Name:    "Query Streamed Select",
+		Query:   "SELECT column1, column2 FROM table1 WHERE column1 > 1",
+		Columns: []string{"column1", "column2"},
+		Result: [][]value.Primary{
+			{value.NewString("2"), value.NewString("str2")},
+			{value.NewString("3"), value.NewString("str3")},
+		},
+	},
+	{
+		Name:    "Query Materialized Select",
+		Query:   "SELECT COUNT(*) AS cnt FROM table1",
+		Columns: []string{"cnt"},
+		Result: [][]value.Primary{
+			{value.NewInteger(3)},
+		},
+	},
+	{
+		Name:    "Query Compressed File",
+		Query:   "SELECT column1, column2 FROM table9 WHERE column1 > 1",
+		Columns: []string{"column1", "column2"},
+		Result: [][]value.Primary{
+			{value.NewString("2"), value.NewString("str2")},
+			{value.NewString("3"), value.NewString("str3")},
+		},
+	},
+	{
+		Name:    "Query Multiple Statements",
+		Query:   "DECLARE @a := 2; SELECT 1; SELECT @a + 1 AS v;",
+		Columns: []string{"v"},
+		Result: [][]value.Primary{
+			{value.NewInteger(3)},
+		},
+	},
+	{
+		Name:    "Query Without Select",
+		Query:   "DECLARE @b := 2;",
+		Columns: nil,
+		Result:  nil,
+	},
+	{
+		Name:  "Query Syntax Error",
+		Query: "SELECT FROM",
+		Error: "[L:1 C:8] syntax error: unexpected token \"FROM\"",
+	},
+	{
+		Name:  "Query Execution Error",
+		Query: "SELECT notexist FROM table1",
+		Error: "[L:1 C:8] field notexist does not exist",
+	},
+}
+
+func TestSession_Query(t *testing.T) {
+	session := newTestSession()
+	defer session.Close()
+
+	ctx := context.Background()
+
+	for _, v := range sessionQueryTests {
+		rows, err := session.Query(ctx, v.Query)
+		if err == nil {
+			var result [][]value.Primary
+			for rows.Next() {
+				result = append(result, rows.Values())
+			}
+			err = rows.Err()
+			rows.Close()
+
+			if err == nil {
+				if !reflect.DeepEqual(rows.Columns(), v.Columns) {
+					t.Errorf("%s: columns = %q, want %q", v.Name, rows.Columns(), v.Columns)
+				}
+				if !reflect.DeepEqual(result, v.Result) {
+					t.Errorf("%s: result = %s, want %s", v.Name, result, v.Result)
+				}
+			}
+		}
+
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+		}
+	}
+}
+
+func TestSession_QueryWithCanceledContext(t *testing.T) {
+	session := newTestSession()
+	defer session.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := session.Query(ctx, "SELECT 1")
+	if err != context.Canceled {
+		t.Errorf("error = %v, want %v", err, context.Canceled)
+	}
+}
+
+func TestSession_DisallowExternalCommand(t *testing.T) {
+	session := newTestSession()
+	defer session.Close()
+	session.DisallowExternalCommand = true
+
+	ctx := context.Background()
+
+	expect := "[L:1 C:1] external commands are not allowed in this session"
+	if _, err := session.Exec(ctx, "$echo foo;"); err == nil || err.Error() != expect {
+		t.Errorf("error = %v, want error %q", err, expect)
+	}
+
+	expect = "[L:1 C:8] external commands are not allowed in this session"
+	if _, err := session.Query(ctx, "SELECT CALL('echo', 'foo')"); err == nil || err.Error() != expect {
+		t.Errorf("error = %v, want error %q", err, expect)
+	}
+}
+
+func TestSession_Sandboxed(t *testing.T) {
+	session := newTestSession()
+	defer session.Close()
+	session.RootDirectory = TestDir
+
+	ctx := context.Background()
+
+	expect := "[L:1 C:1] CHDIR is not allowed in this session"
+	if _, err := session.Exec(ctx, "CHDIR '..';"); err == nil || err.Error() != expect {
+		t.Errorf("error = %v, want error %q", err, expect)
+	}
+
+	expect = "[L:1 C:1] RELOAD is not allowed in this session"
+	if _, err := session.Exec(ctx, "RELOAD CONFIG;"); err == nil || err.Error() != expect {
+		t.Errorf("error = %v, want error %q", err, expect)
+	}
+}
+
+func TestSession_RootDirectory(t *testing.T) {
+	session := newTestSession()
+	defer session.Close()
+	session.Flags.Repository = CompletionTestDir
+	session.RootDirectory = CompletionTestDir
+
+	ctx := context.Background()
+
+	expect := fmt.Sprintf("[L:1 C:15] file %s is not accessible in this session", filepath.Join(TestDir, "table1.csv"))
+	if _, err := session.Query(ctx, "SELECT * FROM `../table1.csv`"); err == nil || err.Error() != expect {
+		t.Errorf("error = %v, want error %q", err, expect)
+	}
+
+	expect = fmt.Sprintf("[L:1 C:14] file %s is not accessible in this session", filepath.Join(TestDir, "root_directory.csv"))
+	if _, err := session.Exec(ctx, "CREATE TABLE `../root_directory.csv` (c1)"); err == nil || err.Error() != expect {
+		t.Errorf("error = %v, want error %q", err, expect)
+	}
+
+	if _, err := session.Exec(ctx, "CREATE TABLE `root_directory.csv` (c1)"); err != nil {
+		t.Errorf("unexpected error %q", err)
+	}
+	if err := session.Rollback(ctx); err != nil {
+		t.Errorf("unexpected error %q", err)
+	}
+}
+
+func TestSession_Isolation(t *testing.T) {
+	ctx := context.Background()
+
+	s1 := newTestSession()
+	s2 := newTestSession()
+
+	if _, err := s1.Exec(ctx, "SET @@WITHOUT_NULL TO TRUE;"); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if !s1.Flags.WithoutNull {
+		t.Error("without-null of the session is false, want true")
+	}
+	if s2.Flags.WithoutNull {
+		t.Error("without-null of another session is true, want false")
+	}
+	if cmd.GetFlags().WithoutNull {
+		t.Error("without-null of the shared flags is true, want false")
+	}
+
+	if _, err := s1.Exec(ctx, "CREATE TABLE session_create(c1);"); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+
+	for _, s := range []struct {
+		Session *Session
+		Expect  value.Primary
+	}{
+		{Session: s1, Expect: value.NewInteger(1)},
+		{Session: s2, Expect: value.NewInteger(0)},
+	} {
+		rows, err := s.Session.Query(ctx, "SELECT @#CREATED")
+		if err != nil {
+			t.Fatalf("unexpected error %q", err)
+		}
+		rows.Next()
+		if !reflect.DeepEqual(rows.Values()[0], s.Expect) {
+			t.Errorf("created tables = %s, want %s", rows.Values()[0], s.Expect)
+		}
+		rows.Close()
+	}
+	if !UncommittedViews.IsEmpty() {
+		t.Error("uncommitted views of the default session are not empty, want empty")
+	}
+
+	if err := s1.Close(); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if err := s2.Close(); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+
+	if file.Exists(filepath.Join(TestDir, "session_create.csv")) {
+		t.Error("created file remains after the session is closed, want to be removed")
+	}
+}
+
+func TestSession_Output(t *testing.T) {
+	ctx := context.Background()
+
+	session := newTestSession()
+	session.Flags.Quiet = false
+	session.Flags.CPU = 1
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+	session.Stdout = stdout
+	session.Stderr = stderr
+
+	oldStdout := Stdout
+	r, w, _ := os.Pipe()
+	Stdout = w
+
+	_, err := session.Exec(ctx, "PRINT 'print';"+
+		"IF TRUE THEN SELECT 'select' AS c1; END IF;"+
+		"CREATE TABLE session_output(c1);"+
+		"COMMIT;")
+
+	w.Close()
+	Stdout = oldStdout
+	global, _ := io.ReadAll(r)
+
+	_ = os.Remove(filepath.Join(TestDir, "session_output"))
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+
+	if 0 < len(global) {
+		t.Errorf("output to the shared stdout = %q, want empty", string(global))
+	}
+	for _, s := range []string{"print", "select", "Commit: file \"" + filepath.Join(TestDir, "session_output") + "\" is created."} {
+		if !strings.Contains(stdout.String(), s) {
+			t.Errorf("output of the session = %q, want to contain %q", stdout.String(), s)
+		}
+	}
+	if 0 < stderr.Len() {
+		t.Errorf("error output of the session = %q, want empty", stderr.String())
+	}
+}
+
+func TestSession_Concurrency(t *testing.T) {
+	ctx := context.Background()
+
+	wg := &sync.WaitGroup{}
+	errs := make([]error, 8)
+	for i := 0; i < len(errs); i++ {
+		wg.Add(1)
+		go func(idx int) {
+			defer wg.Done()
+
+			session := newTestSession()
+			defer session.Close()
+
+			query := fmt.Sprintf("DECLARE @n := %d; SELECT COUNT(*) + @n FROM table1;", idx)
+			rows, err := session.Query(ctx, query)
+			if err != nil {
+				errs[idx] = err
+				return
+			}
+			defer rows.Close()
+
+			rows.Next()
+			if !reflect.DeepEqual(rows.Values()[0], value.NewInteger(int64(3+idx))) {
+				errs[idx] = fmt.Errorf("result = %s, want %d", rows.Values()[0], 3+idx)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	for i, err := range errs {
+		if err != nil {
+			t.Errorf("session %d: %s", i, err)
+		}
+	}
+}
+
+func TestSession_QueryStatements(t *testing.T) {
+	session := newTestSession()
+	defer session.Close()
+
+	ctx := context.Background()
+
+	statements, err := parser.Parse("SELECT column2 FROM table1 WHERE column1 = ? AND column2 = @name", "")
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	params := []Parameter{
+		{Ordinal: 1, Value: value.NewInteger(2)},
+		{Name: "name", Ordinal: 2, Value: value.NewString("str2")},
+	}
+
+	rows, err := session.QueryStatements(ctx, statements, params)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	var result []value.Primary
+	for rows.Next() {
+		result = append(result, rows.Values()[0])
+	}
+	rows.Close()
+	if !reflect.DeepEqual(result, []value.Primary{value.NewString("str2")}) {
+		t.Errorf("result = %s, want %s", result, []value.Primary{value.NewString("str2")})
+	}
+
+	if _, err := session.Procedure().Filter.Variables.Get(parser.Variable{Name: "name"}); err == nil {
+		t.Error("bound variable remains in the session, want to be disposed")
+	}
+}
+
+func TestSession_ExecStatements(t *testing.T) {
+	session := newTestSession()
+	defer session.Close()
+
+	statements, err := parser.Parse("UPDATE table1 SET column2 = ? WHERE column1 > 1; DELETE FROM table1 WHERE column1 = 1;", "")
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+
+	affected, err := session.ExecStatements(context.Background(), statements, []Parameter{{Ordinal: 1, Value: value.NewString("updated")}})
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if affected != 3 {
+		t.Errorf("affected rows = %d, want %d", affected, 3)
+	}
+}
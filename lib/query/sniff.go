@@ -0,0 +1,447 @@
+package query
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/go-text"
+)
+
+// DetectionSampleSize is the number of bytes read from the beginning of a file
+// to detect its attributes.
+const DetectionSampleSize = 64 * 1024
+
+var detectionDelimiterCandidates = []rune{',', '\t', ';', '|'}
+var detectionQuoteCandidates = []rune{'"', '\''}
+
+// Detection represents the attributes of a CSV file to be detected from the contents.
+type Detection struct {
+	Delimiter bool
+	Quote     bool
+	Encoding  bool
+	Header    bool
+}
+
+func NewDetection(flags *cmd.Flags) Detection {
+	return Detection{
+		Delimiter: flags.DetectDelimiter,
+		Quote:     flags.DetectQuote,
+		Encoding:  flags.DetectEncoding,
+		Header:    flags.DetectHeader,
+	}
+}
+
+func (d Detection) IsEmpty() bool {
+	return !d.Delimiter && !d.Quote && !d.Encoding && !d.Header
+}
+
+func (d Detection) String() string {
+	list := make([]string, 0, 4)
+	if d.Delimiter {
+		list = append(list, "Delimiter")
+	}
+	if d.Quote {
+		list = append(list, "Quote")
+	}
+	if d.Encoding {
+		list = append(list, "Encoding")
+	}
+	if d.Header {
+		list = append(list, "Header")
+	}
+	return strings.Join(list, ", ")
+}
+
+// detectCSVAttributes samples the beginning of the file and sets the detected attributes to the file info.
+// The returned reader must be used to read the file instead of fp.
+func detectCSVAttributes(fp io.Reader, fileInfo *FileInfo) (io.Reader, error) {
+	if fileInfo.Detection.IsEmpty() {
+		return fp, nil
+	}
+
+	fp, err := skipByteOrderMark(fp, fileInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	r := bufio.NewReaderSize(fp, DetectionSampleSize)
+	sample, err := r.Peek(DetectionSampleSize)
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+	truncated := len(sample) == DetectionSampleSize
+
+	if truncated {
+		if i := bytes.LastIndexByte(sample, '\n'); 0 < i {
+			sample = sample[:i+1]
+		}
+	}
+
+	if fileInfo.Detection.Encoding && !fileInfo.BOM {
+		fileInfo.Encoding = detectEncoding(sample)
+	}
+
+	s, err := decodeString(string(sample), fileInfo.Encoding)
+	if err != nil {
+		return nil, err
+	}
+	if truncated {
+		if i := strings.LastIndexByte(s, '\n'); 0 < i {
+			s = s[:i+1]
+		}
+	}
+	s = fileInfo.Dialect.trimSample(s)
+
+	if fileInfo.Detection.Quote {
+		fileInfo.Dialect.Quote = detectQuote(s)
+		if fileInfo.Dialect.Quote == '"' {
+			fileInfo.Dialect.Quote = 0
+		}
+	}
+	quote := fileInfo.Dialect.QuoteChar()
+
+	if fileInfo.Detection.Delimiter {
+		if d, ok := detectDelimiter(s, quote, truncated); ok {
+			fileInfo.Delimiter = d
+			if d == '\t' {
+				fileInfo.Format = cmd.TSV
+			} else {
+				fileInfo.Format = cmd.CSV
+			}
+		}
+	}
+
+	if fileInfo.Detection.Header {
+		if records := sampleRecords(s, fileInfo.Delimiter, quote, truncated); 0 < len(records) {
+			fileInfo.NoHeader = !hasHeader(records)
+		}
+	}
+
+	return r, nil
+}
+
+// detectEncoding returns the encoding of the sample.
+// ISO-2022-JP is selected by its escape sequences, and UTF-8 is selected if the sample is valid as UTF-8.
+// Otherwise, the one of Shift JIS and EUC-JP that decodes the sample into more Japanese characters is selected.
+// If the sample contains no Japanese characters in both encodings, Windows-1252 or ISO-8859-1 is selected.
+func detectEncoding(sample []byte) text.Encoding {
+	if isISO2022JP(sample) {
+		return cmd.ISO2022JP
+	}
+	if utf8.Valid(sample) {
+		return text.UTF8
+	}
+
+	enc := text.Encoding("")
+	score := -1
+	for _, e := range []text.Encoding{text.SJIS, cmd.EUCJP} {
+		s, ok := decodeSample(sample, e)
+		if !ok {
+			continue
+		}
+		if n := countJapaneseCharacters(s); score < n {
+			enc, score = e, n
+		}
+	}
+	if 0 < score {
+		return enc
+	}
+
+	if _, ok := decodeSample(sample, cmd.WINDOWS1252); ok {
+		return cmd.WINDOWS1252
+	}
+	if 0 < len(enc) {
+		return enc
+	}
+	return cmd.LATIN1
+}
+
+var iso2022JPEscapeSequences = [][]byte{
+	{0x1b, '$', '@'},
+	{0x1b, '$', 'B'},
+	{0x1b, '(', 'J'},
+	{0x1b, '(', 'I'},
+}
+
+func isISO2022JP(sample []byte) bool {
+	for _, b := range sample {
+		if utf8.RuneSelf <= b {
+			return false
+		}
+	}
+	for _, seq := range iso2022JPEscapeSequences {
+		if bytes.Contains(sample, seq) {
+			return true
+		}
+	}
+	return false
+}
+
+func decodeSample(sample []byte, enc text.Encoding) (string, bool) {
+	s, err := decodeString(string(sample), enc)
+	if err != nil || strings.ContainsRune(s, utf8.RuneError) {
+		return "", false
+	}
+	return s, true
+}
+
+// countJapaneseCharacters returns the number of Hiragana, full-width Katakana and Kanji characters.
+// Half-width Katakana is not counted because multibyte characters in EUC-JP are decoded into them by Shift JIS.
+func countJapaneseCharacters(s string) int {
+	n := 0
+	for _, r := range s {
+		if unicode.In(r, unicode.Hiragana, unicode.Han) || (unicode.Is(unicode.Katakana, r) && r < 0xff00) {
+			n++
+		}
+	}
+	return n
+}
+
+// detectDelimiter returns the candidate that splits the records into the same number of fields most consistently.
+// If the sample cannot be split by any candidate, then the second return value is false.
+func detectDelimiter(s string, quote rune, truncated bool) (rune, bool) {
+	var delimiter rune
+	var bestRatio float64
+	var bestFields int
+
+	for _, c := range detectionDelimiterCandidates {
+		records := sampleRecords(s, c, quote, truncated)
+		if len(records) < 1 {
+			continue
+		}
+
+		counts := make(map[int]int, 10)
+		for _, record := range records {
+			counts[len(record)]++
+		}
+
+		fields, freq := 0, 0
+		for n, cnt := range counts {
+			if freq < cnt || (freq == cnt && fields < n) {
+				fields, freq = n, cnt
+			}
+		}
+		if fields < 2 {
+			continue
+		}
+
+		ratio := float64(freq) / float64(len(records))
+		if bestRatio < ratio || (bestRatio == ratio && bestFields < fields) {
+			delimiter, bestRatio, bestFields = c, ratio, fields
+		}
+	}
+	return delimiter, 0 < bestFields
+}
+
+// detectQuote returns the candidate that encloses the most fields in the sample.
+// A field is counted when the quotation character is at the beginning of the field
+// and the closing one is followed by a delimiter candidate or a line break.
+func detectQuote(s string) rune {
+	quote := detectionQuoteCandidates[0]
+	best := 0
+	for _, c := range detectionQuoteCandidates {
+		if n := countQuotedFields(s, c); best < n {
+			quote, best = c, n
+		}
+	}
+	return quote
+}
+
+func countQuotedFields(s string, quote rune) int {
+	count := 0
+	fieldStart := true
+
+	runes := []rune(s)
+	for i := 0; i < len(runes); i++ {
+		c := runes[i]
+
+		if fieldStart && c == quote {
+			j := i + 1
+			for ; j < len(runes); j++ {
+				if runes[j] == quote {
+					if j+1 < len(runes) && runes[j+1] == quote {
+						j++
+						continue
+					}
+					break
+				}
+			}
+			if j < len(runes) && (j+1 == len(runes) || isSampleFieldEnd(runes[j+1])) {
+				count++
+				i = j
+				fieldStart = false
+				continue
+			}
+		}
+		fieldStart = isSampleFieldEnd(c)
+	}
+	return count
+}
+
+func isSampleFieldEnd(c rune) bool {
+	if c == '\r' || c == '\n' {
+		return true
+	}
+	for _, d := range detectionDelimiterCandidates {
+		if c == d {
+			return true
+		}
+	}
+	return false
+}
+
+// sampleRecords splits the sample into records and fields.
+// Empty lines are ignored, and the last record is dropped if the sample is truncated.
+func sampleRecords(s string, delimiter rune, quote rune, truncated bool) [][]string {
+	records := make([][]string, 0, 100)
+	record := make([]string, 0, 10)
+	var field strings.Builder
+	quoted := false
+	fieldStart := true
+
+	appendField := func() {
+		record = append(record, field.String())
+		field.Reset()
+		fieldStart = true
+	}
+	appendRecord := func() {
+		appendField()
+		if 1 < len(record) || 0 < len(record[0]) {
+			records = append(records, record)
+		}
+		record = make([]string, 0, len(record))
+	}
+
+	runes := []rune(s)
+	for i := 0; i < len(runes); i++ {
+		c := runes[i]
+
+		if quoted {
+			if c == quote {
+				if i+1 < len(runes) && runes[i+1] == quote {
+					field.WriteRune(c)
+					i++
+				} else {
+					quoted = false
+				}
+				continue
+			}
+			field.WriteRune(c)
+			continue
+		}
+
+		switch c {
+		case quote:
+			if fieldStart {
+				quoted = true
+				fieldStart = false
+				continue
+			}
+			field.WriteRune(c)
+		case delimiter:
+			appendField()
+		case '\r', '\n':
+			if c == '\r' && i+1 < len(runes) && runes[i+1] == '\n' {
+				i++
+			}
+			appendRecord()
+		default:
+			field.WriteRune(c)
+			fieldStart = false
+		}
+	}
+
+	if 0 < field.Len() || 0 < len(record) || quoted {
+		if truncated {
+			return records
+		}
+		appendRecord()
+	}
+	return records
+}
+
+// hasHeader compares the values in the first record with the values in the following records.
+// A column votes for a header when the following values are numbers or datetimes and the first value is not,
+// or when the following values have the same length and the first value has a different length.
+func hasHeader(records [][]string) bool {
+	header := records[0]
+	for _, label := range header {
+		if len(strings.TrimSpace(label)) < 1 || sampleValueType(label) != sampleString {
+			return false
+		}
+	}
+	if len(records) < 2 {
+		return true
+	}
+
+	votes := 0
+	for i, label := range header {
+		columnType := -1
+		length := -1
+		for _, record := range records[1:] {
+			if len(record) <= i || len(record[i]) < 1 {
+				continue
+			}
+
+			t := sampleValueType(record[i])
+			if columnType == -1 {
+				columnType = t
+			} else if columnType != t {
+				columnType = sampleString
+				length = -2
+			}
+
+			if length == -1 {
+				length = utf8.RuneCountInString(record[i])
+			} else if length != utf8.RuneCountInString(record[i]) {
+				length = -2
+			}
+		}
+
+		switch {
+		case columnType == sampleNumber || columnType == sampleDatetime:
+			votes++
+		case 0 <= length:
+			if length != utf8.RuneCountInString(label) {
+				votes++
+			} else {
+				votes--
+			}
+		}
+	}
+
+	if votes == 0 {
+		for i := range header {
+			for j := i + 1; j < len(header); j++ {
+				if header[i] == header[j] {
+					return false
+				}
+			}
+		}
+		return true
+	}
+	return 0 < votes
+}
+
+const (
+	sampleString = iota
+	sampleNumber
+	sampleDatetime
+)
+
+func sampleValueType(s string) int {
+	if p := value.ToFloat(value.NewString(strings.TrimSpace(s))); !value.IsNull(p) {
+		return sampleNumber
+	}
+	if _, err := value.StrToTime(s); err == nil {
+		return sampleDatetime
+	}
+	return sampleString
+}
@@ -0,0 +1,309 @@
+package query
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+
+	"github.com/mithrandie/go-text"
+)
+
+var detectCSVAttributesTests = []struct {
+	Name      string
+	Input     string
+	FileInfo  *FileInfo
+	Delimiter rune
+	Format    cmd.Format
+	Encoding  text.Encoding
+	NoHeader  bool
+	Quote     rune
+	BOM       bool
+	Content   string
+}{
+	{
+		Name:  "Semicolon with Quoted Delimiters",
+		Input: "id;name;price\n1;\"Smith; J\";12,5\n2;Doe;3\n",
+		FileInfo: &FileInfo{
+			Delimiter: ',',
+			Format:    cmd.CSV,
+			Encoding:  text.UTF8,
+			Detection: Detection{Delimiter: true, Encoding: true, Header: true},
+		},
+		Delimiter: ';',
+		Format:    cmd.CSV,
+		Encoding:  text.UTF8,
+		NoHeader:  false,
+		Content:   "id;name;price\n1;\"Smith; J\";12,5\n2;Doe;3\n",
+	},
+	{
+		Name:  "Tab without Header",
+		Input: "1\tapple\t2024-01-02\r\n2\tbanana\t2024-01-03\r\n",
+		FileInfo: &FileInfo{
+			Delimiter: ',',
+			Format:    cmd.CSV,
+			Encoding:  text.UTF8,
+			Detection: Detection{Delimiter: true, Header: true},
+		},
+		Delimiter: '\t',
+		Format:    cmd.TSV,
+		Encoding:  text.UTF8,
+		NoHeader:  true,
+		Content:   "1\tapple\t2024-01-02\r\n2\tbanana\t2024-01-03\r\n",
+	},
+	{
+		Name:  "Pipe with Header of Same Length Strings",
+		Input: "code|name\nA001|apple\nB002|lemon\n",
+		FileInfo: &FileInfo{
+			Delimiter: ',',
+			Format:    cmd.CSV,
+			Encoding:  text.UTF8,
+			Detection: Detection{Delimiter: true, Header: true},
+		},
+		Delimiter: '|',
+		Format:    cmd.CSV,
+		Encoding:  text.UTF8,
+		NoHeader:  false,
+		Content:   "code|name\nA001|apple\nB002|lemon\n",
+	},
+	{
+		Name:  "Byte Order Mark",
+		Input: "\ufeffcolumn1,column2\n1,a\n",
+		FileInfo: &FileInfo{
+			Delimiter: ',',
+			Format:    cmd.CSV,
+			Encoding:  text.UTF8,
+			Detection: Detection{Encoding: true},
+		},
+		Delimiter: ',',
+		Format:    cmd.CSV,
+		Encoding:  text.UTF8,
+		BOM:       true,
+		Content:   "column1,column2\n1,a\n",
+	},
+	{
+		Name:  "UTF-16 Byte Order Mark",
+		Input: "\xff\xfea\x00;\x00b\x00\n\x001\x00;\x002\x00\n\x00",
+		FileInfo: &FileInfo{
+			Delimiter: ',',
+			Format:    cmd.CSV,
+			Encoding:  text.UTF8,
+			Detection: Detection{Delimiter: true, Encoding: true},
+		},
+		Delimiter: ';',
+		Format:    cmd.CSV,
+		Encoding:  cmd.UTF16LE,
+		BOM:       true,
+		Content:   "a\x00;\x00b\x00\n\x001\x00;\x002\x00\n\x00",
+	},
+	{
+		Name:  "Shift JIS",
+		Input: "id,name\n1,\x83\x65\x83\x58\x83\x67\n",
+		FileInfo: &FileInfo{
+			Delimiter: ',',
+			Format:    cmd.CSV,
+			Encoding:  text.UTF8,
+			Detection: Detection{Encoding: true},
+		},
+		Delimiter: ',',
+		Format:    cmd.CSV,
+		Encoding:  text.SJIS,
+		Content:   "id,name\n1,\x83\x65\x83\x58\x83\x67\n",
+	},
+	{
+		Name:  "EUC-JP",
+		Input: "id,name\n1,\xa5\xc6\xa5\xb9\xa5\xc8\n2,\xc6\xfc\xcb\xdc\xb8\xec\n",
+		FileInfo: &FileInfo{
+			Delimiter: ',',
+			Format:    cmd.CSV,
+			Encoding:  text.UTF8,
+			Detection: Detection{Encoding: true},
+		},
+		Delimiter: ',',
+		Format:    cmd.CSV,
+		Encoding:  cmd.EUCJP,
+		Content:   "id,name\n1,\xa5\xc6\xa5\xb9\xa5\xc8\n2,\xc6\xfc\xcb\xdc\xb8\xec\n",
+	},
+	{
+		Name:  "ISO-2022-JP",
+		Input: "id,name\n1,\x1b$B%F%9%H\x1b(B\n2,\x1b$BF|K\\8l\x1b(B\n",
+		FileInfo: &FileInfo{
+			Delimiter: ',',
+			Format:    cmd.CSV,
+			Encoding:  text.UTF8,
+			Detection: Detection{Encoding: true},
+		},
+		Delimiter: ',',
+		Format:    cmd.CSV,
+		Encoding:  cmd.ISO2022JP,
+		Content:   "id,name\n1,\x1b$B%F%9%H\x1b(B\n2,\x1b$BF|K\\8l\x1b(B\n",
+	},
+	{
+		Name:  "Windows-1252",
+		Input: "id,name\n1,Jos\xe9\n2,\x80 5\n",
+		FileInfo: &FileInfo{
+			Delimiter: ',',
+			Format:    cmd.CSV,
+			Encoding:  text.UTF8,
+			Detection: Detection{Encoding: true},
+		},
+		Delimiter: ',',
+		Format:    cmd.CSV,
+		Encoding:  cmd.WINDOWS1252,
+		Content:   "id,name\n1,Jos\xe9\n2,\x80 5\n",
+	},
+	{
+		Name:  "ISO-8859-1",
+		Input: "id,code\n1,\x81\xe9\n",
+		FileInfo: &FileInfo{
+			Delimiter: ',',
+			Format:    cmd.CSV,
+			Encoding:  text.UTF8,
+			Detection: Detection{Encoding: true},
+		},
+		Delimiter: ',',
+		Format:    cmd.CSV,
+		Encoding:  cmd.LATIN1,
+		Content:   "id,code\n1,\x81\xe9\n",
+	},
+	{
+		Name:  "Single Column",
+		Input: "value\n1\n2\n",
+		FileInfo: &FileInfo{
+			Delimiter: ';',
+			Format:    cmd.CSV,
+			Encoding:  text.UTF8,
+			NoHeader:  true,
+			Detection: Detection{Delimiter: true, Header: true},
+		},
+		Delimiter: ';',
+		Format:    cmd.CSV,
+		Encoding:  text.UTF8,
+		NoHeader:  false,
+		Content:   "value\n1\n2\n",
+	},
+	{
+		Name:  "Skipped Lines and Comments",
+		Input: "exported, 2024\nid;name\n# 'a,b,c'\n1;'x;y'\n2;z\n",
+		FileInfo: &FileInfo{
+			Delimiter: ',',
+			Format:    cmd.CSV,
+			Encoding:  text.UTF8,
+			NoHeader:  true,
+			Detection: Detection{Delimiter: true, Header: true},
+			Dialect:   CSVDialect{Quote: '\'', Comment: "#", SkipLines: 1},
+		},
+		Delimiter: ';',
+		Format:    cmd.CSV,
+		Encoding:  text.UTF8,
+		NoHeader:  false,
+		Content:   "exported, 2024\nid;name\n# 'a,b,c'\n1;'x;y'\n2;z\n",
+	},
+	{
+		Name:  "Single Quotes",
+		Input: "id,name\n1,'Smith, J'\n2,'Doe'\n",
+		FileInfo: &FileInfo{
+			Delimiter: ',',
+			Format:    cmd.CSV,
+			Encoding:  text.UTF8,
+			Detection: Detection{Delimiter: true, Quote: true, Header: true},
+		},
+		Delimiter: ',',
+		Format:    cmd.CSV,
+		Encoding:  text.UTF8,
+		NoHeader:  false,
+		Quote:     '\'',
+		Content:   "id,name\n1,'Smith, J'\n2,'Doe'\n",
+	},
+	{
+		Name:  "Double Quotes with Apostrophes",
+		Input: "id;name\n1;\"O'Brien; P\"\n2;'Doe's'\n",
+		FileInfo: &FileInfo{
+			Delimiter: ',',
+			Format:    cmd.CSV,
+			Encoding:  text.UTF8,
+			Detection: Detection{Delimiter: true, Quote: true},
+		},
+		Delimiter: ';',
+		Format:    cmd.CSV,
+		Encoding:  text.UTF8,
+		Quote:     '"',
+		Content:   "id;name\n1;\"O'Brien; P\"\n2;'Doe's'\n",
+	},
+	{
+		Name:  "Without Detection",
+		Input: "\ufeffa;b\n1;2\n",
+		FileInfo: &FileInfo{
+			Delimiter: ',',
+			Format:    cmd.CSV,
+			Encoding:  text.UTF8,
+		},
+		Delimiter: ',',
+		Format:    cmd.CSV,
+		Encoding:  text.UTF8,
+		Content:   "\ufeffa;b\n1;2\n",
+	},
+}
+
+func TestDetectCSVAttributes(t *testing.T) {
+	for _, v := range detectCSVAttributesTests {
+		r, err := detectCSVAttributes(strings.NewReader(v.Input), v.FileInfo)
+		if err != nil {
+			t.Errorf("%s: unexpected error %q", v.Name, err)
+			continue
+		}
+
+		if v.FileInfo.Delimiter != v.Delimiter {
+			t.Errorf("%s: delimiter = %q, want %q", v.Name, v.FileInfo.Delimiter, v.Delimiter)
+		}
+		if v.FileInfo.Format != v.Format {
+			t.Errorf("%s: format = %s, want %s", v.Name, v.FileInfo.Format, v.Format)
+		}
+		if v.FileInfo.Encoding != v.Encoding {
+			t.Errorf("%s: encoding = %s, want %s", v.Name, v.FileInfo.Encoding, v.Encoding)
+		}
+		if v.FileInfo.NoHeader != v.NoHeader {
+			t.Errorf("%s: no-header = %t, want %t", v.Name, v.FileInfo.NoHeader, v.NoHeader)
+		}
+		if v.Quote != 0 && v.FileInfo.Dialect.QuoteChar() != v.Quote {
+			t.Errorf("%s: quote = %q, want %q", v.Name, v.FileInfo.Dialect.QuoteChar(), v.Quote)
+		}
+		if v.FileInfo.BOM != v.BOM {
+			t.Errorf("%s: bom = %t, want %t", v.Name, v.FileInfo.BOM, v.BOM)
+		}
+
+		b, _ := io.ReadAll(r)
+		if string(b) != v.Content {
+			t.Errorf("%s: content = %q, want %q", v.Name, string(b), v.Content)
+		}
+	}
+}
+
+func TestDetectCSVAttributes_TruncatedSample(t *testing.T) {
+	var buf strings.Builder
+	buf.WriteString("name|note\n")
+	for buf.Len() < DetectionSampleSize+100 {
+		buf.WriteString("abc|\"x, y; z\"\n")
+	}
+
+	fileInfo := &FileInfo{
+		Delimiter: ',',
+		Format:    cmd.CSV,
+		Encoding:  text.UTF8,
+		Detection: Detection{Delimiter: true, Encoding: true, Header: true},
+	}
+	r, err := detectCSVAttributes(strings.NewReader(buf.String()), fileInfo)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if fileInfo.Delimiter != '|' {
+		t.Errorf("delimiter = %q, want %q", fileInfo.Delimiter, '|')
+	}
+	if fileInfo.NoHeader {
+		t.Errorf("no-header = %t, want %t", fileInfo.NoHeader, false)
+	}
+	if b, _ := io.ReadAll(r); len(b) != buf.Len() {
+		t.Errorf("read %d bytes, want %d bytes", len(b), buf.Len())
+	}
+}
@@ -0,0 +1,593 @@
+package query
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/go-text"
+	"github.com/mithrandie/ternary"
+
+	_ "modernc.org/sqlite"
+)
+
+const sqliteDriverName = "sqlite"
+
+type SqliteTable struct {
+	Path  string
+	Name  string
+	Query string
+
+	txn *sqliteTransaction
+
+	// Rows loaded for update are identified by their indices as row ids.
+	columns    []string
+	keyColumns []string
+	keys       [][]interface{}
+	records    RecordSet
+}
+
+func (t *SqliteTable) IsQuery() bool {
+	return 0 < len(t.Query)
+}
+
+func (t *SqliteTable) Source() string {
+	if t.IsQuery() {
+		return t.Query
+	}
+	return t.Name
+}
+
+func (t *SqliteTable) Key() string {
+	return t.Path + "::" + t.Source()
+}
+
+// load reads the table or the result of the query.
+// When txn is passed, the table is read in the transaction with the values of
+// its key columns so that the changes can be written back row by row.
+func (t *SqliteTable) load(txn *sqliteTransaction, timeout float64) ([]string, [][]interface{}, RecordSet, error) {
+	var q sqliteQueryer
+	if txn != nil {
+		q = txn.tx
+	} else {
+		db, err := openSqliteDatabase(t.Path, timeout)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		defer db.Close()
+		q = db
+	}
+
+	var keyColumns []string
+	if txn != nil && !t.IsQuery() {
+		var err error
+		if keyColumns, err = sqliteKeyColumns(q, t.Name); err != nil {
+			return nil, nil, nil, err
+		}
+	}
+
+	query := t.Query
+	if !t.IsQuery() {
+		fields := make([]string, 0, len(keyColumns)+1)
+		for _, c := range keyColumns {
+			fields = append(fields, quoteSqliteIdentifier(c))
+		}
+		fields = append(fields, "*")
+		query = "SELECT " + strings.Join(fields, ", ") + " FROM " + quoteSqliteIdentifier(t.Name)
+	}
+
+	rows, err := q.Query(query)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	defer rows.Close()
+
+	header, err := rows.Columns()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	header = header[len(keyColumns):]
+
+	var keys [][]interface{}
+	if keyColumns != nil {
+		keys = make([][]interface{}, 0, 100)
+	}
+	records := make(RecordSet, 0, 100)
+	keyValues := make([]interface{}, len(keyColumns))
+	values := make([]interface{}, len(header))
+	pointers := make([]interface{}, 0, len(keyValues)+len(values))
+	for i := range keyValues {
+		pointers = append(pointers, &keyValues[i])
+	}
+	for i := range values {
+		pointers = append(pointers, &values[i])
+	}
+	for rows.Next() {
+		if err := rows.Scan(pointers...); err != nil {
+			return nil, nil, nil, err
+		}
+		record := make(Record, len(header))
+		for i, v := range values {
+			record[i] = NewCell(convertSqliteValue(v))
+		}
+		records = append(records, record)
+		if keyColumns != nil {
+			key := make([]interface{}, len(keyValues))
+			copy(key, keyValues)
+			keys = append(keys, key)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, nil, nil, err
+	}
+
+	t.keyColumns = keyColumns
+	return header, keys, records, nil
+}
+
+func (t *SqliteTable) loadForUpdate(txn *sqliteTransaction, timeout float64) ([]string, []int64, RecordSet, error) {
+	header, keys, records, err := t.load(txn, timeout)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	t.txn = txn
+	t.columns = header
+	t.keys = keys
+	t.records = records.Copy()
+
+	rowIds := make([]int64, len(records))
+	for i := range rowIds {
+		rowIds[i] = int64(i)
+	}
+	return header, rowIds, records, nil
+}
+
+// write applies the differences between the loaded rows and the view to the
+// table in the transaction started when the table was loaded.
+// Rows are identified by their rowids, or by their primary keys for tables
+// declared WITHOUT ROWID, so the rows and the columns that are not changed in
+// the view are kept as they are.
+func (t *SqliteTable) write(view *View) error {
+	if t.IsQuery() {
+		return errors.New(fmt.Sprintf("result of query %q cannot be updated", t.Query))
+	}
+	if t.txn == nil || !t.txn.active() {
+		return errors.New(fmt.Sprintf("table %q in %q is not loaded for update", t.Name, t.Path))
+	}
+	tx := t.txn.tx
+
+	tableName := quoteSqliteIdentifier(t.Name)
+	header := view.Header.TableColumnNames()
+	columns := make([]string, len(header))
+	for i, name := range header {
+		columns[i] = quoteSqliteIdentifier(name)
+	}
+
+	conditions := make([]string, len(t.keyColumns))
+	for i, c := range t.keyColumns {
+		conditions[i] = quoteSqliteIdentifier(c) + " = ?"
+	}
+	keyCondition := strings.Join(conditions, " AND ")
+
+	remaining := make(map[int64]bool, len(view.rowIds))
+	for _, id := range view.rowIds {
+		remaining[id] = true
+	}
+	for i, key := range t.keys {
+		if remaining[int64(i)] {
+			continue
+		}
+		if _, err := tx.Exec("DELETE FROM "+tableName+" WHERE "+keyCondition, key...); err != nil {
+			return err
+		}
+	}
+
+	originalIndices := make(map[string]int, len(t.columns))
+	for i, name := range t.columns {
+		originalIndices[strings.ToUpper(name)] = i
+	}
+	for i, id := range view.rowIds {
+		original := t.records[id]
+		record := view.RecordSet[i]
+
+		sets := make([]string, 0, len(header))
+		args := make([]interface{}, 0, len(header)+len(t.keyColumns))
+		for j, name := range header {
+			if idx, ok := originalIndices[strings.ToUpper(name)]; ok && !sqliteValueChanged(original[idx].Value(), record[j].Value()) {
+				continue
+			}
+			sets = append(sets, columns[j]+" = ?")
+			args = append(args, sqliteArgument(record[j].Value()))
+		}
+		if len(sets) < 1 {
+			continue
+		}
+		args = append(args, t.keys[id]...)
+		if _, err := tx.Exec(fmt.Sprintf("UPDATE %s SET %s WHERE %s", tableName, strings.Join(sets, ", "), keyCondition), args...); err != nil {
+			return err
+		}
+	}
+
+	if len(view.rowIds) < view.RecordLen() {
+		placeholders := make([]string, len(header))
+		for i := range header {
+			placeholders[i] = "?"
+		}
+
+		stmt, err := tx.Prepare(fmt.Sprintf("INSERT INTO %s (%s) VALUES (%s)", tableName, strings.Join(columns, ", "), strings.Join(placeholders, ", ")))
+		if err != nil {
+			return err
+		}
+		defer stmt.Close()
+
+		args := make([]interface{}, len(header))
+		for _, record := range view.RecordSet[len(view.rowIds):] {
+			for i := range args {
+				args[i] = sqliteArgument(record[i].Value())
+			}
+			if _, err = stmt.Exec(args...); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (t *SqliteTable) commit() error {
+	if t.txn == nil {
+		return nil
+	}
+	return t.txn.commit()
+}
+
+func (t *SqliteTable) rollback() error {
+	if t.txn == nil {
+		return nil
+	}
+	return t.txn.rollback()
+}
+
+type sqliteQueryer interface {
+	Query(query string, args ...interface{}) (*sql.Rows, error)
+}
+
+// sqliteKeyColumns returns the columns that identify the rows of the table,
+// that are the primary key columns for tables declared WITHOUT ROWID, or the
+// rowid for the other tables.
+func sqliteKeyColumns(q sqliteQueryer, name string) ([]string, error) {
+	var objectType string
+	var withoutRowId bool
+	err := sqliteQueryRow(q, func(rows *sql.Rows) error {
+		return rows.Scan(&objectType, &withoutRowId)
+	}, "SELECT type, wr FROM pragma_table_list(?)", name)
+	if err == sql.ErrNoRows {
+		// The error that the table does not exist is reported on loading.
+		return []string{"rowid"}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if objectType != "table" {
+		return nil, errors.New(fmt.Sprintf("%s %q cannot be updated", objectType, name))
+	}
+	if !withoutRowId {
+		return []string{"rowid"}, nil
+	}
+
+	rows, err := q.Query("SELECT name FROM pragma_table_info(?) WHERE 0 < pk ORDER BY pk", name)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	columns := make([]string, 0, 2)
+	for rows.Next() {
+		var column string
+		if err := rows.Scan(&column); err != nil {
+			return nil, err
+		}
+		columns = append(columns, column)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(columns) < 1 {
+		return nil, errors.New(fmt.Sprintf("table %q has no primary key", name))
+	}
+	return columns, nil
+}
+
+func sqliteQueryRow(q sqliteQueryer, scan func(rows *sql.Rows) error, query string, args ...interface{}) error {
+	rows, err := q.Query(query, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return err
+		}
+		return sql.ErrNoRows
+	}
+	return scan(rows)
+}
+
+// sqliteTransaction is a write transaction on a database file.
+// The transaction is shared by all the tables in the same file that are
+// loaded for update, and keeps the other writers waiting until commit or
+// rollback.
+type sqliteTransaction struct {
+	Path string
+
+	db *sql.DB
+	tx *sql.Tx
+}
+
+func beginSqliteTransaction(path string, timeout float64) (*sqliteTransaction, error) {
+	db, err := openSqliteDatabase(path, timeout)
+	if err != nil {
+		return nil, err
+	}
+	tx, err := db.Begin()
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+	return &sqliteTransaction{
+		Path: path,
+		db:   db,
+		tx:   tx,
+	}, nil
+}
+
+func (txn *sqliteTransaction) active() bool {
+	return txn.tx != nil
+}
+
+func (txn *sqliteTransaction) commit() error {
+	if txn.tx == nil {
+		return nil
+	}
+	err := txn.tx.Commit()
+	if e := txn.db.Close(); err == nil {
+		err = e
+	}
+	txn.tx = nil
+	txn.db = nil
+	return err
+}
+
+func (txn *sqliteTransaction) rollback() error {
+	if txn.tx == nil {
+		return nil
+	}
+	err := txn.tx.Rollback()
+	if e := txn.db.Close(); err == nil {
+		err = e
+	}
+	txn.tx = nil
+	txn.db = nil
+	return err
+}
+
+func openSqliteDatabase(path string, timeout float64) (*sql.DB, error) {
+	return sql.Open(sqliteDriverName, fmt.Sprintf("%s?_pragma=busy_timeout(%d)&_txlock=immediate&_time_format=sqlite", sqliteFileURI(path), int64(timeout*1000)))
+}
+
+// sqliteFileURI returns the URI of the database file with the path escaped,
+// so that characters such as "?" and "#" in the path are not dealt with as
+// the delimiters of the URI.
+func sqliteFileURI(path string) string {
+	p := filepath.ToSlash(path)
+	if filepath.IsAbs(path) && !strings.HasPrefix(p, "/") {
+		p = "/" + p
+	}
+	u := url.URL{Scheme: "file", Path: p}
+	return u.String()
+}
+
+func activeSqliteTransaction(viewCache ViewMap, path string) *sqliteTransaction {
+	for _, view := range viewCache {
+		if view.FileInfo == nil || view.FileInfo.Sqlite == nil {
+			continue
+		}
+		if txn := view.FileInfo.Sqlite.txn; txn != nil && txn.active() && txn.Path == path {
+			return txn
+		}
+	}
+	return nil
+}
+
+func quoteSqliteIdentifier(s string) string {
+	return "\"" + strings.Replace(s, "\"", "\"\"", -1) + "\""
+}
+
+func isSqliteQuery(s string) bool {
+	s = strings.TrimSpace(s)
+	for _, keyword := range []string{"SELECT", "WITH", "VALUES"} {
+		if len(keyword) < len(s) && strings.EqualFold(s[:len(keyword)], keyword) && strings.IndexByte(" \t\r\n(", s[len(keyword)]) != -1 {
+			return true
+		}
+	}
+	return false
+}
+
+func convertSqliteValue(v interface{}) value.Primary {
+	switch v.(type) {
+	case nil:
+		return value.NewNull()
+	case int64:
+		return value.NewInteger(v.(int64))
+	case float64:
+		return value.NewFloat(v.(float64))
+	case bool:
+		return value.NewBoolean(v.(bool))
+	case time.Time:
+		return value.NewDatetime(v.(time.Time).In(cmd.GetLocation()))
+	case []byte:
+		return value.NewString(string(v.([]byte)))
+	case string:
+		return value.NewString(v.(string))
+	}
+	return value.NewString(fmt.Sprint(v))
+}
+
+func sqliteValueChanged(p1 value.Primary, p2 value.Primary) bool {
+	if value.IsNull(p1) || value.IsNull(p2) {
+		return !(value.IsNull(p1) && value.IsNull(p2))
+	}
+	return value.Identical(p1, p2) != ternary.TRUE
+}
+
+func sqliteArgument(p value.Primary) interface{} {
+	switch p.(type) {
+	case value.Integer:
+		return p.(value.Integer).Raw()
+	case value.Float:
+		return p.(value.Float).Raw()
+	case value.Boolean:
+		return p.(value.Boolean).Raw()
+	case value.Ternary:
+		if p.(value.Ternary).Ternary() == ternary.UNKNOWN {
+			return nil
+		}
+		return p.(value.Ternary).Ternary().ParseBool()
+	case value.Datetime:
+		return p.(value.Datetime).Raw()
+	case value.String:
+		return p.(value.String).Raw()
+	}
+	return nil
+}
+
+func newSqliteTable(tableObject parser.TableObject, filter *Filter) (*SqliteTable, error) {
+	args := make([]parser.QueryExpression, 0, 2)
+	if tableObject.FormatElement != nil {
+		args = append(args, tableObject.FormatElement)
+	}
+	if 0 < len(tableObject.Path.Literal) {
+		args = append(args, tableObject.Path)
+	}
+	args = append(args, tableObject.Args...)
+	if len(args) != 2 {
+		return nil, NewTableObjectJsonArgumentsLengthError(tableObject, 2)
+	}
+
+	dbFile, ok, err := tableObjectStringArgument(args[0], filter)
+	if err != nil {
+		return nil, err
+	}
+	if !ok || len(dbFile) < 1 {
+		return nil, NewTableObjectInvalidArgumentError(tableObject, "database file is not specified")
+	}
+	source, ok, err := tableObjectStringArgument(args[1], filter)
+	if err != nil {
+		return nil, err
+	}
+	if !ok || len(strings.TrimSpace(source)) < 1 {
+		return nil, NewTableObjectInvalidArgumentError(tableObject, "table or query is not specified")
+	}
+
+	dbPath, err := SearchFilePathWithExtType(parser.Identifier{BaseExpr: tableObject.BaseExpr, Literal: dbFile}, filter.Flags().Repository, nil)
+	if err != nil {
+		return nil, err
+	}
+	if err = filter.Session().CheckFileAccess(tableObject, dbPath); err != nil {
+		return nil, err
+	}
+
+	table := &SqliteTable{Path: dbPath}
+	if isSqliteQuery(source) {
+		table.Query = source
+	} else {
+		table.Name = source
+	}
+	return table, nil
+}
+
+func sqlitePlanDetail(table *SqliteTable, cached bool) string {
+	return filePlanDetail(table.Path, cmd.SQLITE, cached) + ", source: " + table.Source()
+}
+
+func loadSqliteObject(
+	tableObject parser.TableObject,
+	tableName parser.Identifier,
+	filter *Filter,
+	useInternalId bool,
+	forUpdate bool,
+) (*View, error) {
+	table, err := newSqliteTable(tableObject, filter)
+	if err != nil {
+		return nil, err
+	}
+	key := table.Key()
+
+	viewCache := filter.Session().ViewCache
+	isCached := true
+	if !viewCache.Exists(key) || (forUpdate && !viewCache[strings.ToUpper(key)].ForUpdate) {
+		isCached = false
+		viewCache.Dispose(key)
+
+		var header []string
+		var rowIds []int64
+		var records RecordSet
+		if forUpdate && !table.IsQuery() {
+			txn := activeSqliteTransaction(viewCache, table.Path)
+			if txn == nil {
+				if txn, err = beginSqliteTransaction(table.Path, filter.Flags().WaitTimeout); err != nil {
+					return nil, NewReadFileError(tableObject, err.Error())
+				}
+				defer func() {
+					if table.txn == nil {
+						_ = txn.rollback()
+					}
+				}()
+			}
+			header, rowIds, records, err = table.loadForUpdate(txn, filter.Flags().WaitTimeout)
+		} else {
+			header, _, records, err = table.load(nil, filter.Flags().WaitTimeout)
+		}
+		if err != nil {
+			return nil, NewReadFileError(tableObject, err.Error())
+		}
+
+		loadView := NewView()
+		loadView.Header = NewHeader(tableName.Literal, header)
+		loadView.RecordSet = records
+		loadView.rowIds = rowIds
+		loadView.FileInfo = &FileInfo{
+			Path:     key,
+			Format:   cmd.SQLITE,
+			Encoding: text.UTF8,
+			Sqlite:   table,
+		}
+		loadView.ForUpdate = forUpdate
+		viewCache.Set(loadView)
+	}
+
+	var view *View
+	pathIdent := parser.Identifier{Literal: key}
+	if useInternalId {
+		view, _ = viewCache.GetWithInternalId(pathIdent, filter.Flags().CPU)
+	} else {
+		view, _ = viewCache.Get(pathIdent)
+	}
+	filter.describePlanStage(sqlitePlanDetail(view.FileInfo.Sqlite, isCached))
+
+	if err = filter.Aliases.Add(tableName, key); err != nil {
+		return nil, err
+	}
+	view.Header.Update(tableName.Literal, nil)
+	return view, nil
+}
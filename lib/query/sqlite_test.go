@@ -0,0 +1,408 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/value"
+)
+
+func createSqliteTestDatabase(t *testing.T) string {
+	path := GetTestFilePath("sqlite_test.db")
+	_ = os.Remove(path)
+
+	db, err := sql.Open(sqliteDriverName, path)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	defer db.Close()
+
+	for _, q := range []string{
+		"CREATE TABLE users (id INTEGER PRIMARY KEY, name TEXT, score REAL, created DATETIME)",
+		"INSERT INTO users VALUES (1, 'alice', 1.5, '2012-02-03 09:18:15'), (2, 'bob', NULL, NULL)",
+		"CREATE TABLE logs (user_id INTEGER, message TEXT)",
+	} {
+		if _, err := db.Exec(q); err != nil {
+			t.Fatalf("unexpected error %q", err)
+		}
+	}
+	return path
+}
+
+var sqliteQueryTests = []struct {
+	Name    string
+	Query   string
+	Columns []string
+	Result  [][]value.Primary
+	Error   string
+}{
+	{
+		Name:    "Select from Sqlite Table",
+		Query:   "SELECT * FROM SQLITE('sqlite_test.db', 'users') ORDER BY id",
+		Columns: []string{"id", "name", "score", "created"},
+		Result: [][]value.Primary{
+			{value.NewInteger(1), value.NewString("alice"), value.NewFloat(1.5), value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 0, time.UTC))},
+			{value.NewInteger(2), value.NewString("bob"), value.NewNull(), value.NewNull()},
+		},
+	},
+	{
+		Name:    "Select from Sqlite Table with Identifiers",
+		Query:   "SELECT u.name FROM SQLITE(`sqlite_test.db`, users) AS u WHERE u.id = 2",
+		Columns: []string{"name"},
+		Result: [][]value.Primary{
+			{value.NewString("bob")},
+		},
+	},
+	{
+		Name:    "Select from Sqlite Query",
+		Query:   "SELECT * FROM SQLITE('sqlite_test.db', 'select count(*) as cnt from users')",
+		Columns: []string{"cnt"},
+		Result: [][]value.Primary{
+			{value.NewInteger(2)},
+		},
+	},
+	{
+		Name:    "Join Sqlite Table and CSV File",
+		Query:   "SELECT u.name, t.column2 FROM SQLITE('sqlite_test.db', 'users') u INNER JOIN table1 t ON u.id = t.column1 ORDER BY u.id",
+		Columns: []string{"name", "column2"},
+		Result: [][]value.Primary{
+			{value.NewString("alice"), value.NewString("str1")},
+			{value.NewString("bob"), value.NewString("str2")},
+		},
+	},
+	{
+		Name:  "Sqlite Table Not Exist Error",
+		Query: "SELECT * FROM SQLITE('sqlite_test.db', 'notexist')",
+		Error: "[L:1 C:15] failed to read from file: SQL logic error: no such table: notexist (1)",
+	},
+	{
+		Name:  "Sqlite Database File Not Exist Error",
+		Query: "SELECT * FROM SQLITE('notexist.db', 'users')",
+		Error: "[L:1 C:15] file notexist.db does not exist",
+	},
+	{
+		Name:  "Sqlite Table Not Specified Error",
+		Query: "SELECT * FROM SQLITE('sqlite_test.db', NULL)",
+		Error: "[L:1 C:15] invalid argument for SQLITE: table or query is not specified",
+	},
+}
+
+func TestSqliteQuery(t *testing.T) {
+	createSqliteTestDatabase(t)
+	defer func() {
+		_ = os.Remove(GetTestFilePath("sqlite_test.db"))
+	}()
+
+	session := newTestSession()
+	defer session.Close()
+
+	ctx := context.Background()
+
+	for _, v := range sqliteQueryTests {
+		rows, err := session.Query(ctx, v.Query)
+		if err == nil {
+			var result [][]value.Primary
+			for rows.Next() {
+				result = append(result, rows.Values())
+			}
+			err = rows.Err()
+			rows.Close()
+
+			if err == nil {
+				if !reflect.DeepEqual(rows.Columns(), v.Columns) {
+					t.Errorf("%s: columns = %q, want %q", v.Name, rows.Columns(), v.Columns)
+				}
+				if !reflect.DeepEqual(result, v.Result) {
+					t.Errorf("%s: result = %s, want %s", v.Name, result, v.Result)
+				}
+			}
+		}
+
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+		}
+	}
+}
+
+func TestSqliteUpdate(t *testing.T) {
+	path := createSqliteTestDatabase(t)
+	defer func() {
+		_ = os.Remove(path)
+	}()
+
+	session := newTestSession()
+	defer session.Close()
+
+	ctx := context.Background()
+
+	affected, err := session.Exec(ctx, ""+
+		"INSERT INTO SQLITE('sqlite_test.db', 'users') (id, name, score) VALUES (3, 'carol', 2);"+
+		"UPDATE SQLITE('sqlite_test.db', 'users') SET score = 0.5 WHERE id = 2;"+
+		"DELETE FROM SQLITE('sqlite_test.db', 'users') WHERE id = 1;"+
+		"INSERT INTO SQLITE('sqlite_test.db', 'logs') VALUES (3, 'created');")
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if affected != 4 {
+		t.Errorf("affected rows = %d, want %d", affected, 4)
+	}
+
+	db, err := sql.Open(sqliteDriverName, path)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	defer db.Close()
+
+	count := func(query string) int {
+		var n int
+		if err := db.QueryRow(query).Scan(&n); err != nil {
+			t.Fatalf("unexpected error %q", err)
+		}
+		return n
+	}
+
+	if n := count("SELECT COUNT(*) FROM users"); n != 2 {
+		t.Errorf("records before commit = %d, want %d", n, 2)
+	}
+
+	if err := session.Rollback(ctx); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if n := count("SELECT COUNT(*) FROM users WHERE id = 3"); n != 0 {
+		t.Errorf("records after rollback = %d, want %d", n, 0)
+	}
+
+	if _, err = session.Exec(ctx, ""+
+		"INSERT INTO SQLITE('sqlite_test.db', 'users') (id, name, score) VALUES (3, 'carol', 2);"+
+		"UPDATE u SET score = 0.5 FROM SQLITE('sqlite_test.db', 'users') u WHERE u.id = 2;"+
+		"DELETE u FROM SQLITE('sqlite_test.db', 'users') u WHERE u.id = 1;"); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if err := session.Commit(ctx); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+
+	rows, err := db.Query("SELECT id, name, score, typeof(score) FROM users ORDER BY id")
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	defer rows.Close()
+
+	type user struct {
+		Id        int64
+		Name      string
+		Score     float64
+		ScoreType string
+	}
+	var result []user
+	for rows.Next() {
+		var u user
+		if err := rows.Scan(&u.Id, &u.Name, &u.Score, &u.ScoreType); err != nil {
+			t.Fatalf("unexpected error %q", err)
+		}
+		result = append(result, u)
+	}
+	expect := []user{
+		{Id: 2, Name: "bob", Score: 0.5, ScoreType: "real"},
+		{Id: 3, Name: "carol", Score: 2, ScoreType: "real"},
+	}
+	if !reflect.DeepEqual(result, expect) {
+		t.Errorf("records after commit = %v, want %v", result, expect)
+	}
+}
+
+func TestSqliteQueryNotUpdatable(t *testing.T) {
+	path := createSqliteTestDatabase(t)
+	defer func() {
+		_ = os.Remove(path)
+	}()
+
+	session := newTestSession()
+	defer session.Close()
+
+	_, err := session.Exec(context.Background(), "DELETE FROM SQLITE('sqlite_test.db', 'SELECT * FROM users') WHERE id = 1")
+	expect := "[L:1 C:13] table SQLITE('sqlite_test.db', 'SELECT * FROM users') is a result of a sqlite query and cannot be updated"
+	if err == nil {
+		t.Errorf("no error, want error %q", expect)
+	} else if err.Error() != expect {
+		t.Errorf("error %q, want error %q", err.Error(), expect)
+	}
+}
+
+func TestSqliteUpdateRowByRow(t *testing.T) {
+	path := createSqliteTestDatabase(t)
+	defer func() {
+		_ = os.Remove(path)
+	}()
+
+	db, err := sql.Open(sqliteDriverName, path)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	defer db.Close()
+
+	for _, q := range []string{
+		"CREATE TABLE notes (message TEXT, data BLOB)",
+		"INSERT INTO notes VALUES ('a', x'00ff'), ('b', x'01'), ('c', x'02')",
+	} {
+		if _, err := db.Exec(q); err != nil {
+			t.Fatalf("unexpected error %q", err)
+		}
+	}
+
+	session := newTestSession()
+	defer session.Close()
+
+	ctx := context.Background()
+
+	if _, err = session.Exec(ctx, ""+
+		"DELETE FROM SQLITE('sqlite_test.db', 'notes') WHERE message = 'a';"+
+		"UPDATE SQLITE('sqlite_test.db', 'notes') SET message = 'B' WHERE message = 'b';"+
+		"INSERT INTO SQLITE('sqlite_test.db', 'notes') (message) VALUES ('d');"); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+
+	if _, err := db.Exec("INSERT INTO notes VALUES ('e', NULL)"); err == nil {
+		t.Errorf("no error, want the table to be locked until commit")
+	}
+
+	if err := session.Commit(ctx); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+
+	if _, err := db.Exec("INSERT INTO notes VALUES ('e', NULL)"); err != nil {
+		t.Errorf("unexpected error %q after commit", err)
+	}
+
+	rows, err := db.Query("SELECT rowid, message, typeof(data) FROM notes ORDER BY rowid")
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	defer rows.Close()
+
+	var result [][]interface{}
+	for rows.Next() {
+		var rowId int64
+		var message, dataType string
+		if err := rows.Scan(&rowId, &message, &dataType); err != nil {
+			t.Fatalf("unexpected error %q", err)
+		}
+		result = append(result, []interface{}{rowId, message, dataType})
+	}
+	expect := [][]interface{}{
+		{int64(2), "B", "blob"},
+		{int64(3), "c", "blob"},
+		{int64(4), "d", "null"},
+		{int64(5), "e", "null"},
+	}
+	if !reflect.DeepEqual(result, expect) {
+		t.Errorf("result = %v, want %v", result, expect)
+	}
+}
+
+func TestSqliteUpdateWithoutRowId(t *testing.T) {
+	path := createSqliteTestDatabase(t)
+	defer func() {
+		_ = os.Remove(path)
+	}()
+
+	db, err := sql.Open(sqliteDriverName, path)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	defer db.Close()
+
+	for _, q := range []string{
+		"CREATE TABLE tags (category TEXT, name TEXT, amount INTEGER, PRIMARY KEY (category, name)) WITHOUT ROWID",
+		"INSERT INTO tags VALUES ('a', 'x', 1), ('a', 'y', 2), ('b', 'x', 3)",
+		"CREATE VIEW tag_names AS SELECT name FROM tags",
+	} {
+		if _, err := db.Exec(q); err != nil {
+			t.Fatalf("unexpected error %q", err)
+		}
+	}
+
+	session := newTestSession()
+	defer session.Close()
+
+	ctx := context.Background()
+
+	if _, err = session.Exec(ctx, ""+
+		"DELETE FROM SQLITE('sqlite_test.db', 'tags') WHERE category = 'a' AND name = 'x';"+
+		"UPDATE SQLITE('sqlite_test.db', 'tags') SET name = 'z', amount = 4 WHERE category = 'b';"+
+		"INSERT INTO SQLITE('sqlite_test.db', 'tags') VALUES ('c', 'x', 5);"); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if err := session.Commit(ctx); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+
+	rows, err := db.Query("SELECT category, name, amount FROM tags ORDER BY category, name")
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	defer rows.Close()
+
+	var result [][]interface{}
+	for rows.Next() {
+		var category, name string
+		var count int64
+		if err := rows.Scan(&category, &name, &count); err != nil {
+			t.Fatalf("unexpected error %q", err)
+		}
+		result = append(result, []interface{}{category, name, count})
+	}
+	expect := [][]interface{}{
+		{"a", "y", int64(2)},
+		{"b", "z", int64(4)},
+		{"c", "x", int64(5)},
+	}
+	if !reflect.DeepEqual(result, expect) {
+		t.Errorf("result = %v, want %v", result, expect)
+	}
+
+	_, err = session.Exec(ctx, "DELETE FROM SQLITE('sqlite_test.db', 'tag_names')")
+	expectErr := "[L:1 C:13] failed to read from file: view \"tag_names\" cannot be updated"
+	if err == nil {
+		t.Errorf("no error, want error %q", expectErr)
+	} else if err.Error() != expectErr {
+		t.Errorf("error %q, want error %q", err.Error(), expectErr)
+	}
+}
+
+func TestSqliteFileURI(t *testing.T) {
+	dir := GetTestFilePath("sqlite uri")
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+	path := filepath.Join(dir, "data?#%.db")
+
+	db, err := openSqliteDatabase(path, 1)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if _, err = db.Exec("CREATE TABLE t (c1 INTEGER)"); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	_ = db.Close()
+
+	if _, err = os.Stat(path); err != nil {
+		t.Errorf("database file %q is not created: %s", path, err)
+	}
+}
@@ -0,0 +1,537 @@
+package query
+
+import (
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/file"
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/go-text"
+	"github.com/mithrandie/go-text/fixedlen"
+	"github.com/mithrandie/ternary"
+)
+
+type RecordIterator interface {
+	Next() (Record, error)
+}
+
+type ViewIterator struct {
+	view  *View
+	index int
+}
+
+func NewViewIterator(view *View) *ViewIterator {
+	return &ViewIterator{
+		view:  view,
+		index: -1,
+	}
+}
+
+func (it *ViewIterator) Next() (Record, error) {
+	it.index++
+	if it.view.RecordLen() <= it.index {
+		return nil, io.EOF
+	}
+	return it.view.RecordSet[it.index], nil
+}
+
+type ReaderIterator struct {
+	reader  RecordReader
+	pending Record
+}
+
+func NewReaderIterator(reader RecordReader) *ReaderIterator {
+	return &ReaderIterator{
+		reader: reader,
+	}
+}
+
+func (it *ReaderIterator) Peek() (Record, error) {
+	if it.pending == nil {
+		record, err := it.Next()
+		if err != nil {
+			return nil, err
+		}
+		it.pending = record
+	}
+	return it.pending, nil
+}
+
+func (it *ReaderIterator) Next() (Record, error) {
+	if it.pending != nil {
+		record := it.pending
+		it.pending = nil
+		return record, nil
+	}
+
+	row, err := it.reader.Read()
+	if err != nil {
+		return nil, err
+	}
+	return NewRecord(ConvertRawTextToPrimaries(row)), nil
+}
+
+type SelectStream struct {
+	Labels []string
+
+	source    *ReaderIterator
+	csv       *csvReader
+	fileInfo  *FileInfo
+	table     parser.Identifier
+	view      *View
+	filter    *Filter
+	condition parser.QueryExpression
+	fields    []parser.QueryExpression
+	columns   []ColumnSchema
+
+	read   int
+	offset int
+	limit  int
+	count  int
+}
+
+func CanEncodeStream(fileInfo *FileInfo) bool {
+	switch fileInfo.Format {
+	case cmd.CSV, cmd.TSV, cmd.LTSV, cmd.JSONL:
+		return true
+	case cmd.FIXED:
+		return fileInfo.DelimiterPositions != nil
+	}
+	return false
+}
+
+func NewSelectStream(query parser.SelectQuery, parentFilter *Filter) (*SelectStream, error) {
+	if !isStreamableQuery(query, parentFilter) {
+		return nil, nil
+	}
+
+	entity := query.SelectEntity.(parser.SelectEntity)
+	table := entity.FromClause.(parser.FromClause).Tables[0].(parser.Table)
+	tableIdentifier := table.Object.(parser.Identifier)
+
+	flags := parentFilter.Flags()
+	viewCache := parentFilter.Session().ViewCache
+	if parentFilter.TempViews.Exists(tableIdentifier.Literal) {
+		return nil, nil
+	}
+	if _, err := parentFilter.InlineTables.Get(tableIdentifier); err == nil {
+		return nil, nil
+	}
+	if filePath, err := CreateFilePath(tableIdentifier, flags.Repository); err != nil || viewCache.Exists(filePath) {
+		return nil, nil
+	}
+
+	fileInfo, err := NewFileInfo(tableIdentifier, flags, cmd.AutoSelect, flags.Delimiter, flags.Encoding)
+	if err != nil || parentFilter.Session().CheckFileAccess(tableIdentifier, fileInfo.Path) != nil {
+		return nil, nil
+	}
+	if viewCache.Exists(fileInfo.Path) || fileInfo.Compression != file.NoCompression {
+		return nil, nil
+	}
+
+	switch fileInfo.Format {
+	case cmd.CSV, cmd.TSV:
+	case cmd.FIXED:
+		if flags.DelimiterPositions == nil {
+			return nil, nil
+		}
+		fileInfo.DelimiterPositions = flags.DelimiterPositions
+	default:
+		return nil, nil
+	}
+	fileInfo.NoHeader = flags.NoHeader
+	fileInfo.LineBreak = flags.LineBreak
+	fileInfo.Detection = NewDetection(flags)
+	fileInfo.Dialect = NewCSVDialect(flags)
+	fileInfo.OnParseError = flags.OnParseError
+	fileInfo.RejectFile = flags.RejectFile
+
+	filter := parentFilter.CreateNode()
+
+	h, err := file.NewHandlerForRead(fileInfo.Path)
+	if err != nil {
+		if _, ok := err.(*file.TimeoutError); ok {
+			return nil, NewFileLockTimeoutError(tableIdentifier, fileInfo.Path)
+		}
+		return nil, NewReadFileError(tableIdentifier, err.Error())
+	}
+	fileInfo.Handler = h
+
+	stream := &SelectStream{
+		fileInfo: fileInfo,
+		table:    tableIdentifier,
+		filter:   filter,
+		limit:    -1,
+	}
+
+	header, err := stream.open(h.FileForRead(), flags.WithoutNull)
+	if err != nil {
+		stream.Close()
+		return nil, NewDataParsingError(tableIdentifier, fileInfo.Path, err.Error())
+	}
+
+	if err = filter.Aliases.Add(table.Name(), fileInfo.Path); err != nil {
+		stream.Close()
+		return nil, err
+	}
+
+	stream.view = NewView()
+	if fileInfo.Format == cmd.FIXED {
+		stream.view.Header = NewHeaderWithAutofill(table.Name().Literal, header)
+	} else {
+		stream.view.Header = NewHeader(table.Name().Literal, header)
+	}
+	if fileInfo.Schema, err = readSchemaFile(fileInfo.Path); err != nil {
+		stream.Close()
+		return nil, NewDataParsingError(tableIdentifier, fileInfo.Path, err.Error())
+	}
+	stream.columns = fileInfo.Schema.FieldColumns(stream.view.Header)
+	stream.view.RecordSet = make(RecordSet, 1)
+	stream.view.FileInfo = fileInfo
+	stream.view.Filter = filter
+	stream.filter = NewFilterForRecord(stream.view, 0, filter)
+
+	if entity.WhereClause != nil {
+		stream.condition = entity.WhereClause.(parser.WhereClause).Filter
+	}
+
+	selectFields := entity.SelectClause.(parser.SelectClause).Fields
+	stream.fields = make([]parser.QueryExpression, 0, len(selectFields))
+	stream.Labels = make([]string, 0, len(selectFields))
+	for _, f := range selectFields {
+		field := f.(parser.Field)
+		if _, ok := field.Object.(parser.AllColumns); ok {
+			for _, c := range stream.view.Header.TableColumns() {
+				stream.fields = append(stream.fields, c)
+				stream.Labels = append(stream.Labels, c.(parser.FieldReference).Column.Literal)
+			}
+			continue
+		}
+		stream.fields = append(stream.fields, field.Object)
+		stream.Labels = append(stream.Labels, field.Name())
+	}
+
+	if query.OffsetClause != nil {
+		if stream.offset, err = evalOffset(query.OffsetClause.(parser.OffsetClause), filter); err != nil {
+			stream.Close()
+			return nil, err
+		}
+	}
+	if query.LimitClause != nil {
+		if stream.limit, err = evalLimit(query.LimitClause.(parser.LimitClause), filter); err != nil {
+			stream.Close()
+			return nil, err
+		}
+	}
+
+	return stream, nil
+}
+
+func (s *SelectStream) open(fp io.Reader, withoutNull bool) ([]string, error) {
+	var reader RecordReader
+	var header []string
+	var err error
+	var fieldLen int
+
+	switch s.fileInfo.Format {
+	case cmd.FIXED:
+		if fp, err = newDecodeReader(fp, s.fileInfo); err != nil {
+			return nil, err
+		}
+		r := fixedlen.NewReader(fp, s.fileInfo.DelimiterPositions, s.fileInfo.Encoding)
+		r.WithoutNull = withoutNull
+		if !s.fileInfo.NoHeader {
+			if header, err = r.ReadHeader(); err != nil && err != io.EOF {
+				return nil, err
+			}
+		}
+		fieldLen = len(s.fileInfo.DelimiterPositions)
+		reader = r
+	default:
+		if fp, err = detectCSVAttributes(fp, s.fileInfo); err != nil {
+			return nil, err
+		}
+		if fp, err = newDecodeReader(fp, s.fileInfo); err != nil {
+			return nil, err
+		}
+		r := newCSVReader(fp, s.fileInfo.Encoding, s.fileInfo.Dialect)
+		r.Delimiter = s.fileInfo.Delimiter
+		r.WithoutNull = withoutNull
+		r.OnParseError = s.fileInfo.OnParseError
+		s.csv = r
+		if !s.fileInfo.NoHeader {
+			if header, err = r.ReadHeader(); err != nil && err != io.EOF {
+				return nil, err
+			}
+		}
+		reader = r
+	}
+
+	s.source = NewReaderIterator(reader)
+	if header == nil {
+		if fieldLen < 1 {
+			record, err := s.source.Peek()
+			if err != nil && err != io.EOF {
+				return nil, err
+			}
+			fieldLen = len(record)
+		}
+		header = make([]string, fieldLen)
+		for i := 0; i < fieldLen; i++ {
+			header[i] = "c" + strconv.Itoa(i+1)
+		}
+	}
+	return header, nil
+}
+
+func (s *SelectStream) Next() (Record, error) {
+	for {
+		if -1 < s.limit && s.limit <= s.count {
+			return nil, io.EOF
+		}
+
+		record, err := s.source.Next()
+		if err != nil {
+			if err != io.EOF {
+				err = NewDataParsingError(s.table, s.fileInfo.Path, err.Error())
+			}
+			return nil, err
+		}
+		s.read++
+		if s.columns != nil {
+			if err = convertLoadedRecord(record, s.columns, s.read); err != nil {
+				return nil, NewDataParsingError(s.table, s.fileInfo.Path, err.Error())
+			}
+		}
+		s.view.RecordSet[0] = record
+
+		if s.condition != nil {
+			p, err := s.filter.Evaluate(s.condition)
+			if err != nil {
+				return nil, err
+			}
+			if p.Ternary() != ternary.TRUE {
+				continue
+			}
+		}
+
+		if 0 < s.offset {
+			s.offset--
+			continue
+		}
+
+		values := make([]value.Primary, len(s.fields))
+		for i, f := range s.fields {
+			if values[i], err = s.filter.Evaluate(f); err != nil {
+				return nil, err
+			}
+		}
+
+		s.count++
+		return NewRecord(values), nil
+	}
+}
+
+func (s *SelectStream) Close() error {
+	if s.csv != nil {
+		s.fileInfo.RejectedRows = len(s.csv.Rejected)
+		s.fileInfo.rejected = s.csv.Rejected
+		s.csv = nil
+
+		if err := recordRejectedRows(s.table, s.fileInfo, s.filter); err != nil {
+			_ = s.fileInfo.Close()
+			return err
+		}
+	}
+	return s.fileInfo.Close()
+}
+
+func isStreamableQuery(query parser.SelectQuery, filter *Filter) bool {
+	if query.WithClause != nil || query.OrderByClause != nil {
+		return false
+	}
+	if query.LimitClause != nil {
+		limit := query.LimitClause.(parser.LimitClause)
+		if limit.IsPercentage() || limit.IsWithTies() {
+			return false
+		}
+	}
+
+	entity, ok := query.SelectEntity.(parser.SelectEntity)
+	if !ok {
+		return false
+	}
+	if entity.GroupByClause != nil || entity.HavingClause != nil || entity.FromClause == nil {
+		return false
+	}
+
+	fromClause := entity.FromClause.(parser.FromClause)
+	if len(fromClause.Tables) != 1 {
+		return false
+	}
+	table, ok := fromClause.Tables[0].(parser.Table)
+	if !ok {
+		return false
+	}
+	if _, ok := table.Object.(parser.Identifier); !ok {
+		return false
+	}
+	if filter.RecursiveTable != nil {
+		return false
+	}
+
+	selectClause := entity.SelectClause.(parser.SelectClause)
+	if selectClause.IsDistinct() {
+		return false
+	}
+	for _, f := range selectClause.Fields {
+		field := f.(parser.Field)
+		if _, ok := field.Object.(parser.AllColumns); ok {
+			continue
+		}
+		if !isStreamableExpr(field.Object, filter) {
+			return false
+		}
+	}
+
+	if entity.WhereClause != nil {
+		if !isStreamableExpr(entity.WhereClause.(parser.WhereClause).Filter, filter) {
+			return false
+		}
+	}
+	return true
+}
+
+func isStreamableExpr(expr parser.QueryExpression, filter *Filter) bool {
+	var all = func(exprs []parser.QueryExpression) bool {
+		for _, e := range exprs {
+			if !isStreamableExpr(e, filter) {
+				return false
+			}
+		}
+		return true
+	}
+
+	if expr == nil {
+		return true
+	}
+
+	switch expr.(type) {
+	case parser.PrimitiveType, parser.FieldReference, parser.ColumnNumber, parser.Variable,
+		parser.EnvironmentVariable, parser.RuntimeInformation, parser.Placeholder, parser.Subquery, parser.Exists:
+		return true
+	case parser.Parentheses:
+		return isStreamableExpr(expr.(parser.Parentheses).Expr, filter)
+	case parser.RowValue:
+		return isStreamableExpr(expr.(parser.RowValue).Value, filter)
+	case parser.ValueList:
+		return all(expr.(parser.ValueList).Values)
+	case parser.RowValueList:
+		return all(expr.(parser.RowValueList).RowValues)
+	case parser.Arithmetic:
+		e := expr.(parser.Arithmetic)
+		return isStreamableExpr(e.LHS, filter) && isStreamableExpr(e.RHS, filter)
+	case parser.UnaryArithmetic:
+		return isStreamableExpr(expr.(parser.UnaryArithmetic).Operand, filter)
+	case parser.Concat:
+		return all(expr.(parser.Concat).Items)
+	case parser.Comparison:
+		e := expr.(parser.Comparison)
+		return isStreamableExpr(e.LHS, filter) && isStreamableExpr(e.RHS, filter)
+	case parser.Is:
+		e := expr.(parser.Is)
+		return isStreamableExpr(e.LHS, filter) && isStreamableExpr(e.RHS, filter)
+	case parser.Between:
+		e := expr.(parser.Between)
+		return isStreamableExpr(e.LHS, filter) && isStreamableExpr(e.Low, filter) && isStreamableExpr(e.High, filter)
+	case parser.Like:
+		e := expr.(parser.Like)
+		return isStreamableExpr(e.LHS, filter) && isStreamableExpr(e.Pattern, filter)
+	case parser.RegExp:
+		e := expr.(parser.RegExp)
+		return isStreamableExpr(e.LHS, filter) && isStreamableExpr(e.Pattern, filter)
+	case parser.In:
+		e := expr.(parser.In)
+		return isStreamableExpr(e.LHS, filter) && isStreamableExpr(e.Values, filter)
+	case parser.Any:
+		e := expr.(parser.Any)
+		return isStreamableExpr(e.LHS, filter) && isStreamableExpr(e.Values, filter)
+	case parser.All:
+		e := expr.(parser.All)
+		return isStreamableExpr(e.LHS, filter) && isStreamableExpr(e.Values, filter)
+	case parser.Logic:
+		e := expr.(parser.Logic)
+		return isStreamableExpr(e.LHS, filter) && isStreamableExpr(e.RHS, filter)
+	case parser.UnaryLogic:
+		return isStreamableExpr(expr.(parser.UnaryLogic).Operand, filter)
+	case parser.CaseExpr:
+		e := expr.(parser.CaseExpr)
+		if !isStreamableExpr(e.Value, filter) || !all(e.When) {
+			return false
+		}
+		if e.Else != nil {
+			return isStreamableExpr(e.Else.(parser.CaseExprElse).Result, filter)
+		}
+		return true
+	case parser.CaseExprWhen:
+		e := expr.(parser.CaseExprWhen)
+		return isStreamableExpr(e.Condition, filter) && isStreamableExpr(e.Result, filter)
+	case parser.Function:
+		e := expr.(parser.Function)
+		if _, ok := Functions[strings.ToUpper(e.Name)]; !ok {
+			if udfn, err := filter.Functions.Get(e, e.Name); err == nil && udfn.IsAggregate {
+				return false
+			}
+		}
+		return all(e.Args)
+	}
+	return false
+}
+
+func evalOffset(clause parser.OffsetClause, filter *Filter) (int, error) {
+	val, err := filter.Evaluate(clause.Value)
+	if err != nil {
+		return 0, err
+	}
+	number := value.ToInteger(val)
+	if value.IsNull(number) {
+		return 0, NewInvalidOffsetNumberError(clause)
+	}
+	offset := int(number.(value.Integer).Raw())
+	if offset < 0 {
+		offset = 0
+	}
+	return offset, nil
+}
+
+func evalLimit(clause parser.LimitClause, filter *Filter) (int, error) {
+	val, err := filter.Evaluate(clause.Value)
+	if err != nil {
+		return 0, err
+	}
+	number := value.ToInteger(val)
+	if value.IsNull(number) {
+		return 0, NewInvalidLimitNumberError(clause)
+	}
+	limit := int(number.(value.Integer).Raw())
+	if limit < 0 {
+		limit = 0
+	}
+	return limit, nil
+}
+
+func ConvertRawTextToPrimaries(row []text.RawText) []value.Primary {
+	fields := make([]value.Primary, len(row))
+	for i, v := range row {
+		if v == nil {
+			fields[i] = value.NewNull()
+		} else {
+			fields[i] = value.NewString(string(v))
+		}
+	}
+	return fields
+}
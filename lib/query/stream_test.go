@@ -0,0 +1,292 @@
+package query
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/go-text"
+)
+
+var selectStreamTests = []struct {
+	Name         string
+	Query        parser.SelectQuery
+	Format       cmd.Format
+	NotStreamed  bool
+	Result       string
+	Error        string
+	ErrorInWrite string
+}{
+	{
+		Name: "Stream Select",
+		Query: parser.SelectQuery{
+			SelectEntity: parser.SelectEntity{
+				SelectClause: parser.SelectClause{
+					Fields: []parser.QueryExpression{
+						parser.Field{Object: parser.AllColumns{}},
+					},
+				},
+				FromClause: parser.FromClause{
+					Tables: []parser.QueryExpression{
+						parser.Table{Object: parser.Identifier{Literal: "table1"}},
+					},
+				},
+			},
+		},
+		Format: cmd.CSV,
+		Result: "column1,column2\n" +
+			"1,str1\n" +
+			"2,str2\n" +
+			"3,str3",
+	},
+	{
+		Name: "Stream Select with Where, Offset and Limit",
+		Query: parser.SelectQuery{
+			SelectEntity: parser.SelectEntity{
+				SelectClause: parser.SelectClause{
+					Fields: []parser.QueryExpression{
+						parser.Field{
+							Object: parser.Arithmetic{
+								LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+								Operator: '*',
+								RHS:      parser.NewIntegerValue(10),
+							},
+							Alias: parser.Identifier{Literal: "c1"},
+						},
+						parser.Field{Object: parser.FieldReference{View: parser.Identifier{Literal: "t"}, Column: parser.Identifier{Literal: "column2"}}},
+					},
+				},
+				FromClause: parser.FromClause{
+					Tables: []parser.QueryExpression{
+						parser.Table{Object: parser.Identifier{Literal: "table1"}, Alias: parser.Identifier{Literal: "t"}},
+					},
+				},
+				WhereClause: parser.WhereClause{
+					Filter: parser.Comparison{
+						LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+						Operator: ">",
+						RHS:      parser.NewIntegerValue(1),
+					},
+				},
+			},
+			OffsetClause: parser.OffsetClause{Value: parser.NewIntegerValue(1)},
+			LimitClause:  parser.LimitClause{Value: parser.NewIntegerValue(5)},
+		},
+		Format: cmd.LTSV,
+		Result: "c1:30\tcolumn2:str3",
+	},
+	{
+		Name: "Stream Select Limit",
+		Query: parser.SelectQuery{
+			SelectEntity: parser.SelectEntity{
+				SelectClause: parser.SelectClause{
+					Fields: []parser.QueryExpression{
+						parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column5"}}},
+					},
+				},
+				FromClause: parser.FromClause{
+					Tables: []parser.QueryExpression{
+						parser.Table{Object: parser.Identifier{Literal: "table3"}},
+					},
+				},
+			},
+			LimitClause: parser.LimitClause{Value: parser.NewIntegerValue(1)},
+		},
+		Format: cmd.TSV,
+		Result: "column5\n" +
+			"1",
+	},
+	{
+		Name: "Stream Select Not Streamable with Order By",
+		Query: parser.SelectQuery{
+			SelectEntity: parser.SelectEntity{
+				SelectClause: parser.SelectClause{
+					Fields: []parser.QueryExpression{
+						parser.Field{Object: parser.AllColumns{}},
+					},
+				},
+				FromClause: parser.FromClause{
+					Tables: []parser.QueryExpression{
+						parser.Table{Object: parser.Identifier{Literal: "table1"}},
+					},
+				},
+			},
+			OrderByClause: parser.OrderByClause{
+				Items: []parser.QueryExpression{
+					parser.OrderItem{Value: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}}},
+				},
+			},
+		},
+		NotStreamed: true,
+	},
+	{
+		Name: "Stream Select Not Streamable with Aggregate Function",
+		Query: parser.SelectQuery{
+			SelectEntity: parser.SelectEntity{
+				SelectClause: parser.SelectClause{
+					Fields: []parser.QueryExpression{
+						parser.Field{Object: parser.AggregateFunction{Name: "count", Args: []parser.QueryExpression{parser.AllColumns{}}}},
+					},
+				},
+				FromClause: parser.FromClause{
+					Tables: []parser.QueryExpression{
+						parser.Table{Object: parser.Identifier{Literal: "table1"}},
+					},
+				},
+			},
+		},
+		NotStreamed: true,
+	},
+	{
+		Name: "Stream Select Not Streamable with JSON File",
+		Query: parser.SelectQuery{
+			SelectEntity: parser.SelectEntity{
+				SelectClause: parser.SelectClause{
+					Fields: []parser.QueryExpression{
+						parser.Field{Object: parser.AllColumns{}},
+					},
+				},
+				FromClause: parser.FromClause{
+					Tables: []parser.QueryExpression{
+						parser.Table{Object: parser.Identifier{Literal: "table.json"}},
+					},
+				},
+			},
+		},
+		NotStreamed: true,
+	},
+	{
+		Name: "Stream Select Field Not Exist Error",
+		Query: parser.SelectQuery{
+			SelectEntity: parser.SelectEntity{
+				SelectClause: parser.SelectClause{
+					Fields: []parser.QueryExpression{
+						parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "notexist"}}},
+					},
+				},
+				FromClause: parser.FromClause{
+					Tables: []parser.QueryExpression{
+						parser.Table{Object: parser.Identifier{Literal: "table1"}},
+					},
+				},
+			},
+		},
+		Format:       cmd.CSV,
+		ErrorInWrite: "[L:- C:-] field notexist does not exist",
+	},
+	{
+		Name: "Stream Select Invalid Limit Error",
+		Query: parser.SelectQuery{
+			SelectEntity: parser.SelectEntity{
+				SelectClause: parser.SelectClause{
+					Fields: []parser.QueryExpression{
+						parser.Field{Object: parser.AllColumns{}},
+					},
+				},
+				FromClause: parser.FromClause{
+					Tables: []parser.QueryExpression{
+						parser.Table{Object: parser.Identifier{Literal: "table1"}},
+					},
+				},
+			},
+			LimitClause: parser.LimitClause{Value: parser.NewStringValue("str")},
+		},
+		Error: "[L:- C:-] limit number of records 'str' is not an integer value",
+	},
+}
+
+func TestNewSelectStream(t *testing.T) {
+	initCmdFlag()
+	tf := cmd.GetFlags()
+	tf.Repository = TestDir
+
+	filter := NewEmptyFilter()
+	buf := new(bytes.Buffer)
+
+	for _, v := range selectStreamTests {
+		ReleaseResources()
+
+		stream, err := NewSelectStream(v.Query, filter)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+
+		if v.NotStreamed {
+			if stream != nil {
+				stream.Close()
+				t.Errorf("%s: query is streamed, want to be materialized", v.Name)
+			}
+			continue
+		}
+		if stream == nil {
+			t.Errorf("%s: query is not streamed", v.Name)
+			continue
+		}
+
+		fileInfo := &FileInfo{
+			Format:    v.Format,
+			Delimiter: ',',
+			Encoding:  text.UTF8,
+			LineBreak: text.LF,
+		}
+
+		buf.Reset()
+		err = EncodeStream(buf, stream.Labels, stream, fileInfo)
+		stream.Close()
+		if err != nil {
+			if len(v.ErrorInWrite) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.ErrorInWrite {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.ErrorInWrite)
+			}
+			continue
+		}
+		if 0 < len(v.ErrorInWrite) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.ErrorInWrite)
+			continue
+		}
+
+		if buf.String() != v.Result {
+			t.Errorf("%s: result = %q, want %q", v.Name, buf.String(), v.Result)
+		}
+	}
+	ReleaseResources()
+}
+
+func TestViewIterator_Next(t *testing.T) {
+	view := &View{
+		Header: NewHeader("test", []string{"c1"}),
+		RecordSet: []Record{
+			NewRecord([]value.Primary{value.NewInteger(1)}),
+			NewRecord([]value.Primary{value.NewInteger(2)}),
+		},
+	}
+
+	it := NewViewIterator(view)
+	cnt := 0
+	for {
+		record, err := it.Next()
+		if err != nil {
+			break
+		}
+		cnt++
+		if !value.Equal(record[0].Value(), value.NewInteger(int64(cnt))).ParseBool() {
+			t.Errorf("record %d = %s, want %d", cnt, record[0].Value(), cnt)
+		}
+	}
+	if cnt != 2 {
+		t.Errorf("record count = %d, want %d", cnt, 2)
+	}
+}
@@ -0,0 +1,326 @@
+package query
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/file"
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+)
+
+const SchemaFileExt = ".schema"
+
+const (
+	ColumnTypeString   = "STRING"
+	ColumnTypeInteger  = "INTEGER"
+	ColumnTypeFloat    = "FLOAT"
+	ColumnTypeBoolean  = "BOOLEAN"
+	ColumnTypeDatetime = "DATETIME"
+)
+
+var columnTypes = []string{
+	ColumnTypeString,
+	ColumnTypeInteger,
+	ColumnTypeFloat,
+	ColumnTypeBoolean,
+	ColumnTypeDatetime,
+}
+
+type ColumnSchema struct {
+	Name    string `json:"name"`
+	Type    string `json:"type,omitempty"`
+	NotNull bool   `json:"not_null,omitempty"`
+}
+
+func NewColumnSchema(column parser.Identifier, columnType parser.Identifier, notNull bool) (ColumnSchema, error) {
+	t := strings.ToUpper(columnType.Literal)
+	if 0 < len(t) && !InStrSliceWithCaseInsensitive(t, columnTypes) {
+		return ColumnSchema{}, NewInvalidColumnTypeError(columnType)
+	}
+	return ColumnSchema{
+		Name:    column.Literal,
+		Type:    t,
+		NotNull: notNull,
+	}, nil
+}
+
+func (col ColumnSchema) IsConstrained() bool {
+	return 0 < len(col.Type) || col.NotNull
+}
+
+// Convert returns the value converted to the type of the column.
+// The second return value is false if the value cannot be stored in the column.
+func (col ColumnSchema) Convert(p value.Primary) (value.Primary, bool) {
+	if value.IsNull(p) {
+		return p, !col.NotNull
+	}
+
+	var v value.Primary
+	switch col.Type {
+	case ColumnTypeString:
+		switch p.(type) {
+		case value.Boolean:
+			v = value.NewString(strconv.FormatBool(p.(value.Boolean).Raw()))
+		case value.Datetime:
+			v = value.NewString(p.(value.Datetime).Format(time.RFC3339Nano))
+		default:
+			v = value.ToString(p)
+		}
+	case ColumnTypeInteger:
+		v = value.ToInteger(p)
+	case ColumnTypeFloat:
+		v = value.ToFloat(p)
+	case ColumnTypeBoolean:
+		v = value.ToBoolean(p)
+	case ColumnTypeDatetime:
+		v = value.ToDatetime(p)
+	default:
+		return p, true
+	}
+	return v, !value.IsNull(v)
+}
+
+func (col ColumnSchema) convertCell(expr parser.Expression, p value.Primary) (value.Primary, error) {
+	v, ok := col.Convert(p)
+	if !ok {
+		if value.IsNull(p) {
+			return nil, NewNotNullViolationError(expr, col.Name)
+		}
+		return nil, NewColumnTypeMismatchError(expr, p, col.Name, col.Type)
+	}
+	return v, nil
+}
+
+// convertLoadedCell converts a value read from a file.
+// Empty strings in columns other than STRING are treated as nulls.
+func (col ColumnSchema) convertLoadedCell(p value.Primary) (value.Primary, error) {
+	if s, ok := p.(value.String); ok && len(s.Raw()) < 1 && 0 < len(col.Type) && col.Type != ColumnTypeString {
+		p = value.NewNull()
+	}
+
+	v, ok := col.Convert(p)
+	if !ok {
+		if value.IsNull(p) {
+			return nil, errors.New(fmt.Sprintf(ErrorNotNullViolation, col.Name))
+		}
+		return nil, errors.New(fmt.Sprintf(ErrorColumnTypeMismatch, p, col.Name, col.Type))
+	}
+	return v, nil
+}
+
+type TableSchema struct {
+	Columns []ColumnSchema `json:"columns"`
+}
+
+func (schema *TableSchema) IsEmpty() bool {
+	return schema == nil || len(schema.Columns) < 1
+}
+
+func (schema *TableSchema) Get(name string) (ColumnSchema, bool) {
+	if schema != nil {
+		for _, col := range schema.Columns {
+			if strings.EqualFold(col.Name, name) {
+				return col, true
+			}
+		}
+	}
+	return ColumnSchema{}, false
+}
+
+// Set returns a new schema in which the definition of the column is replaced.
+func (schema *TableSchema) Set(col ColumnSchema) *TableSchema {
+	newSchema := schema.Remove(col.Name)
+	if col.IsConstrained() {
+		newSchema.Columns = append(newSchema.Columns, col)
+	}
+	return newSchema
+}
+
+// Remove returns a new schema without the definition of the column.
+func (schema *TableSchema) Remove(name string) *TableSchema {
+	newSchema := &TableSchema{Columns: make([]ColumnSchema, 0, 10)}
+	if schema != nil {
+		for _, col := range schema.Columns {
+			if !strings.EqualFold(col.Name, name) {
+				newSchema.Columns = append(newSchema.Columns, col)
+			}
+		}
+	}
+	return newSchema
+}
+
+// Rename returns a new schema in which the column is renamed.
+func (schema *TableSchema) Rename(old string, new string) *TableSchema {
+	col, ok := schema.Get(old)
+	if !ok {
+		return schema
+	}
+	col.Name = new
+	return schema.Remove(old).Set(col)
+}
+
+// FieldColumns returns the definitions corresponding to the fields in the header.
+// If no field is constrained, then returns nil.
+func (schema *TableSchema) FieldColumns(header Header) []ColumnSchema {
+	if schema.IsEmpty() {
+		return nil
+	}
+
+	columns := make([]ColumnSchema, len(header))
+	constrained := false
+	for i, f := range header {
+		if col, ok := schema.Get(f.Column); ok {
+			columns[i] = col
+			constrained = true
+		}
+	}
+	if !constrained {
+		return nil
+	}
+	return columns
+}
+
+// tableSchemaFor returns the schema that contains only the columns in the header.
+func tableSchemaFor(schema *TableSchema, header Header) *TableSchema {
+	newSchema := &TableSchema{Columns: make([]ColumnSchema, 0, len(header))}
+	for _, col := range schema.FieldColumns(header) {
+		if col.IsConstrained() {
+			newSchema.Columns = append(newSchema.Columns, col)
+		}
+	}
+	return newSchema
+}
+
+// createTableColumns returns the column identifiers and the schema declared in a CREATE TABLE statement.
+func createTableColumns(fields []parser.QueryExpression) ([]parser.QueryExpression, *TableSchema, error) {
+	if fields == nil {
+		return nil, nil, nil
+	}
+
+	var schema *TableSchema
+	identifiers := make([]parser.QueryExpression, len(fields))
+	for i, v := range fields {
+		def, ok := v.(parser.ColumnDefinition)
+		if !ok {
+			identifiers[i] = v
+			continue
+		}
+
+		col, err := NewColumnSchema(def.Column, def.Type, def.NotNull)
+		if err != nil {
+			return nil, nil, err
+		}
+		schema = schema.Set(col)
+		identifiers[i] = def.Column
+	}
+	return identifiers, schema, nil
+}
+
+func schemaFilePath(tablePath string) string {
+	return tablePath + SchemaFileExt
+}
+
+func readSchemaFile(tablePath string) (*TableSchema, error) {
+	path := schemaFilePath(tablePath)
+	if !file.Exists(path) {
+		return nil, nil
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	schema := &TableSchema{}
+	if err = json.Unmarshal(b, schema); err != nil {
+		return nil, errors.New(fmt.Sprintf("schema file %s is broken: %s", path, err.Error()))
+	}
+	for _, col := range schema.Columns {
+		if 0 < len(col.Type) && !InStrSliceWithCaseInsensitive(col.Type, columnTypes) {
+			return nil, errors.New(fmt.Sprintf("schema file %s is broken: %s is an unknown column type", path, col.Type))
+		}
+	}
+	return schema, nil
+}
+
+// writeSchemaFile writes the schema next to the table file.
+// If the schema is empty, then the schema file is removed.
+func writeSchemaFile(tablePath string, schema *TableSchema) error {
+	path := schemaFilePath(tablePath)
+
+	if schema.IsEmpty() {
+		if file.Exists(path) {
+			return os.Remove(path)
+		}
+		return nil
+	}
+
+	var h *file.Handler
+	var err error
+
+	if file.Exists(path) {
+		h, err = file.NewHandlerForUpdate(path)
+	} else {
+		h, err = file.NewHandlerForCreate(path)
+	}
+	if err != nil {
+		return err
+	}
+
+	w := bufio.NewWriter(h.FileForUpdate())
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	if err = enc.Encode(schema); err == nil {
+		err = w.Flush()
+	}
+	if err != nil {
+		_ = h.Close()
+		return err
+	}
+	return h.Commit()
+}
+
+// loadTableSchema reads the schema of the table and converts the values in the record set.
+func loadTableSchema(fileInfo *FileInfo, header Header, records RecordSet) error {
+	if fileInfo.IsTemporary || fileInfo.Sqlite != nil || len(fileInfo.Path) < 1 {
+		return nil
+	}
+
+	schema, err := readSchemaFile(fileInfo.Path)
+	if err != nil {
+		return err
+	}
+	fileInfo.Schema = schema
+
+	columns := schema.FieldColumns(header)
+	if columns == nil {
+		return nil
+	}
+	for i, record := range records {
+		if err = convertLoadedRecord(record, columns, i+1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func convertLoadedRecord(record Record, columns []ColumnSchema, recordNumber int) error {
+	for i, col := range columns {
+		if !col.IsConstrained() || len(record) <= i {
+			continue
+		}
+		v, err := col.convertLoadedCell(record[i].Value())
+		if err != nil {
+			return errors.New(fmt.Sprintf("record %d: %s", recordNumber, err.Error()))
+		}
+		record[i] = NewCell(v)
+	}
+	return nil
+}
@@ -0,0 +1,228 @@
+package query
+
+import (
+	"os"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+)
+
+var columnSchemaConvertTests = []struct {
+	Column ColumnSchema
+	Value  value.Primary
+	Result value.Primary
+	OK     bool
+}{
+	{
+		Column: ColumnSchema{Name: "c1", Type: ColumnTypeInteger},
+		Value:  value.NewString(" 12 "),
+		Result: value.NewInteger(12),
+		OK:     true,
+	},
+	{
+		Column: ColumnSchema{Name: "c1", Type: ColumnTypeInteger},
+		Value:  value.NewFloat(1.5),
+		OK:     false,
+	},
+	{
+		Column: ColumnSchema{Name: "c1", Type: ColumnTypeFloat},
+		Value:  value.NewString("12,5"),
+		OK:     false,
+	},
+	{
+		Column: ColumnSchema{Name: "c1", Type: ColumnTypeFloat},
+		Value:  value.NewInteger(2),
+		Result: value.NewFloat(2),
+		OK:     true,
+	},
+	{
+		Column: ColumnSchema{Name: "c1", Type: ColumnTypeBoolean},
+		Value:  value.NewString("false"),
+		Result: value.NewBoolean(false),
+		OK:     true,
+	},
+	{
+		Column: ColumnSchema{Name: "c1", Type: ColumnTypeDatetime},
+		Value:  value.NewString("2012-02-03 09:18:15"),
+		Result: value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 0, GetTestLocation())),
+		OK:     true,
+	},
+	{
+		Column: ColumnSchema{Name: "c1", Type: ColumnTypeString},
+		Value:  value.NewBoolean(true),
+		Result: value.NewString("true"),
+		OK:     true,
+	},
+	{
+		Column: ColumnSchema{Name: "c1", Type: ColumnTypeInteger},
+		Value:  value.NewNull(),
+		Result: value.NewNull(),
+		OK:     true,
+	},
+	{
+		Column: ColumnSchema{Name: "c1", NotNull: true},
+		Value:  value.NewNull(),
+		OK:     false,
+	},
+	{
+		Column: ColumnSchema{Name: "c1", NotNull: true},
+		Value:  value.NewString("a"),
+		Result: value.NewString("a"),
+		OK:     true,
+	},
+}
+
+func TestColumnSchema_Convert(t *testing.T) {
+	for _, v := range columnSchemaConvertTests {
+		result, ok := v.Column.Convert(v.Value)
+		if ok != v.OK {
+			t.Errorf("ok = %t, want %t for %s in %v", ok, v.OK, v.Value, v.Column)
+			continue
+		}
+		if ok && !reflect.DeepEqual(result, v.Result) {
+			t.Errorf("result = %#v, want %#v for %s in %v", result, v.Result, v.Value, v.Column)
+		}
+	}
+}
+
+func TestTableSchema(t *testing.T) {
+	var schema *TableSchema
+
+	schema = schema.Set(ColumnSchema{Name: "column1", Type: ColumnTypeInteger, NotNull: true})
+	schema = schema.Set(ColumnSchema{Name: "column2", Type: ColumnTypeFloat})
+	schema = schema.Set(ColumnSchema{Name: "column3"})
+	schema = schema.Rename("COLUMN2", "price")
+	expect := &TableSchema{
+		Columns: []ColumnSchema{
+			{Name: "column1", Type: ColumnTypeInteger, NotNull: true},
+			{Name: "price", Type: ColumnTypeFloat},
+		},
+	}
+	if !reflect.DeepEqual(schema, expect) {
+		t.Fatalf("schema = %v, want %v", schema, expect)
+	}
+
+	header := NewHeader("table1", []string{"id", "price", "column1"})
+	columns := schema.FieldColumns(header)
+	expectColumns := []ColumnSchema{
+		{},
+		{Name: "price", Type: ColumnTypeFloat},
+		{Name: "column1", Type: ColumnTypeInteger, NotNull: true},
+	}
+	if !reflect.DeepEqual(columns, expectColumns) {
+		t.Errorf("field columns = %v, want %v", columns, expectColumns)
+	}
+
+	schema = schema.Remove("column1")
+	if columns = schema.FieldColumns(NewHeader("table1", []string{"column1"})); columns != nil {
+		t.Errorf("field columns = %v, want nil", columns)
+	}
+}
+
+func TestTableSchemaFile(t *testing.T) {
+	tablePath := GetTestFilePath("table_schema.csv")
+	schema := &TableSchema{
+		Columns: []ColumnSchema{
+			{Name: "column1", Type: ColumnTypeInteger, NotNull: true},
+			{Name: "column2", Type: ColumnTypeDatetime},
+		},
+	}
+
+	if err := writeSchemaFile(tablePath, schema); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	result, err := readSchemaFile(tablePath)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if !reflect.DeepEqual(result, schema) {
+		t.Errorf("schema = %v, want %v", result, schema)
+	}
+
+	fileInfo := &FileInfo{Path: tablePath}
+	header := NewHeader("table_schema", []string{"column1", "column2"})
+	records := RecordSet{
+		NewRecord([]value.Primary{value.NewString("1"), value.NewString("")}),
+	}
+	if err = loadTableSchema(fileInfo, header, records); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	expectRecords := RecordSet{
+		NewRecord([]value.Primary{value.NewInteger(1), value.NewNull()}),
+	}
+	if !reflect.DeepEqual(records, expectRecords) {
+		t.Errorf("records = %v, want %v", records, expectRecords)
+	}
+
+	records = RecordSet{
+		NewRecord([]value.Primary{value.NewString("1"), value.NewNull()}),
+		NewRecord([]value.Primary{value.NewString("1.5"), value.NewNull()}),
+	}
+	expectError := "record 2: value \"1.5\" for field column1 is not a valid INTEGER"
+	if err = loadTableSchema(fileInfo, header, records); err == nil || err.Error() != expectError {
+		t.Errorf("error = %v, want error %q", err, expectError)
+	}
+
+	if err = writeSchemaFile(tablePath, &TableSchema{}); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if _, err = os.Stat(schemaFilePath(tablePath)); !os.IsNotExist(err) {
+		t.Errorf("schema file is not removed")
+	}
+}
+
+func TestView_InsertValuesWithSchema(t *testing.T) {
+	view := &View{
+		Header:    NewHeader("table1", []string{"column1", "column2"}),
+		RecordSet: RecordSet{},
+		FileInfo: &FileInfo{
+			Path: "table1.csv",
+			Schema: &TableSchema{
+				Columns: []ColumnSchema{
+					{Name: "column1", Type: ColumnTypeInteger, NotNull: true},
+				},
+			},
+		},
+		Filter: NewEmptyFilter(),
+	}
+
+	fields := []parser.QueryExpression{
+		parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+		parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+	}
+	rowValue := func(values ...parser.QueryExpression) parser.QueryExpression {
+		return parser.RowValue{Value: parser.ValueList{Values: values}}
+	}
+
+	_, err := view.InsertValues(fields, []parser.QueryExpression{
+		rowValue(parser.NewStringValue("3"), parser.NewStringValue("str3")),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	expect := RecordSet{
+		NewRecord([]value.Primary{value.NewInteger(3), value.NewString("str3")}),
+	}
+	if !reflect.DeepEqual(view.RecordSet, expect) {
+		t.Errorf("records = %v, want %v", view.RecordSet, expect)
+	}
+
+	_, err = view.InsertValues(fields, []parser.QueryExpression{
+		rowValue(parser.NewStringValue("a"), parser.NewStringValue("str4")),
+	})
+	expectError := "[L:- C:-] value \"a\" for field column1 is not a valid INTEGER"
+	if err == nil || err.Error() != expectError {
+		t.Errorf("error = %v, want error %q", err, expectError)
+	}
+
+	_, err = view.InsertValues(fields[1:], []parser.QueryExpression{
+		rowValue(parser.NewStringValue("str4")),
+	})
+	expectError = "[L:- C:-] field column1 does not allow null values"
+	if err == nil || err.Error() != expectError {
+		t.Errorf("error = %v, want error %q", err, expectError)
+	}
+}
@@ -107,7 +107,7 @@ func (p *Prompt) RenderPrompt() (string, error) {
 	if err != nil || len(s) < 1 {
 		s = TerminalPrompt
 	}
-	if cmd.GetFlags().Color {
+	if p.filter.Flags().Color {
 		if strings.IndexByte(s, 0x1b) < 0 {
 			s = p.palette.Render(cmd.PromptEffect, s)
 		}
@@ -122,7 +122,7 @@ func (p *Prompt) RenderContinuousPrompt() (string, error) {
 	if err != nil || len(s) < 1 {
 		s = TerminalContinuousPrompt
 	}
-	if cmd.GetFlags().Color {
+	if p.filter.Flags().Color {
 		if strings.IndexByte(s, 0x1b) < 0 {
 			s = p.palette.Render(cmd.PromptEffect, s)
 		}
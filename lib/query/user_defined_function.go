@@ -140,12 +140,15 @@ func (m UserDefinedFunctionMap) parseParameters(parameters []parser.VariableAssi
 func (m UserDefinedFunctionMap) CheckDuplicate(name parser.Identifier) error {
 	uname := strings.ToUpper(name.Literal)
 
-	if _, ok := Functions[uname]; ok || uname == "NOW" || uname == "JSON_OBJECT" {
+	if _, ok := Functions[uname]; ok || uname == "NOW" || uname == "JSON_OBJECT" || uname == "FILENAME" || uname == "LINE_NUMBER" {
 		return NewBuiltInFunctionDeclaredError(name)
 	}
 	if _, ok := AggregateFunctions[uname]; ok {
 		return NewBuiltInFunctionDeclaredError(name)
 	}
+	if _, ok := BinaryAggregateFunctions[uname]; ok {
+		return NewBuiltInFunctionDeclaredError(name)
+	}
 	if _, ok := AnalyticFunctions[uname]; ok {
 		return NewBuiltInFunctionDeclaredError(name)
 	}
@@ -3,12 +3,17 @@ package query
 import (
 	"reflect"
 	"sort"
+	"strconv"
 	"sync"
 
 	"github.com/mithrandie/csvq/lib/parser"
 	"github.com/mithrandie/csvq/lib/value"
 )
 
+func PlaceholderVariable(ordinal int) parser.Variable {
+	return parser.Variable{Name: string(parser.PlaceholderSign) + strconv.Itoa(ordinal)}
+}
+
 type VariableScopes []VariableMap
 
 func (list VariableScopes) Declare(expr parser.VariableDeclaration, filter *Filter) error {
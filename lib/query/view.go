@@ -20,11 +20,11 @@ import (
 	"github.com/mithrandie/csvq/lib/value"
 
 	"github.com/mithrandie/go-text"
-	"github.com/mithrandie/go-text/csv"
 	"github.com/mithrandie/go-text/fixedlen"
 	txjson "github.com/mithrandie/go-text/json"
 	"github.com/mithrandie/go-text/ltsv"
 	"github.com/mithrandie/ternary"
+	"github.com/parquet-go/parquet-go"
 )
 
 type RecordReader interface {
@@ -50,8 +50,13 @@ type View struct {
 
 	offset int
 
+	indexCondition parser.QueryExpression
+	windows        map[string]parser.AnalyticClause
+
 	UseInternalId bool
 	ForUpdate     bool
+
+	rowIds []int64
 }
 
 func NewView() *View {
@@ -71,6 +76,27 @@ func (view *View) Load(clause parser.FromClause, filter *Filter) error {
 		clause.Tables = []parser.QueryExpression{parser.Table{Object: obj}}
 	}
 
+	indexCondition := view.indexCondition
+	view.indexCondition = nil
+	if indexCondition != nil && len(clause.Tables) == 1 && !view.ForUpdate {
+		loaded, err := loadViewByIndex(clause.Tables[0], indexCondition, filter)
+		if err != nil {
+			return err
+		}
+		if loaded != nil {
+			view.Header = loaded.Header
+			view.RecordSet = loaded.RecordSet
+			view.FileInfo = loaded.FileInfo
+			view.Filter = filter
+			return nil
+		}
+	}
+
+	var stage *planStage
+	if 1 < len(clause.Tables) {
+		stage = filter.startPlanStage("Cross Join", "")
+	}
+
 	views := make([]*View, len(clause.Tables))
 	for i, v := range clause.Tables {
 		loaded, err := loadView(v, filter, view.UseInternalId, view.ForUpdate)
@@ -83,10 +109,14 @@ func (view *View) Load(clause parser.FromClause, filter *Filter) error {
 	view.Header = views[0].Header
 	view.RecordSet = views[0].RecordSet
 	view.FileInfo = views[0].FileInfo
+	if len(views) == 1 {
+		view.rowIds = views[0].rowIds
+	}
 
 	for i := 1; i < len(views); i++ {
 		CrossJoin(view, views[i])
 	}
+	stage.finish(view.RecordLen())
 
 	view.Filter = filter
 	return nil
@@ -108,7 +138,18 @@ func loadView(tableExpr parser.QueryExpression, filter *Filter, useInternalId bo
 	}
 
 	table := tableExpr.(parser.Table)
+	stage := filter.startPlanStage(tablePlanOperation(table), tablePlanDetail(table))
 
+	view, err := loadTableView(table, filter, useInternalId, forUpdate)
+	if err != nil {
+		return nil, err
+	}
+
+	stage.finish(view.RecordLen())
+	return view, nil
+}
+
+func loadTableView(table parser.Table, filter *Filter, useInternalId bool, forUpdate bool) (*View, error) {
 	var view *View
 	var err error
 
@@ -116,7 +157,7 @@ func loadView(tableExpr parser.QueryExpression, filter *Filter, useInternalId bo
 	case parser.Dual:
 		view = loadDualView()
 	case parser.Stdin:
-		flags := cmd.GetFlags()
+		flags := filter.Flags()
 		fileInfo := &FileInfo{
 			Path:               table.Object.String(),
 			Format:             flags.SelectImportFormat(),
@@ -128,9 +169,15 @@ func loadView(tableExpr parser.QueryExpression, filter *Filter, useInternalId bo
 			NoHeader:           flags.NoHeader,
 			EncloseAll:         flags.EncloseAll,
 			JsonEscape:         flags.JsonEscape,
+			Detection:          NewDetection(flags),
+			Dialect:            NewCSVDialect(flags),
+			OnParseError:       flags.OnParseError,
+			RejectFile:         flags.RejectFile,
 			IsTemporary:        true,
 		}
 
+		filter.describePlanStage(filePlanDetail(fileInfo.Path, fileInfo.Format, filter.TempViews[len(filter.TempViews)-1].Exists(fileInfo.Path)))
+
 		if !filter.TempViews[len(filter.TempViews)-1].Exists(fileInfo.Path) {
 			if !cmd.IsReadableFromPipeOrRedirection() {
 				return nil, NewStdinEmptyError(table.Object.(parser.Stdin))
@@ -142,10 +189,13 @@ func loadView(tableExpr parser.QueryExpression, filter *Filter, useInternalId bo
 				fp := os.Stdin
 				defer fp.Close()
 
-				loadView, err = loadViewFromFile(fp, fileInfo, flags.WithoutNull)
+				loadView, err = loadViewFromFile(fp, fileInfo, flags.WithoutNull, flags.CPU)
 				if err != nil {
 					return nil, NewDataParsingError(table.Object, fileInfo.Path, err.Error())
 				}
+				if err = recordRejectedRows(table.Object, fileInfo, filter); err != nil {
+					return nil, err
+				}
 			} else {
 				fileInfo.Encoding = text.UTF8
 
@@ -182,7 +232,7 @@ func loadView(tableExpr parser.QueryExpression, filter *Filter, useInternalId bo
 
 		pathIdent := parser.Identifier{Literal: table.Object.String()}
 		if useInternalId {
-			view, _ = filter.TempViews[len(filter.TempViews)-1].GetWithInternalId(pathIdent)
+			view, _ = filter.TempViews[len(filter.TempViews)-1].GetWithInternalId(pathIdent, filter.Flags().CPU)
 		} else {
 			view, _ = filter.TempViews[len(filter.TempViews)-1].Get(pathIdent)
 		}
@@ -192,7 +242,29 @@ func loadView(tableExpr parser.QueryExpression, filter *Filter, useInternalId bo
 	case parser.TableObject:
 		tableObject := table.Object.(parser.TableObject)
 
-		flags := cmd.GetFlags()
+		if strings.EqualFold(tableObject.Type.Literal, cmd.SQLITE.String()) {
+			view, err = loadSqliteObject(tableObject, table.Name(), filter, useInternalId, forUpdate)
+			if err != nil {
+				return nil, err
+			}
+			break
+		}
+		if strings.EqualFold(tableObject.Type.Literal, cmd.XLSX.String()) {
+			view, err = loadXlsxObject(tableObject, table.Name(), filter, useInternalId, forUpdate)
+			if err != nil {
+				return nil, err
+			}
+			break
+		}
+		if strings.EqualFold(tableObject.Type.Literal, "FILES") {
+			view, err = loadFilesObject(tableObject, table.Name(), filter, useInternalId, forUpdate)
+			if err != nil {
+				return nil, err
+			}
+			break
+		}
+
+		flags := filter.Flags()
 		importFormat := flags.SelectImportFormat()
 		delimiter := flags.Delimiter
 		delimiterPositions := flags.DelimiterPositions
@@ -200,6 +272,8 @@ func loadView(tableExpr parser.QueryExpression, filter *Filter, useInternalId bo
 		encoding := flags.Encoding
 		noHeader := flags.NoHeader
 		withoutNull := flags.WithoutNull
+		detection := NewDetection(flags)
+		dialect := NewCSVDialect(flags)
 
 		var felem value.Primary
 		if tableObject.FormatElement != nil {
@@ -213,6 +287,11 @@ func loadView(tableExpr parser.QueryExpression, filter *Filter, useInternalId bo
 		encodingIdx := 0
 		noHeaderIdx := 1
 		withoutNullIdx := 2
+		quoteCharIdx := 3
+		escapeStyleIdx := 4
+		lazyQuotesIdx := 5
+		commentPrefixIdx := 6
+		skipLinesIdx := 7
 
 		switch strings.ToUpper(tableObject.Type.Literal) {
 		case cmd.CSV.String():
@@ -222,15 +301,21 @@ func loadView(tableExpr parser.QueryExpression, filter *Filter, useInternalId bo
 			if value.IsNull(felem) {
 				return nil, NewTableObjectInvalidDelimiterError(tableObject, tableObject.FormatElement.String())
 			}
+			if 8 < len(tableObject.Args) {
+				return nil, NewTableObjectArgumentsLengthError(tableObject, 10)
+			}
 			s := cmd.UnescapeString(felem.(value.String).Raw())
+			if strings.EqualFold(s, cmd.DetectAutomatically) {
+				detection.Delimiter = true
+				importFormat = cmd.CSV
+				break
+			}
 			d := []rune(s)
 			if 1 != len(d) {
 				return nil, NewTableObjectInvalidDelimiterError(tableObject, tableObject.FormatElement.String())
 			}
-			if 3 < len(tableObject.Args) {
-				return nil, NewTableObjectArgumentsLengthError(tableObject, 5)
-			}
 			delimiter = d[0]
+			detection.Delimiter = false
 			if delimiter == '\t' {
 				importFormat = cmd.TSV
 			} else {
@@ -270,17 +355,29 @@ func loadView(tableExpr parser.QueryExpression, filter *Filter, useInternalId bo
 			jsonQuery = felem.(value.String).Raw()
 			importFormat = cmd.JSON
 			encoding = text.UTF8
+		case cmd.JSONL.String():
+			if tableObject.FormatElement != nil || 0 < len(tableObject.Args) {
+				return nil, NewTableObjectJsonArgumentsLengthError(tableObject, 1)
+			}
+			importFormat = cmd.JSONL
+			encoding = text.UTF8
 		case cmd.LTSV.String():
 			if 2 < len(tableObject.Args) {
 				return nil, NewTableObjectJsonArgumentsLengthError(tableObject, 3)
 			}
 			importFormat = cmd.LTSV
 			withoutNullIdx, noHeaderIdx = noHeaderIdx, withoutNullIdx
+		case cmd.PARQUET.String():
+			if tableObject.FormatElement != nil || 0 < len(tableObject.Args) {
+				return nil, NewTableObjectJsonArgumentsLengthError(tableObject, 1)
+			}
+			importFormat = cmd.PARQUET
+			encoding = text.UTF8
 		default:
 			return nil, NewTableObjectInvalidObjectError(tableObject, tableObject.Type.Literal)
 		}
 
-		args := make([]value.Primary, 3)
+		args := make([]value.Primary, 8)
 		for i, a := range tableObject.Args {
 			if pt, ok := a.(parser.PrimitiveType); ok && value.IsNull(pt.Value) {
 				continue
@@ -303,6 +400,10 @@ func loadView(tableExpr parser.QueryExpression, filter *Filter, useInternalId bo
 					return nil, NewTableObjectInvalidArgumentError(tableObject, fmt.Sprintf("cannot be converted as a encoding value: %s", tableObject.Args[encodingIdx].String()))
 				}
 			case noHeaderIdx:
+				if v := value.ToString(p); !value.IsNull(v) && strings.EqualFold(v.(value.String).Raw(), cmd.DetectAutomatically) {
+					args[i] = v
+					break
+				}
 				v := value.ToBoolean(p)
 				if !value.IsNull(v) {
 					args[i] = v
@@ -316,20 +417,75 @@ func loadView(tableExpr parser.QueryExpression, filter *Filter, useInternalId bo
 				} else {
 					return nil, NewTableObjectInvalidArgumentError(tableObject, fmt.Sprintf("cannot be converted as a without-null value: %s", tableObject.Args[withoutNullIdx].String()))
 				}
+			case quoteCharIdx, escapeStyleIdx, commentPrefixIdx:
+				v := value.ToString(p)
+				if !value.IsNull(v) {
+					args[i] = v
+				} else {
+					return nil, NewTableObjectInvalidArgumentError(tableObject, fmt.Sprintf("cannot be converted as a string value: %s", tableObject.Args[i].String()))
+				}
+			case lazyQuotesIdx:
+				v := value.ToBoolean(p)
+				if !value.IsNull(v) {
+					args[i] = v
+				} else {
+					return nil, NewTableObjectInvalidArgumentError(tableObject, fmt.Sprintf("cannot be converted as a lazy-quotes value: %s", tableObject.Args[lazyQuotesIdx].String()))
+				}
+			case skipLinesIdx:
+				v := value.ToInteger(p)
+				if !value.IsNull(v) {
+					args[i] = v
+				} else {
+					return nil, NewTableObjectInvalidArgumentError(tableObject, fmt.Sprintf("cannot be converted as a skip-lines value: %s", tableObject.Args[skipLinesIdx].String()))
+				}
 			}
 		}
 
 		if args[encodingIdx] != nil {
-			if encoding, err = cmd.ParseEncoding(args[0].(value.String).Raw()); err != nil {
+			if s := args[encodingIdx].(value.String).Raw(); strings.EqualFold(s, cmd.DetectAutomatically) {
+				detection.Encoding = true
+			} else if encoding, err = cmd.ParseEncoding(s); err != nil {
 				return nil, NewTableObjectInvalidArgumentError(tableObject, err.Error())
+			} else {
+				detection.Encoding = false
 			}
 		}
 		if args[noHeaderIdx] != nil {
-			noHeader = args[noHeaderIdx].(value.Boolean).Raw()
+			if b, ok := args[noHeaderIdx].(value.Boolean); ok {
+				noHeader = b.Raw()
+				detection.Header = false
+			} else {
+				detection.Header = true
+			}
 		}
 		if args[withoutNullIdx] != nil {
 			withoutNull = args[withoutNullIdx].(value.Boolean).Raw()
 		}
+		if args[quoteCharIdx] != nil {
+			if s := args[quoteCharIdx].(value.String).Raw(); strings.EqualFold(s, cmd.DetectAutomatically) {
+				detection.Quote = true
+			} else if dialect.Quote, err = cmd.ParseQuoteChar(s); err != nil {
+				return nil, NewTableObjectInvalidArgumentError(tableObject, err.Error())
+			} else {
+				detection.Quote = false
+			}
+		}
+		if args[escapeStyleIdx] != nil {
+			if dialect.Escape, err = cmd.ParseEscapeStyle(args[escapeStyleIdx].(value.String).Raw()); err != nil {
+				return nil, NewTableObjectInvalidArgumentError(tableObject, err.Error())
+			}
+		}
+		if args[lazyQuotesIdx] != nil {
+			dialect.LazyQuotes = args[lazyQuotesIdx].(value.Boolean).Raw()
+		}
+		if args[commentPrefixIdx] != nil {
+			dialect.Comment = cmd.UnescapeString(args[commentPrefixIdx].(value.String).Raw())
+		}
+		if args[skipLinesIdx] != nil {
+			if dialect.SkipLines = int(args[skipLinesIdx].(value.Integer).Raw()); dialect.SkipLines < 0 {
+				dialect.SkipLines = 0
+			}
+		}
 
 		view, err = loadObject(
 			table.Object.(parser.TableObject).Path,
@@ -347,13 +503,15 @@ func loadView(tableExpr parser.QueryExpression, filter *Filter, useInternalId bo
 			flags.EncloseAll,
 			flags.JsonEscape,
 			withoutNull,
+			detection,
+			dialect,
 		)
 		if err != nil {
 			return nil, err
 		}
 
 	case parser.Identifier:
-		flags := cmd.GetFlags()
+		flags := filter.Flags()
 
 		view, err = loadObject(
 			table.Object.(parser.Identifier),
@@ -371,6 +529,8 @@ func loadView(tableExpr parser.QueryExpression, filter *Filter, useInternalId bo
 			flags.EncloseAll,
 			flags.JsonEscape,
 			flags.WithoutNull,
+			NewDetection(flags),
+			NewCSVDialect(flags),
 		)
 		if err != nil {
 			return nil, err
@@ -400,6 +560,14 @@ func loadView(tableExpr parser.QueryExpression, filter *Filter, useInternalId bo
 			}
 		}
 
+		if joinType != parser.CROSS && filter.plan != nil {
+			method := "nested loop"
+			if viewKeys, _ := EquiJoinKeys(condition, view, view2); condition != nil && viewKeys != nil {
+				method = "hash join"
+			}
+			filter.describePlanStage(joinPlanDetail(method, join))
+		}
+
 		switch joinType {
 		case parser.CROSS:
 			CrossJoin(view, view2)
@@ -442,7 +610,7 @@ func loadView(tableExpr parser.QueryExpression, filter *Filter, useInternalId bo
 			}
 			view.Header = header
 
-			NewGoroutineTaskManager(view.RecordLen(), -1).Run(func(index int) {
+			NewGoroutineTaskManager(view.RecordLen(), -1, filter.Flags().CPU).Run(func(index int) {
 				record := make(Record, len(fieldIndices))
 				for i, idx := range fieldIndices {
 					record[i] = view.RecordSet[index][idx]
@@ -451,6 +619,40 @@ func loadView(tableExpr parser.QueryExpression, filter *Filter, useInternalId bo
 			})
 		}
 
+	case parser.PivotTable:
+		pivot := table.Object.(parser.PivotTable)
+		view, err = loadView(pivot.Table, filter, false, false)
+		if err != nil {
+			return nil, err
+		}
+		view, err = Pivot(view, pivot, filter)
+		if err != nil {
+			return nil, err
+		}
+
+		if table.Alias != nil {
+			view.Header.Update(table.Name().Literal, nil)
+			if err = filter.Aliases.Add(table.Name(), ""); err != nil {
+				return nil, err
+			}
+		}
+	case parser.UnpivotTable:
+		unpivot := table.Object.(parser.UnpivotTable)
+		view, err = loadView(unpivot.Table, filter, false, false)
+		if err != nil {
+			return nil, err
+		}
+		view, err = Unpivot(view, unpivot, filter)
+		if err != nil {
+			return nil, err
+		}
+
+		if table.Alias != nil {
+			view.Header.Update(table.Name().Literal, nil)
+			if err = filter.Aliases.Add(table.Name(), ""); err != nil {
+				return nil, err
+			}
+		}
 	case parser.JsonQuery:
 		jsonQuery := table.Object.(parser.JsonQuery)
 		alias := table.Name().Literal
@@ -468,10 +670,13 @@ func loadView(tableExpr parser.QueryExpression, filter *Filter, useInternalId bo
 		var reader io.Reader
 
 		if jsonPath, ok := jsonQuery.JsonText.(parser.Identifier); ok {
-			fpath, err := SearchJsonFilePath(jsonPath, cmd.GetFlags().Repository)
+			fpath, err := SearchJsonFilePath(jsonPath, filter.Flags().Repository)
 			if err != nil {
 				return nil, err
 			}
+			if err = filter.Session().CheckFileAccess(jsonPath, fpath); err != nil {
+				return nil, err
+			}
 
 			h, err := file.NewHandlerForRead(fpath)
 			if err != nil {
@@ -482,6 +687,17 @@ func loadView(tableExpr parser.QueryExpression, filter *Filter, useInternalId bo
 			}
 			defer h.Close()
 			reader = h.FileForRead()
+
+			if compression := file.CompressionOf(fpath); compression != file.NoCompression {
+				r, err := file.NewDecompressReader(reader, compression)
+				if err != nil {
+					return nil, NewReadFileError(jsonPath, err.Error())
+				}
+				defer func() {
+					_ = r.Close()
+				}()
+				reader = r
+			}
 		} else {
 			jsonTextValue, err := filter.Evaluate(jsonQuery.JsonText)
 			if err != nil {
@@ -501,7 +717,7 @@ func loadView(tableExpr parser.QueryExpression, filter *Filter, useInternalId bo
 			Format:      cmd.JSON,
 			JsonQuery:   queryValue.(value.String).Raw(),
 			Encoding:    text.UTF8,
-			LineBreak:   cmd.GetFlags().LineBreak,
+			LineBreak:   filter.Flags().LineBreak,
 			IsTemporary: true,
 		}
 
@@ -547,15 +763,19 @@ func loadObject(
 	encloseAll bool,
 	jsonEscape txjson.EscapeType,
 	withoutNull bool,
+	detection Detection,
+	dialect CSVDialect,
 ) (*View, error) {
 	var view *View
 
 	if filter.RecursiveTable != nil && strings.EqualFold(tableIdentifier.Literal, filter.RecursiveTable.Name.Literal) && filter.RecursiveTmpView != nil {
+		filter.describePlanStage("recursive table: " + tableIdentifier.Literal)
 		view = filter.RecursiveTmpView
 		if !strings.EqualFold(filter.RecursiveTable.Name.Literal, tableName.Literal) {
 			view.Header.Update(tableName.Literal, nil)
 		}
 	} else if it, err := filter.InlineTables.Get(tableIdentifier); err == nil {
+		filter.describePlanStage("inline table: " + tableIdentifier.Literal)
 		if err = filter.Aliases.Add(tableName, ""); err != nil {
 			return nil, err
 		}
@@ -569,25 +789,56 @@ func loadObject(
 
 		filePath = tableIdentifier.Literal
 		if filter.TempViews.Exists(filePath) {
+			filter.describePlanStage("temporary table: " + filePath)
 			commonTableName = parser.FormatTableName(filePath)
 
 			pathIdent := parser.Identifier{Literal: filePath}
 			if useInternalId {
-				view, _ = filter.TempViews.GetWithInternalId(pathIdent)
+				view, _ = filter.TempViews.GetWithInternalId(pathIdent, filter.Flags().CPU)
 			} else {
 				view, _ = filter.TempViews.Get(pathIdent)
 			}
+		} else if IsFilePattern(tableIdentifier, filter.Flags().Repository) {
+			view, err = loadMultipleFiles(
+				tableIdentifier,
+				filter,
+				useInternalId,
+				forUpdate,
+				importFormat,
+				delimiter,
+				delimiterPositions,
+				jsonQuery,
+				encoding,
+				lineBreak,
+				noHeader,
+				encloseAll,
+				jsonEscape,
+				withoutNull,
+				detection,
+				dialect,
+			)
+			if err != nil {
+				return nil, err
+			}
+			filePath = view.FileInfo.Path
+			commonTableName = parser.FormatTableName(filePath)
 		} else {
-			filePath, err = CreateFilePath(tableIdentifier, cmd.GetFlags().Repository)
+			viewCache := filter.Session().ViewCache
+			isCached := true
+
+			filePath, err = CreateFilePath(tableIdentifier, filter.Flags().Repository)
 			if err != nil {
 				return nil, err
 			}
 
-			if !ViewCache.Exists(filePath) {
-				fileInfo, err := NewFileInfo(tableIdentifier, cmd.GetFlags().Repository, importFormat, delimiter, encoding)
+			if !viewCache.Exists(filePath) {
+				fileInfo, err := NewFileInfo(tableIdentifier, filter.Flags(), importFormat, delimiter, encoding)
 				if err != nil {
 					return nil, err
 				}
+				if err = filter.Session().CheckFileAccess(tableIdentifier, fileInfo.Path); err != nil {
+					return nil, err
+				}
 				filePath = fileInfo.Path
 
 				fileInfo.DelimiterPositions = delimiterPositions
@@ -596,9 +847,15 @@ func loadObject(
 				fileInfo.NoHeader = noHeader
 				fileInfo.EncloseAll = encloseAll
 				fileInfo.JsonEscape = jsonEscape
+				fileInfo.Sheet = filter.Flags().Sheet
+				fileInfo.Detection = detection
+				fileInfo.Dialect = dialect
+				fileInfo.OnParseError = filter.Flags().OnParseError
+				fileInfo.RejectFile = filter.Flags().RejectFile
 
-				if !ViewCache.Exists(fileInfo.Path) || (forUpdate && !ViewCache[strings.ToUpper(fileInfo.Path)].ForUpdate) {
-					ViewCache.Dispose(fileInfo.Path)
+				if !viewCache.Exists(fileInfo.Path) || (forUpdate && !viewCache[strings.ToUpper(fileInfo.Path)].ForUpdate) {
+					isCached = false
+					viewCache.Dispose(fileInfo.Path)
 
 					var fp *os.File
 					if forUpdate {
@@ -623,23 +880,32 @@ func loadObject(
 						fp = h.FileForRead()
 					}
 
-					loadView, err := loadViewFromFile(fp, fileInfo, withoutNull)
+					loadView, err := loadViewFromFile(fp, fileInfo, withoutNull, filter.Flags().CPU)
 					if err != nil {
 						fileInfo.Close()
 						return nil, NewDataParsingError(tableIdentifier, fileInfo.Path, err.Error())
 					}
+					if err = recordRejectedRows(tableIdentifier, fileInfo, filter); err != nil {
+						fileInfo.Close()
+						return nil, err
+					}
+					if forUpdate && 0 < fileInfo.RejectedRows {
+						fileInfo.Close()
+						return nil, NewDataParsingError(tableIdentifier, fileInfo.Path, fmt.Sprintf("cannot update the file because %d rows are rejected", fileInfo.RejectedRows))
+					}
 					loadView.ForUpdate = forUpdate
-					ViewCache.Set(loadView)
+					viewCache.Set(loadView)
 				}
 			}
 			commonTableName = parser.FormatTableName(filePath)
 
 			pathIdent := parser.Identifier{Literal: filePath}
 			if useInternalId {
-				view, _ = ViewCache.GetWithInternalId(pathIdent)
+				view, _ = viewCache.GetWithInternalId(pathIdent, filter.Flags().CPU)
 			} else {
-				view, _ = ViewCache.Get(pathIdent)
+				view, _ = viewCache.Get(pathIdent)
 			}
+			filter.describePlanStage(filePlanDetail(view.FileInfo.Path, view.FileInfo.Format, isCached))
 		}
 
 		if err = filter.Aliases.Add(tableName, filePath); err != nil {
@@ -653,20 +919,70 @@ func loadObject(
 	return view, nil
 }
 
-func loadViewFromFile(fp *os.File, fileInfo *FileInfo, withoutNull bool) (*View, error) {
+func tableObjectStringArgument(expr parser.QueryExpression, filter *Filter) (string, bool, error) {
+	switch expr.(type) {
+	case parser.Identifier:
+		return expr.(parser.Identifier).Literal, true, nil
+	case parser.FieldReference:
+		return expr.(parser.FieldReference).Column.Literal, true, nil
+	}
+
+	p, err := filter.Evaluate(expr)
+	if err != nil {
+		return "", false, err
+	}
+	p = value.ToString(p)
+	if value.IsNull(p) {
+		return "", false, nil
+	}
+	return p.(value.String).Raw(), true, nil
+}
+
+func loadViewFromFile(fp io.Reader, fileInfo *FileInfo, withoutNull bool, cpu int) (*View, error) {
+	view, err := readViewFromFile(fp, fileInfo, withoutNull, cpu)
+	if err != nil {
+		return nil, err
+	}
+	if err = loadTableSchema(fileInfo, view.Header, view.RecordSet); err != nil {
+		return nil, err
+	}
+	return view, nil
+}
+
+func readViewFromFile(fp io.Reader, fileInfo *FileInfo, withoutNull bool, cpu int) (*View, error) {
+	if fileInfo.Compression != file.NoCompression {
+		r, err := file.NewDecompressReader(fp, fileInfo.Compression)
+		if err != nil {
+			return nil, err
+		}
+		defer func() {
+			_ = r.Close()
+		}()
+		fp = r
+	}
+
 	switch fileInfo.Format {
 	case cmd.FIXED:
 		return loadViewFromFixedLengthTextFile(fp, fileInfo, withoutNull)
 	case cmd.LTSV:
-		return loadViewFromLTSVFile(fp, fileInfo, withoutNull)
+		return loadViewFromLTSVFile(fp, fileInfo, withoutNull, cpu)
 	case cmd.JSON:
 		return loadViewFromJsonFile(fp, fileInfo)
+	case cmd.JSONL:
+		return loadViewFromJsonLinesFile(fp, fileInfo)
+	case cmd.PARQUET:
+		return loadViewFromParquetFile(fp, fileInfo, withoutNull)
+	case cmd.XLSX:
+		return loadViewFromXlsxFile(fp, fileInfo, withoutNull)
 	}
 	return loadViewFromCSVFile(fp, fileInfo, withoutNull)
 }
 
-func loadViewFromFixedLengthTextFile(fp *os.File, fileInfo *FileInfo, withoutNull bool) (*View, error) {
-	var err error
+func loadViewFromFixedLengthTextFile(fp io.Reader, fileInfo *FileInfo, withoutNull bool) (*View, error) {
+	fp, err := newDecodeReader(fp, fileInfo)
+	if err != nil {
+		return nil, err
+	}
 
 	data, err := ioutil.ReadAll(fp)
 	if err != nil {
@@ -720,12 +1036,20 @@ func loadViewFromFixedLengthTextFile(fp *os.File, fileInfo *FileInfo, withoutNul
 	return view, nil
 }
 
-func loadViewFromCSVFile(fp *os.File, fileInfo *FileInfo, withoutNull bool) (*View, error) {
-	reader := csv.NewReader(fp, fileInfo.Encoding)
+func loadViewFromCSVFile(fp io.Reader, fileInfo *FileInfo, withoutNull bool) (*View, error) {
+	fp, err := detectCSVAttributes(fp, fileInfo)
+	if err != nil {
+		return nil, err
+	}
+	if fp, err = newDecodeReader(fp, fileInfo); err != nil {
+		return nil, err
+	}
+
+	reader := newCSVReader(fp, fileInfo.Encoding, fileInfo.Dialect)
 	reader.Delimiter = fileInfo.Delimiter
 	reader.WithoutNull = withoutNull
+	reader.OnParseError = fileInfo.OnParseError
 
-	var err error
 	var header []string
 	if !fileInfo.NoHeader {
 		header, err = reader.ReadHeader()
@@ -750,6 +1074,9 @@ func loadViewFromCSVFile(fp *os.File, fileInfo *FileInfo, withoutNull bool) (*Vi
 		fileInfo.LineBreak = reader.DetectedLineBreak
 	}
 	fileInfo.EncloseAll = reader.EnclosedAll
+	fileInfo.SkippedLines = reader.SkippedLines
+	fileInfo.RejectedRows = len(reader.Rejected)
+	fileInfo.rejected = reader.Rejected
 
 	view := NewView()
 	view.Header = NewHeader(parser.FormatTableName(fileInfo.Path), header)
@@ -758,7 +1085,71 @@ func loadViewFromCSVFile(fp *os.File, fileInfo *FileInfo, withoutNull bool) (*Vi
 	return view, nil
 }
 
-func loadViewFromLTSVFile(fp *os.File, fileInfo *FileInfo, withoutNull bool) (*View, error) {
+func loadViewFromParquetFile(fp io.Reader, fileInfo *FileInfo, withoutNull bool) (*View, error) {
+	data, err := ioutil.ReadAll(fp)
+	if err != nil {
+		return nil, err
+	}
+
+	pf, err := parquet.OpenFile(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, err
+	}
+
+	columns, err := parquetLeafColumns(pf.Schema(), nil)
+	if err != nil {
+		return nil, err
+	}
+	header := make([]string, len(columns))
+	for i, col := range columns {
+		header[i] = col.Name
+	}
+
+	records := make(RecordSet, 0, pf.NumRows())
+	rows := make([]parquet.Row, 1000)
+	for _, rowGroup := range pf.RowGroups() {
+		reader := rowGroup.Rows()
+		for {
+			n, err := reader.ReadRows(rows)
+			for _, row := range rows[:n] {
+				record := make(Record, len(columns))
+				for _, v := range row {
+					p := convertParquetValue(v, columns[v.Column()].Node)
+					if withoutNull && value.IsNull(p) {
+						p = value.NewString("")
+					}
+					record[v.Column()] = NewCell(p)
+				}
+				records = append(records, record)
+			}
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				reader.Close()
+				return nil, err
+			}
+		}
+		if err := reader.Close(); err != nil {
+			return nil, err
+		}
+	}
+
+	fileInfo.Encoding = text.UTF8
+
+	view := NewView()
+	view.Header = NewHeader(parser.FormatTableName(fileInfo.Path), header)
+	view.RecordSet = records
+	view.FileInfo = fileInfo
+	return view, nil
+}
+
+func loadViewFromLTSVFile(fp io.Reader, fileInfo *FileInfo, withoutNull bool, cpu int) (*View, error) {
+	fp, err := newDecodeReader(fp, fileInfo)
+	if err != nil {
+		return nil, err
+	}
+
 	reader := ltsv.NewReader(fp, fileInfo.Encoding)
 	reader.WithoutNull = withoutNull
 
@@ -768,7 +1159,7 @@ func loadViewFromLTSVFile(fp *os.File, fileInfo *FileInfo, withoutNull bool) (*V
 	}
 
 	header := reader.Header.Fields()
-	NewGoroutineTaskManager(len(records), -1).Run(func(index int) {
+	NewGoroutineTaskManager(len(records), -1, cpu).Run(func(index int) {
 		for j := len(records[index]); j < len(header); j++ {
 			if withoutNull {
 				records[index] = append(records[index], NewCell(value.NewString("")))
@@ -816,15 +1207,7 @@ func readRecordSet(reader RecordReader) (RecordSet, error) {
 			if !ok {
 				break
 			}
-			fields := make([]value.Primary, len(row))
-			for i, v := range row {
-				if v == nil {
-					fields[i] = value.NewNull()
-				} else {
-					fields[i] = value.NewString(string(v))
-				}
-			}
-			fieldch <- fields
+			fieldch <- ConvertRawTextToPrimaries(row)
 		}
 		close(fieldch)
 		wg.Done()
@@ -877,6 +1260,26 @@ func loadViewFromJsonFile(fp io.Reader, fileInfo *FileInfo) (*View, error) {
 	return view, nil
 }
 
+func loadViewFromJsonLinesFile(fp io.Reader, fileInfo *FileInfo) (*View, error) {
+	headerLabels, rows, escapeType, err := json.LoadLines(fp)
+	if err != nil {
+		return nil, err
+	}
+
+	records := make([]Record, 0, len(rows))
+	for _, row := range rows {
+		records = append(records, NewRecord(row))
+	}
+
+	fileInfo.JsonEscape = escapeType
+
+	view := NewView()
+	view.Header = NewHeader(parser.FormatTableName(fileInfo.Path), headerLabels)
+	view.RecordSet = records
+	view.FileInfo = fileInfo
+	return view, nil
+}
+
 func loadDualView() *View {
 	view := View{
 		Header:    NewDualHeader(),
@@ -943,74 +1346,184 @@ func (view *View) filter(condition parser.QueryExpression) error {
 }
 
 func (view *View) GroupBy(clause parser.GroupByClause) error {
-	return view.group(clause.Items)
+	return view.group(expandGroupingSets(clause.Items))
 }
 
-func (view *View) group(items []parser.QueryExpression) error {
-	if items == nil {
+func (view *View) group(sets [][]parser.QueryExpression) error {
+	if sets == nil {
 		return view.groupAll()
 	}
 
-	keys := make([]string, view.RecordLen())
+	items := make([]parser.QueryExpression, 0, len(sets[0]))
+	setIndices := make([][]int, len(sets))
+	for i, set := range sets {
+		setIndices[i] = make([]int, 0, len(set))
+		for _, expr := range set {
+			idx := -1
+			for j, item := range items {
+				if item.String() == expr.String() {
+					idx = j
+					break
+				}
+			}
+			if idx < 0 {
+				idx = len(items)
+				items = append(items, expr)
+			}
+			setIndices[i] = append(setIndices[i], idx)
+		}
+	}
 
-	err := NewFilterForSequentialEvaluation(view, view.Filter).EvaluateSequentially(func(f *Filter, rIdx int) error {
-		values := make([]value.Primary, len(items))
-		keyBuf := new(bytes.Buffer)
+	keys := make([][]string, len(sets))
+	for i := range keys {
+		keys[i] = make([]string, view.RecordLen())
+	}
 
-		for i, item := range items {
-			p, e := f.Evaluate(item)
-			if e != nil {
-				return e
+	if 0 < len(items) {
+		err := NewFilterForSequentialEvaluation(view, view.Filter).EvaluateSequentially(func(f *Filter, rIdx int) error {
+			values := make([]value.Primary, len(items))
+			for i, item := range items {
+				p, e := f.Evaluate(item)
+				if e != nil {
+					return e
+				}
+				values[i] = p
 			}
-			values[i] = p
+
+			keyBuf := new(bytes.Buffer)
+			for i, indices := range setIndices {
+				setValues := make([]value.Primary, len(indices))
+				for j, idx := range indices {
+					setValues[j] = values[idx]
+				}
+				keyBuf.Reset()
+				SerializeComparisonKeys(keyBuf, setValues)
+				keys[i][rIdx] = keyBuf.String()
+			}
+			return nil
+		}, nil)
+		if err != nil {
+			return err
 		}
-		SerializeComparisonKeys(keyBuf, values)
-		keys[rIdx] = keyBuf.String()
-		return nil
-	}, nil)
-	if err != nil {
-		return err
 	}
 
-	groups := make(map[string][]int)
-	groupKeys := make([]string, 0)
-	for i, key := range keys {
-		if _, ok := groups[key]; ok {
-			groups[key] = append(groups[key], i)
-		} else {
-			groups[key] = []int{i}
-			groupKeys = append(groupKeys, key)
+	keyFields := make([]int, len(items))
+	for i, item := range items {
+		keyFields[i] = -1
+		switch item.(type) {
+		case parser.FieldReference, parser.ColumnNumber:
+			keyFields[i], _ = view.FieldIndex(item)
 		}
 	}
 
-	records := make(RecordSet, len(groupKeys))
-	for i, groupKey := range groupKeys {
-		record := make(Record, view.FieldLen())
-		indices := groups[groupKey]
+	records := make(RecordSet, 0, view.RecordLen())
+	for i, indices := range setIndices {
+		rolledUp := make([]bool, view.FieldLen())
+		for _, fieldIdx := range keyFields {
+			if -1 < fieldIdx {
+				rolledUp[fieldIdx] = true
+			}
+		}
+		for _, idx := range indices {
+			if -1 < keyFields[idx] {
+				rolledUp[keyFields[idx]] = false
+			}
+		}
 
-		for j := 0; j < view.FieldLen(); j++ {
-			primaries := make([]value.Primary, len(indices))
-			for k, idx := range indices {
-				primaries[k] = view.RecordSet[idx][j].Value()
+		groups := make(map[string][]int)
+		groupKeys := make([]string, 0)
+		for j, key := range keys[i] {
+			if _, ok := groups[key]; ok {
+				groups[key] = append(groups[key], j)
+			} else {
+				groups[key] = []int{j}
+				groupKeys = append(groupKeys, key)
 			}
-			record[j] = NewGroupCell(primaries)
 		}
 
-		records[i] = record
+		for _, groupKey := range groupKeys {
+			record := make(Record, view.FieldLen())
+			recordIndices := groups[groupKey]
+
+			for j := 0; j < view.FieldLen(); j++ {
+				primaries := make([]value.Primary, len(recordIndices))
+				for k, idx := range recordIndices {
+					primaries[k] = view.RecordSet[idx][j].Value()
+				}
+				if rolledUp[j] {
+					record[j] = NewRolledUpCell(primaries)
+				} else {
+					record[j] = NewGroupCell(primaries)
+				}
+			}
+
+			records = append(records, record)
+		}
 	}
 
 	view.RecordSet = records
 	view.isGrouped = true
-	for _, item := range items {
-		switch item.(type) {
-		case parser.FieldReference, parser.ColumnNumber:
-			idx, _ := view.FieldIndex(item)
-			view.Header[idx].IsGroupKey = true
+	for _, fieldIdx := range keyFields {
+		if -1 < fieldIdx {
+			view.Header[fieldIdx].IsGroupKey = true
 		}
 	}
 	return nil
 }
 
+func expandGroupingSets(items []parser.QueryExpression) [][]parser.QueryExpression {
+	sets := [][]parser.QueryExpression{{}}
+	for _, item := range items {
+		itemSets := groupingElementSets(item)
+		combined := make([][]parser.QueryExpression, 0, len(sets)*len(itemSets))
+		for _, set := range sets {
+			for _, itemSet := range itemSets {
+				s := make([]parser.QueryExpression, 0, len(set)+len(itemSet))
+				s = append(s, set...)
+				combined = append(combined, append(s, itemSet...))
+			}
+		}
+		sets = combined
+	}
+	return sets
+}
+
+func groupingElementSets(item parser.QueryExpression) [][]parser.QueryExpression {
+	switch item.(type) {
+	case parser.GroupingSet:
+		return [][]parser.QueryExpression{item.(parser.GroupingSet).Values}
+	case parser.Rollup:
+		values := item.(parser.Rollup).Values
+		sets := make([][]parser.QueryExpression, 0, len(values)+1)
+		for i := len(values); 0 <= i; i-- {
+			sets = append(sets, values[:i])
+		}
+		return sets
+	case parser.Cube:
+		values := item.(parser.Cube).Values
+		sets := make([][]parser.QueryExpression, 0, 1<<uint(len(values)))
+		for mask := 1<<uint(len(values)) - 1; 0 <= mask; mask-- {
+			set := make([]parser.QueryExpression, 0, len(values))
+			for i, v := range values {
+				if mask&(1<<uint(len(values)-1-i)) != 0 {
+					set = append(set, v)
+				}
+			}
+			sets = append(sets, set)
+		}
+		return sets
+	case parser.GroupingSets:
+		sets := make([][]parser.QueryExpression, 0)
+		for _, v := range item.(parser.GroupingSets).Sets {
+			sets = append(sets, groupingElementSets(v)...)
+		}
+		return sets
+	case parser.Parentheses:
+		return groupingElementSets(item.(parser.Parentheses).Expr)
+	}
+	return [][]parser.QueryExpression{{item}}
+}
+
 func (view *View) groupAll() error {
 	if 0 < view.RecordLen() {
 		records := make(RecordSet, 1)
@@ -1172,7 +1685,7 @@ func (view *View) Select(clause parser.SelectClause) error {
 func (view *View) GenerateComparisonKeys() {
 	view.comparisonKeysInEachRecord = make([]string, view.RecordLen())
 
-	NewGoroutineTaskManager(view.RecordLen(), -1).Run(func(index int) {
+	NewGoroutineTaskManager(view.RecordLen(), -1, view.cpu()).Run(func(index int) {
 		buf := new(bytes.Buffer)
 		if view.selectFields != nil {
 			primaries := make([]value.Primary, len(view.selectFields))
@@ -1220,26 +1733,10 @@ func (view *View) OrderBy(clause parser.OrderByClause) error {
 	view.sortNullPositions = make([]int, len(clause.Items))
 
 	for i, v := range clause.Items {
-		oi := v.(parser.OrderItem)
-		if oi.Direction.IsEmpty() {
-			view.sortDirections[i] = parser.ASC
-		} else {
-			view.sortDirections[i] = oi.Direction.Token
-		}
-
-		if oi.Position.IsEmpty() {
-			switch view.sortDirections[i] {
-			case parser.ASC:
-				view.sortNullPositions[i] = parser.FIRST
-			default: //parser.DESC
-				view.sortNullPositions[i] = parser.LAST
-			}
-		} else {
-			view.sortNullPositions[i] = oi.Position.Token
-		}
+		view.sortDirections[i], view.sortNullPositions[i] = sortOrder(v.(parser.OrderItem))
 	}
 
-	NewGoroutineTaskManager(view.RecordLen(), -1).Run(func(index int) {
+	NewGoroutineTaskManager(view.RecordLen(), -1, view.cpu()).Run(func(index int) {
 		if view.sortValuesInEachCell != nil && view.sortValuesInEachCell[index] == nil {
 			view.sortValuesInEachCell[index] = make([]*SortValue, cap(view.RecordSet[index]))
 		}
@@ -1262,6 +1759,21 @@ func (view *View) OrderBy(clause parser.OrderByClause) error {
 	return nil
 }
 
+func sortOrder(item parser.OrderItem) (int, int) {
+	direction := parser.ASC
+	if !item.Direction.IsEmpty() {
+		direction = item.Direction.Token
+	}
+
+	if !item.Position.IsEmpty() {
+		return direction, item.Position.Token
+	}
+	if direction == parser.ASC {
+		return direction, parser.FIRST
+	}
+	return direction, parser.LAST
+}
+
 func (view *View) additionalColumns(expr parser.QueryExpression) ([]string, error) {
 	list := make([]string, 0)
 
@@ -1284,6 +1796,12 @@ func (view *View) additionalColumns(expr parser.QueryExpression) ([]string, erro
 		}
 	case parser.AnalyticFunction:
 		fn := expr.(parser.AnalyticFunction)
+		clause, err := view.resolveWindow(fn.AnalyticClause)
+		if err != nil {
+			return nil, err
+		}
+		fn.AnalyticClause = clause
+
 		pvalues := fn.AnalyticClause.PartitionValues()
 		ovalues := []parser.QueryExpression(nil)
 		if fn.AnalyticClause.OrderByClause != nil {
@@ -1350,7 +1868,7 @@ func (view *View) ExtendRecordCapacity(exprs []parser.QueryExpression) error {
 		return nil
 	}
 
-	NewGoroutineTaskManager(view.RecordLen(), -1).Run(func(index int) {
+	NewGoroutineTaskManager(view.RecordLen(), -1, view.cpu()).Run(func(index int) {
 		record := make(Record, currentLen, fieldCap)
 		copy(record, view.RecordSet[index])
 		view.RecordSet[index] = record
@@ -1408,13 +1926,21 @@ func (view *View) evalColumn(obj parser.QueryExpression, alias string) (idx int,
 func (view *View) evalAnalyticFunction(expr parser.AnalyticFunction) error {
 	name := strings.ToUpper(expr.Name)
 	if _, ok := AggregateFunctions[name]; !ok {
-		if _, ok := AnalyticFunctions[name]; !ok {
-			if udfn, err := view.Filter.Functions.Get(expr, expr.Name); err != nil || !udfn.IsAggregate {
-				return NewFunctionNotExistError(expr, expr.Name)
+		if _, ok := BinaryAggregateFunctions[name]; !ok {
+			if _, ok := AnalyticFunctions[name]; !ok {
+				if udfn, err := view.Filter.Functions.Get(expr, expr.Name); err != nil || !udfn.IsAggregate {
+					return NewFunctionNotExistError(expr, expr.Name)
+				}
 			}
 		}
 	}
 
+	clause, err := view.resolveWindow(expr.AnalyticClause)
+	if err != nil {
+		return err
+	}
+	expr.AnalyticClause = clause
+
 	var partitionIndices []int
 	if expr.AnalyticClause.PartitionClause != nil {
 		partitionExprs := expr.AnalyticClause.PartitionValues()
@@ -1433,6 +1959,8 @@ func (view *View) evalAnalyticFunction(expr parser.AnalyticFunction) error {
 		view.sortValuesInEachCell = make([][]*SortValue, view.RecordLen())
 	}
 
+	stage := view.Filter.startPlanStage("Analytic Function", expr.String())
+
 	if expr.AnalyticClause.OrderByClause != nil {
 		err := view.OrderBy(expr.AnalyticClause.OrderByClause.(parser.OrderByClause))
 		if err != nil {
@@ -1440,28 +1968,78 @@ func (view *View) evalAnalyticFunction(expr parser.AnalyticFunction) error {
 		}
 	}
 
-	err := Analyze(view, expr, partitionIndices)
+	err = Analyze(view, expr, partitionIndices)
 
 	view.sortValuesInEachRecord = nil
 	view.sortDirections = nil
 	view.sortNullPositions = nil
 
-	return err
+	if err != nil {
+		return err
+	}
+	stage.finish(view.RecordLen())
+	return nil
+}
+
+func (view *View) DefineWindows(clause parser.WindowClause) error {
+	windows := make(map[string]parser.AnalyticClause, len(clause.Definitions))
+	view.windows = windows
+
+	for _, v := range clause.Definitions {
+		def := v.(parser.WindowDefinition)
+		name := strings.ToUpper(def.Name.Literal)
+		if _, ok := windows[name]; ok {
+			return NewWindowRedefinedError(def.Name)
+		}
+
+		resolved, err := view.resolveWindow(def.Clause)
+		if err != nil {
+			return err
+		}
+		windows[name] = resolved
+	}
+	return nil
+}
+
+func (view *View) resolveWindow(clause parser.AnalyticClause) (parser.AnalyticClause, error) {
+	if clause.WindowName == nil {
+		return clause, nil
+	}
+
+	name := clause.WindowName.(parser.Identifier)
+	base, ok := view.windows[strings.ToUpper(name.Literal)]
+	if !ok {
+		return clause, NewUndefinedWindowError(name)
+	}
+	if clause.IsWindowReference() {
+		return base, nil
+	}
+
+	if base.WindowingClause != nil {
+		return clause, NewWindowNotExtendableError(name, "window has a windowing clause")
+	}
+	if base.OrderByClause != nil && clause.OrderByClause != nil {
+		return clause, NewWindowNotExtendableError(name, "window already has an order by clause")
+	}
+
+	resolved := parser.AnalyticClause{
+		BaseExpr:        clause.BaseExpr,
+		PartitionClause: base.PartitionClause,
+		OrderByClause:   base.OrderByClause,
+		WindowingClause: clause.WindowingClause,
+	}
+	if clause.OrderByClause != nil {
+		resolved.OrderByClause = clause.OrderByClause
+	}
+	return resolved, nil
 }
 
 func (view *View) Offset(clause parser.OffsetClause) error {
-	val, err := view.Filter.Evaluate(clause.Value)
+	offset, err := evalOffset(clause, view.Filter)
 	if err != nil {
 		return err
 	}
-	number := value.ToInteger(val)
-	if value.IsNull(number) {
-		return NewInvalidOffsetNumberError(clause)
-	}
-	view.offset = int(number.(value.Integer).Raw())
-	if view.offset < 0 {
-		view.offset = 0
-	}
+	view.offset = offset
 
 	if view.RecordLen() <= view.offset {
 		view.RecordSet = RecordSet{}
@@ -1528,6 +2106,7 @@ func (view *View) Limit(clause parser.LimitClause) error {
 
 func (view *View) InsertValues(fields []parser.QueryExpression, list []parser.QueryExpression) (int, error) {
 	valuesList := make([][]value.Primary, len(list))
+	exprs := make([]parser.QueryExpression, len(list))
 
 	for i, item := range list {
 		rv := item.(parser.RowValue)
@@ -1540,9 +2119,10 @@ func (view *View) InsertValues(fields []parser.QueryExpression, list []parser.Qu
 		}
 
 		valuesList[i] = values
+		exprs[i] = rv
 	}
 
-	return view.insert(fields, valuesList)
+	return view.insert(fields, valuesList, exprs)
 }
 
 func (view *View) InsertFromQuery(fields []parser.QueryExpression, query parser.SelectQuery) (int, error) {
@@ -1555,6 +2135,7 @@ func (view *View) InsertFromQuery(fields []parser.QueryExpression, query parser.
 	}
 
 	valuesList := make([][]value.Primary, insertView.RecordLen())
+	exprs := make([]parser.QueryExpression, insertView.RecordLen())
 
 	for i, record := range insertView.RecordSet {
 		values := make([]value.Primary, insertView.FieldLen())
@@ -1562,12 +2143,15 @@ func (view *View) InsertFromQuery(fields []parser.QueryExpression, query parser.
 			values[j] = cell.Value()
 		}
 		valuesList[i] = values
+		exprs[i] = query
 	}
 
-	return view.insert(fields, valuesList)
+	return view.insert(fields, valuesList, exprs)
 }
 
-func (view *View) insert(fields []parser.QueryExpression, valuesList [][]value.Primary) (int, error) {
+// insert appends the records to the view.
+// Each of the exprs is used to report the errors of the corresponding values.
+func (view *View) insert(fields []parser.QueryExpression, valuesList [][]value.Primary, exprs []parser.QueryExpression) (int, error) {
 	var valueIndex = func(i int, list []int) int {
 		for j, v := range list {
 			if i == v {
@@ -1584,16 +2168,25 @@ func (view *View) insert(fields []parser.QueryExpression, valuesList [][]value.P
 		return insertRecords, err
 	}
 
+	columns := view.schemaColumns()
+
 	records := make([]Record, len(valuesList))
 	for i, values := range valuesList {
 		record := make(Record, view.FieldLen())
 		for j := 0; j < view.FieldLen(); j++ {
+			var val value.Primary
 			idx := valueIndex(j, fieldIndices)
 			if idx < 0 {
-				record[j] = NewCell(value.NewNull())
+				val = value.NewNull()
 			} else {
-				record[j] = NewCell(values[idx])
+				val = values[idx]
 			}
+			if columns != nil {
+				if val, err = columns[j].convertCell(exprs[i], val); err != nil {
+					return insertRecords, err
+				}
+			}
+			record[j] = NewCell(val)
 		}
 		records[i] = record
 	}
@@ -1602,6 +2195,21 @@ func (view *View) insert(fields []parser.QueryExpression, valuesList [][]value.P
 	return len(valuesList), nil
 }
 
+// schemaColumns returns the column definitions of the table for the fields.
+// If the view has no constrained columns, then returns nil.
+func (view *View) schemaColumns() []ColumnSchema {
+	if view.FileInfo == nil {
+		return nil
+	}
+	return view.FileInfo.Schema.FieldColumns(view.Header)
+}
+
+// cpu returns the number of cores to process the view in parallel, that is
+// set in the session of the view.
+func (view *View) cpu() int {
+	return view.Filter.Flags().CPU
+}
+
 func (view *View) Fix() {
 	resize := false
 	if len(view.selectFields) < view.FieldLen() {
@@ -1616,7 +2224,7 @@ func (view *View) Fix() {
 	}
 
 	if resize {
-		NewGoroutineTaskManager(view.RecordLen(), -1).Run(func(index int) {
+		NewGoroutineTaskManager(view.RecordLen(), -1, view.cpu()).Run(func(index int) {
 			record := make(Record, len(view.selectFields))
 			for j, idx := range view.selectFields {
 				if 1 < view.RecordSet[index].GroupLen() {
@@ -1841,5 +2449,25 @@ func (view *View) Copy() *View {
 		RecordSet: records,
 		FileInfo:  view.FileInfo,
 		ForUpdate: view.ForUpdate,
+		rowIds:    view.rowIds,
+	}
+}
+
+func (view *View) removeRecords(indices map[int]bool) {
+	records := make(RecordSet, 0, view.RecordLen()-len(indices))
+	var rowIds []int64
+	if view.rowIds != nil {
+		rowIds = make([]int64, 0, len(view.rowIds))
 	}
+	for i, record := range view.RecordSet {
+		if indices[i] {
+			continue
+		}
+		records = append(records, record)
+		if i < len(view.rowIds) {
+			rowIds = append(rowIds, view.rowIds[i])
+		}
+	}
+	view.RecordSet = records
+	view.rowIds = rowIds
 }
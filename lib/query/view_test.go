@@ -27,6 +27,7 @@ var viewLoadTests = []struct {
 	JsonQuery            string
 	DelimiterPositions   []int
 	DelimitAutomatically bool
+	OnParseError         cmd.ParseErrorPolicy
 	Filter               *Filter
 	Result               *View
 	Error                string
@@ -163,6 +164,53 @@ var viewLoadTests = []struct {
 			},
 		},
 	},
+	{
+		Name: "Load From Stdin Skipping Malformed Rows",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{Object: parser.Stdin{Stdin: "stdin"}, Alias: parser.Identifier{Literal: "t"}},
+			},
+		},
+		Stdin:        "column1,column2\n1,\"str1\"\n2\n3,\"str\"3\"\n4,str4\n",
+		OnParseError: cmd.SkipOnParseError,
+		Result: &View{
+			Header: NewHeader("t", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("1"),
+					value.NewString("str1"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("4"),
+					value.NewString("str4"),
+				}),
+			},
+			FileInfo: &FileInfo{
+				Path:         "stdin",
+				Delimiter:    ',',
+				Encoding:     text.UTF8,
+				LineBreak:    text.LF,
+				IsTemporary:  true,
+				OnParseError: cmd.SkipOnParseError,
+				RejectedRows: 2,
+			},
+			Filter: &Filter{
+				Variables: []VariableMap{{}},
+				TempViews: []ViewMap{
+					{
+						"STDIN": nil,
+					},
+				},
+				Cursors:      []CursorMap{{}},
+				InlineTables: InlineTableNodes{{}},
+				Aliases: AliasNodes{
+					{
+						"T": "STDIN",
+					},
+				},
+			},
+		},
+	},
 	{
 		Name: "Load From Stdin",
 		From: parser.FromClause{
@@ -631,6 +679,62 @@ var viewLoadTests = []struct {
 			},
 		},
 	},
+	{
+		Name: "Load TableObject From CSV File with Dialect",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.TableObject{
+						Type:          parser.Identifier{Literal: "csv"},
+						FormatElement: parser.NewStringValue(";"),
+						Path:          parser.Identifier{Literal: "table_dialect"},
+						Args: []parser.QueryExpression{
+							parser.NewNullValue(),
+							parser.NewNullValue(),
+							parser.NewNullValue(),
+							parser.NewStringValue("'"),
+							parser.NewStringValue("backslash"),
+							parser.NewTernaryValueFromString("false"),
+							parser.NewStringValue("#"),
+							parser.NewIntegerValue(1),
+						},
+					},
+					Alias: parser.Identifier{Literal: "t"},
+				},
+			},
+		},
+		Result: &View{
+			Header: NewHeader("t", []string{"id", "name"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("1"),
+					value.NewString("O'Brien"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("2"),
+					value.NewString("a;b"),
+				}),
+			},
+			FileInfo: &FileInfo{
+				Path:         "table_dialect.csv",
+				Delimiter:    ';',
+				Format:       cmd.CSV,
+				Encoding:     text.UTF8,
+				LineBreak:    text.LF,
+				Dialect:      CSVDialect{Quote: '\'', Escape: cmd.BackslashEscape, Comment: "#", SkipLines: 1},
+				SkippedLines: "exported at 2024-01-01\n",
+			},
+			Filter: &Filter{
+				Variables:    []VariableMap{{}},
+				TempViews:    []ViewMap{{}},
+				Cursors:      []CursorMap{{}},
+				InlineTables: InlineTableNodes{{}},
+				Aliases: AliasNodes{{
+					"T": strings.ToUpper(GetTestFilePath("table_dialect.csv")),
+				}},
+			},
+		},
+	},
 	{
 		Name: "Load TableObject From TSV File",
 		From: parser.FromClause{
@@ -754,6 +858,11 @@ var viewLoadTests = []struct {
 							parser.NewStringValue("SJIS"),
 							parser.NewTernaryValueFromString("true"),
 							parser.NewTernaryValueFromString("true"),
+							parser.NewStringValue("'"),
+							parser.NewStringValue("BACKSLASH"),
+							parser.NewTernaryValueFromString("true"),
+							parser.NewStringValue("#"),
+							parser.NewIntegerValue(1),
 							parser.NewStringValue("extra"),
 						},
 					},
@@ -761,7 +870,7 @@ var viewLoadTests = []struct {
 				},
 			},
 		},
-		Error: "[L:- C:-] table object csv takes at most 5 arguments",
+		Error: "[L:- C:-] table object csv takes at most 10 arguments",
 	},
 	{
 		Name: "Load TableObject From CSV File 3rd Argument Error",
@@ -840,7 +949,7 @@ var viewLoadTests = []struct {
 				},
 			},
 		},
-		Error: "[L:- C:-] invalid argument for csv: encoding must be one of UTF8|SJIS",
+		Error: "[L:- C:-] invalid argument for csv: encoding must be one of UTF8|UTF8BOM|UTF16LE|UTF16BE|SJIS|EUCJP|ISO2022JP|LATIN1|WINDOWS1252",
 	},
 	{
 		Name: "Load TableObject From Fixed-Length File",
@@ -1262,6 +1371,151 @@ var viewLoadTests = []struct {
 		},
 		Error: "[L:- C:-] table object ltsv takes exactly 3 arguments",
 	},
+	{
+		Name: "Load TableObject From Parquet File",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.TableObject{
+						Type: parser.Identifier{Literal: "parquet"},
+						Path: parser.Identifier{Literal: "table7"},
+					},
+					Alias: parser.Identifier{Literal: "t"},
+				},
+			},
+		},
+		Result: &View{
+			Header: NewHeader("t", []string{"column1", "column2", "column3", "column4", "column5", "column6"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewInteger(1),
+					value.NewString("str1"),
+					value.NewFloat(1.5),
+					value.NewBoolean(true),
+					value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 0, GetTestLocation())),
+					value.NewDatetime(time.Date(2012, 2, 3, 0, 0, 0, 0, GetTestLocation())),
+				}),
+				NewRecord([]value.Primary{
+					value.NewInteger(2),
+					value.NewNull(),
+					value.NewFloat(-2.25),
+					value.NewBoolean(false),
+					value.NewDatetime(time.Date(2012, 2, 4, 9, 18, 15, 123000000, GetTestLocation())),
+					value.NewDatetime(time.Date(2012, 2, 4, 0, 0, 0, 0, GetTestLocation())),
+				}),
+				NewRecord([]value.Primary{
+					value.NewInteger(3),
+					value.NewString("str3"),
+					value.NewFloat(3),
+					value.NewBoolean(true),
+					value.NewDatetime(time.Date(2012, 2, 5, 9, 18, 15, 0, GetTestLocation())),
+					value.NewDatetime(time.Date(2012, 2, 5, 0, 0, 0, 0, GetTestLocation())),
+				}),
+			},
+			FileInfo: &FileInfo{
+				Path:      "table7.parquet",
+				Delimiter: ',',
+				Format:    cmd.PARQUET,
+				Encoding:  text.UTF8,
+				LineBreak: text.LF,
+			},
+			Filter: &Filter{
+				Variables:    []VariableMap{{}},
+				TempViews:    []ViewMap{{}},
+				Cursors:      []CursorMap{{}},
+				InlineTables: InlineTableNodes{{}},
+				Aliases: AliasNodes{{
+					"T": strings.ToUpper(GetTestFilePath("table7.parquet")),
+				}},
+			},
+		},
+	},
+	{
+		Name: "Load TableObject From Parquet File Arguments Length Error",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.TableObject{
+						Type: parser.Identifier{Literal: "parquet"},
+						Path: parser.Identifier{Literal: "table7"},
+						Args: []parser.QueryExpression{
+							parser.NewStringValue("UTF8"),
+						},
+					},
+					Alias: parser.Identifier{Literal: "t"},
+				},
+			},
+		},
+		Error: "[L:- C:-] table object parquet takes exactly 1 arguments",
+	},
+	{
+		Name: "Load TableObject From JSON Lines File",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.TableObject{
+						Type: parser.Identifier{Literal: "jsonl"},
+						Path: parser.Identifier{Literal: "table10"},
+					},
+					Alias: parser.Identifier{Literal: "t"},
+				},
+			},
+		},
+		Result: &View{
+			Header: NewHeader("t", []string{"column1", "column2", "column3"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewInteger(1),
+					value.NewString("str1"),
+					value.NewNull(),
+				}),
+				NewRecord([]value.Primary{
+					value.NewInteger(2),
+					value.NewNull(),
+					value.NewBoolean(true),
+				}),
+				NewRecord([]value.Primary{
+					value.NewInteger(3),
+					value.NewString("str3"),
+					value.NewString("{\"key\":\"value\"}"),
+				}),
+			},
+			FileInfo: &FileInfo{
+				Path:      "table10.jsonl",
+				Delimiter: ',',
+				Format:    cmd.JSONL,
+				Encoding:  text.UTF8,
+				LineBreak: text.LF,
+			},
+			Filter: &Filter{
+				Variables:    []VariableMap{{}},
+				TempViews:    []ViewMap{{}},
+				Cursors:      []CursorMap{{}},
+				InlineTables: InlineTableNodes{{}},
+				Aliases: AliasNodes{{
+					"T": strings.ToUpper(GetTestFilePath("table10.jsonl")),
+				}},
+			},
+		},
+	},
+	{
+		Name: "Load TableObject From JSON Lines File Arguments Length Error",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.TableObject{
+						Type: parser.Identifier{Literal: "jsonl"},
+						Path: parser.Identifier{Literal: "table10"},
+						Args: []parser.QueryExpression{
+							parser.NewStringValue("UTF8"),
+						},
+					},
+					Alias: parser.Identifier{Literal: "t"},
+				},
+			},
+		},
+		Error: "[L:- C:-] table object jsonl takes exactly 1 arguments",
+	},
 	{
 		Name: "Load TableObject Invalid Object Type",
 		From: parser.FromClause{
@@ -2338,6 +2592,10 @@ var viewLoadTests = []struct {
 func TestView_Load(t *testing.T) {
 	tf := cmd.GetFlags()
 	tf.Repository = TestDir
+	defer func() {
+		tf.OnParseError = cmd.FailOnParseError
+		RejectedRows = make(RejectedRowMap)
+	}()
 
 	for _, v := range viewLoadTests {
 		ViewCache.Clean()
@@ -2347,6 +2605,7 @@ func TestView_Load(t *testing.T) {
 		tf.DelimitAutomatically = v.DelimitAutomatically
 		tf.JsonQuery = v.JsonQuery
 		tf.NoHeader = v.NoHeader
+		tf.OnParseError = v.OnParseError
 		if v.Encoding != "" {
 			tf.Encoding = v.Encoding
 		} else {
@@ -2418,6 +2677,9 @@ func TestView_Load(t *testing.T) {
 			if view.FileInfo.IsTemporary != v.Result.FileInfo.IsTemporary {
 				t.Errorf("%s: FileInfo.IsTemporary = %t, want %t", v.Name, view.FileInfo.IsTemporary, v.Result.FileInfo.IsTemporary)
 			}
+			if view.FileInfo.RejectedRows != v.Result.FileInfo.RejectedRows {
+				t.Errorf("%s: FileInfo.RejectedRows = %d, want %d", v.Name, view.FileInfo.RejectedRows, v.Result.FileInfo.RejectedRows)
+			}
 		}
 		if view.FileInfo != nil {
 			view.FileInfo.Close()
@@ -2703,7 +2965,7 @@ var viewGroupByTests = []struct {
 		},
 	},
 	{
-		Name: "Group By With ColumnNumber",
+		Name: "Group By Rollup",
 		View: &View{
 			Header: NewHeaderWithId("table1", []string{"column1", "column2", "column3"}),
 			RecordSet: []Record{
@@ -2722,17 +2984,16 @@ var viewGroupByTests = []struct {
 					value.NewString("str3"),
 					value.NewString("group1"),
 				}),
-				NewRecordWithId(4, []value.Primary{
-					value.NewString("4"),
-					value.NewString("str4"),
-					value.NewString("group2"),
-				}),
 			},
 			Filter: NewEmptyFilter(),
 		},
 		GroupBy: parser.GroupByClause{
 			Items: []parser.QueryExpression{
-				parser.ColumnNumber{View: parser.Identifier{Literal: "table1"}, Number: value.NewInteger(3)},
+				parser.Rollup{
+					Values: []parser.QueryExpression{
+						parser.FieldReference{Column: parser.Identifier{Literal: "column3"}},
+					},
+				},
 			},
 		},
 		Result: &View{
@@ -2769,10 +3030,16 @@ var viewGroupByTests = []struct {
 					NewGroupCell([]value.Primary{value.NewString("group1"), value.NewString("group1")}),
 				},
 				{
-					NewGroupCell([]value.Primary{value.NewInteger(2), value.NewInteger(4)}),
-					NewGroupCell([]value.Primary{value.NewString("2"), value.NewString("4")}),
-					NewGroupCell([]value.Primary{value.NewString("str2"), value.NewString("str4")}),
-					NewGroupCell([]value.Primary{value.NewString("group2"), value.NewString("group2")}),
+					NewGroupCell([]value.Primary{value.NewInteger(2)}),
+					NewGroupCell([]value.Primary{value.NewString("2")}),
+					NewGroupCell([]value.Primary{value.NewString("str2")}),
+					NewGroupCell([]value.Primary{value.NewString("group2")}),
+				},
+				{
+					NewGroupCell([]value.Primary{value.NewInteger(1), value.NewInteger(2), value.NewInteger(3)}),
+					NewGroupCell([]value.Primary{value.NewString("1"), value.NewString("2"), value.NewString("3")}),
+					NewGroupCell([]value.Primary{value.NewString("str1"), value.NewString("str2"), value.NewString("str3")}),
+					NewRolledUpCell([]value.Primary{value.NewString("group1"), value.NewString("group2"), value.NewString("group1")}),
 				},
 			},
 			Filter:    NewEmptyFilter(),
@@ -2780,7 +3047,7 @@ var viewGroupByTests = []struct {
 		},
 	},
 	{
-		Name: "Group By Evaluation Error",
+		Name: "Group By Grouping Sets",
 		View: &View{
 			Header: NewHeaderWithId("table1", []string{"column1", "column2", "column3"}),
 			RecordSet: []Record{
@@ -2799,31 +3066,23 @@ var viewGroupByTests = []struct {
 					value.NewString("str3"),
 					value.NewString("group1"),
 				}),
-				NewRecordWithId(4, []value.Primary{
-					value.NewString("4"),
-					value.NewString("str4"),
-					value.NewString("group2"),
-				}),
 			},
 			Filter: NewEmptyFilter(),
 		},
 		GroupBy: parser.GroupByClause{
 			Items: []parser.QueryExpression{
-				parser.ColumnNumber{View: parser.Identifier{Literal: "table1"}, Number: value.NewInteger(0)},
-			},
-		},
-		Error: "[L:- C:-] field table1.0 does not exist",
-	},
-	{
-		Name: "Group By Empty Record",
-		View: &View{
-			Header:    NewHeaderWithId("table1", []string{"column1", "column2", "column3"}),
-			RecordSet: []Record{},
-			Filter:    NewEmptyFilter(),
-		},
-		GroupBy: parser.GroupByClause{
-			Items: []parser.QueryExpression{
-				parser.FieldReference{Column: parser.Identifier{Literal: "column3"}},
+				parser.GroupingSets{
+					Sets: []parser.QueryExpression{
+						parser.FieldReference{Column: parser.Identifier{Literal: "column3"}},
+						parser.GroupingSet{
+							Values: []parser.QueryExpression{
+								parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+								parser.FieldReference{Column: parser.Identifier{Literal: "column3"}},
+							},
+						},
+						parser.GroupingSet{},
+					},
+				},
 			},
 		},
 		Result: &View{
@@ -2837,6 +3096,7 @@ var viewGroupByTests = []struct {
 					Column:      "column1",
 					Number:      1,
 					IsFromTable: true,
+					IsGroupKey:  true,
 				},
 				{
 					View:        "table1",
@@ -2852,21 +3112,213 @@ var viewGroupByTests = []struct {
 					IsGroupKey:  true,
 				},
 			},
-			RecordSet: []Record{},
+			RecordSet: []Record{
+				{
+					NewGroupCell([]value.Primary{value.NewInteger(1), value.NewInteger(3)}),
+					NewRolledUpCell([]value.Primary{value.NewString("1"), value.NewString("3")}),
+					NewGroupCell([]value.Primary{value.NewString("str1"), value.NewString("str3")}),
+					NewGroupCell([]value.Primary{value.NewString("group1"), value.NewString("group1")}),
+				},
+				{
+					NewGroupCell([]value.Primary{value.NewInteger(2)}),
+					NewRolledUpCell([]value.Primary{value.NewString("2")}),
+					NewGroupCell([]value.Primary{value.NewString("str2")}),
+					NewGroupCell([]value.Primary{value.NewString("group2")}),
+				},
+				{
+					NewGroupCell([]value.Primary{value.NewInteger(1)}),
+					NewGroupCell([]value.Primary{value.NewString("1")}),
+					NewGroupCell([]value.Primary{value.NewString("str1")}),
+					NewGroupCell([]value.Primary{value.NewString("group1")}),
+				},
+				{
+					NewGroupCell([]value.Primary{value.NewInteger(2)}),
+					NewGroupCell([]value.Primary{value.NewString("2")}),
+					NewGroupCell([]value.Primary{value.NewString("str2")}),
+					NewGroupCell([]value.Primary{value.NewString("group2")}),
+				},
+				{
+					NewGroupCell([]value.Primary{value.NewInteger(3)}),
+					NewGroupCell([]value.Primary{value.NewString("3")}),
+					NewGroupCell([]value.Primary{value.NewString("str3")}),
+					NewGroupCell([]value.Primary{value.NewString("group1")}),
+				},
+				{
+					NewGroupCell([]value.Primary{value.NewInteger(1), value.NewInteger(2), value.NewInteger(3)}),
+					NewRolledUpCell([]value.Primary{value.NewString("1"), value.NewString("2"), value.NewString("3")}),
+					NewGroupCell([]value.Primary{value.NewString("str1"), value.NewString("str2"), value.NewString("str3")}),
+					NewRolledUpCell([]value.Primary{value.NewString("group1"), value.NewString("group2"), value.NewString("group1")}),
+				},
+			},
 			Filter:    NewEmptyFilter(),
 			isGrouped: true,
 		},
 	},
 	{
-		Name: "Group By Empty Record with No Condition",
+		Name: "Group By With ColumnNumber",
 		View: &View{
-			Header:    NewHeaderWithId("table1", []string{"column1", "column2", "column3"}),
-			RecordSet: []Record{},
-			Filter:    NewEmptyFilter(),
-		},
-		GroupBy: parser.GroupByClause{
-			Items: nil,
-		},
+			Header: NewHeaderWithId("table1", []string{"column1", "column2", "column3"}),
+			RecordSet: []Record{
+				NewRecordWithId(1, []value.Primary{
+					value.NewString("1"),
+					value.NewString("str1"),
+					value.NewString("group1"),
+				}),
+				NewRecordWithId(2, []value.Primary{
+					value.NewString("2"),
+					value.NewString("str2"),
+					value.NewString("group2"),
+				}),
+				NewRecordWithId(3, []value.Primary{
+					value.NewString("3"),
+					value.NewString("str3"),
+					value.NewString("group1"),
+				}),
+				NewRecordWithId(4, []value.Primary{
+					value.NewString("4"),
+					value.NewString("str4"),
+					value.NewString("group2"),
+				}),
+			},
+			Filter: NewEmptyFilter(),
+		},
+		GroupBy: parser.GroupByClause{
+			Items: []parser.QueryExpression{
+				parser.ColumnNumber{View: parser.Identifier{Literal: "table1"}, Number: value.NewInteger(3)},
+			},
+		},
+		Result: &View{
+			Header: []HeaderField{
+				{
+					View:   "table1",
+					Column: InternalIdColumn,
+				},
+				{
+					View:        "table1",
+					Column:      "column1",
+					Number:      1,
+					IsFromTable: true,
+				},
+				{
+					View:        "table1",
+					Column:      "column2",
+					Number:      2,
+					IsFromTable: true,
+				},
+				{
+					View:        "table1",
+					Column:      "column3",
+					Number:      3,
+					IsFromTable: true,
+					IsGroupKey:  true,
+				},
+			},
+			RecordSet: []Record{
+				{
+					NewGroupCell([]value.Primary{value.NewInteger(1), value.NewInteger(3)}),
+					NewGroupCell([]value.Primary{value.NewString("1"), value.NewString("3")}),
+					NewGroupCell([]value.Primary{value.NewString("str1"), value.NewString("str3")}),
+					NewGroupCell([]value.Primary{value.NewString("group1"), value.NewString("group1")}),
+				},
+				{
+					NewGroupCell([]value.Primary{value.NewInteger(2), value.NewInteger(4)}),
+					NewGroupCell([]value.Primary{value.NewString("2"), value.NewString("4")}),
+					NewGroupCell([]value.Primary{value.NewString("str2"), value.NewString("str4")}),
+					NewGroupCell([]value.Primary{value.NewString("group2"), value.NewString("group2")}),
+				},
+			},
+			Filter:    NewEmptyFilter(),
+			isGrouped: true,
+		},
+	},
+	{
+		Name: "Group By Evaluation Error",
+		View: &View{
+			Header: NewHeaderWithId("table1", []string{"column1", "column2", "column3"}),
+			RecordSet: []Record{
+				NewRecordWithId(1, []value.Primary{
+					value.NewString("1"),
+					value.NewString("str1"),
+					value.NewString("group1"),
+				}),
+				NewRecordWithId(2, []value.Primary{
+					value.NewString("2"),
+					value.NewString("str2"),
+					value.NewString("group2"),
+				}),
+				NewRecordWithId(3, []value.Primary{
+					value.NewString("3"),
+					value.NewString("str3"),
+					value.NewString("group1"),
+				}),
+				NewRecordWithId(4, []value.Primary{
+					value.NewString("4"),
+					value.NewString("str4"),
+					value.NewString("group2"),
+				}),
+			},
+			Filter: NewEmptyFilter(),
+		},
+		GroupBy: parser.GroupByClause{
+			Items: []parser.QueryExpression{
+				parser.ColumnNumber{View: parser.Identifier{Literal: "table1"}, Number: value.NewInteger(0)},
+			},
+		},
+		Error: "[L:- C:-] field table1.0 does not exist",
+	},
+	{
+		Name: "Group By Empty Record",
+		View: &View{
+			Header:    NewHeaderWithId("table1", []string{"column1", "column2", "column3"}),
+			RecordSet: []Record{},
+			Filter:    NewEmptyFilter(),
+		},
+		GroupBy: parser.GroupByClause{
+			Items: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column3"}},
+			},
+		},
+		Result: &View{
+			Header: []HeaderField{
+				{
+					View:   "table1",
+					Column: InternalIdColumn,
+				},
+				{
+					View:        "table1",
+					Column:      "column1",
+					Number:      1,
+					IsFromTable: true,
+				},
+				{
+					View:        "table1",
+					Column:      "column2",
+					Number:      2,
+					IsFromTable: true,
+				},
+				{
+					View:        "table1",
+					Column:      "column3",
+					Number:      3,
+					IsFromTable: true,
+					IsGroupKey:  true,
+				},
+			},
+			RecordSet: []Record{},
+			Filter:    NewEmptyFilter(),
+			isGrouped: true,
+		},
+	},
+	{
+		Name: "Group By Empty Record with No Condition",
+		View: &View{
+			Header:    NewHeaderWithId("table1", []string{"column1", "column2", "column3"}),
+			RecordSet: []Record{},
+			Filter:    NewEmptyFilter(),
+		},
+		GroupBy: parser.GroupByClause{
+			Items: nil,
+		},
 		Result: &View{
 			Header: []HeaderField{
 				{
@@ -3583,6 +4035,147 @@ var viewSelectTests = []struct {
 			selectFields: []int{0, 1, 2},
 		},
 	},
+	{
+		Name: "Select Analytic Function with Named Window",
+		View: &View{
+			Header: NewHeader("table1", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("a"),
+					value.NewInteger(2),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("b"),
+					value.NewInteger(3),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("b"),
+					value.NewInteger(5),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("a"),
+					value.NewInteger(1),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("b"),
+					value.NewInteger(4),
+				}),
+			},
+			Filter: NewEmptyFilter(),
+			windows: map[string]parser.AnalyticClause{
+				"W": {
+					PartitionClause: parser.PartitionClause{
+						PartitionBy: "partition by",
+						Values: []parser.QueryExpression{
+							parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+						},
+					},
+					OrderByClause: parser.OrderByClause{
+						OrderBy: "order by",
+						Items: []parser.QueryExpression{
+							parser.OrderItem{
+								Value: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+							},
+						},
+					},
+				},
+			},
+		},
+		Select: parser.SelectClause{
+			Fields: []parser.QueryExpression{
+				parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}}},
+				parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}}},
+				parser.Field{
+					Object: parser.AnalyticFunction{
+						Name: "row_number",
+						Over: "over",
+						AnalyticClause: parser.AnalyticClause{
+							WindowName: parser.Identifier{Literal: "w"},
+						},
+					},
+				},
+			},
+		},
+		Result: &View{
+			Header: []HeaderField{
+				{View: "table1", Column: "column1", Number: 1, IsFromTable: true},
+				{View: "table1", Column: "column2", Number: 2, IsFromTable: true},
+				{Column: "row_number() over w"},
+			},
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("a"),
+					value.NewInteger(1),
+					value.NewInteger(1),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("a"),
+					value.NewInteger(2),
+					value.NewInteger(2),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("b"),
+					value.NewInteger(3),
+					value.NewInteger(1),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("b"),
+					value.NewInteger(4),
+					value.NewInteger(2),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("b"),
+					value.NewInteger(5),
+					value.NewInteger(3),
+				}),
+			},
+			Filter:       NewEmptyFilter(),
+			selectFields: []int{0, 1, 2},
+		},
+	},
+	{
+		Name: "Select Analytic Function Undefined Window Error",
+		View: &View{
+			Header: NewHeader("table1", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("a"),
+					value.NewInteger(2),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("b"),
+					value.NewInteger(3),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("b"),
+					value.NewInteger(5),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("a"),
+					value.NewInteger(1),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("b"),
+					value.NewInteger(4),
+				}),
+			},
+			Filter: NewEmptyFilter(),
+		},
+		Select: parser.SelectClause{
+			Fields: []parser.QueryExpression{
+				parser.Field{
+					Object: parser.AnalyticFunction{
+						Name: "row_number",
+						Over: "over",
+						AnalyticClause: parser.AnalyticClause{
+							WindowName: parser.Identifier{Literal: "w"},
+						},
+					},
+				},
+			},
+		},
+		Error: "[L:- C:-] window w is undefined",
+	},
 	{
 		Name: "Select Analytic Function Not Exist Error",
 		View: &View{
@@ -3925,6 +4518,158 @@ func TestView_Select(t *testing.T) {
 	}
 }
 
+var viewDefineWindowsTests = []struct {
+	Name   string
+	Clause parser.WindowClause
+	Result map[string]parser.AnalyticClause
+	Error  string
+}{
+	{
+		Name: "DefineWindows",
+		Clause: parser.WindowClause{
+			Definitions: []parser.QueryExpression{
+				parser.WindowDefinition{
+					Name: parser.Identifier{Literal: "w1"},
+					Clause: parser.AnalyticClause{
+						PartitionClause: parser.PartitionClause{
+							Values: []parser.QueryExpression{parser.FieldReference{Column: parser.Identifier{Literal: "column1"}}},
+						},
+					},
+				},
+				parser.WindowDefinition{
+					Name: parser.Identifier{Literal: "w2"},
+					Clause: parser.AnalyticClause{
+						WindowName: parser.Identifier{Literal: "W1"},
+						OrderByClause: parser.OrderByClause{
+							Items: []parser.QueryExpression{parser.OrderItem{Value: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}}}},
+						},
+					},
+				},
+			},
+		},
+		Result: map[string]parser.AnalyticClause{
+			"W1": {
+				PartitionClause: parser.PartitionClause{
+					Values: []parser.QueryExpression{parser.FieldReference{Column: parser.Identifier{Literal: "column1"}}},
+				},
+			},
+			"W2": {
+				PartitionClause: parser.PartitionClause{
+					Values: []parser.QueryExpression{parser.FieldReference{Column: parser.Identifier{Literal: "column1"}}},
+				},
+				OrderByClause: parser.OrderByClause{
+					Items: []parser.QueryExpression{parser.OrderItem{Value: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}}}},
+				},
+			},
+		},
+	},
+	{
+		Name: "DefineWindows Redefined Error",
+		Clause: parser.WindowClause{
+			Definitions: []parser.QueryExpression{
+				parser.WindowDefinition{
+					Name:   parser.Identifier{Literal: "w1"},
+					Clause: parser.AnalyticClause{},
+				},
+				parser.WindowDefinition{
+					Name:   parser.Identifier{Literal: "W1"},
+					Clause: parser.AnalyticClause{},
+				},
+			},
+		},
+		Error: "[L:- C:-] window W1 is redefined",
+	},
+	{
+		Name: "DefineWindows Undefined Window Error",
+		Clause: parser.WindowClause{
+			Definitions: []parser.QueryExpression{
+				parser.WindowDefinition{
+					Name: parser.Identifier{Literal: "w2"},
+					Clause: parser.AnalyticClause{
+						WindowName: parser.Identifier{Literal: "w1"},
+					},
+				},
+			},
+		},
+		Error: "[L:- C:-] window w1 is undefined",
+	},
+	{
+		Name: "DefineWindows Order By Override Error",
+		Clause: parser.WindowClause{
+			Definitions: []parser.QueryExpression{
+				parser.WindowDefinition{
+					Name: parser.Identifier{Literal: "w1"},
+					Clause: parser.AnalyticClause{
+						OrderByClause: parser.OrderByClause{
+							Items: []parser.QueryExpression{parser.OrderItem{Value: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}}}},
+						},
+					},
+				},
+				parser.WindowDefinition{
+					Name: parser.Identifier{Literal: "w2"},
+					Clause: parser.AnalyticClause{
+						WindowName: parser.Identifier{Literal: "w1"},
+						OrderByClause: parser.OrderByClause{
+							Items: []parser.QueryExpression{parser.OrderItem{Value: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}}}},
+						},
+					},
+				},
+			},
+		},
+		Error: "[L:- C:-] window w1 cannot be extended: window already has an order by clause",
+	},
+	{
+		Name: "DefineWindows Windowing Clause Extension Error",
+		Clause: parser.WindowClause{
+			Definitions: []parser.QueryExpression{
+				parser.WindowDefinition{
+					Name: parser.Identifier{Literal: "w1"},
+					Clause: parser.AnalyticClause{
+						OrderByClause: parser.OrderByClause{
+							Items: []parser.QueryExpression{parser.OrderItem{Value: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}}}},
+						},
+						WindowingClause: parser.WindowingClause{
+							FrameLow: parser.WindowFramePosition{Direction: parser.CURRENT},
+						},
+					},
+				},
+				parser.WindowDefinition{
+					Name: parser.Identifier{Literal: "w2"},
+					Clause: parser.AnalyticClause{
+						WindowName: parser.Identifier{Literal: "w1"},
+						WindowingClause: parser.WindowingClause{
+							FrameLow: parser.WindowFramePosition{Direction: parser.CURRENT},
+						},
+					},
+				},
+			},
+		},
+		Error: "[L:- C:-] window w1 cannot be extended: window has a windowing clause",
+	},
+}
+
+func TestView_DefineWindows(t *testing.T) {
+	for _, v := range viewDefineWindowsTests {
+		view := NewView()
+		err := view.DefineWindows(v.Clause)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if !reflect.DeepEqual(view.windows, v.Result) {
+			t.Errorf("%s: windows = %v, want %v", v.Name, view.windows, v.Result)
+		}
+	}
+}
+
 var viewOrderByTests = []struct {
 	Name    string
 	View    *View
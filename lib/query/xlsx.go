@@ -0,0 +1,615 @@
+package query
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/file"
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/go-text"
+	"github.com/mithrandie/ternary"
+	"github.com/xuri/excelize/v2"
+)
+
+const xlsxDefaultSheet = "Sheet1"
+
+type xlsxCellRange struct {
+	StartCol int
+	StartRow int
+	EndCol   int
+	EndRow   int
+}
+
+func parseXlsxCellRange(s string) (xlsxCellRange, error) {
+	r := xlsxCellRange{StartCol: 1, StartRow: 1}
+	if len(s) < 1 {
+		return r, nil
+	}
+
+	cells := strings.Split(s, ":")
+	if len(cells) != 2 {
+		return r, errors.New(fmt.Sprintf("invalid cell range %q", s))
+	}
+
+	var err error
+	if r.StartCol, r.StartRow, err = excelize.CellNameToCoordinates(strings.TrimSpace(cells[0])); err != nil {
+		return r, errors.New(fmt.Sprintf("invalid cell range %q", s))
+	}
+	if r.EndCol, r.EndRow, err = excelize.CellNameToCoordinates(strings.TrimSpace(cells[1])); err != nil {
+		return r, errors.New(fmt.Sprintf("invalid cell range %q", s))
+	}
+	if r.EndCol < r.StartCol {
+		r.StartCol, r.EndCol = r.EndCol, r.StartCol
+	}
+	if r.EndRow < r.StartRow {
+		r.StartRow, r.EndRow = r.EndRow, r.StartRow
+	}
+	return r, nil
+}
+
+func xlsxSheetName(book *excelize.File, sheet string) (string, error) {
+	if len(sheet) < 1 {
+		return book.GetSheetName(0), nil
+	}
+	idx, err := book.GetSheetIndex(sheet)
+	if err != nil {
+		return "", err
+	}
+	if idx < 0 {
+		return "", errors.New(fmt.Sprintf("sheet %q does not exist", sheet))
+	}
+	return book.GetSheetName(idx), nil
+}
+
+func loadViewFromXlsxFile(fp io.Reader, fileInfo *FileInfo, withoutNull bool) (*View, error) {
+	book, err := excelize.OpenReader(fp)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = book.Close()
+	}()
+
+	sheet, err := xlsxSheetName(book, fileInfo.Sheet)
+	if err != nil {
+		return nil, err
+	}
+	cellRange, err := parseXlsxCellRange(fileInfo.CellRange)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := book.GetRows(sheet, excelize.Options{RawCellValue: true})
+	if err != nil {
+		return nil, err
+	}
+
+	endRow := cellRange.EndRow
+	if endRow < 1 || len(rows) < endRow {
+		endRow = len(rows)
+	}
+	for cellRange.StartRow <= endRow && isEmptyXlsxRow(rows[endRow-1], cellRange) {
+		endRow--
+	}
+	endCol := cellRange.EndCol
+	if endCol < 1 {
+		for i := cellRange.StartRow - 1; i < endRow; i++ {
+			for j := len(rows[i]); cellRange.StartCol <= j && endCol < j; j-- {
+				if 0 < len(rows[i][j-1]) {
+					endCol = j
+					break
+				}
+			}
+		}
+	}
+
+	reader, err := newXlsxValueReader(book, sheet)
+	if err != nil {
+		return nil, err
+	}
+
+	fieldLen := 0
+	if cellRange.StartCol <= endCol {
+		fieldLen = endCol - cellRange.StartCol + 1
+	}
+
+	var header []string
+	records := make(RecordSet, 0, endRow)
+	for i := cellRange.StartRow - 1; i < endRow; i++ {
+		values := make([]value.Primary, fieldLen)
+		for j := range values {
+			col := cellRange.StartCol + j
+			raw := ""
+			if col <= len(rows[i]) {
+				raw = rows[i][col-1]
+			}
+			if len(raw) < 1 {
+				values[j] = value.NewNull()
+				continue
+			}
+			if values[j], err = reader.Value(col, i+1, raw); err != nil {
+				return nil, err
+			}
+		}
+
+		if header == nil && !fileInfo.NoHeader {
+			header = make([]string, fieldLen)
+			for j, p := range values {
+				if !value.IsNull(p) {
+					header[j], _, _ = ConvertFieldContents(p, false)
+				}
+			}
+			continue
+		}
+
+		record := make(Record, fieldLen)
+		for j, p := range values {
+			if withoutNull && value.IsNull(p) {
+				p = value.NewString("")
+			}
+			record[j] = NewCell(p)
+		}
+		records = append(records, record)
+	}
+
+	if header == nil {
+		header = make([]string, fieldLen)
+		for i := 0; i < fieldLen; i++ {
+			header[i] = "c" + strconv.Itoa(i+1)
+		}
+	}
+
+	fileInfo.Sheet = sheet
+	fileInfo.Encoding = text.UTF8
+
+	view := NewView()
+	view.Header = NewHeader(parser.FormatTableName(fileInfo.Path), header)
+	view.RecordSet = records
+	view.FileInfo = fileInfo
+	return view, nil
+}
+
+func isEmptyXlsxRow(row []string, cellRange xlsxCellRange) bool {
+	for i := cellRange.StartCol - 1; i < len(row); i++ {
+		if 0 < cellRange.EndCol && cellRange.EndCol <= i {
+			break
+		}
+		if 0 < len(row[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+type xlsxValueReader struct {
+	book     *excelize.File
+	sheet    string
+	date1904 bool
+
+	dateStyles map[int]bool
+}
+
+func newXlsxValueReader(book *excelize.File, sheet string) (*xlsxValueReader, error) {
+	props, err := book.GetWorkbookProps()
+	if err != nil {
+		return nil, err
+	}
+
+	return &xlsxValueReader{
+		book:       book,
+		sheet:      sheet,
+		date1904:   props.Date1904 != nil && *props.Date1904,
+		dateStyles: make(map[int]bool),
+	}, nil
+}
+
+func (r *xlsxValueReader) Value(col int, row int, raw string) (value.Primary, error) {
+	cell, err := excelize.CoordinatesToCellName(col, row)
+	if err != nil {
+		return nil, err
+	}
+	cellType, err := r.book.GetCellType(r.sheet, cell)
+	if err != nil {
+		return nil, err
+	}
+
+	switch cellType {
+	case excelize.CellTypeBool:
+		return value.NewBoolean(raw == "1" || strings.EqualFold(raw, "TRUE")), nil
+	case excelize.CellTypeDate:
+		for _, layout := range []string{"2006-01-02T15:04:05.999999999", "2006-01-02T15:04:05.999999999Z07:00", "2006-01-02"} {
+			if t, err := time.Parse(layout, raw); err == nil {
+				return value.NewDatetime(xlsxWallClock(t)), nil
+			}
+		}
+		return value.NewString(raw), nil
+	case excelize.CellTypeUnset, excelize.CellTypeNumber:
+		// Numeric cells do not have a type attribute.
+	default:
+		return value.NewString(raw), nil
+	}
+
+	isDate, err := r.isDate(cell)
+	if err != nil {
+		return nil, err
+	}
+	if isDate {
+		if f, err := strconv.ParseFloat(raw, 64); err == nil {
+			if t, err := excelize.ExcelDateToTime(f, r.date1904); err == nil {
+				return value.NewDatetime(xlsxWallClock(t)), nil
+			}
+		}
+	}
+
+	if i, err := strconv.ParseInt(raw, 10, 64); err == nil {
+		return value.NewInteger(i), nil
+	}
+	if f, err := strconv.ParseFloat(raw, 64); err == nil {
+		return value.NewFloat(f), nil
+	}
+	return value.NewString(raw), nil
+}
+
+func (r *xlsxValueReader) isDate(cell string) (bool, error) {
+	idx, err := r.book.GetCellStyle(r.sheet, cell)
+	if err != nil {
+		return false, err
+	}
+	if isDate, ok := r.dateStyles[idx]; ok {
+		return isDate, nil
+	}
+
+	isDate := false
+	if style, err := r.book.GetStyle(idx); err == nil {
+		if style.CustomNumFmt != nil {
+			isDate = isXlsxDateFormatCode(*style.CustomNumFmt)
+		} else {
+			isDate = isXlsxDateFormatId(style.NumFmt)
+		}
+	}
+	r.dateStyles[idx] = isDate
+	return isDate, nil
+}
+
+func isXlsxDateFormatId(id int) bool {
+	return (14 <= id && id <= 22) ||
+		(27 <= id && id <= 36) ||
+		(45 <= id && id <= 47) ||
+		(50 <= id && id <= 58)
+}
+
+func isXlsxDateFormatCode(code string) bool {
+	// Only the first section is used, which is applied to positive numbers.
+	if i := strings.IndexByte(code, ';'); -1 < i {
+		code = code[:i]
+	}
+
+	runes := []rune(code)
+	for i := 0; i < len(runes); i++ {
+		switch runes[i] {
+		case '"':
+			for i++; i < len(runes) && runes[i] != '"'; i++ {
+			}
+		case '[':
+			for i++; i < len(runes) && runes[i] != ']'; i++ {
+			}
+		case '\\', '_', '*':
+			i++
+		case 'y', 'Y', 'm', 'M', 'd', 'D', 'h', 'H', 's', 'S':
+			return true
+		}
+	}
+	return false
+}
+
+func xlsxWallClock(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), cmd.GetLocation())
+}
+
+func xlsxCellValue(p value.Primary) interface{} {
+	switch p.(type) {
+	case value.Integer:
+		return p.(value.Integer).Raw()
+	case value.Float:
+		return p.(value.Float).Raw()
+	case value.Boolean:
+		return p.(value.Boolean).Raw()
+	case value.Ternary:
+		if p.(value.Ternary).Ternary() == ternary.UNKNOWN {
+			return nil
+		}
+		return p.(value.Ternary).Ternary().ParseBool()
+	case value.Datetime:
+		t := p.(value.Datetime).Raw()
+		return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)
+	case value.String:
+		return p.(value.String).Raw()
+	}
+	return nil
+}
+
+func openXlsxWorkbookForWrite(fileInfo *FileInfo) (*excelize.File, bool) {
+	if fileInfo.Handler != nil {
+		fp := fileInfo.Handler.FileForRead()
+		if fp != nil && fp != fileInfo.Handler.FileForUpdate() {
+			if _, err := fp.Seek(0, io.SeekStart); err == nil {
+				// The original file is not a workbook if its format has been changed.
+				if book, err := excelize.OpenReader(fp); err == nil {
+					return book, false
+				}
+			}
+		}
+	}
+	return excelize.NewFile(), true
+}
+
+func encodeXlsx(fp io.Writer, view *View, fileInfo *FileInfo) error {
+	cellRange, err := parseXlsxCellRange(fileInfo.CellRange)
+	if err != nil {
+		return err
+	}
+
+	book, isNew := openXlsxWorkbookForWrite(fileInfo)
+	defer func() {
+		_ = book.Close()
+	}()
+
+	sheet := fileInfo.Sheet
+	if len(sheet) < 1 {
+		sheet = xlsxDefaultSheet
+	}
+
+	if isNew {
+		if err = book.SetSheetName(xlsxDefaultSheet, sheet); err != nil {
+			return err
+		}
+	} else {
+		idx, err := book.GetSheetIndex(sheet)
+		if err != nil {
+			return err
+		}
+		if idx < 0 {
+			_, err = book.NewSheet(sheet)
+		} else {
+			err = clearXlsxSheet(book, sheet, cellRange)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	row := cellRange.StartRow
+	setRow := func(values []interface{}) error {
+		cell, err := excelize.CoordinatesToCellName(cellRange.StartCol, row)
+		if err != nil {
+			return err
+		}
+		row++
+		return book.SetSheetRow(sheet, cell, &values)
+	}
+
+	if !fileInfo.NoHeader {
+		header := view.Header.TableColumnNames()
+		values := make([]interface{}, len(header))
+		for i, v := range header {
+			values[i] = v
+		}
+		if err = setRow(values); err != nil {
+			return err
+		}
+	}
+
+	for _, record := range view.RecordSet {
+		values := make([]interface{}, len(record))
+		for i, cell := range record {
+			values[i] = xlsxCellValue(cell.Value())
+		}
+		if err = setRow(values); err != nil {
+			return err
+		}
+	}
+
+	return book.Write(fp)
+}
+
+func clearXlsxSheet(book *excelize.File, sheet string, cellRange xlsxCellRange) error {
+	rows, err := book.GetRows(sheet, excelize.Options{RawCellValue: true})
+	if err != nil {
+		return err
+	}
+
+	for i := cellRange.StartRow - 1; i < len(rows); i++ {
+		if 0 < cellRange.EndRow && cellRange.EndRow <= i {
+			break
+		}
+		for j := cellRange.StartCol - 1; j < len(rows[i]); j++ {
+			if 0 < cellRange.EndCol && cellRange.EndCol <= j {
+				break
+			}
+			if len(rows[i][j]) < 1 {
+				continue
+			}
+			cell, err := excelize.CoordinatesToCellName(j+1, i+1)
+			if err != nil {
+				return err
+			}
+			if err = book.SetCellValue(sheet, cell, nil); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+type XlsxTable struct {
+	Path        string
+	Sheet       string
+	CellRange   string
+	NoHeader    bool
+	WithoutNull bool
+}
+
+func (t *XlsxTable) Key() string {
+	if len(t.Sheet) < 1 && len(t.CellRange) < 1 {
+		return t.Path
+	}
+	key := t.Path + "::" + t.Sheet
+	if 0 < len(t.CellRange) {
+		key = key + "!" + t.CellRange
+	}
+	return key
+}
+
+func newXlsxTable(tableObject parser.TableObject, filter *Filter) (*XlsxTable, error) {
+	if 5 < len(tableObject.Args) {
+		return nil, NewTableObjectArgumentsLengthError(tableObject, 5)
+	}
+
+	flags := filter.Flags()
+	args := make([]string, 3)
+	for i := 0; i < len(tableObject.Args) && i < len(args); i++ {
+		s, ok, err := tableObjectStringArgument(tableObject.Args[i], filter)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			args[i] = strings.TrimSpace(s)
+		}
+	}
+	if len(args[0]) < 1 {
+		return nil, NewTableObjectInvalidArgumentError(tableObject, "file is not specified")
+	}
+	if _, err := parseXlsxCellRange(args[2]); err != nil {
+		return nil, NewTableObjectInvalidArgumentError(tableObject, err.Error())
+	}
+
+	table := &XlsxTable{
+		Sheet:       args[1],
+		CellRange:   strings.ToUpper(args[2]),
+		NoHeader:    flags.NoHeader,
+		WithoutNull: flags.WithoutNull,
+	}
+	if len(table.Sheet) < 1 {
+		table.Sheet = flags.Sheet
+	}
+
+	for i := 3; i < len(tableObject.Args); i++ {
+		if pt, ok := tableObject.Args[i].(parser.PrimitiveType); ok && value.IsNull(pt.Value) {
+			continue
+		}
+
+		var p value.Primary = value.NewNull()
+		if pv, err := filter.Evaluate(tableObject.Args[i]); err == nil {
+			p = value.ToBoolean(pv)
+		}
+
+		if i == 3 {
+			if value.IsNull(p) {
+				return nil, NewTableObjectInvalidArgumentError(tableObject, fmt.Sprintf("cannot be converted as a no-header value: %s", tableObject.Args[i].String()))
+			}
+			table.NoHeader = p.(value.Boolean).Raw()
+		} else {
+			if value.IsNull(p) {
+				return nil, NewTableObjectInvalidArgumentError(tableObject, fmt.Sprintf("cannot be converted as a without-null value: %s", tableObject.Args[i].String()))
+			}
+			table.WithoutNull = p.(value.Boolean).Raw()
+		}
+	}
+
+	fpath, err := SearchXlsxFilePath(parser.Identifier{BaseExpr: tableObject.BaseExpr, Literal: args[0]}, flags.Repository)
+	if err != nil {
+		return nil, err
+	}
+	if err = filter.Session().CheckFileAccess(tableObject, fpath); err != nil {
+		return nil, err
+	}
+	table.Path = fpath
+	return table, nil
+}
+
+func loadXlsxObject(
+	tableObject parser.TableObject,
+	tableName parser.Identifier,
+	filter *Filter,
+	useInternalId bool,
+	forUpdate bool,
+) (*View, error) {
+	table, err := newXlsxTable(tableObject, filter)
+	if err != nil {
+		return nil, err
+	}
+	key := table.Key()
+
+	viewCache := filter.Session().ViewCache
+	isCached := true
+	if !viewCache.Exists(key) || (forUpdate && !viewCache[strings.ToUpper(key)].ForUpdate) {
+		isCached = false
+		viewCache.Dispose(key)
+
+		fileInfo := &FileInfo{
+			Path:      key,
+			Format:    cmd.XLSX,
+			Sheet:     table.Sheet,
+			CellRange: table.CellRange,
+			Encoding:  text.UTF8,
+			LineBreak: filter.Flags().LineBreak,
+			NoHeader:  table.NoHeader,
+		}
+
+		var fp *os.File
+		if forUpdate {
+			h, err := file.NewHandlerForUpdate(table.Path)
+			if err != nil {
+				if _, ok := err.(*file.TimeoutError); ok {
+					return nil, NewFileLockTimeoutError(parser.Identifier{BaseExpr: tableObject.BaseExpr, Literal: table.Path}, table.Path)
+				}
+				return nil, NewReadFileError(tableObject, err.Error())
+			}
+			fileInfo.Handler = h
+			fp = h.FileForRead()
+		} else {
+			h, err := file.NewHandlerForRead(table.Path)
+			if err != nil {
+				if _, ok := err.(*file.TimeoutError); ok {
+					return nil, NewFileLockTimeoutError(parser.Identifier{BaseExpr: tableObject.BaseExpr, Literal: table.Path}, table.Path)
+				}
+				return nil, NewReadFileError(tableObject, err.Error())
+			}
+			defer func() {
+				_ = h.Close()
+			}()
+			fp = h.FileForRead()
+		}
+
+		loadView, err := loadViewFromXlsxFile(fp, fileInfo, table.WithoutNull)
+		if err != nil {
+			_ = fileInfo.Close()
+			return nil, NewDataParsingError(tableObject, table.Path, err.Error())
+		}
+		loadView.ForUpdate = forUpdate
+		viewCache.Set(loadView)
+	}
+
+	var view *View
+	pathIdent := parser.Identifier{Literal: key}
+	if useInternalId {
+		view, _ = viewCache.GetWithInternalId(pathIdent, filter.Flags().CPU)
+	} else {
+		view, _ = viewCache.Get(pathIdent)
+	}
+	filter.describePlanStage(filePlanDetail(view.FileInfo.Path, cmd.XLSX, isCached))
+
+	if err = filter.Aliases.Add(tableName, key); err != nil {
+		return nil, err
+	}
+	view.Header.Update(tableName.Literal, nil)
+	return view, nil
+}
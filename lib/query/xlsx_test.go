@@ -0,0 +1,319 @@
+package query
+
+import (
+	"context"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/ternary"
+	"github.com/xuri/excelize/v2"
+)
+
+var parseXlsxCellRangeTests = []struct {
+	Range  string
+	Result xlsxCellRange
+	Error  string
+}{
+	{
+		Range:  "",
+		Result: xlsxCellRange{StartCol: 1, StartRow: 1},
+	},
+	{
+		Range:  "b3:c5",
+		Result: xlsxCellRange{StartCol: 2, StartRow: 3, EndCol: 3, EndRow: 5},
+	},
+	{
+		Range:  "C5:B3",
+		Result: xlsxCellRange{StartCol: 2, StartRow: 3, EndCol: 3, EndRow: 5},
+	},
+	{
+		Range: "B3",
+		Error: "invalid cell range \"B3\"",
+	},
+	{
+		Range: "B3:5C",
+		Error: "invalid cell range \"B3:5C\"",
+	},
+}
+
+func TestParseXlsxCellRange(t *testing.T) {
+	for _, v := range parseXlsxCellRangeTests {
+		result, err := parseXlsxCellRange(v.Range)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%q: unexpected error %q", v.Range, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%q: error %q, want error %q", v.Range, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%q: no error, want error %q", v.Range, v.Error)
+			continue
+		}
+		if result != v.Result {
+			t.Errorf("%q: result = %v, want %v", v.Range, result, v.Result)
+		}
+	}
+}
+
+var isXlsxDateFormatCodeTests = []struct {
+	Code   string
+	Result bool
+}{
+	{Code: "yyyy/mm/dd", Result: true},
+	{Code: "[$-409]h:mm AM/PM", Result: true},
+	{Code: "0.00", Result: false},
+	{Code: "#,##0;[Red]-#,##0", Result: false},
+	{Code: "\"Day\" 0", Result: false},
+	{Code: "0\\d", Result: false},
+}
+
+func TestIsXlsxDateFormatCode(t *testing.T) {
+	for _, v := range isXlsxDateFormatCodeTests {
+		if result := isXlsxDateFormatCode(v.Code); result != v.Result {
+			t.Errorf("%q: result = %t, want %t", v.Code, result, v.Result)
+		}
+	}
+}
+
+var xlsxQueryTests = []struct {
+	Name    string
+	Query   string
+	Columns []string
+	Result  [][]value.Primary
+	Error   string
+}{
+	{
+		Name:    "Select from Xlsx File",
+		Query:   "SELECT * FROM table8",
+		Columns: []string{"column1", "column2", "column3", "column4", "column5"},
+		Result: [][]value.Primary{
+			{value.NewInteger(1), value.NewString("str1"), value.NewFloat(1.5), value.NewBoolean(true), value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 0, GetTestLocation()))},
+			{value.NewInteger(2), value.NewNull(), value.NewFloat(-2.25), value.NewBoolean(false), value.NewDatetime(time.Date(2012, 2, 4, 0, 0, 0, 0, GetTestLocation()))},
+			{value.NewInteger(3), value.NewString("str3"), value.NewInteger(3), value.NewBoolean(true), value.NewNull()},
+		},
+	},
+	{
+		Name:    "Select from Xlsx Table Object with Sheet and Range",
+		Query:   "SELECT * FROM XLSX('table8.xlsx', 'sheet2', 'B3:C5')",
+		Columns: []string{"id", "name"},
+		Result: [][]value.Primary{
+			{value.NewInteger(1), value.NewString("a")},
+			{value.NewInteger(2), value.NewString("b")},
+		},
+	},
+	{
+		Name:    "Select from Xlsx Table Object without Header",
+		Query:   "SELECT * FROM XLSX('table8.xlsx', 'Sheet2', 'B4:C6', TRUE, TRUE)",
+		Columns: []string{"c1", "c2"},
+		Result: [][]value.Primary{
+			{value.NewInteger(1), value.NewString("a")},
+			{value.NewInteger(2), value.NewString("b")},
+		},
+	},
+	{
+		Name:    "Select from Xlsx Table Object with Without-Null",
+		Query:   "SELECT column2 FROM XLSX('table8.xlsx', 'Sheet1', NULL, FALSE, TRUE) WHERE column1 = 2",
+		Columns: []string{"column2"},
+		Result: [][]value.Primary{
+			{value.NewString("")},
+		},
+	},
+	{
+		Name:  "Xlsx Sheet Not Exist Error",
+		Query: "SELECT * FROM XLSX('table8.xlsx', 'notexist')",
+		Error: "[L:1 C:15] data parse error in file " + GetTestFilePath("table8.xlsx") + ": sheet \"notexist\" does not exist",
+	},
+	{
+		Name:  "Xlsx Invalid Range Error",
+		Query: "SELECT * FROM XLSX('table8.xlsx', 'Sheet1', 'A1')",
+		Error: "[L:1 C:15] invalid argument for XLSX: invalid cell range \"A1\"",
+	},
+	{
+		Name:  "Xlsx File Not Specified Error",
+		Query: "SELECT * FROM XLSX(NULL)",
+		Error: "[L:1 C:15] invalid argument for XLSX: file is not specified",
+	},
+	{
+		Name:  "Xlsx Invalid No-Header Value Error",
+		Query: "SELECT * FROM XLSX('table8.xlsx', 'Sheet1', '', 'abc')",
+		Error: "[L:1 C:15] invalid argument for XLSX: cannot be converted as a no-header value: 'abc'",
+	},
+	{
+		Name:  "Xlsx Arguments Length Error",
+		Query: "SELECT * FROM XLSX('table8.xlsx', 'Sheet1', '', TRUE, TRUE, TRUE)",
+		Error: "[L:1 C:15] table object XLSX takes at most 5 arguments",
+	},
+}
+
+func TestXlsxQuery(t *testing.T) {
+	session := newTestSession()
+	defer session.Close()
+
+	ctx := context.Background()
+
+	for _, v := range xlsxQueryTests {
+		rows, err := session.Query(ctx, v.Query)
+		if err == nil {
+			var result [][]value.Primary
+			for rows.Next() {
+				result = append(result, rows.Values())
+			}
+			err = rows.Err()
+			rows.Close()
+
+			if err == nil {
+				if !reflect.DeepEqual(rows.Columns(), v.Columns) {
+					t.Errorf("%s: columns = %q, want %q", v.Name, rows.Columns(), v.Columns)
+				}
+				if !reflect.DeepEqual(result, v.Result) {
+					t.Errorf("%s: result = %s, want %s", v.Name, result, v.Result)
+				}
+			}
+		}
+
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+		}
+	}
+}
+
+func TestXlsxUpdate(t *testing.T) {
+	path := GetTestFilePath("xlsx_update.xlsx")
+	if err := copyfile(path, GetTestFilePath("table8.xlsx")); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	defer func() {
+		_ = os.Remove(path)
+	}()
+
+	session := newTestSession()
+	defer session.Close()
+
+	ctx := context.Background()
+
+	if _, err := session.Exec(ctx, "UPDATE XLSX('xlsx_update.xlsx', 'Sheet2', 'B3:C5') SET name = 'z' WHERE id = 2"); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if err := session.Commit(ctx); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+
+	book, err := excelize.OpenFile(path)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	defer func() {
+		_ = book.Close()
+	}()
+
+	for _, v := range []struct {
+		Sheet string
+		Cell  string
+		Value string
+	}{
+		{Sheet: "Sheet1", Cell: "B2", Value: "str1"},
+		{Sheet: "Sheet2", Cell: "A1", Value: "Report"},
+		{Sheet: "Sheet2", Cell: "C4", Value: "a"},
+		{Sheet: "Sheet2", Cell: "C5", Value: "z"},
+		{Sheet: "Sheet2", Cell: "E10", Value: "note"},
+	} {
+		s, err := book.GetCellValue(v.Sheet, v.Cell)
+		if err != nil {
+			t.Fatalf("unexpected error %q", err)
+		}
+		if s != v.Value {
+			t.Errorf("%s!%s = %q, want %q", v.Sheet, v.Cell, s, v.Value)
+		}
+	}
+}
+
+func TestEncodeView_Xlsx(t *testing.T) {
+	view := &View{
+		Header: NewHeader("test", []string{"int", "float", "bool", "datetime", "string", "null"}),
+		RecordSet: []Record{
+			NewRecord([]value.Primary{
+				value.NewInteger(1),
+				value.NewFloat(2.5),
+				value.NewTernary(ternary.TRUE),
+				value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 0, GetTestLocation())),
+				value.NewString("str1"),
+				value.NewNull(),
+			}),
+			NewRecord([]value.Primary{
+				value.NewNull(),
+				value.NewFloat(-1.5),
+				value.NewTernary(ternary.UNKNOWN),
+				value.NewNull(),
+				value.NewString("2"),
+				value.NewNull(),
+			}),
+		},
+	}
+	expect := RecordSet{
+		NewRecord([]value.Primary{
+			value.NewInteger(1),
+			value.NewFloat(2.5),
+			value.NewBoolean(true),
+			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 0, GetTestLocation())),
+			value.NewString("str1"),
+			value.NewNull(),
+		}),
+		NewRecord([]value.Primary{
+			value.NewNull(),
+			value.NewFloat(-1.5),
+			value.NewNull(),
+			value.NewNull(),
+			value.NewString("2"),
+			value.NewNull(),
+		}),
+	}
+
+	fpath := GetTestFilePath("encode_xlsx.xlsx")
+	fp, err := os.Create(fpath)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	defer os.Remove(fpath)
+
+	err = EncodeView(fp, view, &FileInfo{Format: cmd.XLSX, Sheet: "Result"}, cmd.GetFlags())
+	fp.Close()
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+
+	fp, err = os.Open(fpath)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	defer fp.Close()
+
+	fileInfo := &FileInfo{Path: fpath, Format: cmd.XLSX}
+	result, err := loadViewFromXlsxFile(fp, fileInfo, false)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if fileInfo.Sheet != "Result" {
+		t.Errorf("sheet = %q, want %q", fileInfo.Sheet, "Result")
+	}
+	if !reflect.DeepEqual(result.Header.TableColumnNames(), view.Header.TableColumnNames()) {
+		t.Errorf("header = %q, want %q", result.Header.TableColumnNames(), view.Header.TableColumnNames())
+	}
+	if !reflect.DeepEqual(result.RecordSet, expect) {
+		t.Errorf("records = %s, want %s", result.RecordSet, expect)
+	}
+}
@@ -28,7 +28,7 @@ var CsvqSyntax = []Expression{
 			{
 				Name: "select_entity",
 				Group: []Grammar{
-					{Link("select_clause"), Option{Link("from_clause")}, Option{Link("where_clause")}, Option{Link("group_by_clause")}, Option{Link("having_clause")}},
+					{Link("select_clause"), Option{Link("from_clause")}, Option{Link("where_clause")}, Option{Link("group_by_clause")}, Option{Link("having_clause")}, Option{Link("window_clause")}},
 					{Link("select_set_entity"), Link("Set Operators"), Option{Keyword("ALL")}, Link("select_set_entity")},
 				},
 			},
@@ -92,6 +92,9 @@ var CsvqSyntax = []Expression{
 							{Link("table_entity"), Identifier("alias")},
 							{Link("table_entity"), Keyword("AS"), Identifier("alias")},
 							{Link("join")},
+							{Link("pivot_table")},
+							{Link("pivot_table"), Identifier("alias")},
+							{Link("pivot_table"), Keyword("AS"), Identifier("alias")},
 							{Keyword("DUAL")},
 							{Parentheses{Link("table")}},
 						},
@@ -124,13 +127,47 @@ var CsvqSyntax = []Expression{
 							{Keyword("USING"), Parentheses{ContinuousOption{Identifier("column_name")}}},
 						},
 					},
+					{
+						Name: "pivot_table",
+						Group: []Grammar{
+							{Link("table"), Keyword("PIVOT"), Parentheses{ContinuousOption{Link("aggregate_field")}, Keyword("FOR"), Identifier("column_name"), Keyword("IN"), Parentheses{ContinuousOption{Link("pivot_value")}}}},
+							{Link("table"), Keyword("PIVOT"), Parentheses{ContinuousOption{Link("aggregate_field")}, Keyword("FOR"), Identifier("column_name"), Keyword("IN"), Parentheses{Keyword("ANY")}}},
+							{Link("table"), Keyword("UNPIVOT"), Option{AnyOne{Keyword("INCLUDE"), Keyword("EXCLUDE")}, Keyword("NULLS")}, Parentheses{Identifier("value_column"), Keyword("FOR"), Identifier("key_column"), Keyword("IN"), Parentheses{ContinuousOption{Link("unpivot_column")}}}},
+						},
+					},
+					{
+						Name: "aggregate_field",
+						Group: []Grammar{
+							{Link("aggregate_function_call")},
+							{Link("aggregate_function_call"), Keyword("AS"), Identifier("alias")},
+						},
+					},
+					{
+						Name: "pivot_value",
+						Group: []Grammar{
+							{Link("value")},
+							{Link("value"), Keyword("AS"), Identifier("alias")},
+						},
+					},
+					{
+						Name: "unpivot_column",
+						Group: []Grammar{
+							{Identifier("column_name")},
+							{Identifier("column_name"), Keyword("AS"), Identifier("alias")},
+						},
+					},
 					{
 						Name: "table_object",
 						Group: []Grammar{
 							{Function{Name: "CSV", Args: []Element{String("delimiter"), Identifier("table_name"), Option{String("encoding"), Boolean("no_header"), Boolean("without_null")}}}},
 							{Function{Name: "FIXED", Args: []Element{String("delimiter_positions"), Identifier("table_name"), Option{String("encoding"), Boolean("no_header"), Boolean("without_null")}}}},
 							{Function{Name: "JSON", Args: []Element{String("json_query"), Identifier("table_name")}}},
+							{Function{Name: "JSONL", Args: []Element{Identifier("table_name")}}},
 							{Function{Name: "LTSV", Args: []Element{Identifier("table_name"), Option{String("encoding"), Boolean("without_null")}}}},
+							{Function{Name: "PARQUET", Args: []Element{Identifier("table_name")}}},
+							{Function{Name: "SQLITE", Args: []Element{String("database_file"), String("table_or_query")}}},
+							{Function{Name: "XLSX", Args: []Element{String("workbook_file"), Option{String("sheet"), String("cell_range"), Boolean("no_header"), Boolean("without_null")}}}},
+							{Function{Name: "FILES", Args: []Element{String("directory"), Option{String("pattern")}}}},
 						},
 					},
 					{
@@ -165,7 +202,18 @@ var CsvqSyntax = []Expression{
 					{
 						Name: "group_by_clause",
 						Group: []Grammar{
-							{Keyword("GROUP"), Keyword("BY"), ContinuousOption{Link("field")}},
+							{Keyword("GROUP"), Keyword("BY"), ContinuousOption{Link("grouping_element")}},
+						},
+					},
+					{
+						Name: "grouping_element",
+						Group: []Grammar{
+							{Link("value")},
+							{Parentheses{}},
+							{Parentheses{ContinuousOption{Link("value")}}},
+							{Keyword("ROLLUP"), Parentheses{ContinuousOption{Link("value")}}},
+							{Keyword("CUBE"), Parentheses{ContinuousOption{Link("value")}}},
+							{Keyword("GROUPING"), Keyword("SETS"), Parentheses{ContinuousOption{Link("grouping_element")}}},
 						},
 					},
 				},
@@ -181,6 +229,24 @@ var CsvqSyntax = []Expression{
 					},
 				},
 			},
+			{
+				Label: "WINDOW Clause",
+				Grammar: []Definition{
+					{
+						Name: "window_clause",
+						Group: []Grammar{
+							{Keyword("WINDOW"), ContinuousOption{Link("window_definition")}},
+						},
+					},
+					{
+						Name: "window_definition",
+						Group: []Grammar{
+							{Identifier("window_name"), Keyword("AS"), Parentheses{Option{Link("partition_clause")}, Option{Link("order_by_clause"), Option{Link("windowing_clause")}}}},
+							{Identifier("window_name"), Keyword("AS"), Parentheses{Identifier("base_window_name"), Option{Link("order_by_clause")}, Option{Link("windowing_clause")}}},
+						},
+					},
+				},
+			},
 			{
 				Label: "ORDER BY Clause",
 				Grammar: []Definition{
@@ -247,6 +313,24 @@ var CsvqSyntax = []Expression{
 			},
 		},
 	},
+	{
+		Label: "EXPLAIN Statement",
+		Grammar: []Definition{
+			{
+				Name: "explain_statement",
+				Group: []Grammar{
+					{Keyword("EXPLAIN"), Option{Keyword("ANALYZE")}, Link("select_statement")},
+				},
+				Description: Description{
+					Template: "" +
+						"Show the stages of the select query. " +
+						"If %s is specified, the query is executed and the number of rows and the elapsed time in seconds of each stage are shown. " +
+						"The plan is output as a nested object if %s is %s, otherwise as a table.",
+					Values: []Element{Keyword("ANALYZE"), Flag("@@FORMAT"), Keyword("JSON")},
+				},
+			},
+		},
+	},
 	{
 		Label: "INSERT Statement",
 		Grammar: []Definition{
@@ -307,14 +391,55 @@ var CsvqSyntax = []Expression{
 			},
 		},
 	},
+	{
+		Label: "MERGE Statement",
+		Grammar: []Definition{
+			{
+				Name: "merge_statement",
+				Group: []Grammar{
+					{Option{Link("with_clause")}, Link("merge_query")},
+				},
+			},
+			{
+				Name: "merge_query",
+				Group: []Grammar{
+					{Keyword("MERGE"), Keyword("INTO"), Identifier("table_name"), Keyword("USING"), Link("table"), Keyword("ON"), Link("condition"), Link("merge_when"), Option{Link("merge_when"), Token("...")}},
+				},
+			},
+			{
+				Name: "merge_when",
+				Group: []Grammar{
+					{Keyword("WHEN"), Keyword("MATCHED"), Option{Keyword("AND"), Link("condition")}, Keyword("THEN"), Keyword("UPDATE"), Keyword("SET"), ContinuousOption{Link("set_value")}},
+					{Keyword("WHEN"), Keyword("MATCHED"), Option{Keyword("AND"), Link("condition")}, Keyword("THEN"), Keyword("DELETE")},
+					{Keyword("WHEN"), Keyword("NOT"), Keyword("MATCHED"), Option{Keyword("AND"), Link("condition")}, Keyword("THEN"), Keyword("INSERT"), Option{Parentheses{ContinuousOption{Identifier("column_name")}}}, Keyword("VALUES"), Link("row_value")},
+				},
+			},
+		},
+	},
 	{
 		Label: "CREATE TABLE Statement",
 		Grammar: []Definition{
 			{
 				Name: "create_table_statement",
 				Group: []Grammar{
-					{Keyword("CREATE"), Keyword("TABLE"), Identifier("file_path"), Parentheses{ContinuousOption{Identifier("column_name")}}},
-					{Keyword("CREATE"), Keyword("TABLE"), Identifier("file_path"), Option{Parentheses{ContinuousOption{Identifier("column_name")}}}, Option{Keyword("AS")}, Link("select_query")},
+					{Keyword("CREATE"), Keyword("TABLE"), Identifier("file_path"), Parentheses{ContinuousOption{Link("table_column")}}},
+					{Keyword("CREATE"), Keyword("TABLE"), Identifier("file_path"), Option{Parentheses{ContinuousOption{Link("table_column")}}}, Option{Keyword("AS")}, Link("select_query")},
+				},
+			},
+			{
+				Name: "table_column",
+				Group: []Grammar{
+					{Identifier("column_name"), Option{Link("column_type")}, Option{Keyword("NOT"), Keyword("NULL")}},
+				},
+				Description: Description{
+					Template: "The column types and the %s constraints are saved in a schema file next to the table file.",
+					Values:   []Element{Keyword("NOT NULL")},
+				},
+			},
+			{
+				Name: "column_type",
+				Group: []Grammar{
+					{AnyOne{Keyword("STRING"), Keyword("INTEGER"), Keyword("FLOAT"), Keyword("BOOLEAN"), Keyword("DATETIME")}},
 				},
 			},
 		},
@@ -332,7 +457,7 @@ var CsvqSyntax = []Expression{
 			{
 				Name: "column_definition",
 				Group: []Grammar{
-					{Identifier("column_name"), Option{Keyword("DEFAULT"), Link("value")}},
+					{Identifier("column_name"), Option{Link("column_type")}, Option{Keyword("NOT"), Keyword("NULL")}, Option{Keyword("DEFAULT"), Link("value")}},
 				},
 				Description: Description{
 					Template: "%s is the default value.",
@@ -376,6 +501,33 @@ var CsvqSyntax = []Expression{
 			},
 		},
 	},
+	{
+		Label: "CREATE INDEX Statement",
+		Grammar: []Definition{
+			{
+				Name: "create_index_statement",
+				Group: []Grammar{
+					{Keyword("CREATE"), Keyword("INDEX"), Identifier("index_name"), Keyword("ON"), Identifier("file_path"), Parentheses{ContinuousOption{Identifier("column_name")}}},
+				},
+				Description: Description{
+					Template: "" +
+						"Write an index file of a CSV or TSV file. " +
+						"The index is used to read only the records that can satisfy the conditions in the where clause, and ignored after the file is modified by other programs.",
+				},
+			},
+		},
+	},
+	{
+		Label: "DROP INDEX Statement",
+		Grammar: []Definition{
+			{
+				Name: "drop_index_statement",
+				Group: []Grammar{
+					{Keyword("DROP"), Keyword("INDEX"), Identifier("index_name"), Keyword("ON"), Identifier("file_path")},
+				},
+			},
+		},
+	},
 	{
 		Label: "Variables",
 		Grammar: []Definition{
@@ -536,6 +688,7 @@ var CsvqSyntax = []Expression{
 				Name: "analytic_function_call",
 				Group: []Grammar{
 					{Identifier("function_name"), Parentheses{Option{Keyword("DISTINCT")}, Link("list_value"), Option{ContinuousOption{Link("argument")}}}, Keyword("OVER"), Parentheses{Option{Link("partition_clause")}, Option{Link("order_by_clause"), Option{Link("windowing_clause")}}}},
+					{Identifier("function_name"), Parentheses{Option{Keyword("DISTINCT")}, Link("list_value"), Option{ContinuousOption{Link("argument")}}}, Keyword("OVER"), Identifier("window_name")},
 				},
 			},
 		},
@@ -600,6 +753,26 @@ var CsvqSyntax = []Expression{
 					Values:   []Element{Token("1"), Integer("exit_code")},
 				},
 			},
+			{
+				Name: "try_catch_statement",
+				Group: []Grammar{
+					{Keyword("TRY"), Token("statements"), Keyword("CATCH"), Token("statements"), Keyword("END"), Keyword("TRY")},
+				},
+				Description: Description{
+					Template: "Errors raised in the %s block can be referred as %s, %s and %s in the %s block.",
+					Values:   []Element{Keyword("TRY"), Variable("@#ERROR_CODE"), Variable("@#ERROR_MESSAGE"), Variable("@#ERROR_LINE"), Keyword("CATCH")},
+				},
+			},
+			{
+				Name: "raise_statement",
+				Group: []Grammar{
+					{Keyword("RAISE")},
+				},
+				Description: Description{
+					Template: "Raise the error caught by the %s block again.",
+					Values:   []Element{Keyword("CATCH")},
+				},
+			},
 		},
 	},
 	{
@@ -881,6 +1054,8 @@ var CsvqSyntax = []Expression{
 				"%s  <type::%s>\n" +
 				"  > Query for JSON data.\n" +
 				"%s  <type::%s>\n" +
+				"  > Sheet name of Excel workbooks.\n" +
+				"%s  <type::%s>\n" +
 				"  > Character %s.\n" +
 				"%s  <type::%s>\n" +
 				"  > Import first line as a record.\n" +
@@ -924,6 +1099,7 @@ var CsvqSyntax = []Expression{
 				Flag("@@WAIT_TIMEOUT"), Float("float"),
 				Flag("@@DELIMITER"), String("string"),
 				Flag("@@JSON_QUERY"), String("string"),
+				Flag("@@SHEET"), String("string"),
 				Flag("@@ENCODING"), String("string"), Link("Encoding"),
 				Flag("@@NO_HEADER"), Boolean("boolean"),
 				Flag("@@WITHOUT_NULL"), Boolean("boolean"),
@@ -1009,6 +1185,12 @@ var CsvqSyntax = []Expression{
 				"  > Current working directory.\n" +
 				"%s  <type::%s>\n" +
 				"  > Version of csvq.\n" +
+				"%s  <type::%s>\n" +
+				"  > Code of the error caught in the CATCH block.\n" +
+				"%s  <type::%s>\n" +
+				"  > Message of the error caught in the CATCH block.\n" +
+				"%s  <type::%s>\n" +
+				"  > Line number where the error caught in the CATCH block occurred.\n" +
 				"",
 			Values: []Element{
 				Variable("@#UNCOMMITTED"), Boolean("boolean"),
@@ -1018,6 +1200,9 @@ var CsvqSyntax = []Expression{
 				Variable("@#LOADED_TABLES"), Integer("integer"),
 				Variable("@#WORKING_DIRECTORY"), String("string"),
 				Variable("@#VERSION"), String("string"),
+				Variable("@#ERROR_CODE"), Integer("integer"),
+				Variable("@#ERROR_MESSAGE"), String("string"),
+				Variable("@#ERROR_LINE"), Integer("integer"),
 			},
 		},
 	},
@@ -1083,8 +1268,8 @@ var CsvqSyntax = []Expression{
 			{
 				Name: "json_object_field",
 				Group: []Grammar{
-					{Identifier("field_name")},
-					{Identifier("field_name"), Keyword("as"), Identifier("alias")},
+					{Identifier("column_name")},
+					{Identifier("column_name"), Keyword("as"), Identifier("alias")},
 				},
 			},
 		},
@@ -1122,6 +1307,7 @@ var CsvqSyntax = []Expression{
 						"  |            | BETWEEN             | n/a           |\n" +
 						"  |            | IN                  | n/a           |\n" +
 						"  |            | LIKE                | n/a           |\n" +
+						"  |            | REGEXP              | n/a           |\n" +
 						"  |          6 | NOT                 | Right-to-Left |\n" +
 						"  |          7 | AND                 | Left-to-Right |\n" +
 						"  |          8 | OR                  | Left-to-Right |\n" +
@@ -1244,6 +1430,17 @@ var CsvqSyntax = []Expression{
 							Values: []Element{String("str"), String("pattern"), String("str"), Ternary("UNKNOWN"), String("pattern"), Token("%")},
 						},
 					},
+					{
+						Name: "regexp",
+						Group: []Grammar{
+							{String("str"), Option{Keyword("NOT")}, Keyword("REGEXP"), String("pattern")},
+						},
+						Description: Description{
+							Template: "Check if %s matches the regular expression %s. If %s or %s is null, then returns %s. " +
+								"The syntax of the regular expressions is the same as the RE2 syntax. Matching is case-sensitive unless the flag (?i) is specified in %s.",
+							Values: []Element{String("str"), String("pattern"), String("str"), String("pattern"), Ternary("UNKNOWN"), String("pattern")},
+						},
+					},
 					{
 						Name: "in",
 						Group: []Grammar{
@@ -2026,6 +2223,41 @@ var CsvqSyntax = []Expression{
 						},
 						Description: Description{Template: "Returns the string that is replaced all occurrences of %s with %s in %s.", Values: []Element{String("old"), String("new"), String("str")}},
 					},
+					{
+						Name: "regexp_match",
+						Group: []Grammar{
+							{Function{Name: "REGEXP_MATCH", Args: []Element{String("str"), String("pattern"), Option{String("flags")}}, Return: Return("ternary")}},
+						},
+						Description: Description{Template: "Returns %s if %s matches the regular expression %s. %s is a combination of the characters i, m, s and U.", Values: []Element{Ternary("TRUE"), String("str"), String("pattern"), String("flags")}},
+					},
+					{
+						Name: "regexp_find",
+						Group: []Grammar{
+							{Function{Name: "REGEXP_FIND", Args: []Element{String("str"), String("pattern"), Option{String("flags")}}, Return: Return("string")}},
+						},
+						Description: Description{Template: "Returns the first substring of %s that matches %s. If no substring matches, returns null.", Values: []Element{String("str"), String("pattern")}},
+					},
+					{
+						Name: "regexp_find_all",
+						Group: []Grammar{
+							{Function{Name: "REGEXP_FIND_ALL", Args: []Element{String("str"), String("pattern"), Option{String("flags")}}, Return: Return("string")}},
+						},
+						Description: Description{Template: "Returns all substrings of %s that match %s as a JSON array. If no substring matches, returns null.", Values: []Element{String("str"), String("pattern")}},
+					},
+					{
+						Name: "regexp_find_submatches",
+						Group: []Grammar{
+							{Function{Name: "REGEXP_FIND_SUBMATCHES", Args: []Element{String("str"), String("pattern"), Option{String("flags")}}, Return: Return("string")}},
+						},
+						Description: Description{Template: "Returns the first match of %s in %s and the matches of its capturing groups as a JSON array. If no substring matches, returns null.", Values: []Element{String("pattern"), String("str")}},
+					},
+					{
+						Name: "regexp_replace",
+						Group: []Grammar{
+							{Function{Name: "REGEXP_REPLACE", Args: []Element{String("str"), String("pattern"), String("replacement"), Option{String("flags")}}, Return: Return("string")}},
+						},
+						Description: Description{Template: "Returns the string that is replaced all matches of %s with %s in %s. In %s, $1 or ${name} represents the text of the corresponding capturing group.", Values: []Element{String("pattern"), String("replacement"), String("str"), String("replacement")}},
+					},
 					{
 						Name: "format",
 						Group: []Grammar{
@@ -2171,6 +2403,26 @@ var CsvqSyntax = []Expression{
 							Values: []Element{String("command"), String("command")},
 						},
 					},
+					{
+						Name: "filename",
+						Group: []Grammar{
+							{Function{Name: "FILENAME", Args: []Element{Option{Identifier("table_name")}}, Return: Return("string")}},
+						},
+						Description: Description{
+							Template: "Returns the path of the file from which the current record was loaded. " +
+								"If the table was not loaded from multiple files, then returns null.",
+						},
+					},
+					{
+						Name: "line_number",
+						Group: []Grammar{
+							{Function{Name: "LINE_NUMBER", Args: []Element{Option{Identifier("table_name")}}, Return: Return("integer")}},
+						},
+						Description: Description{
+							Template: "Returns the position of the current record in the file from which the record was loaded. " +
+								"If the table was not loaded from multiple files, then returns null.",
+						},
+					},
 				},
 			},
 			{
@@ -2255,6 +2507,138 @@ var CsvqSyntax = []Expression{
 							Values: []Element{Link("value"), Null("NULL"), Link("value"), Keyword("DATETIME")},
 						},
 					},
+					{
+						Name: "stddev_pop",
+						Group: []Grammar{
+							{Function{Name: "STDDEV_POP", Args: []Element{Option{Keyword("DISTINCT")}, Link("value")}, Return: Return("float or integer")}},
+						},
+						Description: Description{
+							Template: "Returns the population standard deviation of float values of %s. " +
+								"If all values are null, then returns %s.",
+							Values: []Element{Link("value"), Null("NULL")},
+						},
+					},
+					{
+						Name: "stddev_samp",
+						Group: []Grammar{
+							{Function{Name: "STDDEV_SAMP", Args: []Element{Option{Keyword("DISTINCT")}, Link("value")}, Return: Return("float or integer")}},
+						},
+						Description: Description{
+							Template: "Returns the sample standard deviation of float values of %s. " +
+								"If there are less than two non-null values, then returns %s.",
+							Values: []Element{Link("value"), Null("NULL")},
+						},
+					},
+					{
+						Name: "var_pop",
+						Group: []Grammar{
+							{Function{Name: "VAR_POP", Args: []Element{Option{Keyword("DISTINCT")}, Link("value")}, Return: Return("float or integer")}},
+						},
+						Description: Description{
+							Template: "Returns the population variance of float values of %s. " +
+								"If all values are null, then returns %s.",
+							Values: []Element{Link("value"), Null("NULL")},
+						},
+					},
+					{
+						Name: "var_samp",
+						Group: []Grammar{
+							{Function{Name: "VAR_SAMP", Args: []Element{Option{Keyword("DISTINCT")}, Link("value")}, Return: Return("float or integer")}},
+						},
+						Description: Description{
+							Template: "Returns the sample variance of float values of %s. " +
+								"If there are less than two non-null values, then returns %s.",
+							Values: []Element{Link("value"), Null("NULL")},
+						},
+					},
+					{
+						Name: "mode",
+						Group: []Grammar{
+							{Function{Name: "MODE", Args: []Element{Link("value")}, Return: Return("primitive type")}},
+						},
+						Description: Description{
+							Template: "Returns the most frequent value of non-null values of %s. " +
+								"If there are multiple most frequent values, then returns the one that appears first.",
+							Values: []Element{Link("value")},
+						},
+					},
+					{
+						Name: "corr",
+						Group: []Grammar{
+							{Function{Name: "CORR", Args: []Element{Link("y"), Link("x")}, Return: Return("float or integer")}},
+						},
+						Description: Description{
+							Template: "Returns the correlation coefficient of the pairs of float values of %s and %s. " +
+								"Pairs in which either value is null are ignored.",
+							Values: []Element{Link("y"), Link("x")},
+						},
+					},
+					{
+						Name: "covar_pop",
+						Group: []Grammar{
+							{Function{Name: "COVAR_POP", Args: []Element{Link("y"), Link("x")}, Return: Return("float or integer")}},
+						},
+						Description: Description{
+							Template: "Returns the population covariance of the pairs of float values of %s and %s. " +
+								"Pairs in which either value is null are ignored.",
+							Values: []Element{Link("y"), Link("x")},
+						},
+					},
+					{
+						Name: "covar_samp",
+						Group: []Grammar{
+							{Function{Name: "COVAR_SAMP", Args: []Element{Link("y"), Link("x")}, Return: Return("float or integer")}},
+						},
+						Description: Description{
+							Template: "Returns the sample covariance of the pairs of float values of %s and %s. " +
+								"Pairs in which either value is null are ignored.",
+							Values: []Element{Link("y"), Link("x")},
+						},
+					},
+					{
+						Name: "regr_slope",
+						Group: []Grammar{
+							{Function{Name: "REGR_SLOPE", Args: []Element{Link("y"), Link("x")}, Return: Return("float or integer")}},
+						},
+						Description: Description{
+							Template: "Returns the slope of the least-squares regression line fitted to the pairs of float values of %s and %s. " +
+								"Pairs in which either value is null are ignored.",
+							Values: []Element{Link("y"), Link("x")},
+						},
+					},
+					{
+						Name: "regr_intercept",
+						Group: []Grammar{
+							{Function{Name: "REGR_INTERCEPT", Args: []Element{Link("y"), Link("x")}, Return: Return("float or integer")}},
+						},
+						Description: Description{
+							Template: "Returns the y-intercept of the least-squares regression line fitted to the pairs of float values of %s and %s. " +
+								"Pairs in which either value is null are ignored.",
+							Values: []Element{Link("y"), Link("x")},
+						},
+					},
+					{
+						Name: "percentile_cont",
+						Group: []Grammar{
+							{Function{Name: "PERCENTILE_CONT", Args: []Element{Float("fraction")}, AfterArgs: []Element{Keyword("WITHIN"), Keyword("GROUP"), Parentheses{Keyword("ORDER"), Keyword("BY"), Link("value"), Option{AnyOne{Keyword("ASC"), Keyword("DESC")}}}}, Return: Return("float or integer")}},
+						},
+						Description: Description{
+							Template: "Returns the value at the position specified by %s in the float values of %s sorted by the order. " +
+								"If the position lies between two values, the result is linearly interpolated.",
+							Values: []Element{Float("fraction"), Link("value")},
+						},
+					},
+					{
+						Name: "percentile_disc",
+						Group: []Grammar{
+							{Function{Name: "PERCENTILE_DISC", Args: []Element{Float("fraction")}, AfterArgs: []Element{Keyword("WITHIN"), Keyword("GROUP"), Parentheses{Keyword("ORDER"), Keyword("BY"), Link("value"), Option{AnyOne{Keyword("ASC"), Keyword("DESC")}}}}, Return: Return("primitive type")}},
+						},
+						Description: Description{
+							Template: "Returns the first value of the non-null values of %s sorted by the order " +
+								"whose cumulative distribution is greater than or equal to %s.",
+							Values: []Element{Link("value"), Float("fraction")},
+						},
+					},
 					{
 						Name: "listagg",
 						Group: []Grammar{
@@ -2280,6 +2664,17 @@ var CsvqSyntax = []Expression{
 							Values: []Element{Link("value"), Link("order_by_clause")},
 						},
 					},
+					{
+						Name: "grouping",
+						Group: []Grammar{
+							{Function{Name: "GROUPING", Args: []Element{ContinuousOption{Identifier("column_name")}}, Return: Return("integer")}},
+						},
+						Description: Description{
+							Template: "Returns 1 if %s is not included in the grouping set of the current row, otherwise returns 0. " +
+								"If multiple fields are specified, returns an integer bit mask in which the last field corresponds to the lowest bit.",
+							Values: []Element{Identifier("column_name")},
+						},
+					},
 				},
 			},
 			{
@@ -2463,6 +2858,138 @@ var CsvqSyntax = []Expression{
 							Values: []Element{Link("value"), Null("NULL"), Link("value"), Keyword("DATETIME")},
 						},
 					},
+					{
+						Name: "stddev_pop",
+						Group: []Grammar{
+							{Function{Name: "STDDEV_POP", Args: []Element{Option{Keyword("DISTINCT")}, Link("value")}, AfterArgs: []Element{Keyword("OVER"), Parentheses{Option{Link("partition_clause")}, Option{Link("order_by_clause"), Option{Link("windowing_clause")}}}}, Return: Return("float or integer")}},
+						},
+						Description: Description{
+							Template: "Returns the population standard deviation of float values of %s. " +
+								"If all values are null, then returns %s.",
+							Values: []Element{Link("value"), Null("NULL")},
+						},
+					},
+					{
+						Name: "stddev_samp",
+						Group: []Grammar{
+							{Function{Name: "STDDEV_SAMP", Args: []Element{Option{Keyword("DISTINCT")}, Link("value")}, AfterArgs: []Element{Keyword("OVER"), Parentheses{Option{Link("partition_clause")}, Option{Link("order_by_clause"), Option{Link("windowing_clause")}}}}, Return: Return("float or integer")}},
+						},
+						Description: Description{
+							Template: "Returns the sample standard deviation of float values of %s. " +
+								"If there are less than two non-null values, then returns %s.",
+							Values: []Element{Link("value"), Null("NULL")},
+						},
+					},
+					{
+						Name: "var_pop",
+						Group: []Grammar{
+							{Function{Name: "VAR_POP", Args: []Element{Option{Keyword("DISTINCT")}, Link("value")}, AfterArgs: []Element{Keyword("OVER"), Parentheses{Option{Link("partition_clause")}, Option{Link("order_by_clause"), Option{Link("windowing_clause")}}}}, Return: Return("float or integer")}},
+						},
+						Description: Description{
+							Template: "Returns the population variance of float values of %s. " +
+								"If all values are null, then returns %s.",
+							Values: []Element{Link("value"), Null("NULL")},
+						},
+					},
+					{
+						Name: "var_samp",
+						Group: []Grammar{
+							{Function{Name: "VAR_SAMP", Args: []Element{Option{Keyword("DISTINCT")}, Link("value")}, AfterArgs: []Element{Keyword("OVER"), Parentheses{Option{Link("partition_clause")}, Option{Link("order_by_clause"), Option{Link("windowing_clause")}}}}, Return: Return("float or integer")}},
+						},
+						Description: Description{
+							Template: "Returns the sample variance of float values of %s. " +
+								"If there are less than two non-null values, then returns %s.",
+							Values: []Element{Link("value"), Null("NULL")},
+						},
+					},
+					{
+						Name: "mode",
+						Group: []Grammar{
+							{Function{Name: "MODE", Args: []Element{Link("value")}, AfterArgs: []Element{Keyword("OVER"), Parentheses{Option{Link("partition_clause")}, Option{Link("order_by_clause"), Option{Link("windowing_clause")}}}}, Return: Return("primitive type")}},
+						},
+						Description: Description{
+							Template: "Returns the most frequent value of non-null values of %s. " +
+								"If there are multiple most frequent values, then returns the one that appears first.",
+							Values: []Element{Link("value")},
+						},
+					},
+					{
+						Name: "corr",
+						Group: []Grammar{
+							{Function{Name: "CORR", Args: []Element{Link("y"), Link("x")}, AfterArgs: []Element{Keyword("OVER"), Parentheses{Option{Link("partition_clause")}, Option{Link("order_by_clause"), Option{Link("windowing_clause")}}}}, Return: Return("float or integer")}},
+						},
+						Description: Description{
+							Template: "Returns the correlation coefficient of the pairs of float values of %s and %s. " +
+								"Pairs in which either value is null are ignored.",
+							Values: []Element{Link("y"), Link("x")},
+						},
+					},
+					{
+						Name: "covar_pop",
+						Group: []Grammar{
+							{Function{Name: "COVAR_POP", Args: []Element{Link("y"), Link("x")}, AfterArgs: []Element{Keyword("OVER"), Parentheses{Option{Link("partition_clause")}, Option{Link("order_by_clause"), Option{Link("windowing_clause")}}}}, Return: Return("float or integer")}},
+						},
+						Description: Description{
+							Template: "Returns the population covariance of the pairs of float values of %s and %s. " +
+								"Pairs in which either value is null are ignored.",
+							Values: []Element{Link("y"), Link("x")},
+						},
+					},
+					{
+						Name: "covar_samp",
+						Group: []Grammar{
+							{Function{Name: "COVAR_SAMP", Args: []Element{Link("y"), Link("x")}, AfterArgs: []Element{Keyword("OVER"), Parentheses{Option{Link("partition_clause")}, Option{Link("order_by_clause"), Option{Link("windowing_clause")}}}}, Return: Return("float or integer")}},
+						},
+						Description: Description{
+							Template: "Returns the sample covariance of the pairs of float values of %s and %s. " +
+								"Pairs in which either value is null are ignored.",
+							Values: []Element{Link("y"), Link("x")},
+						},
+					},
+					{
+						Name: "regr_slope",
+						Group: []Grammar{
+							{Function{Name: "REGR_SLOPE", Args: []Element{Link("y"), Link("x")}, AfterArgs: []Element{Keyword("OVER"), Parentheses{Option{Link("partition_clause")}, Option{Link("order_by_clause"), Option{Link("windowing_clause")}}}}, Return: Return("float or integer")}},
+						},
+						Description: Description{
+							Template: "Returns the slope of the least-squares regression line fitted to the pairs of float values of %s and %s. " +
+								"Pairs in which either value is null are ignored.",
+							Values: []Element{Link("y"), Link("x")},
+						},
+					},
+					{
+						Name: "regr_intercept",
+						Group: []Grammar{
+							{Function{Name: "REGR_INTERCEPT", Args: []Element{Link("y"), Link("x")}, AfterArgs: []Element{Keyword("OVER"), Parentheses{Option{Link("partition_clause")}, Option{Link("order_by_clause"), Option{Link("windowing_clause")}}}}, Return: Return("float or integer")}},
+						},
+						Description: Description{
+							Template: "Returns the y-intercept of the least-squares regression line fitted to the pairs of float values of %s and %s. " +
+								"Pairs in which either value is null are ignored.",
+							Values: []Element{Link("y"), Link("x")},
+						},
+					},
+					{
+						Name: "percentile_cont",
+						Group: []Grammar{
+							{Function{Name: "PERCENTILE_CONT", Args: []Element{Float("fraction")}, AfterArgs: []Element{Keyword("WITHIN"), Keyword("GROUP"), Parentheses{Keyword("ORDER"), Keyword("BY"), Link("value"), Option{AnyOne{Keyword("ASC"), Keyword("DESC")}}}, Keyword("OVER"), Parentheses{Option{Link("partition_clause")}}}, Return: Return("float or integer")}},
+						},
+						Description: Description{
+							Template: "Returns the value at the position specified by %s in the float values of %s sorted by the order. " +
+								"If the position lies between two values, the result is linearly interpolated.",
+							Values: []Element{Float("fraction"), Link("value")},
+						},
+					},
+					{
+						Name: "percentile_disc",
+						Group: []Grammar{
+							{Function{Name: "PERCENTILE_DISC", Args: []Element{Float("fraction")}, AfterArgs: []Element{Keyword("WITHIN"), Keyword("GROUP"), Parentheses{Keyword("ORDER"), Keyword("BY"), Link("value"), Option{AnyOne{Keyword("ASC"), Keyword("DESC")}}}, Keyword("OVER"), Parentheses{Option{Link("partition_clause")}}}, Return: Return("primitive type")}},
+						},
+						Description: Description{
+							Template: "Returns the first value of the non-null values of %s sorted by the order " +
+								"whose cumulative distribution is greater than or equal to %s.",
+							Values: []Element{Link("value"), Float("fraction")},
+						},
+					},
 					{
 						Name: "listagg",
 						Group: []Grammar{
@@ -2504,15 +3031,21 @@ var CsvqSyntax = []Expression{
 							{
 								Name: "windowing_clause",
 								Group: []Grammar{
-									{Keyword("ROWS"), Link("window_position")},
-									{Keyword("ROWS"), Keyword("BETWEEN"), Link("window_frame_low"), Keyword("AND"), Link("window_frame_high")},
+									{Link("frame_unit"), Link("window_position"), Option{Link("frame_exclusion")}},
+									{Link("frame_unit"), Keyword("BETWEEN"), Link("window_frame_low"), Keyword("AND"), Link("window_frame_high"), Option{Link("frame_exclusion")}},
+								},
+							},
+							{
+								Name: "frame_unit",
+								Group: []Grammar{
+									{AnyOne{Keyword("ROWS"), Keyword("RANGE"), Keyword("GROUPS")}},
 								},
 							},
 							{
 								Name: "window_position",
 								Group: []Grammar{
 									{Keyword("UNBOUNDED"), Keyword("PRECEDING")},
-									{Integer("offset"), Keyword("PRECEDING")},
+									{Link("offset"), Keyword("PRECEDING")},
 									{Keyword("CURRENT"), Keyword("ROW")},
 								},
 							},
@@ -2520,8 +3053,8 @@ var CsvqSyntax = []Expression{
 								Name: "window_frame_low",
 								Group: []Grammar{
 									{Keyword("UNBOUNDED"), Keyword("PRECEDING")},
-									{Integer("offset"), Keyword("PRECEDING")},
-									{Integer("offset"), Keyword("FOLLOWING")},
+									{Link("offset"), Keyword("PRECEDING")},
+									{Link("offset"), Keyword("FOLLOWING")},
 									{Keyword("CURRENT"), Keyword("ROW")},
 								},
 							},
@@ -2529,11 +3062,31 @@ var CsvqSyntax = []Expression{
 								Name: "window_frame_high",
 								Group: []Grammar{
 									{Keyword("UNBOUNDED"), Keyword("FOLLOWING")},
-									{Integer("offset"), Keyword("PRECEDING")},
-									{Integer("offset"), Keyword("FOLLOWING")},
+									{Link("offset"), Keyword("PRECEDING")},
+									{Link("offset"), Keyword("FOLLOWING")},
 									{Keyword("CURRENT"), Keyword("ROW")},
 								},
 							},
+							{
+								Name: "offset",
+								Group: []Grammar{
+									{Float("number")},
+									{Keyword("INTERVAL"), Integer("interval_value"), Identifier("interval_unit")},
+								},
+								Description: Description{
+									Template: "%s must be an integer for %s and %s. %s is one of YEAR, MONTH, DAY, HOUR, MINUTE, SECOND, MILLISECOND, MICROSECOND and NANOSECOND, and can be used only for %s.",
+									Values:   []Element{Link("offset"), Keyword("ROWS"), Keyword("GROUPS"), Identifier("interval_unit"), Keyword("RANGE")},
+								},
+							},
+							{
+								Name: "frame_exclusion",
+								Group: []Grammar{
+									{Keyword("EXCLUDE"), Keyword("CURRENT"), Keyword("ROW")},
+									{Keyword("EXCLUDE"), Keyword("GROUP")},
+									{Keyword("EXCLUDE"), Keyword("TIES")},
+									{Keyword("EXCLUDE"), Keyword("NO"), Keyword("OTHERS")},
+								},
+							},
 						},
 					},
 				},
@@ -2659,20 +3212,20 @@ var CsvqSyntax = []Expression{
 				Name: "Reserved Words",
 				Description: Description{
 					Template: "" +
-						"ABSOLUTE ADD AFTER AGGREGATE ALTER ALL AND ANY AS ASC AVG BEFORE BEGIN " +
-						"BETWEEN BREAK BY CASE CHDIR CLOSE COMMIT CONTINUE COUNT CREATE CROSS " +
+						"ABSOLUTE ADD AFTER AGGREGATE ALTER ALL ANALYZE AND ANY AS ASC AVG BEFORE BEGIN " +
+						"BETWEEN BREAK BY CASE CATCH CHDIR CLOSE COMMIT CONTINUE CORR COUNT COVAR_POP COVAR_SAMP CREATE CROSS CUBE " +
 						"CUME_DIST CURRENT CURSOR DECLARE DEFAULT DELETE DENSE_RANK DESC DISPOSE " +
 						"DISTINCT DO DROP DUAL ECHO ELSE ELSEIF END EXCEPT EXECUTE EXISTS " +
-						"EXIT FALSE FETCH FIRST FIRST_VALUE FOLLOWING FOR FROM FULL FUNCTION " +
-						"GROUP HAVING IF IGNORE IN INNER INSERT INTERSECT INTO IS JOIN " +
+						"EXIT EXPLAIN FALSE FETCH FILES FIRST FIRST_VALUE FOLLOWING FOR FROM FULL FUNCTION " +
+						"GROUP GROUPING HAVING IF IGNORE IN INDEX INNER INSERT INTERSECT INTO IS JOIN " +
 						"JSON_AGG JSON_OBJECT JSON_ROW JSON_TABLE LAG LAST LAST_VALUE LEAD " +
-						"LEFT LIKE LIMIT LISTAGG MAX MEDIAN MIN NATURAL NEXT NOT NTH_VALUE " +
+						"LEFT LIKE LIMIT LISTAGG MAX MEDIAN MERGE MIN MODE NATURAL NEXT NOT NTH_VALUE " +
 						"NTILE NULL OFFSET ON OPEN OR ORDER OUTER OVER PARTITION PERCENT " +
-						"PERCENT_RANK PRECEDING PRINT PRINTF PRIOR PWD RANGE RANK RECURSIVE " +
-						"RELATIVE RELOAD REMOVE RENAME RETURN RIGHT ROLLBACK ROW ROW_NUMBER " +
-						"SELECT SEPARATOR SET SHOW SOURCE STDIN SUM SYNTAX TABLE THEN TO TRIGGER TRUE " +
-						"UNBOUNDED UNION UNKNOWN UNSET UPDATE USING VALUES VAR VIEW WHEN WHERE " +
-						"WHILE WITH WITHIN",
+						"PERCENT_RANK PERCENTILE_CONT PERCENTILE_DISC PIVOT PRECEDING PRINT PRINTF PRIOR PWD RAISE RANGE RANK RECURSIVE REGEXP " +
+						"REGR_INTERCEPT REGR_SLOPE RELATIVE RELOAD REMOVE RENAME RETURN RIGHT ROLLBACK ROLLUP ROW ROW_NUMBER " +
+						"SELECT SEPARATOR SET SHOW SOURCE SQLITE STDDEV_POP STDDEV_SAMP STDIN SUM SYNTAX TABLE THEN TO TRIGGER TRUE TRY " +
+						"UNBOUNDED UNION UNKNOWN UNPIVOT UNSET UPDATE USING VALUES VAR VAR_POP VAR_SAMP VIEW WHEN WHERE " +
+						"WHILE WINDOW WITH WITHIN XLSX",
 				},
 			},
 		},
@@ -2845,18 +3398,21 @@ var CsvqSyntax = []Expression{
 				Description: Description{
 					Template: "" +
 						"```\n" +
-						"+-------+------------------------------------------+\n" +
-						"| Value |                  Format                  |\n" +
-						"+-------+------------------------------------------+\n" +
-						"| CSV   | Character separated values               |\n" +
-						"| TSV   | Tab separated values                     |\n" +
-						"| FIXED | Fixed-Length Format                      |\n" +
-						"| JSON  | JSON Format                              |\n" +
-						"| LTSV  | Labeled Tab-separated Values             |\n" +
-						"| GFM   | Text Table for GitHub Flavored Markdown  |\n" +
-						"| ORG   | Text Table for Emacs Org-Mode            |\n" +
-						"| TEXT  | Text Table for console                   |\n" +
-						"+-------+------------------------------------------+\n" +
+						"+---------+------------------------------------------+\n" +
+						"|  Value  |                  Format                  |\n" +
+						"+---------+------------------------------------------+\n" +
+						"| CSV     | Character separated values               |\n" +
+						"| TSV     | Tab separated values                     |\n" +
+						"| FIXED   | Fixed-Length Format                      |\n" +
+						"| JSON    | JSON Format                              |\n" +
+						"| JSONL   | JSON Lines Format                        |\n" +
+						"| LTSV    | Labeled Tab-separated Values             |\n" +
+						"| GFM     | Text Table for GitHub Flavored Markdown  |\n" +
+						"| ORG     | Text Table for Emacs Org-Mode            |\n" +
+						"| TEXT    | Text Table for console                   |\n" +
+						"| PARQUET | Apache Parquet                           |\n" +
+						"| XLSX    | Excel Workbook                           |\n" +
+						"+---------+------------------------------------------+\n" +
 						"```",
 				},
 			},
@@ -6,6 +6,7 @@ import (
 	"math"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/mithrandie/csvq/lib/cmd"
@@ -15,12 +16,20 @@ import (
 
 type DatetimeFormatMap map[string]string
 
+var datetimeFormatsMtx = &sync.RWMutex{}
+
 func (m DatetimeFormatMap) Get(s string) string {
-	if f, ok := m[s]; ok {
+	datetimeFormatsMtx.RLock()
+	f, ok := m[s]
+	datetimeFormatsMtx.RUnlock()
+	if ok {
 		return f
 	}
-	f := ConvertDatetimeFormat(s)
+
+	f = ConvertDatetimeFormat(s)
+	datetimeFormatsMtx.Lock()
 	m[s] = f
+	datetimeFormatsMtx.Unlock()
 	return f
 }
 
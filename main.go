@@ -2,10 +2,12 @@ package main
 
 import (
 	"errors"
+	"flag"
 	"fmt"
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/mithrandie/csvq/lib/action"
 	"github.com/mithrandie/csvq/lib/cmd"
@@ -67,25 +69,67 @@ func main() {
 		cli.StringFlag{
 			Name:  "delimiter, d",
 			Value: ",",
-			Usage: "field delimiter for CSV, or delimiter positions for Fixed-Length Format",
+			Usage: "field delimiter for CSV, delimiter positions for Fixed-Length Format, or AUTO to detect the field delimiter for CSV",
 		},
 		cli.StringFlag{
 			Name:  "json-query, j",
 			Usage: "`QUERY` for JSON data passed from standard input",
 		},
+		cli.StringFlag{
+			Name:  "sheet, k",
+			Usage: "`NAME` of the sheet to be loaded from or written to Excel workbooks",
+		},
 		cli.StringFlag{
 			Name:  "encoding, e",
 			Value: "UTF8",
-			Usage: "file encoding. one of: UTF8|SJIS",
+			Usage: "file encoding. one of: UTF8|UTF8BOM|UTF16LE|UTF16BE|SJIS|EUCJP|ISO2022JP|LATIN1|WINDOWS1252|AUTO",
+		},
+		noHeaderFlag{
+			BoolFlag: cli.BoolFlag{
+				Name:  "no-header, n",
+				Usage: "import the first line as a record. pass AUTO as --no-header=AUTO to detect the header",
+			},
+			Value: &cmd.NoHeaderValue{},
 		},
 		cli.BoolFlag{
-			Name:  "no-header, n",
-			Usage: "import the first line as a record",
+			Name:  "detect-header",
+			Usage: "detect whether the first line of CSV is a header",
 		},
 		cli.BoolFlag{
 			Name:  "without-null, a",
 			Usage: "parse empty fields as empty strings",
 		},
+		cli.StringFlag{
+			Name:  "quote-char",
+			Value: "\"",
+			Usage: "quotation character for CSV, or AUTO to detect it",
+		},
+		cli.StringFlag{
+			Name:  "escape-style",
+			Value: "DOUBLE",
+			Usage: "escape style of quotation characters in CSV. one of: DOUBLE|BACKSLASH",
+		},
+		cli.BoolFlag{
+			Name:  "lazy-quotes",
+			Usage: "allow unescaped quotation characters in quoted fields of CSV",
+		},
+		cli.StringFlag{
+			Name:  "comment-prefix",
+			Usage: "skip lines beginning with `PREFIX` in CSV",
+		},
+		cli.IntFlag{
+			Name:  "skip-lines",
+			Usage: "skip the first `N` lines of CSV",
+		},
+		cli.StringFlag{
+			Name:  "on-parse-error",
+			Value: "FAIL",
+			Usage: "policy for malformed rows in CSV. one of: FAIL|SKIP|PAD",
+		},
+		cli.StringFlag{
+			Name:  "reject-file",
+			Usage: "write rows rejected by the on-parse-error policy to `FILE`",
+		},
 		cli.StringFlag{
 			Name:  "out, o",
 			Usage: "export result sets of select queries to `FILE`",
@@ -93,12 +137,12 @@ func main() {
 		cli.StringFlag{
 			Name:  "format, f",
 			Value: "TEXT",
-			Usage: "format of query results. one of: CSV|TSV|FIXED|JSON|LTSV|GFM|ORG|TEXT",
+			Usage: "format of query results. one of: CSV|TSV|FIXED|JSON|JSONL|LTSV|GFM|ORG|TEXT|PARQUET|XLSX",
 		},
 		cli.StringFlag{
 			Name:  "write-encoding, E",
 			Value: "UTF8",
-			Usage: "character encoding of query results. one of: UTF8|SJIS",
+			Usage: "character encoding of query results. one of: UTF8|UTF8BOM|UTF16LE|UTF16BE|SJIS|EUCJP|ISO2022JP|LATIN1|WINDOWS1252",
 		},
 		cli.StringFlag{
 			Name:  "write-delimiter, D",
@@ -202,6 +246,41 @@ func main() {
 				return NewExitError(fmt.Sprintf("Incorrect Usage: %s", err.Error()), 1)
 			},
 		},
+		{
+			Name:  "serve",
+			Usage: "Serve the repository to PostgreSQL clients",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "pg",
+					Value: "127.0.0.1:5432",
+					Usage: "`ADDRESS` to listen on for the PostgreSQL protocol",
+				},
+				cli.StringFlag{
+					Name:  "password",
+					Usage: "`PASSWORD` required for clients to connect (mandatory on non-loopback addresses)",
+				},
+				cli.BoolFlag{
+					Name:  "allow-outside-repository",
+					Usage: "allow clients to access files outside of the repository",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				addr := c.String("pg")
+				if len(addr) < 1 {
+					return NewExitError("listen address is not specified", 1)
+				}
+
+				err := action.Serve(addr, c.String("password"), c.Bool("allow-outside-repository"))
+				if err != nil {
+					return NewExitError(err.Error(), 1)
+				}
+
+				return nil
+			},
+			OnUsageError: func(c *cli.Context, err error, isSubcommand bool) error {
+				return NewExitError(fmt.Sprintf("Incorrect Usage: %s", err.Error()), 1)
+			},
+		},
 		{
 			Name:      "syntax",
 			Usage:     "Print syntax",
@@ -276,6 +355,23 @@ func main() {
 	app.Run(os.Args)
 }
 
+// noHeaderFlag is a boolean option that also accepts AUTO as its value.
+type noHeaderFlag struct {
+	cli.BoolFlag
+	Value *cmd.NoHeaderValue
+}
+
+func (f noHeaderFlag) Apply(set *flag.FlagSet) {
+	_ = f.ApplyWithError(set)
+}
+
+func (f noHeaderFlag) ApplyWithError(set *flag.FlagSet) error {
+	for _, name := range strings.Split(f.Name, ",") {
+		set.Var(f.Value, strings.TrimSpace(name), f.Usage)
+	}
+	return nil
+}
+
 func readQuery(c *cli.Context) (string, string, error) {
 	var queryString string
 	var path string
@@ -341,20 +437,55 @@ func overwriteFlags(c *cli.Context) error {
 	if c.IsSet("json-query") {
 		flags.SetJsonQuery(c.GlobalString("json-query"))
 	}
+	if c.IsSet("sheet") {
+		flags.SetSheet(c.GlobalString("sheet"))
+	}
 	if c.IsSet("encoding") {
 		if err := flags.SetEncoding(c.GlobalString("encoding")); err != nil {
 			return err
 		}
 	}
 	if c.IsSet("no-header") {
-		flags.SetNoHeader(c.GlobalBool("no-header"))
+		if err := flags.SetNoHeaderValue(c.GlobalGeneric("no-header").(*cmd.NoHeaderValue).String()); err != nil {
+			return err
+		}
+	}
+	if c.IsSet("detect-header") {
+		flags.SetDetectHeader(c.GlobalBool("detect-header"))
 	}
 	if c.IsSet("without-null") {
 		flags.SetWithoutNull(c.GlobalBool("without-null"))
 	}
+	if c.IsSet("quote-char") {
+		if err := flags.SetQuoteChar(c.GlobalString("quote-char")); err != nil {
+			return err
+		}
+	}
+	if c.IsSet("escape-style") {
+		if err := flags.SetEscapeStyle(c.GlobalString("escape-style")); err != nil {
+			return err
+		}
+	}
+	if c.IsSet("lazy-quotes") {
+		flags.SetLazyQuotes(c.GlobalBool("lazy-quotes"))
+	}
+	if c.IsSet("comment-prefix") {
+		flags.SetCommentPrefix(c.GlobalString("comment-prefix"))
+	}
+	if c.IsSet("skip-lines") {
+		flags.SetSkipLines(c.GlobalInt("skip-lines"))
+	}
+	if c.IsSet("on-parse-error") {
+		if err := flags.SetOnParseError(c.GlobalString("on-parse-error")); err != nil {
+			return err
+		}
+	}
+	if c.IsSet("reject-file") {
+		flags.SetRejectFile(c.GlobalString("reject-file"))
+	}
 
 	if c.IsSet("format") {
-		if err := flags.SetFormat(c.GlobalString("format"), c.GlobalString("out")); err != nil {
+		if err := flags.SetFormat(c.GlobalString("format"), file.TrimCompressionExt(c.GlobalString("out"))); err != nil {
 			return err
 		}
 	}